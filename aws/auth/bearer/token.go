@@ -2,26 +2,10 @@ package bearer
 
 import (
 	"github.com/aws/aws-sdk-go/aws"
-	"time"
 )
 
 // Token provides a type wrapping a bearer token and expiration metadata.
-type Token struct {
-	Value string
-
-	CanExpire bool
-	Expires   time.Time
-}
-
-// Expired returns if the token's Expires time is before or equal to the time
-// provided. If CanExpire is false, Expired will always return false.
-func (t Token) Expired(now time.Time) bool {
-	if !t.CanExpire {
-		return false
-	}
-	now = now.Round(0)
-	return now.Equal(t.Expires) || now.After(t.Expires)
-}
+type Token = aws.BearerToken
 
 // TokenProvider provides interface for retrieving bearer tokens.
 type TokenProvider interface {
@@ -0,0 +1,190 @@
+package bearer
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/internal/sync/singleflight"
+)
+
+// DefaultRefreshBeforeExpires is the duration before a token expires that
+// the TokenCache will start refreshing the token by default.
+const DefaultRefreshBeforeExpires = 5 * time.Minute
+
+// DefaultAsyncRefreshMinimumDelay is the minimum duration the TokenCache will
+// wait between asynchronous refresh attempts by default.
+const DefaultAsyncRefreshMinimumDelay = 30 * time.Second
+
+// TokenCacheOptions provides the options for configuring the TokenCache.
+type TokenCacheOptions struct {
+	// The duration before the token expires that the token will be refreshed
+	// in the background, while the cached token continues to be returned.
+	// Defaults to DefaultRefreshBeforeExpires if zero.
+	//
+	// A token that has expired is always refreshed synchronously.
+	RefreshBeforeExpires time.Duration
+
+	// The minimum duration between asynchronous refresh attempts. Prevents
+	// a failing provider from being called for every token retrieved within
+	// the refresh window. Defaults to DefaultAsyncRefreshMinimumDelay if
+	// zero.
+	AsyncRefreshMinimumDelay time.Duration
+
+	// Disables refreshing the token in the background before it expires.
+	// The token will only be refreshed once it has expired.
+	DisableAsyncRefresh bool
+}
+
+// TokenCache provides a utility for caching the bearer token retrieved from
+// a TokenProvider. The token is refreshed in the background when it is close
+// to expiring, so callers are not blocked waiting for the token to be
+// refreshed. If the token has expired, or was never retrieved, the token will
+// be retrieved synchronously.
+//
+// TokenCache is safe to use across multiple goroutines, and will ensure only
+// a single call to the wrapped provider is in flight at a time.
+//
+//	provider := bearer.NewTokenCache(ssocreds.NewSSOTokenProvider(client, cachedTokenFilepath))
+type TokenCache struct {
+	options  TokenCacheOptions
+	provider TokenProvider
+
+	sf singleflight.Group
+
+	m                sync.RWMutex
+	token            Token
+	hasToken         bool
+	lastAsyncRefresh time.Time
+
+	// nowTime returns the time value which represents the current time.
+	// This value should only be used for testing. If it is nil the default
+	// time.Now will be used.
+	nowTime func() time.Time
+}
+
+// NewTokenCache returns a TokenCache wrapping the provider. Additional
+// functional options can be provided to configure the cache.
+func NewTokenCache(provider TokenProvider, optFns ...func(*TokenCacheOptions)) *TokenCache {
+	options := TokenCacheOptions{
+		RefreshBeforeExpires:     DefaultRefreshBeforeExpires,
+		AsyncRefreshMinimumDelay: DefaultAsyncRefreshMinimumDelay,
+	}
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	if options.RefreshBeforeExpires == 0 {
+		options.RefreshBeforeExpires = DefaultRefreshBeforeExpires
+	}
+	if options.AsyncRefreshMinimumDelay == 0 {
+		options.AsyncRefreshMinimumDelay = DefaultAsyncRefreshMinimumDelay
+	}
+
+	return &TokenCache{
+		options:  options,
+		provider: provider,
+	}
+}
+
+// RetrieveBearerToken returns the cached token if it has not expired. If the
+// cached token is within the refresh window an asynchronous refresh will be
+// started, and the cached token returned. If the token has expired, or no
+// token is cached, the wrapped provider will be called to retrieve a new
+// token.
+func (c *TokenCache) RetrieveBearerToken(ctx aws.Context) (Token, error) {
+	now := c.now()
+
+	c.m.RLock()
+	token, ok := c.token, c.hasToken
+	c.m.RUnlock()
+
+	if !ok || token.Expired(now) {
+		return c.refresh(ctx)
+	}
+
+	if !c.options.DisableAsyncRefresh && token.Expired(now.Add(c.options.RefreshBeforeExpires)) {
+		c.tryAsyncRefresh()
+	}
+
+	return token, nil
+}
+
+// refresh retrieves a new token from the provider, and blocks until the
+// token is retrieved or the context is canceled.
+func (c *TokenCache) refresh(ctx aws.Context) (Token, error) {
+	// The context is not passed down to the provider, because the first
+	// caller's context would cancel the retrieve for every caller waiting
+	// on the same refresh.
+	resCh := c.sf.DoChan("", func() (interface{}, error) {
+		return c.singleRetrieve(&suppressedContext{ctx})
+	})
+
+	select {
+	case res := <-resCh:
+		if res.Err != nil {
+			return Token{}, res.Err
+		}
+		return res.Val.(Token), nil
+	case <-ctx.Done():
+		return Token{}, fmt.Errorf("retrieve bearer token canceled, %v", ctx.Err())
+	}
+}
+
+// tryAsyncRefresh starts a background refresh of the token, unless one was
+// attempted within the AsyncRefreshMinimumDelay.
+func (c *TokenCache) tryAsyncRefresh() {
+	now := c.now()
+
+	c.m.Lock()
+	if now.Sub(c.lastAsyncRefresh) < c.options.AsyncRefreshMinimumDelay {
+		c.m.Unlock()
+		return
+	}
+	c.lastAsyncRefresh = now
+	c.m.Unlock()
+
+	// Errors are ignored, the cached token will continue to be used until
+	// it expires, at which point the refresh will be retried synchronously.
+	c.sf.DoChan("", func() (interface{}, error) {
+		return c.singleRetrieve(aws.BackgroundContext())
+	})
+}
+
+func (c *TokenCache) singleRetrieve(ctx aws.Context) (interface{}, error) {
+	token, err := c.provider.RetrieveBearerToken(ctx)
+	if err != nil {
+		return Token{}, fmt.Errorf("failed to retrieve bearer token, %v", err)
+	}
+
+	c.m.Lock()
+	c.token = token
+	c.hasToken = true
+	c.m.Unlock()
+
+	return token, nil
+}
+
+func (c *TokenCache) now() time.Time {
+	if c.nowTime != nil {
+		return c.nowTime()
+	}
+	return time.Now()
+}
+
+type suppressedContext struct {
+	aws.Context
+}
+
+func (s *suppressedContext) Deadline() (deadline time.Time, ok bool) {
+	return time.Time{}, false
+}
+
+func (s *suppressedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (s *suppressedContext) Err() error {
+	return nil
+}
@@ -0,0 +1,185 @@
+//go:build go1.7
+// +build go1.7
+
+package bearer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+type countingProvider struct {
+	m       sync.Mutex
+	calls   int
+	expires time.Duration
+	err     error
+	now     func() time.Time
+	done    chan struct{}
+}
+
+func (p *countingProvider) RetrieveBearerToken(ctx aws.Context) (Token, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	if p.done != nil {
+		defer func() { p.done <- struct{}{} }()
+	}
+
+	p.calls++
+	if p.err != nil {
+		return Token{}, p.err
+	}
+	return Token{
+		Value:     fmt.Sprintf("token%d", p.calls),
+		CanExpire: true,
+		Expires:   p.now().Add(p.expires),
+	}, nil
+}
+
+func (p *countingProvider) Calls() int {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return p.calls
+}
+
+func TestTokenCache(t *testing.T) {
+	now := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	nowFn := func() time.Time { return now }
+
+	provider := &countingProvider{expires: 10 * time.Minute, now: nowFn}
+	cache := NewTokenCache(provider)
+	cache.nowTime = nowFn
+
+	expectToken := func(expect string) {
+		t.Helper()
+		token, err := cache.RetrieveBearerToken(context.Background())
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		if e, a := expect, token.Value; e != a {
+			t.Errorf("expect token %v, got %v", e, a)
+		}
+	}
+
+	expectToken("token1")
+	expectToken("token1")
+	if e, a := 1, provider.Calls(); e != a {
+		t.Errorf("expect %v calls, got %v", e, a)
+	}
+
+	// Within refresh window, cached token is returned and refreshed async.
+	provider.done = make(chan struct{}, 1)
+	now = now.Add(6 * time.Minute)
+	expectToken("token1")
+	select {
+	case <-provider.done:
+	case <-time.After(time.Second):
+		t.Fatalf("expect async refresh")
+	}
+	provider.done = nil
+	for i := 0; i < 100; i++ {
+		// The cache is updated after the provider returns.
+		if token, _ := cache.RetrieveBearerToken(context.Background()); token.Value == "token2" {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	expectToken("token2")
+
+	// Expired token is refreshed synchronously.
+	now = now.Add(11 * time.Minute)
+	expectToken("token3")
+	if e, a := 3, provider.Calls(); e != a {
+		t.Errorf("expect %v calls, got %v", e, a)
+	}
+}
+
+func TestTokenCache_AsyncRefreshMinimumDelay(t *testing.T) {
+	now := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	nowFn := func() time.Time { return now }
+
+	provider := &countingProvider{expires: 10 * time.Minute, now: nowFn}
+	cache := NewTokenCache(provider)
+	cache.nowTime = nowFn
+
+	if _, err := cache.RetrieveBearerToken(context.Background()); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	// Async refreshes fail, and are only retried after the minimum delay.
+	provider.err = fmt.Errorf("refresh failed")
+	provider.done = make(chan struct{}, 1)
+	now = now.Add(6 * time.Minute)
+	for i := 0; i < 3; i++ {
+		if _, err := cache.RetrieveBearerToken(context.Background()); err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+	}
+	<-provider.done
+	if e, a := 2, provider.Calls(); e != a {
+		t.Errorf("expect %v calls, got %v", e, a)
+	}
+
+	now = now.Add(DefaultAsyncRefreshMinimumDelay)
+	if _, err := cache.RetrieveBearerToken(context.Background()); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	<-provider.done
+	if e, a := 3, provider.Calls(); e != a {
+		t.Errorf("expect %v calls, got %v", e, a)
+	}
+
+	// Expired token returns the error of the synchronous refresh.
+	now = now.Add(10 * time.Minute)
+	_, err := cache.RetrieveBearerToken(context.Background())
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := "refresh failed", err.Error(); !strings.Contains(a, e) {
+		t.Errorf("expect error to contain %v, got %v", e, a)
+	}
+}
+
+func TestTokenCache_DisableAsyncRefresh(t *testing.T) {
+	now := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	nowFn := func() time.Time { return now }
+
+	provider := &countingProvider{expires: 10 * time.Minute, now: nowFn}
+	cache := NewTokenCache(provider, func(o *TokenCacheOptions) {
+		o.DisableAsyncRefresh = true
+	})
+	cache.nowTime = nowFn
+
+	for i := 0; i < 2; i++ {
+		if _, err := cache.RetrieveBearerToken(context.Background()); err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		now = now.Add(6 * time.Minute)
+	}
+
+	if e, a := 1, provider.Calls(); e != a {
+		t.Errorf("expect %v calls, got %v", e, a)
+	}
+}
+
+func TestTokenCache_ContextCanceled(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+
+	cache := NewTokenCache(TokenProviderFunc(func(ctx aws.Context) (Token, error) {
+		<-block
+		return Token{Value: "token"}, nil
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := cache.RetrieveBearerToken(ctx); err == nil {
+		t.Fatalf("expect error, got none")
+	}
+}
@@ -111,6 +111,16 @@ func (b baseError) OrigErrs() []error {
 	return b.errs
 }
 
+// Unwrap returns the first original error if one was set. Nil is returned if
+// no error was set. errors.Is and errors.As will match any of the original
+// errors of a batch, not only the first.
+func (b baseError) Unwrap() error {
+	if len(b.errs) == 0 {
+		return nil
+	}
+	return b.errs[0]
+}
+
 // So that the Error interface type can be included as an anonymous field
 // in the requestError struct and not conflict with the error.Error() method.
 type awsError Error
@@ -174,6 +184,11 @@ func (r requestError) OrigErrs() []error {
 	return []error{r.OrigErr()}
 }
 
+// Unwrap returns the wrapped Error.
+func (r requestError) Unwrap() error {
+	return r.awsError
+}
+
 type unmarshalError struct {
 	awsError
 	bytes []byte
@@ -197,6 +212,11 @@ func (e unmarshalError) Bytes() []byte {
 	return e.bytes
 }
 
+// Unwrap returns the wrapped Error.
+func (e unmarshalError) Unwrap() error {
+	return e.awsError
+}
+
 // An error list that satisfies the golang interface
 type errorList []error
 
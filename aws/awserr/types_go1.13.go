@@ -0,0 +1,31 @@
+//go:build go1.13
+// +build go1.13
+
+package awserr
+
+import "errors"
+
+// Is reports whether any of the original errors matches target.
+//
+// Used by errors.Is so that each error of a batch is compared with target.
+func (b baseError) Is(target error) bool {
+	for _, err := range b.errs {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first of the original errors that matches target, and if so,
+// sets target to that error value and returns true.
+//
+// Used by errors.As so that each error of a batch is compared with target.
+func (b baseError) As(target interface{}) bool {
+	for _, err := range b.errs {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+//go:build go1.13
+// +build go1.13
+
+package awserr
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+)
+
+func TestErrorIs(t *testing.T) {
+	opErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+
+	cases := map[string]struct {
+		Err    error
+		Target error
+		Expect bool
+	}{
+		"no original error": {
+			Err:    New("code", "message", nil),
+			Target: context.DeadlineExceeded,
+		},
+		"original error": {
+			Err:    New("code", "message", context.DeadlineExceeded),
+			Target: context.DeadlineExceeded,
+			Expect: true,
+		},
+		"nested error": {
+			Err:    New("outer", "message", New("inner", "message", context.Canceled)),
+			Target: context.Canceled,
+			Expect: true,
+		},
+		"request failure": {
+			Err: NewRequestFailure(
+				New("code", "message", context.DeadlineExceeded), 500, "reqID"),
+			Target: context.DeadlineExceeded,
+			Expect: true,
+		},
+		"unmarshal error": {
+			Err:    NewUnmarshalError(context.Canceled, "message", []byte("abc")),
+			Target: context.Canceled,
+			Expect: true,
+		},
+		"batched errors": {
+			Err: NewBatchError("code", "message", []error{
+				opErr, context.DeadlineExceeded,
+			}),
+			Target: context.DeadlineExceeded,
+			Expect: true,
+		},
+		"batched errors no match": {
+			Err: NewBatchError("code", "message", []error{
+				opErr, context.Canceled,
+			}),
+			Target: context.DeadlineExceeded,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if e, a := c.Expect, errors.Is(c.Err, c.Target); e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestErrorAs(t *testing.T) {
+	opErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+
+	cases := map[string]error{
+		"original error": New("code", "message", opErr),
+		"request failure": NewRequestFailure(
+			New("code", "message", opErr), 0, ""),
+		"batched errors": NewBatchError("code", "message", []error{
+			context.Canceled, opErr,
+		}),
+	}
+
+	for name, err := range cases {
+		t.Run(name, func(t *testing.T) {
+			var target *net.OpError
+			if !errors.As(err, &target) {
+				t.Fatalf("expect error to match %T", target)
+			}
+			if e, a := opErr, target; e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+
+			var reqErr RequestFailure
+			if e, a := name == "request failure", errors.As(err, &reqErr); e != a {
+				t.Errorf("expect RequestFailure match %v, got %v", e, a)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package aws
+
+import "time"
+
+// BearerToken provides a type wrapping a bearer token and expiration
+// metadata. The aws/auth/bearer package's Token is the same type.
+type BearerToken struct {
+	Value string
+
+	CanExpire bool
+	Expires   time.Time
+}
+
+// Expired returns if the token's Expires time is before or equal to the time
+// provided. If CanExpire is false, Expired will always return false.
+func (t BearerToken) Expired(now time.Time) bool {
+	if !t.CanExpire {
+		return false
+	}
+	now = now.Round(0)
+	return now.Equal(t.Expires) || now.After(t.Expires)
+}
+
+// BearerTokenProvider provides the interface for retrieving the bearer token
+// of Config.TokenProvider. It is declared in the aws package so the Config can
+// refer to it, and is satisfied by the aws/auth/bearer package's
+// TokenProvider.
+type BearerTokenProvider interface {
+	RetrieveBearerToken(Context) (BearerToken, error)
+}
@@ -0,0 +1,353 @@
+// Package circuitbreaker provides a circuit breaker for the requests made by
+// the SDK's API clients. A circuit is kept for each resolved endpoint host and
+// API operation. A circuit is opened when the rate of the retryable failures
+// of the attempts made within a window exceeds a threshold. While a circuit is
+// open, requests fail fast with the ErrCodeCircuitOpen error code, and failed
+// attempts are not retried. After the open duration elapses, the circuit is
+// half-open and a limited number of probe attempts are made. The circuit is
+// closed if a probe succeeds, and opened again if a probe fails.
+//
+// The circuit breaker can be enabled for all API clients created from a
+// Session with the session.Options CircuitBreaker field.
+//
+//	sess := session.Must(session.NewSessionWithOptions(session.Options{
+//		CircuitBreaker: circuitbreaker.New(),
+//	}))
+//
+// The handlers can also be added to a Session, or API client's handlers
+// directly with InjectHandlers.
+//
+//	breaker := circuitbreaker.New(func(o *circuitbreaker.Options) {
+//		o.FailureRateThreshold = 0.25
+//	})
+//	breaker.InjectHandlers(&svc.Handlers)
+package circuitbreaker
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// ErrCodeCircuitOpen is the error code of the errors returned for requests
+// which failed fast, because the circuit of their endpoint host and operation
+// was open.
+const ErrCodeCircuitOpen = "CircuitBreakerOpen"
+
+// Names of the request handlers added by InjectHandlers.
+const (
+	AllowHandlerName  = "awscircuitbreaker.AllowHandler"
+	RecordHandlerName = "awscircuitbreaker.RecordHandler"
+	RetryHandlerName  = "awscircuitbreaker.RetryHandler"
+)
+
+// Default option values of a Breaker.
+const (
+	DefaultFailureRateThreshold = 0.5
+	DefaultMinimumAttempts      = 20
+	DefaultWindow               = 10 * time.Second
+	DefaultOpenDuration         = 30 * time.Second
+	DefaultHalfOpenProbes       = 1
+)
+
+// Options are the options of a Breaker.
+type Options struct {
+	// The rate of retryable failures, of the attempts made within the window,
+	// at which the circuit is opened. Defaults to
+	// DefaultFailureRateThreshold.
+	FailureRateThreshold float64
+
+	// The minimum number of attempts which must be made within the window
+	// before the circuit can be opened. Defaults to DefaultMinimumAttempts.
+	MinimumAttempts int
+
+	// The duration of the window attempts are counted within. Defaults to
+	// DefaultWindow.
+	Window time.Duration
+
+	// The duration a circuit is open before it is half-open. Defaults to
+	// DefaultOpenDuration.
+	OpenDuration time.Duration
+
+	// The number of concurrent probe attempts allowed while a circuit is
+	// half-open. Defaults to DefaultHalfOpenProbes.
+	HalfOpenProbes int
+}
+
+// State is the state of a circuit.
+type State int
+
+// Enumeration of the states of a circuit.
+const (
+	// StateClosed is the state of a circuit which allows all attempts.
+	StateClosed State = iota
+
+	// StateOpen is the state of a circuit which fails all attempts fast.
+	StateOpen
+
+	// StateHalfOpen is the state of a circuit which allows a limited number
+	// of probe attempts.
+	StateHalfOpen
+)
+
+// String returns the string representation of the State.
+func (s State) String() string {
+	switch s {
+	case StateOpen:
+		return "Open"
+	case StateHalfOpen:
+		return "HalfOpen"
+	default:
+		return "Closed"
+	}
+}
+
+// A Breaker is a circuit breaker for the requests made by API clients. A
+// Breaker keeps a circuit for each endpoint host and API operation. A Breaker
+// is safe to use concurrently.
+type Breaker struct {
+	opts Options
+	now  func() time.Time
+
+	mu       sync.Mutex
+	circuits map[circuitKey]*circuit
+}
+
+// New returns a Breaker initialized with the default options, modified by
+// the functional options provided.
+func New(optFns ...func(*Options)) *Breaker {
+	opts := Options{
+		FailureRateThreshold: DefaultFailureRateThreshold,
+		MinimumAttempts:      DefaultMinimumAttempts,
+		Window:               DefaultWindow,
+		OpenDuration:         DefaultOpenDuration,
+		HalfOpenProbes:       DefaultHalfOpenProbes,
+	}
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	return &Breaker{
+		opts:     opts,
+		now:      time.Now,
+		circuits: map[circuitKey]*circuit{},
+	}
+}
+
+// State returns the state of the circuit for the endpoint host and API
+// operation.
+func (b *Breaker) State(host, operation string) State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, ok := b.circuits[circuitKey{host: host, operation: operation}]
+	if !ok {
+		return StateClosed
+	}
+	return c.currentState(b.now(), b.opts)
+}
+
+// InjectHandlers adds the Breaker's request handlers to the handlers.
+//
+// The Sign handler fails the request's attempt fast with the
+// ErrCodeCircuitOpen error code if the circuit for the request's endpoint
+// host and operation is open. The CompleteAttempt handler records the outcome
+// of the attempt. The Retry handler prevents the request's Retryer from
+// retrying a failed attempt if the circuit was opened.
+func (b *Breaker) InjectHandlers(handlers *request.Handlers) {
+	handlers.Sign.SetBackNamed(request.NamedHandler{
+		Name: AllowHandlerName,
+		Fn:   b.allowAttempt,
+	})
+	handlers.CompleteAttempt.SetBackNamed(recordHandler(nil, false))
+	handlers.Retry.SetFrontNamed(request.NamedHandler{
+		Name: RetryHandlerName,
+		Fn:   b.preventRetry,
+	})
+}
+
+func (b *Breaker) allowAttempt(r *request.Request) {
+	if r.Error != nil || r.IsPresigned() {
+		return
+	}
+
+	key := requestCircuitKey(r)
+
+	b.mu.Lock()
+	c, ok := b.circuits[key]
+	if !ok {
+		c = &circuit{breaker: b, windowStart: b.now()}
+		b.circuits[key] = c
+	}
+	allowed, probe := c.allow(b.now(), b.opts)
+	b.mu.Unlock()
+
+	if !allowed {
+		r.Error = awserr.New(ErrCodeCircuitOpen,
+			fmt.Sprintf("circuit breaker open for %s %s", key.host, key.operation),
+			nil)
+		r.Retryable = aws.Bool(false)
+		return
+	}
+
+	// Replaced for each attempt so the outcome is recorded to the circuit
+	// the attempt was allowed by.
+	r.Handlers.CompleteAttempt.SwapNamed(recordHandler(c, probe))
+}
+
+func (b *Breaker) preventRetry(r *request.Request) {
+	if r.Error == nil || r.IsPresigned() {
+		return
+	}
+	key := requestCircuitKey(r)
+	if b.State(key.host, key.operation) != StateClosed {
+		r.Retryable = aws.Bool(false)
+	}
+}
+
+func recordHandler(c *circuit, probe bool) request.NamedHandler {
+	return request.NamedHandler{
+		Name: RecordHandlerName,
+		Fn: func(r *request.Request) {
+			if c == nil {
+				return
+			}
+			c.record(isFailure(r), probe)
+		},
+	}
+}
+
+// isFailure returns whether the request's attempt failed with an error which
+// counts towards opening the circuit.
+func isFailure(r *request.Request) bool {
+	if r.Error == nil {
+		return false
+	}
+
+	switch r.ClassifyError().Category {
+	case request.ErrorCategoryCanceled, request.ErrorCategoryExpiredCreds,
+		request.ErrorCategoryClientFault:
+		return false
+	}
+	return r.IsErrorRetryable() || r.IsErrorThrottle()
+}
+
+type circuitKey struct {
+	host      string
+	operation string
+}
+
+func requestCircuitKey(r *request.Request) circuitKey {
+	var key circuitKey
+	if r.HTTPRequest != nil && r.HTTPRequest.URL != nil {
+		key.host = r.HTTPRequest.URL.Host
+	}
+	if r.Operation != nil {
+		key.operation = r.Operation.Name
+	}
+	return key
+}
+
+// A circuit is the state of a Breaker for an endpoint host and operation.
+// The breaker's mutex guards the circuit.
+type circuit struct {
+	breaker *Breaker
+
+	state    State
+	openedAt time.Time
+
+	windowStart time.Time
+	attempts    int
+	failures    int
+
+	probes     int
+	probeStart time.Time
+}
+
+func (c *circuit) currentState(now time.Time, opts Options) State {
+	if c.state == StateOpen && now.Sub(c.openedAt) >= opts.OpenDuration {
+		c.state = StateHalfOpen
+		c.probes = 0
+	}
+	return c.state
+}
+
+// allow returns whether an attempt is allowed, and if the attempt is a probe
+// of a half-open circuit.
+func (c *circuit) allow(now time.Time, opts Options) (allowed, probe bool) {
+	switch c.currentState(now, opts) {
+	case StateOpen:
+		return false, false
+	case StateHalfOpen:
+		// Probes which did not complete within the open duration, such as
+		// probes which failed to be signed, are abandoned.
+		if c.probes >= opts.HalfOpenProbes && now.Sub(c.probeStart) < opts.OpenDuration {
+			return false, false
+		} else if c.probes >= opts.HalfOpenProbes {
+			c.probes = 0
+		}
+		c.probes++
+		c.probeStart = now
+		return true, true
+	default:
+		return true, false
+	}
+}
+
+func (c *circuit) record(failure, probe bool) {
+	b := c.breaker
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+
+	if probe {
+		if c.state != StateHalfOpen {
+			return
+		}
+		if c.probes > 0 {
+			c.probes--
+		}
+		if failure {
+			c.open(now)
+		} else {
+			c.close(now)
+		}
+		return
+	}
+
+	if c.state != StateClosed {
+		return
+	}
+
+	if now.Sub(c.windowStart) >= b.opts.Window {
+		c.windowStart = now
+		c.attempts, c.failures = 0, 0
+	}
+	c.attempts++
+	if failure {
+		c.failures++
+	}
+
+	if c.attempts >= b.opts.MinimumAttempts &&
+		float64(c.failures)/float64(c.attempts) >= b.opts.FailureRateThreshold {
+		c.open(now)
+	}
+}
+
+func (c *circuit) open(now time.Time) {
+	c.state = StateOpen
+	c.openedAt = now
+	c.probes = 0
+}
+
+func (c *circuit) close(now time.Time) {
+	c.state = StateClosed
+	c.windowStart = now
+	c.attempts, c.failures = 0, 0
+	c.probes = 0
+}
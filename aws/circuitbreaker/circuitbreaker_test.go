@@ -0,0 +1,173 @@
+package circuitbreaker
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/corehandlers"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func newTestClient(t *testing.T, handler http.Handler) (*client.Client, string) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	def := defaults.Get()
+	def.Config.MergeIn(&aws.Config{
+		Endpoint:   aws.String(server.URL),
+		MaxRetries: aws.Int(2),
+		SleepDelay: func(time.Duration) {},
+	})
+
+	svc := client.New(*def.Config, metadata.ClientInfo{
+		ServiceID: "Mock",
+		Endpoint:  server.URL,
+	}, def.Handlers)
+	svc.Handlers.Clear()
+	svc.Handlers.Send.PushBackNamed(corehandlers.SendHandler)
+	svc.Handlers.ValidateResponse.PushBackNamed(corehandlers.ValidateResponseHandler)
+	svc.Handlers.AfterRetry.PushBackNamed(corehandlers.AfterRetryHandler)
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("expect valid server URL, %v", err)
+	}
+	return svc, u.Host
+}
+
+func TestBreaker(t *testing.T) {
+	var attempts, status int32 = 0, 500
+	svc, host := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(int(atomic.LoadInt32(&status)))
+	}))
+
+	now := time.Now()
+	breaker := New(func(o *Options) {
+		o.MinimumAttempts = 4
+		o.OpenDuration = time.Minute
+	})
+	breaker.now = func() time.Time { return now }
+	breaker.InjectHandlers(&svc.Handlers)
+
+	send := func(operation string) *request.Request {
+		r := svc.NewRequest(&request.Operation{Name: operation}, nil, nil)
+		r.Send()
+		return r
+	}
+
+	// Attempts of the first request are retried without opening the circuit.
+	if r := send("Operation"); r.Error == nil || r.RetryCount != 2 {
+		t.Fatalf("expect error after 2 retries, got %v, %v", r.RetryCount, r.Error)
+	}
+	if e, a := StateClosed, breaker.State(host, "Operation"); e != a {
+		t.Errorf("expect %v state, got %v", e, a)
+	}
+
+	// The failed attempt of the second request opens the circuit, and is not
+	// retried.
+	if r := send("Operation"); r.Error == nil || r.RetryCount != 0 {
+		t.Fatalf("expect error without retries, got %v, %v", r.RetryCount, r.Error)
+	}
+	if e, a := StateOpen, breaker.State(host, "Operation"); e != a {
+		t.Errorf("expect %v state, got %v", e, a)
+	}
+	if e, a := int32(4), atomic.LoadInt32(&attempts); e != a {
+		t.Errorf("expect %v attempts, got %v", e, a)
+	}
+
+	// Requests fail fast while the circuit is open.
+	r := send("Operation")
+	if aerr, ok := r.Error.(awserr.Error); !ok || aerr.Code() != ErrCodeCircuitOpen {
+		t.Fatalf("expect %v error, got %v", ErrCodeCircuitOpen, r.Error)
+	}
+	if e, a := int32(4), atomic.LoadInt32(&attempts); e != a {
+		t.Errorf("expect %v attempts, got %v", e, a)
+	}
+
+	// Circuits of other operations are not affected.
+	if e, a := StateClosed, breaker.State(host, "OtherOperation"); e != a {
+		t.Errorf("expect %v state, got %v", e, a)
+	}
+
+	// A failed probe opens the circuit again.
+	now = now.Add(time.Minute)
+	if e, a := StateHalfOpen, breaker.State(host, "Operation"); e != a {
+		t.Errorf("expect %v state, got %v", e, a)
+	}
+	if r := send("Operation"); r.Error == nil || r.RetryCount != 0 {
+		t.Fatalf("expect error without retries, got %v, %v", r.RetryCount, r.Error)
+	}
+	if e, a := StateOpen, breaker.State(host, "Operation"); e != a {
+		t.Errorf("expect %v state, got %v", e, a)
+	}
+
+	// A successful probe closes the circuit.
+	atomic.StoreInt32(&status, 200)
+	now = now.Add(time.Minute)
+	if r := send("Operation"); r.Error != nil {
+		t.Fatalf("expect no error, got %v", r.Error)
+	}
+	if e, a := StateClosed, breaker.State(host, "Operation"); e != a {
+		t.Errorf("expect %v state, got %v", e, a)
+	}
+}
+
+func TestBreaker_ClientFaultsNotCounted(t *testing.T) {
+	svc, host := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(400)
+	}))
+
+	breaker := New(func(o *Options) {
+		o.MinimumAttempts = 1
+	})
+	breaker.InjectHandlers(&svc.Handlers)
+
+	for i := 0; i < 5; i++ {
+		r := svc.NewRequest(&request.Operation{Name: "Operation"}, nil, nil)
+		if err := r.Send(); err == nil {
+			t.Fatalf("expect error, got none")
+		}
+	}
+	if e, a := StateClosed, breaker.State(host, "Operation"); e != a {
+		t.Errorf("expect %v state, got %v", e, a)
+	}
+}
+
+func TestBreaker_HalfOpenProbes(t *testing.T) {
+	now := time.Now()
+	breaker := New(func(o *Options) {
+		o.MinimumAttempts = 1
+		o.OpenDuration = time.Minute
+	})
+	breaker.now = func() time.Time { return now }
+
+	c := &circuit{breaker: breaker, windowStart: now}
+	c.record(true, false)
+	if e, a := StateOpen, c.state; e != a {
+		t.Fatalf("expect %v state, got %v", e, a)
+	}
+
+	now = now.Add(time.Minute)
+	if allowed, probe := c.allow(now, breaker.opts); !allowed || !probe {
+		t.Fatalf("expect probe allowed, got %v, %v", allowed, probe)
+	}
+	if allowed, _ := c.allow(now, breaker.opts); allowed {
+		t.Fatalf("expect second probe not allowed")
+	}
+
+	// Probes which never complete are abandoned after the open duration.
+	now = now.Add(time.Minute)
+	if allowed, probe := c.allow(now, breaker.opts); !allowed || !probe {
+		t.Fatalf("expect probe allowed, got %v, %v", allowed, probe)
+	}
+}
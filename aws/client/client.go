@@ -6,6 +6,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/client/metadata"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/internal/sdklog"
 )
 
 // A Config provides configuration to a service client instance.
@@ -61,14 +62,20 @@ func New(cfg aws.Config, info metadata.ClientInfo, handlers request.Handlers, op
 		svc.Retryer = retryer
 	case cfg.Retryer != nil && cfg.Logger != nil:
 		s := fmt.Sprintf("WARNING: %T does not implement request.Retryer; using DefaultRetryer instead", cfg.Retryer)
-		cfg.Logger.Log(s)
+		sdklog.Log(cfg.Logger, aws.LogSeverityWarn,
+			"retryer does not implement request.Retryer, using DefaultRetryer instead", s,
+			sdklog.Field("retryer", fmt.Sprintf("%T", cfg.Retryer)))
 		fallthrough
 	default:
 		maxRetries := aws.IntValue(cfg.MaxRetries)
 		if cfg.MaxRetries == nil || maxRetries == aws.UseServiceDefaultRetries {
 			maxRetries = DefaultRetryerMaxNumRetries
 		}
-		svc.Retryer = DefaultRetryer{NumMaxRetries: maxRetries}
+		svc.Retryer = NewDefaultRetryer(maxRetries, cfg.RetryMode)
+	}
+
+	if retryer, ok := svc.Retryer.(DefaultRetryer); ok {
+		retryer.addRetryModeHandlers(&svc.Handlers)
 	}
 
 	svc.AddDebugHandlers()
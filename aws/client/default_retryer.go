@@ -5,6 +5,8 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/internal/sdkrand"
 )
@@ -33,6 +35,22 @@ type DefaultRetryer struct {
 	// MaxThrottleDelay is the maximum retry delay when throttled.
 	// If not set, the value is 0ns.
 	MaxThrottleDelay time.Duration
+
+	// RetryQuota is the retry token bucket shared by all requests made with
+	// this retryer. Each retry attempt must take tokens from the bucket,
+	// and the request will not be retried if the bucket is empty. Successful
+	// requests return tokens to the bucket.
+	//
+	// If nil, the number of retries is only limited by NumMaxRetries. Set
+	// by NewDefaultRetryer for the standard and adaptive retry modes.
+	RetryQuota *RetryTokenBucket
+
+	// SendRateLimiter limits the rate request attempts are sent at based
+	// on the throttling errors returned by the service.
+	//
+	// If nil, the send rate is not limited. Set by NewDefaultRetryer for
+	// the adaptive retry mode.
+	SendRateLimiter *SendRateLimiter
 }
 
 const (
@@ -52,6 +70,28 @@ const (
 	DefaultRetryerMaxThrottleDelay = 300 * time.Second
 )
 
+// NewDefaultRetryer returns a DefaultRetryer configured for the retry mode
+// provided. The standard and adaptive retry modes will create a new retry
+// token bucket, and the adaptive retry mode a new send rate limiter, for the
+// retryer.
+//
+// The retry token bucket and send rate limiter are only used by a service
+// client's requests if the client was created with the retryer, see
+// client.New.
+func NewDefaultRetryer(numMaxRetries int, mode aws.RetryMode) DefaultRetryer {
+	r := DefaultRetryer{NumMaxRetries: numMaxRetries}
+
+	switch mode {
+	case aws.RetryModeStandard:
+		r.RetryQuota = NewRetryTokenBucket(DefaultRetryTokenBucketCapacity)
+	case aws.RetryModeAdaptive:
+		r.RetryQuota = NewRetryTokenBucket(DefaultRetryTokenBucketCapacity)
+		r.SendRateLimiter = NewSendRateLimiter()
+	}
+
+	return r
+}
+
 // MaxRetries returns the number of maximum returns the service will use to make
 // an individual API request.
 func (d DefaultRetryer) MaxRetries() int {
@@ -137,10 +177,115 @@ func (d DefaultRetryer) ShouldRetry(r *request.Request) bool {
 
 	// If one of the other handlers already set the retry state
 	// we don't want to override it based on the service's state
+	var retryable bool
 	if r.Retryable != nil {
-		return *r.Retryable
+		retryable = *r.Retryable
+	} else {
+		retryable = r.IsErrorRetryable() || r.IsErrorThrottle()
+	}
+
+	if !retryable || d.RetryQuota == nil || r.RetryCount >= d.NumMaxRetries {
+		return retryable
+	}
+	return d.acquireRetryQuota(r)
+}
+
+// acquireRetryQuota takes the retry cost of the request's error from the
+// retry token bucket. If the tokens were acquired a handler is added to the
+// request which will return them to the bucket if the retry attempt
+// succeeds.
+func (d DefaultRetryer) acquireRetryQuota(r *request.Request) bool {
+	cost := DefaultRetryCost
+	if isErrorTimeout(r.Error) {
+		cost = DefaultRetryTimeoutCost
+	}
+
+	if !d.RetryQuota.Acquire(cost) {
+		return false
+	}
+
+	quota := d.RetryQuota
+	r.Handlers.CompleteAttempt.SetBackNamed(request.NamedHandler{
+		Name: retryQuotaReleaseHandlerName,
+		Fn: func(r *request.Request) {
+			if r.Error == nil {
+				quota.Release(cost)
+			}
+		},
+	})
+
+	return true
+}
+
+const (
+	retryQuotaReleaseHandlerName   = "core.RetryQuotaReleaseHandler"
+	retryQuotaIncrementHandlerName = "core.RetryQuotaIncrementHandler"
+	sendRateAcquireHandlerName     = "core.SendRateAcquireHandler"
+	sendRateUpdateHandlerName      = "core.SendRateUpdateHandler"
+)
+
+// addRetryModeHandlers adds the request handlers for the retryer's retry
+// token bucket and send rate limiter to the handler list, if set.
+func (d DefaultRetryer) addRetryModeHandlers(handlers *request.Handlers) {
+	if quota := d.RetryQuota; quota != nil {
+		handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+			Name: retryQuotaIncrementHandlerName,
+			Fn: func(r *request.Request) {
+				if r.Error == nil && r.RetryCount == 0 {
+					quota.Release(DefaultNoRetryIncrement)
+				}
+			},
+		})
+	}
+
+	if limiter := d.SendRateLimiter; limiter != nil {
+		handlers.Sign.PushBackNamed(request.NamedHandler{
+			Name: sendRateAcquireHandlerName,
+			Fn: func(r *request.Request) {
+				if r.IsPresigned() {
+					return
+				}
+				if err := limiter.Acquire(r.Context(), 1); err != nil {
+					r.Error = awserr.New(request.CanceledErrorCode,
+						"request context canceled", err)
+					r.Retryable = aws.Bool(false)
+				}
+			},
+		})
+		handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+			Name: sendRateUpdateHandlerName,
+			Fn: func(r *request.Request) {
+				limiter.Update(r.Error != nil && r.IsErrorThrottle())
+			},
+		})
+	}
+}
+
+type timeoutError interface {
+	Timeout() bool
+}
+
+// isErrorTimeout returns if the error, or an error it wraps, is a timeout
+// error.
+func isErrorTimeout(err error) bool {
+	for err != nil {
+		if t, ok := err.(timeoutError); ok && t.Timeout() {
+			return true
+		}
+
+		aerr, ok := err.(awserr.Error)
+		if !ok {
+			return false
+		}
+		switch aerr.Code() {
+		case request.ErrCodeResponseTimeout, request.ErrCodeAttemptTimeout,
+			"RequestTimeout", "RequestTimeoutException":
+			return true
+		}
+		err = aerr.OrigErr()
 	}
-	return r.IsErrorRetryable() || r.IsErrorThrottle()
+
+	return false
 }
 
 // This will look in the Retry-After header, RFC 7231, for how long
@@ -5,6 +5,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
 	"github.com/aws/aws-sdk-go/aws/request"
 )
 
@@ -197,3 +200,88 @@ func TestRetryDelay(t *testing.T) {
 	}
 
 }
+
+func TestDefaultRetryer_RetryQuota(t *testing.T) {
+	retryer := NewDefaultRetryer(3, aws.RetryModeStandard)
+	retryer.RetryQuota = NewRetryTokenBucket(12)
+
+	var failAttempts int
+	handlers := request.Handlers{}
+	handlers.Send.PushBack(func(r *request.Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 200}
+		if r.RetryCount < failAttempts {
+			r.HTTPResponse.StatusCode = 500
+			r.Error = awserr.New(request.ErrCodeRequestError, "send request failed", nil)
+		}
+	})
+	handlers.AfterRetry.PushBack(func(r *request.Request) {
+		r.Retryable = aws.Bool(r.ShouldRetry(r))
+		if r.WillRetry() {
+			r.RetryCount++
+			r.Error = nil
+		}
+	})
+
+	c := New(*request.WithRetryer(aws.NewConfig(), retryer), metadata.ClientInfo{}, handlers)
+
+	cases := []struct {
+		FailAttempts     int
+		ExpectErr        bool
+		ExpectRetryCount int
+		ExpectAvailable  int
+	}{
+		// Successful request after a retry returns the acquired tokens.
+		{FailAttempts: 1, ExpectRetryCount: 1, ExpectAvailable: 12},
+		// Retries stop when the bucket cannot cover the retry cost.
+		{FailAttempts: 4, ExpectErr: true, ExpectRetryCount: 2, ExpectAvailable: 2},
+		// Successful request without retries adds tokens to the bucket.
+		{FailAttempts: 0, ExpectAvailable: 3},
+	}
+
+	for i, c2 := range cases {
+		failAttempts = c2.FailAttempts
+		req := c.NewRequest(&request.Operation{Name: "Operation"}, nil, nil)
+		err := req.Send()
+		if (err != nil) != c2.ExpectErr {
+			t.Fatalf("%d, expect error %v, got %v", i, c2.ExpectErr, err)
+		}
+		if e, a := c2.ExpectRetryCount, req.RetryCount; e != a {
+			t.Errorf("%d, expect %v retries, got %v", i, e, a)
+		}
+		if e, a := c2.ExpectAvailable, retryer.RetryQuota.Available(); e != a {
+			t.Errorf("%d, expect %v tokens available, got %v", i, e, a)
+		}
+	}
+}
+
+func TestNewClient_RetryMode(t *testing.T) {
+	cases := map[aws.RetryMode]struct {
+		ExpectQuota   bool
+		ExpectLimiter bool
+	}{
+		aws.RetryModeUnset:    {},
+		aws.RetryModeLegacy:   {},
+		aws.RetryModeStandard: {ExpectQuota: true},
+		aws.RetryModeAdaptive: {ExpectQuota: true, ExpectLimiter: true},
+	}
+
+	for mode, c := range cases {
+		t.Run(mode.String(), func(t *testing.T) {
+			svc := New(*aws.NewConfig().WithRetryMode(mode), metadata.ClientInfo{}, request.Handlers{})
+
+			retryer, ok := svc.Retryer.(DefaultRetryer)
+			if !ok {
+				t.Fatalf("expect DefaultRetryer, got %T", svc.Retryer)
+			}
+			if e, a := c.ExpectQuota, retryer.RetryQuota != nil; e != a {
+				t.Errorf("expect retry quota %v, got %v", e, a)
+			}
+			if e, a := c.ExpectLimiter, retryer.SendRateLimiter != nil; e != a {
+				t.Errorf("expect send rate limiter %v, got %v", e, a)
+			}
+			if e, a := c.ExpectLimiter, svc.Handlers.Sign.Len() == 1; e != a {
+				t.Errorf("expect send rate handler %v, got %v", e, a)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/internal/sdklog"
 )
 
 const logReqMsg = `DEBUG: Request %s/%s Details:
@@ -60,14 +61,19 @@ func logRequest(r *request.Request) {
 	logBody := r.Config.LogLevel.Matches(aws.LogDebugWithHTTPBody)
 	bodySeekable := aws.IsReaderSeekable(r.Body)
 
-	b, err := httputil.DumpRequestOut(r.HTTPRequest, logBody)
+	redactor := sdklog.NewRedactor(r.Params)
+	dumpReq := redactor.RedactRequest(r.HTTPRequest)
+
+	b, err := httputil.DumpRequestOut(dumpReq, logBody)
 	if err != nil {
-		r.Config.Logger.Log(fmt.Sprintf(logReqErrMsg,
-			r.ClientInfo.ServiceName, r.Operation.Name, err))
+		logRequestError(r, logReqErrMsg, err)
 		return
 	}
 
 	if logBody {
+		// The dumped request's body was read, and replaced with a reader of
+		// the bytes read.
+		r.HTTPRequest.Body = dumpReq.Body
 		if !bodySeekable {
 			r.SetReaderBody(aws.ReadSeekCloser(r.HTTPRequest.Body))
 		}
@@ -75,14 +81,13 @@ func logRequest(r *request.Request) {
 		// r.HTTPRequest's Body as a NoOpCloser and will not be reset after
 		// read by the HTTP client reader.
 		if err := r.Error; err != nil {
-			r.Config.Logger.Log(fmt.Sprintf(logReqErrMsg,
-				r.ClientInfo.ServiceName, r.Operation.Name, err))
+			logRequestError(r, logReqErrMsg, err)
 			return
 		}
+		b = redactor.RedactBody(b)
 	}
 
-	r.Config.Logger.Log(fmt.Sprintf(logReqMsg,
-		r.ClientInfo.ServiceName, r.Operation.Name, string(b)))
+	logHTTPMessage(r, "HTTP request", logReqMsg, sdklog.FieldHTTPRequest, b)
 }
 
 // LogHTTPRequestHeaderHandler is a SDK request handler to log the HTTP request sent
@@ -98,15 +103,14 @@ func logRequestHeader(r *request.Request) {
 		return
 	}
 
-	b, err := httputil.DumpRequestOut(r.HTTPRequest, false)
+	redactor := sdklog.NewRedactor(r.Params)
+	b, err := httputil.DumpRequestOut(redactor.RedactRequest(r.HTTPRequest), false)
 	if err != nil {
-		r.Config.Logger.Log(fmt.Sprintf(logReqErrMsg,
-			r.ClientInfo.ServiceName, r.Operation.Name, err))
+		logRequestError(r, logReqErrMsg, err)
 		return
 	}
 
-	r.Config.Logger.Log(fmt.Sprintf(logReqMsg,
-		r.ClientInfo.ServiceName, r.Operation.Name, string(b)))
+	logHTTPMessage(r, "HTTP request", logReqMsg, sdklog.FieldHTTPRequest, b)
 }
 
 const logRespMsg = `DEBUG: Response %s/%s Details:
@@ -135,8 +139,7 @@ func logResponse(r *request.Request) {
 	lw := &logWriter{r.Config.Logger, bytes.NewBuffer(nil)}
 
 	if r.HTTPResponse == nil {
-		lw.Logger.Log(fmt.Sprintf(logRespErrMsg,
-			r.ClientInfo.ServiceName, r.Operation.Name, "request's HTTPResponse is nil"))
+		logRequestError(r, logRespErrMsg, fmt.Errorf("request's HTTPResponse is nil"))
 		return
 	}
 
@@ -149,25 +152,25 @@ func logResponse(r *request.Request) {
 	}
 
 	handlerFn := func(req *request.Request) {
-		b, err := httputil.DumpResponse(req.HTTPResponse, false)
+		redactor := sdklog.NewRedactor(req.Data)
+		b, err := httputil.DumpResponse(redactor.RedactResponse(req.HTTPResponse), false)
 		if err != nil {
-			lw.Logger.Log(fmt.Sprintf(logRespErrMsg,
-				req.ClientInfo.ServiceName, req.Operation.Name, err))
+			logRequestError(req, logRespErrMsg, err)
 			return
 		}
 
-		lw.Logger.Log(fmt.Sprintf(logRespMsg,
-			req.ClientInfo.ServiceName, req.Operation.Name, string(b)))
+		logHTTPMessage(req, "HTTP response", logRespMsg, sdklog.FieldHTTPResponse, b)
 
 		if logBody {
 			b, err := ioutil.ReadAll(lw.buf)
 			if err != nil {
-				lw.Logger.Log(fmt.Sprintf(logRespErrMsg,
-					req.ClientInfo.ServiceName, req.Operation.Name, err))
+				logRequestError(req, logRespErrMsg, err)
 				return
 			}
 
-			lw.Logger.Log(string(b))
+			b = redactor.RedactBody(b)
+			sdklog.Log(lw.Logger, aws.LogSeverityDebug, "HTTP response body", string(b),
+				append(logFields(req), sdklog.Field(sdklog.FieldHTTPBody, string(b)))...)
 		}
 	}
 
@@ -194,13 +197,35 @@ func logResponseHeader(r *request.Request) {
 		return
 	}
 
-	b, err := httputil.DumpResponse(r.HTTPResponse, false)
+	redactor := sdklog.NewRedactor(r.Data)
+	b, err := httputil.DumpResponse(redactor.RedactResponse(r.HTTPResponse), false)
 	if err != nil {
-		r.Config.Logger.Log(fmt.Sprintf(logRespErrMsg,
-			r.ClientInfo.ServiceName, r.Operation.Name, err))
+		logRequestError(r, logRespErrMsg, err)
 		return
 	}
 
-	r.Config.Logger.Log(fmt.Sprintf(logRespMsg,
-		r.ClientInfo.ServiceName, r.Operation.Name, string(b)))
+	logHTTPMessage(r, "HTTP response", logRespMsg, sdklog.FieldHTTPResponse, b)
+}
+
+// logHTTPMessage logs the dumped HTTP request or response of the request.
+// The legacy message format is used for loggers that are not structured.
+func logHTTPMessage(r *request.Request, msg, legacyFormat, key string, dump []byte) {
+	sdklog.Log(r.Config.Logger, aws.LogSeverityDebug, msg,
+		fmt.Sprintf(legacyFormat, r.ClientInfo.ServiceName, r.Operation.Name, string(dump)),
+		append(logFields(r), sdklog.Field(key, string(dump)))...)
+}
+
+// logRequestError logs the error encountered while logging the HTTP request
+// or response of the request.
+func logRequestError(r *request.Request, legacyFormat string, err error) {
+	sdklog.Log(r.Config.Logger, aws.LogSeverityError, "failed to log HTTP message",
+		fmt.Sprintf(legacyFormat, r.ClientInfo.ServiceName, r.Operation.Name, err),
+		append(logFields(r), sdklog.Field(sdklog.FieldError, err))...)
+}
+
+func logFields(r *request.Request) []aws.LogField {
+	return []aws.LogField{
+		sdklog.Field(sdklog.FieldService, r.ClientInfo.ServiceName),
+		sdklog.Field(sdklog.FieldOperation, r.Operation.Name),
+	}
 }
@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -210,6 +211,100 @@ func TestLogResponse(t *testing.T) {
 	}
 }
 
+type redactInput struct {
+	_ struct{} `type:"structure"`
+
+	Name     *string `type:"string"`
+	Password *string `type:"string" sensitive:"true"`
+}
+
+type structuredBufLogger struct {
+	bufLogger
+	fields []aws.LogField
+}
+
+func (l *structuredBufLogger) LogFields(severity aws.LogSeverity, msg string, fields ...aws.LogField) {
+	l.fields = append(l.fields, fields...)
+	fmt.Fprintln(l.w, severity, msg)
+}
+
+func TestLogRequest_Redacted(t *testing.T) {
+	cases := map[string]struct {
+		Logger    func(*bytes.Buffer) aws.Logger
+		ExpectKey string
+	}{
+		"legacy logger": {
+			Logger: func(w *bytes.Buffer) aws.Logger { return &bufLogger{w: w} },
+		},
+		"structured logger": {
+			Logger:    func(w *bytes.Buffer) aws.Logger { return &structuredBufLogger{bufLogger: bufLogger{w: w}} },
+			ExpectKey: "http_request",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			logW := bytes.NewBuffer(nil)
+			logger := c.Logger(logW)
+			req := request.New(
+				aws.Config{
+					Credentials: credentials.AnonymousCredentials,
+					Logger:      logger,
+					LogLevel:    aws.LogLevel(aws.LogDebugWithHTTPBody),
+				},
+				metadata.ClientInfo{
+					Endpoint: "https://mock-service.mock-region.amazonaws.com",
+				},
+				testHandlers(),
+				nil,
+				&request.Operation{
+					Name:       "APIName",
+					HTTPMethod: "POST",
+					HTTPPath:   "/",
+				},
+				&redactInput{}, nil,
+			)
+			req.SetStringBody(`{"Name":"name","Password":"hunter2"}`)
+			req.Build()
+			req.HTTPRequest.Header.Set("Content-Type", "application/json")
+			req.HTTPRequest.Header.Set("Authorization", "AWS4-HMAC-SHA256 Signature=abc")
+
+			logRequest(req)
+
+			var logged string
+			if sl, ok := logger.(*structuredBufLogger); ok {
+				for _, f := range sl.fields {
+					if f.Key == c.ExpectKey {
+						logged = f.Value.(string)
+					}
+				}
+			} else {
+				logged = logW.String()
+			}
+
+			for _, secret := range []string{"hunter2", "Signature=abc"} {
+				if strings.Contains(logged, secret) {
+					t.Errorf("expect %q to be redacted, got\n%s", secret, logged)
+				}
+			}
+			if e, a := `"Name":"name"`, logged; !strings.Contains(a, e) {
+				t.Errorf("expect %q to be logged, got\n%s", e, a)
+			}
+
+			b, err := ioutil.ReadAll(req.HTTPRequest.Body)
+			if err != nil {
+				t.Fatalf("expect to read SDK request Body")
+			}
+			if e, a := `{"Name":"name","Password":"hunter2"}`, string(b); e != a {
+				t.Errorf("expect %v body, got %v", e, a)
+			}
+			if e, a := "AWS4-HMAC-SHA256 Signature=abc", req.HTTPRequest.Header.Get("Authorization"); e != a {
+				t.Errorf("expect request header not to be modified, got %v", a)
+			}
+		})
+	}
+}
+
 type bufLogger struct {
 	w *bytes.Buffer
 }
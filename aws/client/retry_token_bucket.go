@@ -0,0 +1,80 @@
+package client
+
+import (
+	"sync"
+)
+
+const (
+	// DefaultRetryTokenBucketCapacity is the number of tokens available in
+	// a service client's retry token bucket when the standard, or adaptive
+	// retry mode is used.
+	DefaultRetryTokenBucketCapacity = 500
+
+	// DefaultRetryCost is the number of tokens a retry attempt will take
+	// from the retry token bucket.
+	DefaultRetryCost = 5
+
+	// DefaultRetryTimeoutCost is the number of tokens a retry attempt will
+	// take from the retry token bucket if the request failed with a timeout
+	// error.
+	DefaultRetryTimeoutCost = 10
+
+	// DefaultNoRetryIncrement is the number of tokens returned to the retry
+	// token bucket when a request succeeds without being retried.
+	DefaultNoRetryIncrement = 1
+)
+
+// RetryTokenBucket provides a concurrency safe bucket of retry tokens shared
+// by all requests made by a service client. Each retry attempt must take
+// tokens from the bucket. When the bucket is empty the request will not be
+// retried. Successful requests return tokens to the bucket.
+//
+// The RetryTokenBucket prevents a service client from amplifying the load
+// on a service that is failing a large number of requests.
+type RetryTokenBucket struct {
+	mu        sync.Mutex
+	capacity  int
+	available int
+}
+
+// NewRetryTokenBucket returns an initialized RetryTokenBucket filled to the
+// capacity provided.
+func NewRetryTokenBucket(capacity int) *RetryTokenBucket {
+	return &RetryTokenBucket{
+		capacity:  capacity,
+		available: capacity,
+	}
+}
+
+// Acquire attempts to take amount tokens from the bucket. Returns false,
+// and takes no tokens, if the bucket does not have enough tokens available.
+func (b *RetryTokenBucket) Acquire(amount int) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if amount > b.available {
+		return false
+	}
+	b.available -= amount
+	return true
+}
+
+// Release returns amount tokens to the bucket. The bucket will never hold
+// more tokens than its capacity.
+func (b *RetryTokenBucket) Release(amount int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.available += amount
+	if b.available > b.capacity {
+		b.available = b.capacity
+	}
+}
+
+// Available returns the number of tokens currently in the bucket.
+func (b *RetryTokenBucket) Available() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.available
+}
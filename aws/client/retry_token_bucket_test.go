@@ -0,0 +1,28 @@
+package client
+
+import "testing"
+
+func TestRetryTokenBucket(t *testing.T) {
+	b := NewRetryTokenBucket(10)
+
+	if !b.Acquire(DefaultRetryTimeoutCost) {
+		t.Fatalf("expect tokens to be acquired")
+	}
+	if e, a := 0, b.Available(); e != a {
+		t.Errorf("expect %v tokens available, got %v", e, a)
+	}
+
+	if b.Acquire(DefaultRetryCost) {
+		t.Fatalf("expect tokens not to be acquired from empty bucket")
+	}
+
+	b.Release(DefaultRetryCost)
+	if e, a := 5, b.Available(); e != a {
+		t.Errorf("expect %v tokens available, got %v", e, a)
+	}
+
+	b.Release(DefaultRetryTimeoutCost)
+	if e, a := 10, b.Available(); e != a {
+		t.Errorf("expect bucket capped at %v tokens, got %v", e, a)
+	}
+}
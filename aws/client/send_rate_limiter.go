@@ -0,0 +1,176 @@
+package client
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+const (
+	sendRateSmooth        = 0.8
+	sendRateBeta          = 0.7
+	sendRateScaleConstant = 0.4
+	sendRateMinFillRate   = 0.5
+	sendRateMinCapacity   = 1
+)
+
+// SendRateLimiter provides a concurrency safe client side rate limiter for
+// the requests made by a service client. The limiter is disabled until the
+// first throttling error is reported. Once enabled the allowed send rate is
+// adjusted with a CUBIC algorithm. The rate is reduced multiplicatively when
+// requests are throttled, and grows back towards, and then beyond, the rate
+// where throttling was last seen as requests succeed.
+//
+// A SendRateLimiter is used by the DefaultRetryer when the adaptive retry
+// mode is enabled.
+type SendRateLimiter struct {
+	mu sync.Mutex
+
+	enabled bool
+
+	fillRate       float64
+	maxCapacity    float64
+	currentCap     float64
+	lastRefilled   time.Time
+	calculatedRate float64
+
+	measuredTxRate   float64
+	lastTxRateBucket float64
+	requestCount     int64
+
+	lastMaxRate      float64
+	lastThrottleTime time.Time
+	timeWindow       float64
+
+	// Returns the current time. Used by tests to control the passage of
+	// time.
+	now func() time.Time
+}
+
+// NewSendRateLimiter returns an initialized SendRateLimiter. The limiter will
+// not limit the rate requests are sent at until a throttled request is
+// reported with Update.
+func NewSendRateLimiter() *SendRateLimiter {
+	return newSendRateLimiter(time.Now)
+}
+
+func newSendRateLimiter(now func() time.Time) *SendRateLimiter {
+	t := now()
+	return &SendRateLimiter{
+		lastTxRateBucket: math.Floor(timeSeconds(t)),
+		lastThrottleTime: t,
+		now:              now,
+	}
+}
+
+// Acquire blocks until amount send tokens are available, or the context is
+// canceled. Returns immediately if the limiter has not been enabled by a
+// throttled request.
+func (l *SendRateLimiter) Acquire(ctx aws.Context, amount float64) error {
+	for {
+		ok, delay := l.tryAcquire(amount)
+		if ok {
+			return nil
+		}
+		if err := aws.SleepWithContext(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// tryAcquire attempts to take amount tokens from the limiter's bucket. If
+// not enough tokens are available the amount of time to wait before trying
+// again is returned.
+func (l *SendRateLimiter) tryAcquire(amount float64) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.enabled {
+		return true, 0
+	}
+
+	l.refill()
+	if amount > l.currentCap {
+		wait := (amount - l.currentCap) / l.fillRate
+		return false, durationSeconds(wait)
+	}
+	l.currentCap -= amount
+	return true, 0
+}
+
+// Update adjusts the allowed send rate based on the outcome of a request
+// attempt. The throttled parameter should be true if the attempt failed
+// because the request was throttled by the service.
+func (l *SendRateLimiter) Update(throttled bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.updateMeasuredRate()
+
+	if throttled {
+		rateToUse := l.measuredTxRate
+		if l.enabled {
+			rateToUse = math.Min(rateToUse, l.fillRate)
+		}
+		l.lastMaxRate = rateToUse
+		l.calculateTimeWindow()
+		l.lastThrottleTime = l.now()
+		l.calculatedRate = rateToUse * sendRateBeta
+		l.enabled = true
+	} else {
+		l.calculateTimeWindow()
+		l.calculatedRate = l.cubicSuccess(l.now())
+	}
+
+	l.updateRate(math.Min(l.calculatedRate, 2*l.measuredTxRate))
+}
+
+func (l *SendRateLimiter) cubicSuccess(t time.Time) float64 {
+	dt := t.Sub(l.lastThrottleTime).Seconds()
+	return sendRateScaleConstant*math.Pow(dt-l.timeWindow, 3) + l.lastMaxRate
+}
+
+func (l *SendRateLimiter) calculateTimeWindow() {
+	l.timeWindow = math.Cbrt(l.lastMaxRate * (1 - sendRateBeta) / sendRateScaleConstant)
+}
+
+func (l *SendRateLimiter) updateRate(rate float64) {
+	l.refill()
+	l.fillRate = math.Max(rate, sendRateMinFillRate)
+	l.maxCapacity = math.Max(rate, sendRateMinCapacity)
+	l.currentCap = math.Min(l.currentCap, l.maxCapacity)
+}
+
+func (l *SendRateLimiter) updateMeasuredRate() {
+	t := l.now()
+	timeBucket := math.Floor(timeSeconds(t)*2) / 2
+	l.requestCount++
+
+	if timeBucket > l.lastTxRateBucket {
+		currentRate := float64(l.requestCount) / (timeBucket - l.lastTxRateBucket)
+		l.measuredTxRate = currentRate*sendRateSmooth + l.measuredTxRate*(1-sendRateSmooth)
+		l.requestCount = 0
+		l.lastTxRateBucket = timeBucket
+	}
+}
+
+func (l *SendRateLimiter) refill() {
+	t := l.now()
+	if l.lastRefilled.IsZero() {
+		l.lastRefilled = t
+		return
+	}
+
+	l.currentCap = math.Min(l.maxCapacity, l.currentCap+t.Sub(l.lastRefilled).Seconds()*l.fillRate)
+	l.lastRefilled = t
+}
+
+func timeSeconds(t time.Time) float64 {
+	return float64(t.UnixNano()) / float64(time.Second)
+}
+
+func durationSeconds(v float64) time.Duration {
+	return time.Duration(v * float64(time.Second))
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSendRateLimiter_DisabledUntilThrottled(t *testing.T) {
+	now := time.Unix(1000, 0)
+	l := newSendRateLimiter(func() time.Time { return now })
+
+	for i := 0; i < 100; i++ {
+		if ok, _ := l.tryAcquire(1); !ok {
+			t.Fatalf("expect limiter to not limit requests before throttle")
+		}
+		l.Update(false)
+	}
+}
+
+func TestSendRateLimiter_Throttled(t *testing.T) {
+	now := time.Unix(1000, 0)
+	l := newSendRateLimiter(func() time.Time { return now })
+
+	// Establish a measured send rate of 10 requests per second.
+	for i := 0; i < 20; i++ {
+		now = now.Add(100 * time.Millisecond)
+		l.Update(false)
+	}
+	l.Update(true)
+
+	if !l.enabled {
+		t.Fatalf("expect limiter to be enabled after throttle")
+	}
+	throttledRate := l.fillRate
+	if throttledRate <= 0 || throttledRate >= l.lastMaxRate {
+		t.Errorf("expect fill rate reduced below %v, got %v", l.lastMaxRate, throttledRate)
+	}
+
+	var limited bool
+	for i := 0; i < 100 && !limited; i++ {
+		ok, delay := l.tryAcquire(1)
+		if !ok {
+			limited = true
+			if delay <= 0 {
+				t.Errorf("expect positive retry delay, got %v", delay)
+			}
+		}
+	}
+	if !limited {
+		t.Errorf("expect limiter to limit requests after throttle")
+	}
+
+	// Rate recovers as requests succeed.
+	for i := 0; i < 50; i++ {
+		now = now.Add(100 * time.Millisecond)
+		l.Update(false)
+	}
+	if l.fillRate <= throttledRate {
+		t.Errorf("expect fill rate to recover above %v, got %v", throttledRate, l.fillRate)
+	}
+}
+
+func TestSendRateLimiter_AcquireCanceled(t *testing.T) {
+	l := NewSendRateLimiter()
+	l.Update(true)
+
+	// Drain the bucket, the limiter's minimum fill rate is very low.
+	for {
+		if ok, _ := l.tryAcquire(1); !ok {
+			break
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := l.Acquire(ctx, 1); err == nil {
+		t.Fatalf("expect error for canceled context")
+	}
+}
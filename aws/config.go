@@ -17,6 +17,10 @@ const UseServiceDefaultRetries = -1
 // interface.
 type RequestRetryer interface{}
 
+// RequestTracer is an alias for a type that implements the request.Tracer
+// interface.
+type RequestTracer interface{}
+
 // A Config provides service configuration for service clients. By default,
 // all clients will use the defaults.DefaultConfig structure.
 //
@@ -41,6 +45,16 @@ type Config struct {
 	// variables, shared credential file, and EC2 Instance Roles.
 	Credentials *credentials.Credentials
 
+	// The bearer token provider to use when signing requests for API
+	// operations that are authenticated with a bearer token, instead of
+	// credentials. Only used by service clients that support bearer token
+	// authentication. The provider should cache the token, e.g. by wrapping
+	// it with bearer.NewTokenCache.
+	//
+	// When created by a Session, defaults to the SSO token of the shared
+	// config profile's sso-session, if one is configured.
+	TokenProvider BearerTokenProvider
+
 	// An optional endpoint URL (hostname only or fully qualified URI)
 	// that overrides the default generated endpoint for a client. Set this
 	// to `nil` or the value to `""` to use the default generated endpoint.
@@ -107,6 +121,54 @@ type Config struct {
 	//
 	Retryer RequestRetryer
 
+	// RetryMode selects the retry behavior of the client.DefaultRetryer the
+	// SDK creates for service clients when Retryer is not set.
+	//
+	// RetryModeStandard limits the number of retries a service client will
+	// make with a retry token bucket. RetryModeAdaptive additionally limits
+	// the rate requests are sent at when the service responds with throttling
+	// errors. Defaults to RetryModeLegacy.
+	//
+	// Can also be set with the AWS_RETRY_MODE environment variable, or the
+	// retry_mode shared config key.
+	RetryMode RetryMode
+
+	// DefaultsMode selects the set of default configuration values the
+	// session uses for service clients. Such as the HTTP client's connect
+	// and TLS negotiation timeouts, the retry mode, and the STS and S3
+	// us-east-1 regional endpoint flags. Explicitly configured values take
+	// precedence over the defaults mode's. Defaults to DefaultsModeLegacy.
+	//
+	// DefaultsModeAuto is resolved to the detected defaults mode when the
+	// session is created.
+	//
+	// Can also be set with the AWS_DEFAULTS_MODE environment variable, or the
+	// defaults_mode shared config key.
+	DefaultsMode DefaultsMode
+
+	// Tracer instruments the requests made by service clients with spans
+	// for each API operation, request attempt, and request phase.
+	//
+	// When nil or the value does not implement the request.Tracer interface,
+	// requests will not be traced.
+	//
+	// To set the Tracer field in a type-safe manner and with chaining, use
+	// the request.WithTracer helper function:
+	//
+	//   cfg := request.WithTracer(aws.NewConfig(), myTracer)
+	//
+	Tracer RequestTracer
+
+	// AttemptTimeout limits the amount of time each request attempt may take,
+	// including reading the response body. When an attempt times out only
+	// that attempt is canceled, and the request may be retried. The request's
+	// context still applies to the request as a whole, including retries.
+	//
+	// Zero, the default, disables the attempt timeout. Use the
+	// request.WithAttemptTimeout request option to set the attempt timeout
+	// of a single API operation call.
+	AttemptTimeout time.Duration
+
 	// Disables semantic parameter validation, which validates input for
 	// missing required fields and/or other semantic request input errors.
 	DisableParamValidation *bool
@@ -328,6 +390,13 @@ func (c *Config) WithCredentials(creds *credentials.Credentials) *Config {
 	return c
 }
 
+// WithTokenProvider sets a config TokenProvider value returning a Config
+// pointer for chaining.
+func (c *Config) WithTokenProvider(provider BearerTokenProvider) *Config {
+	c.TokenProvider = provider
+	return c
+}
+
 // WithEndpoint sets a config Endpoint value returning a Config pointer for
 // chaining.
 func (c *Config) WithEndpoint(endpoint string) *Config {
@@ -370,6 +439,27 @@ func (c *Config) WithMaxRetries(max int) *Config {
 	return c
 }
 
+// WithRetryMode sets a config RetryMode value returning a Config pointer
+// for chaining.
+func (c *Config) WithRetryMode(mode RetryMode) *Config {
+	c.RetryMode = mode
+	return c
+}
+
+// WithDefaultsMode sets a config DefaultsMode value returning a Config
+// pointer for chaining.
+func (c *Config) WithDefaultsMode(mode DefaultsMode) *Config {
+	c.DefaultsMode = mode
+	return c
+}
+
+// WithAttemptTimeout sets a config AttemptTimeout value returning a Config
+// pointer for chaining.
+func (c *Config) WithAttemptTimeout(d time.Duration) *Config {
+	c.AttemptTimeout = d
+	return c
+}
+
 // WithDisableParamValidation sets a config DisableParamValidation value
 // returning a Config pointer for chaining.
 func (c *Config) WithDisableParamValidation(disable bool) *Config {
@@ -535,6 +625,10 @@ func mergeInConfig(dst *Config, other *Config) {
 		dst.Credentials = other.Credentials
 	}
 
+	if other.TokenProvider != nil {
+		dst.TokenProvider = other.TokenProvider
+	}
+
 	if other.Endpoint != nil {
 		dst.Endpoint = other.Endpoint
 	}
@@ -571,6 +665,22 @@ func mergeInConfig(dst *Config, other *Config) {
 		dst.Retryer = other.Retryer
 	}
 
+	if other.RetryMode != RetryModeUnset {
+		dst.RetryMode = other.RetryMode
+	}
+
+	if other.DefaultsMode != DefaultsModeUnset {
+		dst.DefaultsMode = other.DefaultsMode
+	}
+
+	if other.Tracer != nil {
+		dst.Tracer = other.Tracer
+	}
+
+	if other.AttemptTimeout != 0 {
+		dst.AttemptTimeout = other.AttemptTimeout
+	}
+
 	if other.DisableParamValidation != nil {
 		dst.DisableParamValidation = other.DisableParamValidation
 	}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/credentials"
 )
@@ -57,6 +58,9 @@ var mergeTestConfig = Config{
 	LogLevel:                       LogLevel(LogDebug),
 	Logger:                         NewDefaultLogger(),
 	MaxRetries:                     Int(10),
+	RetryMode:                      RetryModeAdaptive,
+	DefaultsMode:                   DefaultsModeInRegion,
+	AttemptTimeout:                 2 * time.Second,
 	DisableParamValidation:         Bool(true),
 	DisableComputeChecksums:        Bool(true),
 	DisableEndpointHostPrefix:      Bool(true),
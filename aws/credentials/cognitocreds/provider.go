@@ -0,0 +1,355 @@
+/*
+Package cognitocreds provides a credential provider for retrieving temporary
+AWS credentials for an Amazon Cognito identity pool identity.
+
+The provider gets an identity ID from the identity pool for the logins of
+the user, and exchanges the identity for credentials of the identity pool's
+role. The identity ID is cached by the provider, and can be persisted by the
+application to reuse the same identity across processes.
+
+# Unauthenticated Identities
+
+Without logins, credentials of the identity pool's unauthenticated role are
+retrieved for a guest identity. The identity pool must allow unauthenticated
+identities.
+
+	creds := cognitocreds.NewCredentials(sess, "us-east-1:00000000-0000-0000-0000-000000000000")
+
+# Authenticated Identities
+
+The Logins function returns the logins map of identity provider names to
+identity provider tokens of the user. It is called each time credentials
+are retrieved, so it can refresh the tokens if needed.
+
+	creds := cognitocreds.NewCredentials(sess, identityPoolID, func(p *cognitocreds.Provider) {
+		p.Logins = func(ctx aws.Context) (map[string]string, error) {
+			token, err := getIDToken(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]string{
+				"cognito-idp.us-east-1.amazonaws.com/us-east-1_123456789": token,
+			}, nil
+		}
+	})
+
+# Basic Flow
+
+By default the enhanced, (simplified), authflow is used, which retrieves
+credentials with the GetCredentialsForIdentity API operation. If RoleARN is
+set, the basic, (classic), authflow is used instead, which gets an OpenID
+Connect token for the identity with the GetOpenIdToken API operation, and
+assumes the role with the STS AssumeRoleWithWebIdentity API operation.
+
+	creds := cognitocreds.NewCredentials(sess, identityPoolID, func(p *cognitocreds.Provider) {
+		p.RoleARN = "arn:aws:iam::123456789012:role/Cognito_PoolUnauth_Role"
+	})
+*/
+package cognitocreds
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// ProviderName is the name of the provider used to specify the source of
+// credentials.
+const ProviderName = "CognitoIdentityProvider"
+
+// ErrCodeCognitoIdentity is the error code returned when credentials cannot
+// be retrieved for the Cognito identity.
+const ErrCodeCognitoIdentity = "CognitoIdentityProviderError"
+
+// now is used to return the current time, swapped out by tests.
+var now = time.Now
+
+// IdentityAPIClient is the subset of the Amazon Cognito Identity API client
+// used by the Provider.
+type IdentityAPIClient interface {
+	GetIdWithContext(aws.Context, *cognitoidentity.GetIdInput, ...request.Option) (*cognitoidentity.GetIdOutput, error)
+	GetCredentialsForIdentityWithContext(aws.Context, *cognitoidentity.GetCredentialsForIdentityInput, ...request.Option) (*cognitoidentity.GetCredentialsForIdentityOutput, error)
+	GetOpenIdTokenWithContext(aws.Context, *cognitoidentity.GetOpenIdTokenInput, ...request.Option) (*cognitoidentity.GetOpenIdTokenOutput, error)
+}
+
+// AssumeRoleWithWebIdentityAPIClient is the subset of the STS API client
+// used by the Provider's basic authflow.
+type AssumeRoleWithWebIdentityAPIClient interface {
+	AssumeRoleWithWebIdentityWithContext(aws.Context, *sts.AssumeRoleWithWebIdentityInput, ...request.Option) (*sts.AssumeRoleWithWebIdentityOutput, error)
+}
+
+// Provider is an AWS credential provider that retrieves temporary AWS
+// credentials for an Amazon Cognito identity pool identity.
+type Provider struct {
+	credentials.Expiry
+
+	// The client configured for the region of the identity pool.
+	Client IdentityAPIClient
+
+	// The ID of the identity pool.
+	IdentityPoolID string
+
+	// The AWS account ID of the identity pool's owner. Optional.
+	AccountID string
+
+	// Returns the logins map of identity provider names to identity provider
+	// tokens of the user. If nil, or an empty map is returned, an
+	// unauthenticated identity is used.
+	Logins func(aws.Context) (map[string]string, error)
+
+	// The ARN of the role to retrieve credentials for, if the identity pool
+	// has multiple roles for authenticated identities. Only used by the
+	// enhanced authflow.
+	CustomRoleARN string
+
+	// The ARN of the role to assume with the basic authflow. If set, the
+	// basic authflow is used instead of the enhanced authflow.
+	RoleARN string
+
+	// The STS client used to assume the role with the basic authflow.
+	// Required if RoleARN is set.
+	STSClient AssumeRoleWithWebIdentityAPIClient
+
+	// The session name of the role assumed with the basic authflow. Defaults
+	// to a name generated from the current time.
+	RoleSessionName string
+
+	// Duration the credentials of the role assumed with the basic authflow
+	// will be valid for. Truncated to seconds. If unset, the
+	// AssumeRoleWithWebIdentity default is used.
+	Duration time.Duration
+
+	// The amount of time the credentials will be refreshed before they
+	// expire. If unset, will default to no expiry window.
+	ExpiryWindow time.Duration
+
+	m          sync.Mutex
+	identityID string
+}
+
+// NewCredentials returns a pointer to a new Credentials value wrapping the
+// Cognito identity Provider. The ConfigProvider is expected to be configured
+// for the region of the identity pool.
+func NewCredentials(c client.ConfigProvider, identityPoolID string, options ...func(*Provider)) *credentials.Credentials {
+	p := &Provider{
+		Client:         cognitoidentity.New(c),
+		STSClient:      sts.New(c),
+		IdentityPoolID: identityPoolID,
+	}
+	for _, option := range options {
+		option(p)
+	}
+
+	return credentials.NewCredentials(p)
+}
+
+// NewCredentialsWithClient returns a pointer to a new Credentials value
+// wrapping the Cognito identity Provider using the client provided.
+func NewCredentialsWithClient(client IdentityAPIClient, identityPoolID string, options ...func(*Provider)) *credentials.Credentials {
+	p := &Provider{
+		Client:         client,
+		IdentityPoolID: identityPoolID,
+	}
+	for _, option := range options {
+		option(p)
+	}
+
+	return credentials.NewCredentials(p)
+}
+
+// IdentityID returns the ID of the Cognito identity credentials are
+// retrieved for. Returns an empty string if credentials have not been
+// retrieved yet, and no identity ID was set with SetIdentityID.
+func (p *Provider) IdentityID() string {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return p.identityID
+}
+
+// SetIdentityID sets the ID of the Cognito identity credentials are
+// retrieved for, such as an identity ID persisted by the application from
+// a previous process. The identity pool will not be asked for an identity
+// ID until the identity ID is cleared by ClearIdentityID.
+func (p *Provider) SetIdentityID(id string) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.identityID = id
+}
+
+// ClearIdentityID clears the cached identity ID, so a new identity ID is
+// retrieved from the identity pool the next time credentials are retrieved,
+// such as after the user logs out.
+func (p *Provider) ClearIdentityID() {
+	p.SetIdentityID("")
+}
+
+// Retrieve retrieves credentials for the Cognito identity.
+func (p *Provider) Retrieve() (credentials.Value, error) {
+	return p.RetrieveWithContext(aws.BackgroundContext())
+}
+
+// RetrieveWithContext retrieves credentials for the Cognito identity.
+func (p *Provider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
+	var logins map[string]*string
+	if p.Logins != nil {
+		v, err := p.Logins(ctx)
+		if err != nil {
+			return credentials.Value{}, awserr.New(ErrCodeCognitoIdentity,
+				"failed to get Cognito identity logins", err)
+		}
+		if len(v) != 0 {
+			logins = aws.StringMap(v)
+		}
+	}
+
+	identityID := p.IdentityID()
+	cachedID := len(identityID) != 0
+	if !cachedID {
+		var err error
+		if identityID, err = p.getID(ctx, logins); err != nil {
+			return credentials.Value{}, err
+		}
+	}
+
+	v, err := p.retrieveForIdentity(ctx, identityID, logins)
+	if aerr, ok := err.(awserr.Error); ok && cachedID &&
+		aerr.Code() == cognitoidentity.ErrCodeResourceNotFoundException {
+		// The cached identity no longer exists in the identity pool, so a
+		// new identity is used instead.
+		if identityID, err = p.getID(ctx, logins); err != nil {
+			return credentials.Value{}, err
+		}
+		v, err = p.retrieveForIdentity(ctx, identityID, logins)
+	}
+	if err != nil {
+		return credentials.Value{}, err
+	}
+
+	return v, nil
+}
+
+func (p *Provider) getID(ctx aws.Context, logins map[string]*string) (string, error) {
+	input := &cognitoidentity.GetIdInput{
+		IdentityPoolId: &p.IdentityPoolID,
+		Logins:         logins,
+	}
+	if len(p.AccountID) != 0 {
+		input.AccountId = &p.AccountID
+	}
+
+	output, err := p.Client.GetIdWithContext(ctx, input)
+	if err != nil {
+		return "", err
+	}
+	if len(aws.StringValue(output.IdentityId)) == 0 {
+		return "", awserr.New(ErrCodeCognitoIdentity, "missing required field IdentityId", nil)
+	}
+
+	p.SetIdentityID(*output.IdentityId)
+	return *output.IdentityId, nil
+}
+
+func (p *Provider) retrieveForIdentity(ctx aws.Context, identityID string, logins map[string]*string) (credentials.Value, error) {
+	if len(p.RoleARN) != 0 {
+		return p.retrieveBasic(ctx, identityID, logins)
+	}
+	return p.retrieveEnhanced(ctx, identityID, logins)
+}
+
+// retrieveEnhanced retrieves credentials with the enhanced authflow.
+func (p *Provider) retrieveEnhanced(ctx aws.Context, identityID string, logins map[string]*string) (credentials.Value, error) {
+	input := &cognitoidentity.GetCredentialsForIdentityInput{
+		IdentityId: &identityID,
+		Logins:     logins,
+	}
+	if len(p.CustomRoleARN) != 0 {
+		input.CustomRoleArn = &p.CustomRoleARN
+	}
+
+	output, err := p.Client.GetCredentialsForIdentityWithContext(ctx, input)
+	if err != nil {
+		return credentials.Value{}, err
+	}
+	if output.Credentials == nil {
+		return credentials.Value{}, awserr.New(ErrCodeCognitoIdentity, "missing required field Credentials", nil)
+	}
+	if id := aws.StringValue(output.IdentityId); len(id) != 0 && id != identityID {
+		p.SetIdentityID(id)
+	}
+
+	creds := output.Credentials
+	if creds.Expiration != nil {
+		p.SetExpiration(*creds.Expiration, p.ExpiryWindow)
+	}
+
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(creds.AccessKeyId),
+		SecretAccessKey: aws.StringValue(creds.SecretKey),
+		SessionToken:    aws.StringValue(creds.SessionToken),
+		ProviderName:    ProviderName,
+	}, nil
+}
+
+// retrieveBasic retrieves credentials with the basic authflow.
+func (p *Provider) retrieveBasic(ctx aws.Context, identityID string, logins map[string]*string) (credentials.Value, error) {
+	if p.STSClient == nil {
+		return credentials.Value{}, awserr.New(ErrCodeCognitoIdentity,
+			"STSClient is required for the basic authflow", nil)
+	}
+
+	tokenOutput, err := p.Client.GetOpenIdTokenWithContext(ctx, &cognitoidentity.GetOpenIdTokenInput{
+		IdentityId: &identityID,
+		Logins:     logins,
+	})
+	if err != nil {
+		return credentials.Value{}, err
+	}
+	if len(aws.StringValue(tokenOutput.Token)) == 0 {
+		return credentials.Value{}, awserr.New(ErrCodeCognitoIdentity, "missing required field Token", nil)
+	}
+	if id := aws.StringValue(tokenOutput.IdentityId); len(id) != 0 && id != identityID {
+		p.SetIdentityID(id)
+	}
+
+	sessionName := p.RoleSessionName
+	if len(sessionName) == 0 {
+		// session name is used to uniquely identify a session. This simply
+		// uses unix time in nanoseconds to uniquely identify sessions.
+		sessionName = strconv.FormatInt(now().UnixNano(), 10)
+	}
+
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          &p.RoleARN,
+		RoleSessionName:  &sessionName,
+		WebIdentityToken: tokenOutput.Token,
+	}
+	if p.Duration != 0 {
+		// If set use the value, otherwise STS will assign a default expiration duration.
+		input.DurationSeconds = aws.Int64(int64(p.Duration / time.Second))
+	}
+
+	output, err := p.STSClient.AssumeRoleWithWebIdentityWithContext(ctx, input)
+	if err != nil {
+		return credentials.Value{}, err
+	}
+	if output.Credentials == nil {
+		return credentials.Value{}, awserr.New(ErrCodeCognitoIdentity, "missing required field Credentials", nil)
+	}
+
+	p.SetExpiration(aws.TimeValue(output.Credentials.Expiration), p.ExpiryWindow)
+
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(output.Credentials.AccessKeyId),
+		SecretAccessKey: aws.StringValue(output.Credentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(output.Credentials.SessionToken),
+		ProviderName:    ProviderName,
+	}, nil
+}
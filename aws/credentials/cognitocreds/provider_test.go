@@ -0,0 +1,261 @@
+package cognitocreds
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+type mockIdentityClient struct {
+	t *testing.T
+
+	ExpectLogins map[string]*string
+	IdentityID   string
+	NotFoundIDs  map[string]bool
+
+	GetIdCalls int
+	Calls      []string
+}
+
+func (c *mockIdentityClient) GetIdWithContext(ctx aws.Context, input *cognitoidentity.GetIdInput, opts ...request.Option) (*cognitoidentity.GetIdOutput, error) {
+	c.GetIdCalls++
+	c.Calls = append(c.Calls, "GetId")
+	if e, a := "pool-id", aws.StringValue(input.IdentityPoolId); e != a {
+		c.t.Errorf("expect %v identity pool, got %v", e, a)
+	}
+	c.checkLogins(input.Logins)
+	return &cognitoidentity.GetIdOutput{
+		IdentityId: aws.String(fmt.Sprintf("%s-%d", c.IdentityID, c.GetIdCalls)),
+	}, nil
+}
+
+func (c *mockIdentityClient) GetCredentialsForIdentityWithContext(ctx aws.Context, input *cognitoidentity.GetCredentialsForIdentityInput, opts ...request.Option) (*cognitoidentity.GetCredentialsForIdentityOutput, error) {
+	id := aws.StringValue(input.IdentityId)
+	c.Calls = append(c.Calls, "GetCredentialsForIdentity "+id)
+	c.checkLogins(input.Logins)
+	if c.NotFoundIDs[id] {
+		return nil, awserr.New(cognitoidentity.ErrCodeResourceNotFoundException, "not found", nil)
+	}
+	return &cognitoidentity.GetCredentialsForIdentityOutput{
+		IdentityId: input.IdentityId,
+		Credentials: &cognitoidentity.Credentials{
+			AccessKeyId:  aws.String("AKID"),
+			SecretKey:    aws.String("SECRET"),
+			SessionToken: aws.String("TOKEN " + id),
+			Expiration:   aws.Time(now().Add(time.Hour)),
+		},
+	}, nil
+}
+
+func (c *mockIdentityClient) GetOpenIdTokenWithContext(ctx aws.Context, input *cognitoidentity.GetOpenIdTokenInput, opts ...request.Option) (*cognitoidentity.GetOpenIdTokenOutput, error) {
+	id := aws.StringValue(input.IdentityId)
+	c.Calls = append(c.Calls, "GetOpenIdToken "+id)
+	c.checkLogins(input.Logins)
+	return &cognitoidentity.GetOpenIdTokenOutput{
+		IdentityId: input.IdentityId,
+		Token:      aws.String("oidc-token"),
+	}, nil
+}
+
+func (c *mockIdentityClient) checkLogins(logins map[string]*string) {
+	if e, a := c.ExpectLogins, logins; !reflect.DeepEqual(e, a) {
+		c.t.Errorf("expect %v logins, got %v", e, a)
+	}
+}
+
+type mockSTSClient struct {
+	t *testing.T
+}
+
+func (c *mockSTSClient) AssumeRoleWithWebIdentityWithContext(ctx aws.Context, input *sts.AssumeRoleWithWebIdentityInput, opts ...request.Option) (*sts.AssumeRoleWithWebIdentityOutput, error) {
+	if e, a := "arn:aws:iam::123456789012:role/role", aws.StringValue(input.RoleArn); e != a {
+		c.t.Errorf("expect %v role, got %v", e, a)
+	}
+	if e, a := "oidc-token", aws.StringValue(input.WebIdentityToken); e != a {
+		c.t.Errorf("expect %v token, got %v", e, a)
+	}
+	if e, a := int64(900), aws.Int64Value(input.DurationSeconds); e != a {
+		c.t.Errorf("expect %v duration, got %v", e, a)
+	}
+	return &sts.AssumeRoleWithWebIdentityOutput{
+		Credentials: &sts.Credentials{
+			AccessKeyId:     aws.String("AKID"),
+			SecretAccessKey: aws.String("SECRET"),
+			SessionToken:    aws.String("TOKEN basic"),
+			Expiration:      aws.Time(now().Add(15 * time.Minute)),
+		},
+	}, nil
+}
+
+func TestProvider(t *testing.T) {
+	cases := map[string]struct {
+		Logins       map[string]string
+		LoginsErr    error
+		RoleARN      string
+		ExpectLogins map[string]*string
+		ExpectToken  string
+		ExpectCalls  []string
+		ExpectErr    bool
+	}{
+		"unauthenticated": {
+			ExpectToken: "TOKEN id-1",
+			ExpectCalls: []string{"GetId", "GetCredentialsForIdentity id-1"},
+		},
+		"authenticated": {
+			Logins:       map[string]string{"accounts.google.com": "google-token"},
+			ExpectLogins: map[string]*string{"accounts.google.com": aws.String("google-token")},
+			ExpectToken:  "TOKEN id-1",
+			ExpectCalls:  []string{"GetId", "GetCredentialsForIdentity id-1"},
+		},
+		"basic flow": {
+			RoleARN:     "arn:aws:iam::123456789012:role/role",
+			ExpectToken: "TOKEN basic",
+			ExpectCalls: []string{"GetId", "GetOpenIdToken id-1"},
+		},
+		"logins error": {
+			LoginsErr: fmt.Errorf("token error"),
+			ExpectErr: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockIdentityClient{t: t, IdentityID: "id", ExpectLogins: c.ExpectLogins}
+			p := &Provider{
+				Client:         client,
+				STSClient:      &mockSTSClient{t: t},
+				IdentityPoolID: "pool-id",
+				RoleARN:        c.RoleARN,
+				Duration:       15 * time.Minute,
+				Logins: func(aws.Context) (map[string]string, error) {
+					return c.Logins, c.LoginsErr
+				},
+			}
+
+			v, err := p.Retrieve()
+			if c.ExpectErr {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.ExpectToken, v.SessionToken; e != a {
+				t.Errorf("expect %v session token, got %v", e, a)
+			}
+			if e, a := ProviderName, v.ProviderName; e != a {
+				t.Errorf("expect %v provider name, got %v", e, a)
+			}
+			if e, a := c.ExpectCalls, client.Calls; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v calls, got %v", e, a)
+			}
+			if e, a := "id-1", p.IdentityID(); e != a {
+				t.Errorf("expect %v identity ID, got %v", e, a)
+			}
+			if p.IsExpired() {
+				t.Errorf("expect credentials not to be expired")
+			}
+		})
+	}
+}
+
+func TestProvider_IdentityIDCache(t *testing.T) {
+	client := &mockIdentityClient{t: t, IdentityID: "id"}
+	p := &Provider{
+		Client:         client,
+		IdentityPoolID: "pool-id",
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := p.Retrieve(); err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+	}
+	if e, a := 1, client.GetIdCalls; e != a {
+		t.Errorf("expect %v GetId calls, got %v", e, a)
+	}
+
+	// persisted identity ID is used
+	p.SetIdentityID("persisted-id")
+	v, err := p.Retrieve()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "TOKEN persisted-id", v.SessionToken; e != a {
+		t.Errorf("expect %v session token, got %v", e, a)
+	}
+
+	// cleared identity ID is replaced
+	p.ClearIdentityID()
+	if _, err := p.Retrieve(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "id-2", p.IdentityID(); e != a {
+		t.Errorf("expect %v identity ID, got %v", e, a)
+	}
+}
+
+func TestProvider_IdentityNotFound(t *testing.T) {
+	client := &mockIdentityClient{
+		t:           t,
+		IdentityID:  "id",
+		NotFoundIDs: map[string]bool{"deleted-id": true},
+	}
+	p := &Provider{
+		Client:         client,
+		IdentityPoolID: "pool-id",
+	}
+	p.SetIdentityID("deleted-id")
+
+	v, err := p.Retrieve()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "TOKEN id-1", v.SessionToken; e != a {
+		t.Errorf("expect %v session token, got %v", e, a)
+	}
+	expectCalls := []string{
+		"GetCredentialsForIdentity deleted-id",
+		"GetId",
+		"GetCredentialsForIdentity id-1",
+	}
+	if e, a := expectCalls, client.Calls; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v calls, got %v", e, a)
+	}
+}
+
+func TestProvider_Expiry(t *testing.T) {
+	orig := now
+	defer func() { now = orig }()
+	current := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	now = func() time.Time { return current }
+
+	p := &Provider{
+		Client:         &mockIdentityClient{t: t, IdentityID: "id"},
+		IdentityPoolID: "pool-id",
+		ExpiryWindow:   5 * time.Minute,
+	}
+	p.CurrentTime = now
+
+	if _, err := p.Retrieve(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := current.Add(55*time.Minute), p.ExpiresAt(); !e.Equal(a) {
+		t.Errorf("expect %v expiration, got %v", e, a)
+	}
+
+	current = current.Add(56 * time.Minute)
+	if !p.IsExpired() {
+		t.Errorf("expect credentials to be expired")
+	}
+}
@@ -46,6 +46,24 @@
 //     creds := credentials.NewCredentials(&MyProvider{})
 //     credValue, err := creds.Get()
 //
+// Refresh Ahead
+//
+// Credentials created with NewCredentialsWithOptions can be configured to
+// refresh the credentials in the background before they expire. The cached
+// credentials continue to be returned while they are refreshed, so requests
+// are not blocked waiting for the provider at the time the credentials
+// expire. Refresh ahead is only used with providers that implement the
+// Expirer interface.
+//
+//     creds := credentials.NewCredentialsWithOptions(provider,
+//         func(o *credentials.CredentialsOptions) {
+//             o.RefreshAheadWindow = 5 * time.Minute
+//             o.RefreshAheadJitter = time.Minute
+//             o.OnRefreshError = func(err error) {
+//                 log.Printf("failed to refresh credentials, %v", err)
+//             }
+//         })
+//
 package credentials
 
 import (
@@ -54,6 +72,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/internal/sdkrand"
 	"github.com/aws/aws-sdk-go/internal/sync/singleflight"
 )
 
@@ -212,6 +231,47 @@ type Credentials struct {
 	m        sync.RWMutex
 	creds    Value
 	provider Provider
+
+	options CredentialsOptions
+
+	// The expiration of the cached credentials, and the time the credentials
+	// will be refreshed in the background at. Only set if refresh ahead is
+	// enabled, and the provider implements Expirer.
+	expiresAt time.Time
+	refreshAt time.Time
+}
+
+// DefaultRefreshAheadRetryDelay is the duration Credentials will wait before
+// retrying a failed background refresh by default.
+const DefaultRefreshAheadRetryDelay = 30 * time.Second
+
+// CredentialsOptions provides the options for configuring Credentials.
+type CredentialsOptions struct {
+	// RefreshAheadWindow enables refreshing the credentials in the background
+	// once they are within the window of expiring. The cached credentials
+	// continue to be returned until the refresh completes. The window is
+	// relative to the expiration returned by the provider's ExpiresAt, which
+	// already includes any expiry window the provider was configured with.
+	//
+	// Refresh ahead is disabled if zero, or if the provider does not
+	// implement the Expirer interface.
+	RefreshAheadWindow time.Duration
+
+	// RefreshAheadJitter is the maximum random duration added to the
+	// RefreshAheadWindow each time the credentials are retrieved. Spreads out
+	// the refreshes of processes that retrieved credentials at the same time.
+	RefreshAheadJitter time.Duration
+
+	// RefreshAheadRetryDelay is the duration to wait before retrying a
+	// failed background refresh. Defaults to DefaultRefreshAheadRetryDelay
+	// if zero. Once the credentials expire they will be refreshed
+	// synchronously, and the error returned by Get.
+	RefreshAheadRetryDelay time.Duration
+
+	// OnRefreshError is called with the error of each failed background
+	// refresh, (e.g. to log the error, or record a metric). Called from the
+	// goroutine refreshing the credentials.
+	OnRefreshError func(error)
 }
 
 // NewCredentials returns a pointer to a new Credentials with the provider set.
@@ -222,6 +282,20 @@ func NewCredentials(provider Provider) *Credentials {
 	return c
 }
 
+// NewCredentialsWithOptions returns a pointer to a new Credentials with the
+// provider set. Additional functional options can be provided to configure
+// the Credentials, such as enabling refresh ahead.
+func NewCredentialsWithOptions(provider Provider, optFns ...func(*CredentialsOptions)) *Credentials {
+	c := NewCredentials(provider)
+	for _, fn := range optFns {
+		fn(&c.options)
+	}
+	if c.options.RefreshAheadRetryDelay == 0 {
+		c.options.RefreshAheadRetryDelay = DefaultRefreshAheadRetryDelay
+	}
+	return c
+}
+
 // GetWithContext returns the credentials value, or error if the credentials
 // Value failed to be retrieved. Will return early if the passed in context is
 // canceled.
@@ -241,6 +315,7 @@ func (c *Credentials) GetWithContext(ctx Context) (Value, error) {
 		// ok will only be true, of the credentials were not expired. ok will
 		// be false and have no value if the credentials are expired.
 		if ok {
+			c.tryRefreshAhead()
 			return curCreds, nil
 		}
 	case <-ctx.Done():
@@ -271,20 +346,105 @@ func (c *Credentials) singleRetrieve(ctx Context) (interface{}, error) {
 		return curCreds, nil
 	}
 
-	var creds Value
-	var err error
-	if p, ok := c.provider.(ProviderWithContext); ok {
-		creds, err = p.RetrieveWithContext(ctx)
-	} else {
-		creds, err = c.provider.Retrieve()
-	}
+	creds, err := c.retrieve(ctx)
 	if err == nil {
 		c.creds = creds
+		c.updateRefreshAheadLocked()
 	}
 
 	return creds, err
 }
 
+func (c *Credentials) retrieve(ctx Context) (Value, error) {
+	if p, ok := c.provider.(ProviderWithContext); ok {
+		return p.RetrieveWithContext(ctx)
+	}
+	return c.provider.Retrieve()
+}
+
+// tryRefreshAhead starts refreshing the credentials in the background if
+// refresh ahead is enabled, and the credentials are within the refresh ahead
+// window.
+func (c *Credentials) tryRefreshAhead() {
+	if c.options.RefreshAheadWindow <= 0 {
+		return
+	}
+
+	now := time.Now()
+
+	c.m.RLock()
+	refresh := !c.refreshAt.IsZero() && !now.Before(c.refreshAt)
+	c.m.RUnlock()
+	if !refresh {
+		return
+	}
+
+	c.m.Lock()
+	if c.refreshAt.IsZero() || now.Before(c.refreshAt) {
+		c.m.Unlock()
+		return
+	}
+	// Delay the next attempt, in case this refresh fails.
+	c.refreshAt = now.Add(c.options.RefreshAheadRetryDelay)
+	c.m.Unlock()
+
+	// Shares the singleflight key with the synchronous retrieve, so the
+	// provider is never called concurrently. Callers whose credentials
+	// expire while the background refresh is in flight will wait on it.
+	c.sf.DoChan("", c.backgroundRetrieve)
+}
+
+// backgroundRetrieve retrieves new credentials from the provider, without
+// holding the lock, so cached credentials can be returned while the provider
+// is called.
+func (c *Credentials) backgroundRetrieve() (interface{}, error) {
+	creds, err := c.retrieve(backgroundContext())
+	if err != nil {
+		if fn := c.options.OnRefreshError; fn != nil {
+			fn(err)
+		}
+		return Value{}, err
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.creds = creds
+	c.updateRefreshAheadLocked()
+
+	return creds, nil
+}
+
+// updateRefreshAheadLocked updates the expiration of the cached credentials,
+// and the time they will be refreshed in the background at. The expiration
+// is only tracked by Credentials if refresh ahead is enabled, since the
+// provider may be retrieving credentials when the cached credentials are
+// checked.
+func (c *Credentials) updateRefreshAheadLocked() {
+	if c.options.RefreshAheadWindow <= 0 {
+		return
+	}
+
+	c.expiresAt, c.refreshAt = time.Time{}, time.Time{}
+
+	expirer, ok := c.provider.(Expirer)
+	if !ok {
+		return
+	}
+	expiresAt := expirer.ExpiresAt()
+	if expiresAt.IsZero() {
+		return
+	}
+
+	window := c.options.RefreshAheadWindow
+	if jitter := c.options.RefreshAheadJitter; jitter > 0 {
+		window += time.Duration(sdkrand.SeededRand.Int63n(int64(jitter)))
+	}
+
+	c.expiresAt = expiresAt
+	c.refreshAt = expiresAt.Add(-window)
+}
+
 // Get returns the credentials value, or error if the credentials Value failed
 // to be retrieved.
 //
@@ -308,6 +468,7 @@ func (c *Credentials) Expire() {
 	defer c.m.Unlock()
 
 	c.creds = Value{}
+	c.expiresAt, c.refreshAt = time.Time{}, time.Time{}
 }
 
 // IsExpired returns if the credentials are no longer valid, and need
@@ -342,7 +503,15 @@ func (c *Credentials) asyncIsExpired() <-chan Value {
 
 // isExpiredLocked helper method wrapping the definition of expired credentials.
 func (c *Credentials) isExpiredLocked(creds interface{}) bool {
-	return creds == nil || creds.(Value) == Value{} || c.provider.IsExpired()
+	if creds == nil || creds.(Value) == (Value{}) {
+		return true
+	}
+	if !c.expiresAt.IsZero() {
+		// The provider may be refreshing the credentials in the
+		// background, use the expiration of the cached credentials.
+		return c.expiresAt.Before(time.Now())
+	}
+	return c.provider.IsExpired()
 }
 
 // ExpiresAt provides access to the functionality of the Expirer interface of
@@ -363,6 +532,9 @@ func (c *Credentials) ExpiresAt() (time.Time, error) {
 		// set expiration time to the distant past
 		return time.Time{}, nil
 	}
+	if !c.expiresAt.IsZero() {
+		return c.expiresAt, nil
+	}
 	return expirer.ExpiresAt(), nil
 }
 
@@ -1,6 +1,7 @@
 package credentials
 
 import (
+	"fmt"
 	"math/rand"
 	"sync"
 	"testing"
@@ -278,3 +279,154 @@ func TestCredentialsGet_RefreshableProviderRace(t *testing.T) {
 
 	wg.Wait()
 }
+
+type stubProviderRefreshAhead struct {
+	Expiry
+
+	m         sync.Mutex
+	retrieved int
+	expires   time.Duration
+	err       error
+}
+
+func (s *stubProviderRefreshAhead) Retrieve() (Value, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.retrieved++
+	if s.err != nil {
+		return Value{}, s.err
+	}
+	s.SetExpiration(time.Now().Add(s.expires), 0)
+	return Value{
+		AccessKeyID:     fmt.Sprintf("AKID%d", s.retrieved),
+		SecretAccessKey: "SECRET",
+	}, nil
+}
+
+func (s *stubProviderRefreshAhead) Retrieved() int {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.retrieved
+}
+
+func (s *stubProviderRefreshAhead) SetErr(err error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.err = err
+}
+
+func waitForRetrieved(t *testing.T, stub *stubProviderRefreshAhead, expect int) {
+	t.Helper()
+	for i := 0; i < 100 && stub.Retrieved() < expect; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if e, a := expect, stub.Retrieved(); e != a {
+		t.Fatalf("expect %v retrieves, got %v", e, a)
+	}
+}
+
+func TestCredentialsGet_RefreshAhead(t *testing.T) {
+	stub := &stubProviderRefreshAhead{expires: time.Minute}
+	c := NewCredentialsWithOptions(stub, func(o *CredentialsOptions) {
+		o.RefreshAheadWindow = 5 * time.Minute
+	})
+
+	creds, err := c.Get()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "AKID1", creds.AccessKeyID; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	// Within the refresh window, the cached credentials are returned while
+	// they are refreshed in the background.
+	creds, err = c.Get()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "AKID1", creds.AccessKeyID; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	waitForRetrieved(t, stub, 2)
+	for i := 0; i < 100; i++ {
+		// The credentials are cached after the provider returns.
+		if creds, _ = c.Get(); creds.AccessKeyID == "AKID2" {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if e, a := "AKID2", creds.AccessKeyID; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestCredentialsGet_RefreshAheadError(t *testing.T) {
+	stub := &stubProviderRefreshAhead{expires: time.Minute}
+
+	var m sync.Mutex
+	var refreshErrs []error
+	c := NewCredentialsWithOptions(stub, func(o *CredentialsOptions) {
+		o.RefreshAheadWindow = 5 * time.Minute
+		o.RefreshAheadJitter = time.Minute
+		o.OnRefreshError = func(err error) {
+			m.Lock()
+			defer m.Unlock()
+			refreshErrs = append(refreshErrs, err)
+		}
+	})
+
+	if _, err := c.Get(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	stub.SetErr(fmt.Errorf("refresh failed"))
+	for i := 0; i < 3; i++ {
+		creds, err := c.Get()
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		if e, a := "AKID1", creds.AccessKeyID; e != a {
+			t.Errorf("expect %v, got %v", e, a)
+		}
+	}
+
+	// Failed refreshes are not retried until the retry delay elapses.
+	waitForRetrieved(t, stub, 2)
+	if c.IsExpired() {
+		t.Errorf("expect credentials not to be expired")
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	if e, a := 1, len(refreshErrs); e != a {
+		t.Fatalf("expect %v refresh errors, got %v", e, a)
+	}
+	if e, a := "refresh failed", refreshErrs[0].Error(); e != a {
+		t.Errorf("expect %v error, got %v", e, a)
+	}
+}
+
+func TestCredentialsGet_RefreshAheadNotExpirer(t *testing.T) {
+	stub := &stubProvider{
+		creds: Value{
+			AccessKeyID:     "AKID",
+			SecretAccessKey: "SECRET",
+		},
+	}
+	c := NewCredentialsWithOptions(stub, func(o *CredentialsOptions) {
+		o.RefreshAheadWindow = 5 * time.Minute
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.Get(); err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+	}
+
+	if e, a := 1, stub.retrievedCount; e != a {
+		t.Errorf("expect %v retrieves, got %v", e, a)
+	}
+}
@@ -41,6 +41,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/client/metadata"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/internal/sdklog"
 	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 )
 
@@ -156,8 +157,9 @@ func (p *Provider) Retrieve() (credentials.Value, error) {
 func (p *Provider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
 	resp, err := p.getCredentials(ctx)
 	if err != nil {
-		return credentials.Value{ProviderName: ProviderName},
-			awserr.New("CredentialsEndpointError", "failed to load credentials", err)
+		err = awserr.New("CredentialsEndpointError", "failed to load credentials", err)
+		sdklog.LogCredentialsError(sdklog.CredentialsLogger(&p.Client.Config), ProviderName, err)
+		return credentials.Value{ProviderName: ProviderName}, err
 	}
 
 	if resp.Expiration != nil {
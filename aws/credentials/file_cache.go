@@ -0,0 +1,289 @@
+package credentials
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+	"unicode/utf16"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/internal/shareddefaults"
+)
+
+// ErrCodeFileCache is the error code returned when credentials cannot be
+// stored in a FileCache.
+const ErrCodeFileCache = "FileCacheError"
+
+// DefaultFileCacheDir returns the directory the AWS CLI caches credentials
+// in, which is used as the directory of a FileCache by default.
+//
+//   - Linux/Unix: $HOME/.aws/cli/cache
+//   - Windows: %USERPROFILE%\.aws\cli\cache
+func DefaultFileCacheDir() string {
+	return filepath.Join(shareddefaults.UserHomeDir(), ".aws", "cli", "cache")
+}
+
+// A FileCache caches credentials in files on disk, so credentials can be
+// shared by processes, and reused by short lived processes. The files are
+// in the same JSON format as the files of the AWS CLI's credential cache,
+// and assumed role credentials are cached under the same file name as the
+// AWS CLI would cache them.
+//
+// Cached credentials are only readable by the user the process is running
+// as, and are written atomically so concurrent processes will not read
+// partially written files.
+//
+// A FileCache can be set on the AssumeRoleProvider and ProcessProvider to
+// cache the credentials they retrieve.
+type FileCache struct {
+	// The directory the credential files are stored in. Defaults to
+	// DefaultFileCacheDir if empty.
+	Dir string
+}
+
+// NewFileCache returns a FileCache storing credentials in the directory. If
+// dir is empty, DefaultFileCacheDir is used.
+func NewFileCache(dir string) *FileCache {
+	return &FileCache{Dir: dir}
+}
+
+// fileCacheEntry is the format of the files in the AWS CLI's credential
+// cache.
+type fileCacheEntry struct {
+	Credentials fileCacheCredentials
+}
+
+type fileCacheCredentials struct {
+	AccessKeyID     string `json:"AccessKeyId"`
+	SecretAccessKey string
+	SessionToken    string
+	Expiration      string
+}
+
+// Load returns the credentials cached for the key, and their expiration.
+// Returns false if no credentials are cached for the key, or if the cached
+// credentials could not be read.
+func (c *FileCache) Load(key string) (Value, time.Time, bool) {
+	b, err := ioutil.ReadFile(c.filename(key))
+	if err != nil {
+		return Value{}, time.Time{}, false
+	}
+
+	var entry fileCacheEntry
+	if err := json.Unmarshal(b, &entry); err != nil {
+		return Value{}, time.Time{}, false
+	}
+
+	creds := entry.Credentials
+	expires, err := parseFileCacheTime(creds.Expiration)
+	if err != nil {
+		return Value{}, time.Time{}, false
+	}
+
+	v := Value{
+		AccessKeyID:     creds.AccessKeyID,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.SessionToken,
+	}
+	if !v.HasKeys() {
+		return Value{}, time.Time{}, false
+	}
+
+	return v, expires, true
+}
+
+// Store caches the credentials for the key, with their expiration. The
+// cache directory is created if it does not exist.
+func (c *FileCache) Store(key string, v Value, expires time.Time) error {
+	b, err := json.Marshal(fileCacheEntry{
+		Credentials: fileCacheCredentials{
+			AccessKeyID:     v.AccessKeyID,
+			SecretAccessKey: v.SecretAccessKey,
+			SessionToken:    v.SessionToken,
+			Expiration:      expires.UTC().Format(time.RFC3339),
+		},
+	})
+	if err != nil {
+		return awserr.New(ErrCodeFileCache, "failed to encode cached credentials", err)
+	}
+
+	dir := c.dir()
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return awserr.New(ErrCodeFileCache, "failed to create credential cache directory", err)
+	}
+
+	// Write to a temporary file that is renamed to the cache file, so the
+	// cache file is replaced atomically.
+	f, err := ioutil.TempFile(dir, key+".*.tmp")
+	if err != nil {
+		return awserr.New(ErrCodeFileCache, "failed to create cached credentials file", err)
+	}
+	tmpName := f.Name()
+
+	if err = f.Chmod(0600); err == nil {
+		_, err = f.Write(b)
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpName, c.filename(key))
+	}
+	if err != nil {
+		os.Remove(tmpName)
+		return awserr.New(ErrCodeFileCache, "failed to write cached credentials file", err)
+	}
+
+	return nil
+}
+
+func (c *FileCache) dir() string {
+	if len(c.Dir) != 0 {
+		return c.Dir
+	}
+	return DefaultFileCacheDir()
+}
+
+func (c *FileCache) filename(key string) string {
+	return filepath.Join(c.dir(), key+".json")
+}
+
+// parseFileCacheTime parses the expiration of cached credentials. In
+// addition to RFC 3339, the AWS CLI writes expirations with the time zone
+// name, (e.g. 2006-01-02T15:04:05UTC).
+func parseFileCacheTime(v string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02T15:04:05MST", v)
+}
+
+// FileCacheKey returns the key credentials are cached under for the
+// arguments. The key is the same as the AWS CLI's credential cache key for
+// the arguments, the SHA1 hash of the arguments encoded as JSON with sorted
+// keys.
+//
+// Argument values must be strings, numbers, booleans, or slices and maps of
+// those values. JSON documents, such as policies, should be unmarshaled so
+// their keys are also sorted.
+func FileCacheKey(args map[string]interface{}) (string, error) {
+	var buf bytes.Buffer
+	if err := writeCacheKeyJSON(&buf, args); err != nil {
+		return "", err
+	}
+
+	sum := sha1.Sum(buf.Bytes())
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// writeCacheKeyJSON encodes the value as JSON the same as Python's
+// json.dumps with sort_keys, which is used by the AWS CLI to build its
+// credential cache keys.
+func writeCacheKeyJSON(buf *bytes.Buffer, v interface{}) error {
+	switch tv := v.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		if tv {
+			buf.WriteString("true")
+		} else {
+			buf.WriteString("false")
+		}
+	case string:
+		writeCacheKeyString(buf, tv)
+	case int:
+		fmt.Fprintf(buf, "%d", tv)
+	case int64:
+		fmt.Fprintf(buf, "%d", tv)
+	case json.Number:
+		buf.WriteString(tv.String())
+	case float64:
+		fmt.Fprintf(buf, "%v", tv)
+	case []string:
+		values := make([]interface{}, len(tv))
+		for i, s := range tv {
+			values[i] = s
+		}
+		return writeCacheKeyJSON(buf, values)
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, e := range tv {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			if err := writeCacheKeyJSON(buf, e); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case map[string]string:
+		values := make(map[string]interface{}, len(tv))
+		for k, s := range tv {
+			values[k] = s
+		}
+		return writeCacheKeyJSON(buf, values)
+	case map[string]interface{}:
+		keys := make([]string, 0, len(tv))
+		for k := range tv {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		buf.WriteByte('{')
+		for i, k := range keys {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			writeCacheKeyString(buf, k)
+			buf.WriteString(": ")
+			if err := writeCacheKeyJSON(buf, tv[k]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	default:
+		return awserr.New(ErrCodeFileCache,
+			fmt.Sprintf("unsupported cache key argument type %T", v), nil)
+	}
+
+	return nil
+}
+
+// writeCacheKeyString encodes the string as JSON, escaping all non-ASCII
+// characters.
+func writeCacheKeyString(buf *bytes.Buffer, s string) {
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch {
+		case r == '"':
+			buf.WriteString(`\"`)
+		case r == '\\':
+			buf.WriteString(`\\`)
+		case r == '\n':
+			buf.WriteString(`\n`)
+		case r == '\r':
+			buf.WriteString(`\r`)
+		case r == '\t':
+			buf.WriteString(`\t`)
+		case r == '\b':
+			buf.WriteString(`\b`)
+		case r == '\f':
+			buf.WriteString(`\f`)
+		case r < 0x20 || (r >= 0x80 && r < 0x10000):
+			fmt.Fprintf(buf, `\u%04x`, r)
+		case r >= 0x10000:
+			r1, r2 := utf16.EncodeRune(r)
+			fmt.Fprintf(buf, `\u%04x\u%04x`, r1, r2)
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	buf.WriteByte('"')
+}
@@ -0,0 +1,174 @@
+package credentials
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestFileCacheKey(t *testing.T) {
+	cases := map[string]struct {
+		Args   map[string]interface{}
+		Expect string
+	}{
+		"role arn": {
+			Args: map[string]interface{}{
+				"RoleArn": "arn:aws:iam::123456789012:role/role_name",
+			},
+			Expect: "22566ee894f9595e216cffb51319b35d7a4a2e9a",
+		},
+		"sorted and escaped": {
+			Args: map[string]interface{}{
+				"RoleArn":         "arn:aws:iam::123456789012:role/role_name",
+				"RoleSessionName": "session",
+				"DurationSeconds": int64(3600),
+				"ExternalId":      "exté\"",
+				"SerialNumber":    "arn:aws:iam::123456789012:mfa/user",
+			},
+			Expect: "082fd7cbc4bf05c68b0f6ede6acaa813e8b874f1",
+		},
+		"nested": {
+			Args: map[string]interface{}{
+				"RoleArn": "arn",
+				"Policy": map[string]interface{}{
+					"Version": "2012-10-17",
+					"Statement": []interface{}{
+						map[string]interface{}{
+							"Effect":   "Allow",
+							"Action":   "s3:*",
+							"Resource": "*",
+						},
+					},
+				},
+			},
+			Expect: "24f71d9704528d56fd3de17e2ecf38c876a0eb4c",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			key, err := FileCacheKey(c.Args)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.Expect, key; e != a {
+				t.Errorf("expect %v key, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestFileCacheKey_UnsupportedType(t *testing.T) {
+	_, err := FileCacheKey(map[string]interface{}{"Value": struct{}{}})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+}
+
+func TestFileCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "aws-sdk-go-file-cache")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	cache := NewFileCache(filepath.Join(dir, "cache"))
+
+	if _, _, ok := cache.Load("key"); ok {
+		t.Fatalf("expect no cached credentials")
+	}
+
+	expires := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	v := Value{
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "SECRET",
+		SessionToken:    "SESSION",
+	}
+	if err := cache.Store("key", v, expires); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	cached, cachedExpires, ok := cache.Load("key")
+	if !ok {
+		t.Fatalf("expect cached credentials")
+	}
+	if e, a := v, cached; e != a {
+		t.Errorf("expect %v credentials, got %v", e, a)
+	}
+	if e, a := expires, cachedExpires; !e.Equal(a) {
+		t.Errorf("expect %v expiration, got %v", e, a)
+	}
+
+	files, err := ioutil.ReadDir(filepath.Join(dir, "cache"))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 1, len(files); e != a {
+		t.Fatalf("expect %v files, got %v", e, a)
+	}
+	if e, a := "key.json", files[0].Name(); e != a {
+		t.Errorf("expect %v file, got %v", e, a)
+	}
+	if runtime.GOOS != "windows" {
+		if e, a := os.FileMode(0600), files[0].Mode().Perm(); e != a {
+			t.Errorf("expect %v file mode, got %v", e, a)
+		}
+	}
+}
+
+func TestFileCache_LoadCLIFormat(t *testing.T) {
+	dir, err := ioutil.TempDir("", "aws-sdk-go-file-cache")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	cases := map[string]struct {
+		Content      string
+		ExpectCached bool
+	}{
+		"time zone name": {
+			Content:      `{"Credentials": {"AccessKeyId": "AKID", "SecretAccessKey": "SECRET", "SessionToken": "SESSION", "Expiration": "2022-10-01T12:00:00UTC"}, "AssumedRoleUser": {"AssumedRoleId": "AROA:session", "Arn": "arn:aws:sts::123456789012:assumed-role/role/session"}}`,
+			ExpectCached: true,
+		},
+		"time zone offset": {
+			Content:      `{"Credentials": {"AccessKeyId": "AKID", "SecretAccessKey": "SECRET", "SessionToken": "SESSION", "Expiration": "2022-10-01T12:00:00+00:00"}}`,
+			ExpectCached: true,
+		},
+		"invalid expiration": {
+			Content: `{"Credentials": {"AccessKeyId": "AKID", "SecretAccessKey": "SECRET", "SessionToken": "SESSION", "Expiration": "tomorrow"}}`,
+		},
+		"missing keys": {
+			Content: `{"Credentials": {"AccessKeyId": "AKID", "Expiration": "2022-10-01T12:00:00Z"}}`,
+		},
+		"invalid json": {
+			Content: `{"Credentials": `,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if err := ioutil.WriteFile(filepath.Join(dir, "key.json"), []byte(c.Content), 0600); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			v, expires, ok := NewFileCache(dir).Load("key")
+			if e, a := c.ExpectCached, ok; e != a {
+				t.Fatalf("expect cached %v, got %v", e, a)
+			}
+			if !ok {
+				return
+			}
+
+			if e, a := "SESSION", v.SessionToken; e != a {
+				t.Errorf("expect %v session token, got %v", e, a)
+			}
+			if e, a := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC), expires; !e.Equal(a) {
+				t.Errorf("expect %v expiration, got %v", e, a)
+			}
+		})
+	}
+}
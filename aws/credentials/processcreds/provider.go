@@ -88,9 +88,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/internal/sdkio"
+	"github.com/aws/aws-sdk-go/internal/sdklog"
 )
 
 const (
@@ -180,6 +182,20 @@ type ProcessProvider struct {
 
 	// Timeout limits the time a process can run.
 	Timeout time.Duration
+
+	// Cache is the file cache the process's credentials are cached in, so
+	// they can be reused by other processes until they expire, without
+	// running the credential process. Only credentials with an expiration
+	// are cached.
+	//
+	// If nil, credentials are only cached in memory.
+	Cache *credentials.FileCache
+
+	// Logger is the logger failures to retrieve credentials are logged to.
+	// The output of the process is not logged.
+	//
+	// If nil, failures are not logged.
+	Logger aws.Logger
 }
 
 // NewCredentials returns a pointer to a new Credentials object wrapping the
@@ -248,6 +264,35 @@ type CredentialProcessResponse struct {
 
 // Retrieve executes the 'credential_process' and returns the credentials.
 func (p *ProcessProvider) Retrieve() (credentials.Value, error) {
+	v, err := p.retrieve()
+	if err != nil {
+		logErr := err
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == ErrCodeProcessProviderParse {
+			// The process's output may contain credentials.
+			logErr = awserr.New(ErrCodeProcessProviderParse, errMsgProcessProviderParse, aerr.OrigErr())
+		}
+		sdklog.LogCredentialsError(p.Logger, ProviderName, logErr)
+	}
+	return v, err
+}
+
+func (p *ProcessProvider) retrieve() (credentials.Value, error) {
+	var cacheKey string
+	if p.Cache != nil {
+		var err error
+		if cacheKey, err = p.fileCacheKey(); err != nil {
+			return credentials.Value{ProviderName: ProviderName}, err
+		}
+		if v, expires, ok := p.Cache.Load(cacheKey); ok {
+			p.SetExpiration(expires, p.ExpiryWindow)
+			if !p.Expiry.IsExpired() {
+				p.staticCreds = false
+				v.ProviderName = ProviderName
+				return v, nil
+			}
+		}
+	}
+
 	out, err := p.executeCredentialProcess()
 	if err != nil {
 		return credentials.Value{ProviderName: ProviderName}, err
@@ -289,12 +334,33 @@ func (p *ProcessProvider) Retrieve() (credentials.Value, error) {
 		p.SetExpiration(*resp.Expiration, p.ExpiryWindow)
 	}
 
-	return credentials.Value{
+	v := credentials.Value{
 		ProviderName:    ProviderName,
 		AccessKeyID:     resp.AccessKeyID,
 		SecretAccessKey: resp.SecretAccessKey,
 		SessionToken:    resp.SessionToken,
-	}, nil
+	}
+
+	if p.Cache != nil && resp.Expiration != nil {
+		// Failing to cache the credentials does not prevent them from being
+		// used, the process will be run again by the next process.
+		p.Cache.Store(cacheKey, v, *resp.Expiration)
+	}
+
+	return v, nil
+}
+
+// fileCacheKey returns the key the process's credentials are cached under,
+// computed from the credential process command.
+func (p *ProcessProvider) fileCacheKey() (string, error) {
+	args := p.originalCommand
+	if len(args) == 0 {
+		args = p.command.Args
+	}
+
+	return credentials.FileCacheKey(map[string]interface{}{
+		"CredentialProcess": strings.Join(args, " "),
+	})
 }
 
 // IsExpired returns true if the credentials retrieved are expired, or not yet
@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"testing"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/processcreds"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/internal/sdktesting"
@@ -301,6 +303,35 @@ func TestProcessProviderExpectErrors(t *testing.T) {
 
 }
 
+func TestProcessProviderLogParseError(t *testing.T) {
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
+	var buf bytes.Buffer
+	creds := processcreds.NewCredentials(
+		fmt.Sprintf(
+			"%s %s",
+			getOSCat(),
+			strings.Join(
+				[]string{"testdata", "malformed.json"},
+				string(os.PathSeparator))),
+		func(p *processcreds.ProcessProvider) {
+			p.Logger = aws.NewStructuredLogger(&buf)
+		})
+	if _, err := creds.Get(); err == nil {
+		t.Fatalf("expect error")
+	}
+
+	logged := buf.String()
+	if e, a := processcreds.ErrCodeProcessProviderParse, logged; !strings.Contains(a, e) {
+		t.Errorf("expect %q logged, got %v", e, a)
+	}
+	// The process's output is not logged.
+	if e, a := "Version", logged; strings.Contains(a, e) {
+		t.Errorf("expect %q not logged, got %v", e, a)
+	}
+}
+
 func TestProcessProviderTimeout(t *testing.T) {
 	restoreEnvFn := sdktesting.StashEnv()
 	defer restoreEnvFn()
@@ -413,6 +444,61 @@ func TestProcessProviderNotExpired(t *testing.T) {
 	}
 }
 
+func TestProcessProviderFileCache(t *testing.T) {
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
+	dir, err := ioutil.TempDir(os.TempDir(), "tmp_cache")
+	if err != nil {
+		t.Fatalf("expected %v, got %v", "no error", err)
+	}
+	defer os.RemoveAll(dir)
+
+	exp := &credentialTest{
+		Version:         1,
+		AccessKeyID:     "accesskey",
+		SecretAccessKey: "secretkey",
+		Expiration:      time.Now().Add(1 * time.Hour).UTC().Format(time.RFC3339),
+	}
+	b, err := json.Marshal(exp)
+	if err != nil {
+		t.Fatalf("expected %v, got %v", "no error", err)
+	}
+	credsFile := filepath.Join(dir, "creds.json")
+	if err = ioutil.WriteFile(credsFile, b, 0600); err != nil {
+		t.Fatalf("expected %v, got %v", "no error", err)
+	}
+
+	newCreds := func() *credentials.Credentials {
+		return processcreds.NewCredentials(
+			fmt.Sprintf("%s %s", getOSCat(), credsFile),
+			func(p *processcreds.ProcessProvider) {
+				p.Cache = credentials.NewFileCache(filepath.Join(dir, "cache"))
+			})
+	}
+
+	if _, err = newCreds().Get(); err != nil {
+		t.Fatalf("expected %v, got %v", "no error", err)
+	}
+
+	// The process would fail, so the credentials must be from the cache.
+	if err = os.Remove(credsFile); err != nil {
+		t.Fatalf("expected %v, got %v", "no error", err)
+	}
+
+	creds := newCreds()
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("expected %v, got %v", "no error", err)
+	}
+	if e, a := "accesskey", v.AccessKeyID; e != a {
+		t.Errorf("expected %v, got %v", e, a)
+	}
+	if creds.IsExpired() {
+		t.Errorf("expected %v, got %v", "not expired", "expired")
+	}
+}
+
 func TestProcessProviderExpired(t *testing.T) {
 	restoreEnvFn := sdktesting.StashEnv()
 	defer restoreEnvFn()
@@ -0,0 +1,244 @@
+/*
+Package rolesanywherecreds provides a credential provider for retrieving
+temporary AWS credentials from IAM Roles Anywhere, for workloads outside of
+AWS with an X.509 certificate issued by a certificate authority registered
+as a Roles Anywhere trust anchor.
+
+The provider calls the Roles Anywhere CreateSession API operation, signed
+with the private key of the certificate, to retrieve the credentials of the
+role. This is the equivalent of using the aws_signing_helper
+credential-process command.
+
+	signer, err := rolesanywherecreds.LoadX509SignerFromFiles(
+		"certificate.pem", "private-key.pem", "")
+	if err != nil {
+		return err
+	}
+
+	creds := rolesanywherecreds.NewCredentials(sess, signer,
+		"arn:aws:rolesanywhere:us-east-1:123456789012:trust-anchor/...",
+		"arn:aws:rolesanywhere:us-east-1:123456789012:profile/...",
+		"arn:aws:iam::123456789012:role/MyRole",
+	)
+
+The session, or client, the provider is created with must be configured
+for the region of the trust anchor and profile. The session does not need
+credentials.
+*/
+package rolesanywherecreds
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/service/rolesanywhere"
+)
+
+// ProviderName is the name of the provider used to specify the source of
+// credentials.
+const ProviderName = "RolesAnywhereProvider"
+
+// ErrCodeCreateSession is the error code returned when the CreateSession
+// API operation does not return credentials.
+const ErrCodeCreateSession = "RolesAnywhereCreateSessionError"
+
+// DefaultDuration is the default amount of time the credentials will be
+// valid for.
+var DefaultDuration = time.Hour
+
+const opCreateSession = "CreateSession"
+
+// SignRequestHandlerName is the name of the request handler signing the
+// CreateSession request with the X509Signer.
+const SignRequestHandlerName = "rolesanywherecreds.SignRequestHandler"
+
+// now is used to return the current time, swapped out by tests.
+var now = time.Now
+
+// Provider is an AWS credential provider that retrieves temporary AWS
+// credentials from IAM Roles Anywhere.
+type Provider struct {
+	credentials.Expiry
+
+	// The client configured for the region of the trust anchor and profile.
+	Client *client.Client
+
+	// Signs the CreateSession request with the certificate's private key.
+	Signer *X509Signer
+
+	// The ARN of the trust anchor the certificate was issued by.
+	TrustAnchorARN string
+
+	// The ARN of the Roles Anywhere profile.
+	ProfileARN string
+
+	// The ARN of the role to retrieve credentials for.
+	RoleARN string
+
+	// The name of the role session. Optional, the profile must accept a
+	// custom role session name.
+	SessionName string
+
+	// Duration the credentials will be valid for. Truncated to seconds.
+	// Defaults to DefaultDuration.
+	Duration time.Duration
+
+	// The amount of time the credentials will be refreshed before they
+	// expire. If unset, will default to no expiry window.
+	ExpiryWindow time.Duration
+}
+
+// NewCredentials returns a pointer to a new Credentials value wrapping the
+// Roles Anywhere Provider. The ConfigProvider is expected to be configured
+// for the region of the trust anchor and profile.
+func NewCredentials(c client.ConfigProvider, signer *X509Signer, trustAnchorARN, profileARN, roleARN string, options ...func(*Provider)) *credentials.Credentials {
+	return NewCredentialsWithClient(rolesanywhere.New(c).Client, signer, trustAnchorARN, profileARN, roleARN, options...)
+}
+
+// NewCredentialsWithClient returns a pointer to a new Credentials value
+// wrapping the Roles Anywhere Provider using the Roles Anywhere API client.
+func NewCredentialsWithClient(client *client.Client, signer *X509Signer, trustAnchorARN, profileARN, roleARN string, options ...func(*Provider)) *credentials.Credentials {
+	p := &Provider{
+		Client:         client,
+		Signer:         signer,
+		TrustAnchorARN: trustAnchorARN,
+		ProfileARN:     profileARN,
+		RoleARN:        roleARN,
+		Duration:       DefaultDuration,
+	}
+	for _, option := range options {
+		option(p)
+	}
+
+	return credentials.NewCredentials(p)
+}
+
+// Retrieve retrieves credentials from IAM Roles Anywhere.
+func (p *Provider) Retrieve() (credentials.Value, error) {
+	return p.RetrieveWithContext(aws.BackgroundContext())
+}
+
+// RetrieveWithContext retrieves credentials from IAM Roles Anywhere.
+func (p *Provider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
+	if p.Duration == 0 {
+		p.Duration = DefaultDuration
+	}
+
+	input := &createSessionInput{
+		DurationSeconds: aws.Int64(int64(p.Duration / time.Second)),
+		ProfileArn:      &p.ProfileARN,
+		RoleArn:         &p.RoleARN,
+		TrustAnchorArn:  &p.TrustAnchorARN,
+	}
+	if len(p.SessionName) != 0 {
+		input.RoleSessionName = &p.SessionName
+	}
+
+	output := &createSessionOutput{}
+	req := p.Client.NewRequest(&request.Operation{
+		Name:       opCreateSession,
+		HTTPMethod: "POST",
+		HTTPPath:   "/sessions",
+	}, input, output)
+	req.SetContext(ctx)
+	req.Config.Credentials = credentials.AnonymousCredentials
+	req.Handlers.Sign.Remove(v4.SignRequestHandler)
+	req.Handlers.Sign.PushBackNamed(request.NamedHandler{
+		Name: SignRequestHandlerName,
+		Fn:   p.signRequest,
+	})
+
+	if err := req.Send(); err != nil {
+		return credentials.Value{}, err
+	}
+
+	if len(output.CredentialSet) == 0 || output.CredentialSet[0].Credentials == nil {
+		return credentials.Value{}, awserr.New(ErrCodeCreateSession, "missing required field credentialSet", nil)
+	}
+	creds := output.CredentialSet[0].Credentials
+
+	p.SetExpiration(aws.TimeValue(creds.Expiration), p.ExpiryWindow)
+
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(creds.AccessKeyId),
+		SecretAccessKey: aws.StringValue(creds.SecretAccessKey),
+		SessionToken:    aws.StringValue(creds.SessionToken),
+		ProviderName:    ProviderName,
+	}, nil
+}
+
+// signRequest signs the CreateSession request with the X509Signer.
+func (p *Provider) signRequest(r *request.Request) {
+	if p.Signer == nil {
+		r.Error = awserr.New(ErrCodeInvalidCertificate, "Signer is required to create session", nil)
+		return
+	}
+
+	region := r.ClientInfo.SigningRegion
+	if region == "" {
+		region = aws.StringValue(r.Config.Region)
+	}
+
+	name := r.ClientInfo.SigningName
+	if name == "" {
+		name = "rolesanywhere"
+	}
+
+	signTime := now()
+	signedHeaders, err := p.Signer.SignHTTPRequest(r.HTTPRequest, r.GetBody(), name, region, signTime)
+	if err != nil {
+		r.Error = err
+		r.SignedHeaderVals = nil
+		return
+	}
+
+	r.SignedHeaderVals = signedHeaders
+	r.LastSignedAt = signTime
+}
+
+type createSessionInput struct {
+	_ struct{} `type:"structure"`
+
+	DurationSeconds *int64 `locationName:"durationSeconds" type:"integer"`
+
+	ProfileArn *string `locationName:"profileArn" type:"string" required:"true"`
+
+	RoleArn *string `locationName:"roleArn" type:"string" required:"true"`
+
+	RoleSessionName *string `locationName:"roleSessionName" type:"string"`
+
+	TrustAnchorArn *string `locationName:"trustAnchorArn" type:"string" required:"true"`
+}
+
+type createSessionOutput struct {
+	_ struct{} `type:"structure"`
+
+	CredentialSet []*credentialSetItem `locationName:"credentialSet" type:"list"`
+
+	SubjectArn *string `locationName:"subjectArn" type:"string"`
+}
+
+type credentialSetItem struct {
+	_ struct{} `type:"structure"`
+
+	Credentials *sessionCredentials `locationName:"credentials" type:"structure"`
+
+	RoleArn *string `locationName:"roleArn" type:"string"`
+}
+
+type sessionCredentials struct {
+	_ struct{} `type:"structure"`
+
+	AccessKeyId *string `locationName:"accessKeyId" type:"string"`
+
+	Expiration *time.Time `locationName:"expiration" type:"timestamp" timestampFormat:"iso8601"`
+
+	SecretAccessKey *string `locationName:"secretAccessKey" type:"string"`
+
+	SessionToken *string `locationName:"sessionToken" type:"string"`
+}
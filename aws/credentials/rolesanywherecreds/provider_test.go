@@ -0,0 +1,325 @@
+package rolesanywherecreds
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+)
+
+type testCertificate struct {
+	Certificate *x509.Certificate
+	PrivateKey  crypto.Signer
+	CertPEM     []byte
+}
+
+func newTestCertificate(t *testing.T, key crypto.Signer, serial int64, parent *testCertificate) testCertificate {
+	t.Helper()
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	parentCert, parentKey := template, key
+	if parent != nil {
+		parentCert, parentKey = parent.Certificate, parent.PrivateKey
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, key.Public(), parentKey)
+	if err != nil {
+		t.Fatalf("failed to create certificate, %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate, %v", err)
+	}
+
+	return testCertificate{
+		Certificate: cert,
+		PrivateKey:  key,
+		CertPEM:     pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+func newRSAKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key, %v", err)
+	}
+	return key
+}
+
+func newECKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key, %v", err)
+	}
+	return key
+}
+
+func TestLoadX509Signer(t *testing.T) {
+	rsaKey := newRSAKey(t)
+	ecKey := newECKey(t)
+
+	pkcs8 := func(key crypto.Signer) []byte {
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			t.Fatalf("failed to marshal key, %v", err)
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	}
+	sec1, err := x509.MarshalECPrivateKey(ecKey)
+	if err != nil {
+		t.Fatalf("failed to marshal key, %v", err)
+	}
+
+	cases := map[string]struct {
+		Key             crypto.Signer
+		KeyPEM          []byte
+		ExpectAlgorithm string
+		ExpectErr       bool
+	}{
+		"RSA PKCS#8": {
+			Key:             rsaKey,
+			KeyPEM:          pkcs8(rsaKey),
+			ExpectAlgorithm: "AWS4-X509-RSA-SHA256",
+		},
+		"RSA PKCS#1": {
+			Key: rsaKey,
+			KeyPEM: pem.EncodeToMemory(&pem.Block{
+				Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey),
+			}),
+			ExpectAlgorithm: "AWS4-X509-RSA-SHA256",
+		},
+		"EC PKCS#8": {
+			Key:             ecKey,
+			KeyPEM:          pkcs8(ecKey),
+			ExpectAlgorithm: "AWS4-X509-ECDSA-SHA256",
+		},
+		"EC SEC 1": {
+			Key:             ecKey,
+			KeyPEM:          pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: sec1}),
+			ExpectAlgorithm: "AWS4-X509-ECDSA-SHA256",
+		},
+		"mismatched key": {
+			Key:       rsaKey,
+			KeyPEM:    pkcs8(newRSAKey(t)),
+			ExpectErr: true,
+		},
+		"encrypted key": {
+			Key:       rsaKey,
+			KeyPEM:    pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: []byte{1}}),
+			ExpectErr: true,
+		},
+		"no key": {
+			Key:       rsaKey,
+			KeyPEM:    []byte("not a key"),
+			ExpectErr: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			cert := newTestCertificate(t, c.Key, 1234, nil)
+
+			signer, err := LoadX509Signer(cert.CertPEM, c.KeyPEM)
+			if c.ExpectErr {
+				aerr, ok := err.(awserr.Error)
+				if !ok {
+					t.Fatalf("expect awserr.Error, got %T, %v", err, err)
+				}
+				if e, a := ErrCodeInvalidCertificate, aerr.Code(); e != a {
+					t.Errorf("expect %v error code, got %v", e, a)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.ExpectAlgorithm, signer.Algorithm(); e != a {
+				t.Errorf("expect %v algorithm, got %v", e, a)
+			}
+			if e, a := "1234", signer.CredentialID(); e != a {
+				t.Errorf("expect %v credential ID, got %v", e, a)
+			}
+
+			signature, err := signer.SignString("string to sign")
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			digest := sha256.Sum256([]byte("string to sign"))
+			switch pub := cert.Certificate.PublicKey.(type) {
+			case *rsa.PublicKey:
+				err = rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], signature)
+			case *ecdsa.PublicKey:
+				if !ecdsa.VerifyASN1(pub, digest[:], signature) {
+					err = errInvalidSignature
+				}
+			}
+			if err != nil {
+				t.Errorf("expect valid signature, got %v", err)
+			}
+		})
+	}
+}
+
+var errInvalidSignature = awserr.New("InvalidSignature", "invalid signature", nil)
+
+func TestLoadX509SignerFromFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "aws-sdk-go-rolesanywhere")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	ca := newTestCertificate(t, newECKey(t), 1, nil)
+	leafKey := newECKey(t)
+	leaf := newTestCertificate(t, leafKey, 2, &ca)
+
+	keyDER, err := x509.MarshalECPrivateKey(leafKey)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	files := map[string][]byte{
+		"cert.pem":  leaf.CertPEM,
+		"key.pem":   pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		"chain.pem": ca.CertPEM,
+	}
+	for name, b := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), b, 0600); err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+	}
+
+	signer, err := LoadX509SignerFromFiles(filepath.Join(dir, "cert.pem"),
+		filepath.Join(dir, "key.pem"), filepath.Join(dir, "chain.pem"))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := leaf.Certificate.SerialNumber, signer.Certificate().SerialNumber; e.Cmp(a) != 0 {
+		t.Errorf("expect %v serial number, got %v", e, a)
+	}
+	if e, a := 1, len(signer.chain); e != a {
+		t.Errorf("expect %v chain certificates, got %v", e, a)
+	}
+}
+
+func TestProvider(t *testing.T) {
+	ca := newTestCertificate(t, newRSAKey(t), 1, nil)
+	leaf := newTestCertificate(t, newRSAKey(t), 1234, &ca)
+
+	signer, err := NewX509Signer(leaf.Certificate, []*x509.Certificate{ca.Certificate}, leaf.PrivateKey)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expiration := time.Date(2022, 10, 1, 13, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if e, a := "/sessions", r.URL.Path; e != a {
+			t.Errorf("expect %v path, got %v", e, a)
+		}
+		if e, a := base64.StdEncoding.EncodeToString(leaf.Certificate.Raw), r.Header.Get("X-Amz-X509"); e != a {
+			t.Errorf("expect %v certificate header, got %v", e, a)
+		}
+		if e, a := base64.StdEncoding.EncodeToString(ca.Certificate.Raw), r.Header.Get("X-Amz-X509-Chain"); e != a {
+			t.Errorf("expect %v chain header, got %v", e, a)
+		}
+
+		auth := r.Header.Get("Authorization")
+		prefix := "AWS4-X509-RSA-SHA256 Credential=1234/"
+		if !strings.HasPrefix(auth, prefix) {
+			t.Errorf("expect Authorization to start with %v, got %v", prefix, auth)
+		}
+		for _, h := range []string{"x-amz-date", "x-amz-x509", "x-amz-x509-chain"} {
+			if !strings.Contains(auth, h) {
+				t.Errorf("expect %v to be signed, got %v", h, auth)
+			}
+		}
+		sig := auth[strings.Index(auth, "Signature=")+len("Signature="):]
+		if _, err := hex.DecodeString(sig); err != nil || len(sig) == 0 {
+			t.Errorf("expect hex signature, got %v", sig)
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("expect no error, got %v", err)
+		}
+		expect := map[string]interface{}{
+			"durationSeconds": float64(900),
+			"profileArn":      "profile-arn",
+			"roleArn":         "role-arn",
+			"trustAnchorArn":  "trust-anchor-arn",
+			"roleSessionName": "session",
+		}
+		for k, v := range expect {
+			if e, a := v, body[k]; e != a {
+				t.Errorf("expect %v %v, got %v", k, e, a)
+			}
+		}
+
+		w.Write([]byte(`{"credentialSet": [{"credentials": {
+			"accessKeyId": "AKID",
+			"secretAccessKey": "SECRET",
+			"sessionToken": "TOKEN",
+			"expiration": "2022-10-01T13:00:00Z"
+		}, "roleArn": "role-arn"}], "subjectArn": "subject-arn"}`))
+	}))
+	defer server.Close()
+
+	sess := unit.Session.Copy(&aws.Config{
+		Endpoint:   aws.String(server.URL),
+		DisableSSL: aws.Bool(true),
+	})
+
+	creds := NewCredentials(sess, signer, "trust-anchor-arn", "profile-arn", "role-arn", func(p *Provider) {
+		p.SessionName = "session"
+		p.Duration = 15 * time.Minute
+	})
+
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "AKID", v.AccessKeyID; e != a {
+		t.Errorf("expect %v access key, got %v", e, a)
+	}
+	if e, a := "TOKEN", v.SessionToken; e != a {
+		t.Errorf("expect %v session token, got %v", e, a)
+	}
+	if e, a := ProviderName, v.ProviderName; e != a {
+		t.Errorf("expect %v provider name, got %v", e, a)
+	}
+
+	expiresAt, err := creds.ExpiresAt()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := expiration, expiresAt; !e.Equal(a) {
+		t.Errorf("expect %v expiration, got %v", e, a)
+	}
+}
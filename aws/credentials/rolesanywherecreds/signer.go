@@ -0,0 +1,246 @@
+package rolesanywherecreds
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+)
+
+// ErrCodeInvalidCertificate is the error code returned when the X.509
+// certificate, or its private key, cannot be loaded or used to sign
+// requests.
+const ErrCodeInvalidCertificate = "InvalidCertificateError"
+
+const (
+	rsaSigningAlgorithm   = "AWS4-X509-RSA-SHA256"
+	ecdsaSigningAlgorithm = "AWS4-X509-ECDSA-SHA256"
+
+	x509Header      = "X-Amz-X509"
+	x509ChainHeader = "X-Amz-X509-Chain"
+)
+
+// X509Signer signs requests with the private key of an X.509 certificate,
+// as required by IAM Roles Anywhere. RSA and EC private keys are supported.
+//
+// The X509Signer implements v4.AlgorithmSigner, so requests are signed with
+// the AWS v4 canonical request.
+type X509Signer struct {
+	certificate *x509.Certificate
+	chain       []*x509.Certificate
+	privateKey  crypto.Signer
+	algorithm   string
+}
+
+// NewX509Signer returns an X509Signer signing requests with the private key
+// of the certificate. The chain of intermediate certificates is sent with
+// requests, so IAM Roles Anywhere can verify the certificate was issued by
+// the trust anchor. The chain is optional.
+//
+// Returns an error if the private key is not an RSA or EC private key, or
+// does not match the certificate's public key.
+func NewX509Signer(certificate *x509.Certificate, chain []*x509.Certificate, privateKey crypto.Signer) (*X509Signer, error) {
+	var algorithm string
+	switch privateKey.(type) {
+	case *rsa.PrivateKey:
+		algorithm = rsaSigningAlgorithm
+	case *ecdsa.PrivateKey:
+		algorithm = ecdsaSigningAlgorithm
+	default:
+		return nil, awserr.New(ErrCodeInvalidCertificate,
+			fmt.Sprintf("unsupported private key type %T, expect RSA or EC private key", privateKey), nil)
+	}
+
+	if !publicKeysEqual(certificate.PublicKey, privateKey.Public()) {
+		return nil, awserr.New(ErrCodeInvalidCertificate,
+			"private key does not match the certificate's public key", nil)
+	}
+
+	return &X509Signer{
+		certificate: certificate,
+		chain:       chain,
+		privateKey:  privateKey,
+		algorithm:   algorithm,
+	}, nil
+}
+
+// LoadX509Signer returns an X509Signer for the PEM encoded certificate and
+// private key. The first certificate of certPEM is the certificate requests
+// are signed for, and any additional certificates are its chain of
+// intermediate certificates.
+//
+// The private key may be a PKCS#8, PKCS#1 RSA, or SEC 1 EC private key.
+// Encrypted private keys are not supported.
+func LoadX509Signer(certPEM, keyPEM []byte) (*X509Signer, error) {
+	certs, err := parseCertificates(certPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := parsePrivateKey(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewX509Signer(certs[0], certs[1:], privateKey)
+}
+
+// LoadX509SignerFromFiles returns an X509Signer for the PEM encoded
+// certificate and private key files. An optional certificate chain file
+// contains the PEM encoded intermediate certificates. See LoadX509Signer
+// for the supported formats.
+func LoadX509SignerFromFiles(certFile, keyFile, chainFile string) (*X509Signer, error) {
+	certPEM, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		return nil, awserr.New(ErrCodeInvalidCertificate, "failed to read certificate file", err)
+	}
+
+	keyPEM, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, awserr.New(ErrCodeInvalidCertificate, "failed to read private key file", err)
+	}
+
+	if len(chainFile) != 0 {
+		chainPEM, err := ioutil.ReadFile(chainFile)
+		if err != nil {
+			return nil, awserr.New(ErrCodeInvalidCertificate, "failed to read certificate chain file", err)
+		}
+		certPEM = append(append(certPEM, '\n'), chainPEM...)
+	}
+
+	return LoadX509Signer(certPEM, keyPEM)
+}
+
+// Certificate returns the certificate requests are signed for.
+func (s *X509Signer) Certificate() *x509.Certificate {
+	return s.certificate
+}
+
+// Algorithm returns the signing algorithm of the private key, either
+// AWS4-X509-RSA-SHA256, or AWS4-X509-ECDSA-SHA256.
+func (s *X509Signer) Algorithm() string {
+	return s.algorithm
+}
+
+// CredentialID returns the certificate's serial number, in decimal.
+func (s *X509Signer) CredentialID() string {
+	return s.certificate.SerialNumber.String()
+}
+
+// SignString returns the signature of the SHA256 digest of the string to
+// sign. RSA keys sign with PKCS #1 v1.5, and EC keys sign with ECDSA
+// returning an ASN.1 encoded signature.
+func (s *X509Signer) SignString(stringToSign string) ([]byte, error) {
+	digest := sha256.Sum256([]byte(stringToSign))
+
+	signature, err := s.privateKey.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return nil, awserr.New(ErrCodeInvalidCertificate, "failed to sign request", err)
+	}
+	return signature, nil
+}
+
+// SignHTTPRequest adds the certificate, and its chain, to the request's
+// headers and signs the request for the service and region.
+func (s *X509Signer) SignHTTPRequest(r *http.Request, body io.ReadSeeker, service, region string, signTime time.Time) (http.Header, error) {
+	r.Header.Set(x509Header, base64.StdEncoding.EncodeToString(s.certificate.Raw))
+	if len(s.chain) != 0 {
+		chain := make([]string, len(s.chain))
+		for i, cert := range s.chain {
+			chain[i] = base64.StdEncoding.EncodeToString(cert.Raw)
+		}
+		r.Header.Set(x509ChainHeader, strings.Join(chain, ","))
+	} else {
+		r.Header.Del(x509ChainHeader)
+	}
+
+	return v4.SignWithAlgorithm(r, body, service, region, signTime, s)
+}
+
+func parseCertificates(certPEM []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for rest := certPEM; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, awserr.New(ErrCodeInvalidCertificate, "failed to parse certificate", err)
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, awserr.New(ErrCodeInvalidCertificate, "no PEM encoded certificate found", nil)
+	}
+	return certs, nil
+}
+
+func parsePrivateKey(keyPEM []byte) (crypto.Signer, error) {
+	for rest := keyPEM; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		var key interface{}
+		var err error
+		switch block.Type {
+		case "PRIVATE KEY":
+			key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		case "RSA PRIVATE KEY":
+			key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		case "EC PRIVATE KEY":
+			key, err = x509.ParseECPrivateKey(block.Bytes)
+		case "ENCRYPTED PRIVATE KEY":
+			return nil, awserr.New(ErrCodeInvalidCertificate, "encrypted private keys are not supported", nil)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, awserr.New(ErrCodeInvalidCertificate, "failed to parse private key", err)
+		}
+
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, awserr.New(ErrCodeInvalidCertificate,
+				fmt.Sprintf("unsupported private key type %T", key), nil)
+		}
+		return signer, nil
+	}
+
+	return nil, awserr.New(ErrCodeInvalidCertificate, "no PEM encoded private key found", nil)
+}
+
+func publicKeysEqual(a, b crypto.PublicKey) bool {
+	aDER, err := x509.MarshalPKIXPublicKey(a)
+	if err != nil {
+		return false
+	}
+	bDER, err := x509.MarshalPKIXPublicKey(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(aDER, bDER)
+}
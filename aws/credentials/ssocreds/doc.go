@@ -5,6 +5,9 @@
 // some other mechanism. The provider must find a valid non-expired access token for the AWS SSO user portal URL in
 // ~/.aws/sso/cache. If a cached token is not found, it is expired, or the file is malformed an error will be returned.
 //
+// The Login function performs the AWS SSO login flow, and writes the access token to ~/.aws/sso/cache. The
+// session.SSOLogin function performs the login flow for the SSO configuration of a shared config profile.
+//
 // Loading AWS SSO credentials with the AWS shared configuration file
 //
 // You can use configure AWS SSO credentials from the AWS shared configuration file by
@@ -0,0 +1,315 @@
+package ssocreds
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/auth/bearer"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ssooidc"
+)
+
+// ErrCodeSSOLogin is the code type that is returned if the SSO login flow
+// fails.
+const ErrCodeSSOLogin = "SSOLoginError"
+
+// DefaultLoginClientName is the name the OIDC client is registered with by
+// Login if LoginOptions.ClientName is not set.
+const DefaultLoginClientName = "aws-sdk-go"
+
+const (
+	deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+	// default interval to poll for the token, if not specified by
+	// StartDeviceAuthorization.
+	defaultLoginPollInterval = 5 * time.Second
+
+	// amount the poll interval is increased by when the client is asked to
+	// slow down.
+	loginSlowDownDelay = 5 * time.Second
+
+	startURLTokenField              = "startUrl"
+	regionTokenField                = "region"
+	registrationExpiresAtTokenField = "registrationExpiresAt"
+)
+
+// used to wait between CreateToken polls, swapped out by tests.
+var sleepWithContext = aws.SleepWithContext
+
+// LoginAPIClient provides the interface for the API client used by Login to
+// perform the SSO OIDC device authorization flow.
+type LoginAPIClient interface {
+	RegisterClientWithContext(aws.Context, *ssooidc.RegisterClientInput, ...request.Option) (*ssooidc.RegisterClientOutput, error)
+	StartDeviceAuthorizationWithContext(aws.Context, *ssooidc.StartDeviceAuthorizationInput, ...request.Option) (*ssooidc.StartDeviceAuthorizationOutput, error)
+	CreateTokenWithContext(aws.Context, *ssooidc.CreateTokenInput, ...request.Option) (*ssooidc.CreateTokenOutput, error)
+}
+
+// DeviceAuthorization is the device authorization the user must approve,
+// in their browser, for Login to complete.
+type DeviceAuthorization struct {
+	// The URI the user must visit to approve the authorization.
+	VerificationURI string
+
+	// The VerificationURI including the UserCode, so the user does not need
+	// to enter the code.
+	VerificationURIComplete string
+
+	// The code the user must enter at the VerificationURI.
+	UserCode string
+
+	// The time the authorization must be approved by.
+	ExpiresAt time.Time
+}
+
+// LoginOptions provides the options for configuring Login.
+type LoginOptions struct {
+	// The name of the sso-session the login is for. The token is cached
+	// under the session name, so it can be used by the SSOTokenProvider. If
+	// empty, the token is cached under the start URL, as used by the
+	// Provider for legacy SSO profiles without an sso-session.
+	SessionName string
+
+	// The region of the SSO OIDC service. Stored with the cached token.
+	Region string
+
+	// The scopes the OIDC client is registered with, (e.g.
+	// sso:account:access).
+	Scopes []string
+
+	// The name the OIDC client is registered with. Defaults to
+	// DefaultLoginClientName.
+	ClientName string
+
+	// The path the SSO token will be written to. Defaults to
+	// StandardCachedTokenFilepath of the SessionName, or the start URL if
+	// SessionName is empty.
+	CachedTokenFilepath string
+
+	// Called with the device authorization the user must approve, such as by
+	// displaying the VerificationURIComplete and UserCode to the user, or
+	// opening the URI in a browser. Login returns the error if one is
+	// returned. Required.
+	OnDeviceAuthorization func(DeviceAuthorization) error
+}
+
+// Login performs the SSO OIDC device authorization flow for the SSO start
+// URL, and writes the SSO token to the cached token file that is read by
+// the SSOTokenProvider and Provider.
+//
+// The OIDC client is registered with RegisterClient, unless the cached
+// token file already contains a client registration that has not expired.
+// The device is authorized with StartDeviceAuthorization, and
+// OnDeviceAuthorization is called so the user can approve the
+// authorization. Login then polls CreateToken until the user approves the
+// authorization, the authorization expires, or the context is canceled.
+//
+// The client must be configured for the AWS region of the SSO start URL.
+func Login(ctx aws.Context, client LoginAPIClient, startURL string, optFns ...func(*LoginOptions)) (bearer.Token, error) {
+	options := LoginOptions{
+		ClientName: DefaultLoginClientName,
+	}
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	if options.OnDeviceAuthorization == nil {
+		return bearer.Token{}, awserr.New(ErrCodeSSOLogin,
+			"OnDeviceAuthorization is required to login", nil)
+	}
+
+	if len(options.CachedTokenFilepath) == 0 {
+		key := options.SessionName
+		if len(key) == 0 {
+			key = startURL
+		}
+		cachedPath, err := StandardCachedTokenFilepath(key)
+		if err != nil {
+			return bearer.Token{}, awserr.New(ErrCodeSSOLogin,
+				"failed to get cached SSO token filepath", err)
+		}
+		options.CachedTokenFilepath = cachedPath
+	}
+
+	token, err := loadClientRegistration(options.CachedTokenFilepath)
+	if err != nil {
+		token, err = registerClient(ctx, client, options)
+		if err != nil {
+			return bearer.Token{}, err
+		}
+	}
+
+	auth, err := client.StartDeviceAuthorizationWithContext(ctx, &ssooidc.StartDeviceAuthorizationInput{
+		ClientId:     &token.ClientID,
+		ClientSecret: &token.ClientSecret,
+		StartUrl:     &startURL,
+	})
+	if err != nil {
+		return bearer.Token{}, awserr.New(ErrCodeSSOLogin,
+			"failed to start SSO device authorization", err)
+	}
+
+	created, err := pollCreateToken(ctx, client, token, auth, options)
+	if err != nil {
+		return bearer.Token{}, err
+	}
+
+	expiresAt := nowTime().Add(time.Duration(*created.ExpiresIn) * time.Second)
+	token.AccessToken = *created.AccessToken
+	token.ExpiresAt = (*rfc3339)(&expiresAt)
+	token.RefreshToken = aws.StringValue(created.RefreshToken)
+	token.UnknownFields[startURLTokenField] = startURL
+	if len(options.Region) != 0 {
+		token.UnknownFields[regionTokenField] = options.Region
+	}
+
+	if err := os.MkdirAll(filepath.Dir(options.CachedTokenFilepath), 0700); err != nil {
+		return bearer.Token{}, awserr.New(ErrCodeSSOLogin,
+			"failed to create SSO token cache directory", err)
+	}
+	if err := storeCachedToken(options.CachedTokenFilepath, token, 0600); err != nil {
+		return bearer.Token{}, awserr.New(ErrCodeSSOLogin,
+			"failed to cache SSO token", err)
+	}
+
+	return bearer.Token{
+		Value:     token.AccessToken,
+		CanExpire: true,
+		Expires:   expiresAt,
+	}, nil
+}
+
+// loadClientRegistration returns the OIDC client registration of the cached
+// token file, if present and not expired.
+func loadClientRegistration(filename string) (cachedToken, error) {
+	// The cached token's access token may be missing or expired, so the
+	// token file is loaded without requiring it.
+	var t cachedToken
+	if err := readCacheFile(filename, &t); err != nil {
+		return cachedToken{}, err
+	}
+
+	if len(t.ClientID) == 0 || len(t.ClientSecret) == 0 {
+		return cachedToken{}, fmt.Errorf("cached SSO token has no client registration")
+	}
+
+	v, _ := t.UnknownFields[registrationExpiresAtTokenField].(string)
+	expiresAt, err := parseRFC3339(v)
+	if err != nil {
+		return cachedToken{}, err
+	}
+	if !nowTime().Before(time.Time(expiresAt)) {
+		return cachedToken{}, fmt.Errorf("cached SSO client registration expired")
+	}
+
+	return cachedToken{
+		tokenKnownFields: tokenKnownFields{
+			ClientID:     t.ClientID,
+			ClientSecret: t.ClientSecret,
+		},
+		UnknownFields: map[string]interface{}{
+			registrationExpiresAtTokenField: v,
+		},
+	}, nil
+}
+
+func registerClient(ctx aws.Context, client LoginAPIClient, options LoginOptions) (cachedToken, error) {
+	input := &ssooidc.RegisterClientInput{
+		ClientName: &options.ClientName,
+		ClientType: aws.String("public"),
+	}
+	if len(options.Scopes) != 0 {
+		input.Scopes = aws.StringSlice(options.Scopes)
+	}
+
+	registered, err := client.RegisterClientWithContext(ctx, input)
+	if err != nil {
+		return cachedToken{}, awserr.New(ErrCodeSSOLogin,
+			"failed to register SSO OIDC client", err)
+	}
+	if registered.ClientId == nil || registered.ClientSecret == nil {
+		return cachedToken{}, awserr.New(ErrCodeSSOLogin,
+			"missing required field ClientId or ClientSecret", nil)
+	}
+
+	t := cachedToken{
+		tokenKnownFields: tokenKnownFields{
+			ClientID:     *registered.ClientId,
+			ClientSecret: *registered.ClientSecret,
+		},
+		UnknownFields: map[string]interface{}{},
+	}
+	if registered.ClientSecretExpiresAt != nil {
+		expiresAt := time.Unix(*registered.ClientSecretExpiresAt, 0).UTC()
+		t.UnknownFields[registrationExpiresAtTokenField] = expiresAt.Format(time.RFC3339)
+	}
+
+	return t, nil
+}
+
+// pollCreateToken calls OnDeviceAuthorization, and polls CreateToken until
+// the device authorization is approved by the user.
+func pollCreateToken(ctx aws.Context, client LoginAPIClient, token cachedToken,
+	auth *ssooidc.StartDeviceAuthorizationOutput, options LoginOptions,
+) (*ssooidc.CreateTokenOutput, error) {
+	expiresAt := nowTime().Add(time.Duration(aws.Int64Value(auth.ExpiresIn)) * time.Second)
+
+	err := options.OnDeviceAuthorization(DeviceAuthorization{
+		VerificationURI:         aws.StringValue(auth.VerificationUri),
+		VerificationURIComplete: aws.StringValue(auth.VerificationUriComplete),
+		UserCode:                aws.StringValue(auth.UserCode),
+		ExpiresAt:               expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	interval := defaultLoginPollInterval
+	if v := aws.Int64Value(auth.Interval); v > 0 {
+		interval = time.Duration(v) * time.Second
+	}
+
+	for {
+		if err := sleepWithContext(ctx, interval); err != nil {
+			return nil, awserr.New(request.CanceledErrorCode,
+				"SSO login canceled", err)
+		}
+
+		created, err := client.CreateTokenWithContext(ctx, &ssooidc.CreateTokenInput{
+			ClientId:     &token.ClientID,
+			ClientSecret: &token.ClientSecret,
+			DeviceCode:   auth.DeviceCode,
+			GrantType:    aws.String(deviceCodeGrantType),
+		})
+		if err == nil {
+			if created.AccessToken == nil || created.ExpiresIn == nil {
+				return nil, awserr.New(ErrCodeSSOLogin,
+					"missing required field AccessToken or ExpiresIn", nil)
+			}
+			return created, nil
+		}
+
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			case ssooidc.ErrCodeAuthorizationPendingException:
+				if nowTime().Before(expiresAt) {
+					continue
+				}
+			case ssooidc.ErrCodeSlowDownException:
+				interval += loginSlowDownDelay
+				if nowTime().Before(expiresAt) {
+					continue
+				}
+			}
+		}
+
+		if !nowTime().Before(expiresAt) {
+			return nil, awserr.New(ErrCodeSSOLogin,
+				"SSO device authorization expired before it was approved", err)
+		}
+		return nil, awserr.New(ErrCodeSSOLogin, "failed to create SSO token", err)
+	}
+}
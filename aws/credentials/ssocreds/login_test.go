@@ -0,0 +1,292 @@
+//go:build go1.9
+// +build go1.9
+
+package ssocreds
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ssooidc"
+)
+
+type mockLoginClient struct {
+	t *testing.T
+
+	RegisterCalls int
+	CreateErrs    []error
+	CreateCalls   int
+}
+
+func (c *mockLoginClient) RegisterClientWithContext(ctx aws.Context, input *ssooidc.RegisterClientInput, opts ...request.Option) (*ssooidc.RegisterClientOutput, error) {
+	c.RegisterCalls++
+	if e, a := "public", aws.StringValue(input.ClientType); e != a {
+		c.t.Errorf("expect %v client type, got %v", e, a)
+	}
+	if e, a := []string{"sso:account:access"}, aws.StringValueSlice(input.Scopes); !reflect.DeepEqual(e, a) {
+		c.t.Errorf("expect %v scopes, got %v", e, a)
+	}
+	return &ssooidc.RegisterClientOutput{
+		ClientId:              aws.String("client-id"),
+		ClientSecret:          aws.String("client-secret"),
+		ClientSecretExpiresAt: aws.Int64(time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC).Unix()),
+	}, nil
+}
+
+func (c *mockLoginClient) StartDeviceAuthorizationWithContext(ctx aws.Context, input *ssooidc.StartDeviceAuthorizationInput, opts ...request.Option) (*ssooidc.StartDeviceAuthorizationOutput, error) {
+	if e, a := "client-id", aws.StringValue(input.ClientId); e != a {
+		c.t.Errorf("expect %v client id, got %v", e, a)
+	}
+	if e, a := "https://start.url", aws.StringValue(input.StartUrl); e != a {
+		c.t.Errorf("expect %v start URL, got %v", e, a)
+	}
+	return &ssooidc.StartDeviceAuthorizationOutput{
+		DeviceCode:              aws.String("device-code"),
+		UserCode:                aws.String("ABCD-EFGH"),
+		VerificationUri:         aws.String("https://device.sso.us-west-2.amazonaws.com/"),
+		VerificationUriComplete: aws.String("https://device.sso.us-west-2.amazonaws.com/?user_code=ABCD-EFGH"),
+		ExpiresIn:               aws.Int64(60),
+		Interval:                aws.Int64(1),
+	}, nil
+}
+
+func (c *mockLoginClient) CreateTokenWithContext(ctx aws.Context, input *ssooidc.CreateTokenInput, opts ...request.Option) (*ssooidc.CreateTokenOutput, error) {
+	c.CreateCalls++
+	if e, a := deviceCodeGrantType, aws.StringValue(input.GrantType); e != a {
+		c.t.Errorf("expect %v grant type, got %v", e, a)
+	}
+	if e, a := "device-code", aws.StringValue(input.DeviceCode); e != a {
+		c.t.Errorf("expect %v device code, got %v", e, a)
+	}
+	if len(c.CreateErrs) != 0 {
+		err := c.CreateErrs[0]
+		c.CreateErrs = c.CreateErrs[1:]
+		return nil, err
+	}
+	return &ssooidc.CreateTokenOutput{
+		AccessToken:  aws.String("access-token"),
+		RefreshToken: aws.String("refresh-token"),
+		ExpiresIn:    aws.Int64(3600),
+	}, nil
+}
+
+func swapLoginSleep(now *time.Time, sleeps *[]time.Duration) func() {
+	origNowTime, origSleep := nowTime, sleepWithContext
+	nowTime = func() time.Time { return *now }
+	sleepWithContext = func(ctx aws.Context, d time.Duration) error {
+		*sleeps = append(*sleeps, d)
+		*now = now.Add(d)
+		return nil
+	}
+	return func() {
+		nowTime, sleepWithContext = origNowTime, origSleep
+	}
+}
+
+func TestLogin(t *testing.T) {
+	tempDir, err := ioutil.TempDir(os.TempDir(), "aws-sdk-go-"+t.Name())
+	if err != nil {
+		t.Fatalf("failed to create temporary test directory, %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	cases := map[string]struct {
+		CreateErrs        []error
+		ExpectErr         string
+		ExpectSleeps      []time.Duration
+		ExpectCreateCalls int
+	}{
+		"approved": {
+			ExpectSleeps:      []time.Duration{time.Second},
+			ExpectCreateCalls: 1,
+		},
+		"pending and slow down": {
+			CreateErrs: []error{
+				awserr.New(ssooidc.ErrCodeAuthorizationPendingException, "pending", nil),
+				awserr.New(ssooidc.ErrCodeSlowDownException, "slow down", nil),
+				awserr.New(ssooidc.ErrCodeAuthorizationPendingException, "pending", nil),
+			},
+			ExpectSleeps:      []time.Duration{time.Second, time.Second, 6 * time.Second, 6 * time.Second},
+			ExpectCreateCalls: 4,
+		},
+		"access denied": {
+			CreateErrs: []error{
+				awserr.New(ssooidc.ErrCodeAccessDeniedException, "denied", nil),
+			},
+			ExpectErr: "failed to create SSO token",
+		},
+		"authorization expired": {
+			CreateErrs: func() []error {
+				errs := make([]error, 61)
+				for i := range errs {
+					errs[i] = awserr.New(ssooidc.ErrCodeAuthorizationPendingException, "pending", nil)
+				}
+				return errs
+			}(),
+			ExpectErr: "expired before it was approved",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			now := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+			var sleeps []time.Duration
+			defer swapLoginSleep(&now, &sleeps)()
+
+			client := &mockLoginClient{t: t, CreateErrs: c.CreateErrs}
+			cachePath := filepath.Join(tempDir, name, "cache.json")
+
+			var auth DeviceAuthorization
+			token, err := Login(aws.BackgroundContext(), client, "https://start.url", func(o *LoginOptions) {
+				o.SessionName = "my-sso"
+				o.Region = "us-west-2"
+				o.Scopes = []string{"sso:account:access"}
+				o.CachedTokenFilepath = cachePath
+				o.OnDeviceAuthorization = func(v DeviceAuthorization) error {
+					auth = v
+					return nil
+				}
+			})
+			if e, a := "ABCD-EFGH", auth.UserCode; e != a {
+				t.Errorf("expect %v user code, got %v", e, a)
+			}
+
+			if len(c.ExpectErr) != 0 {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				if e, a := c.ExpectErr, err.Error(); !strings.Contains(a, e) {
+					t.Fatalf("expect %v error, got %v", e, a)
+				}
+				if _, err := os.Stat(cachePath); !os.IsNotExist(err) {
+					t.Errorf("expect no cached token file, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.ExpectSleeps, sleeps; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v sleeps, got %v", e, a)
+			}
+			if e, a := c.ExpectCreateCalls, client.CreateCalls; e != a {
+				t.Errorf("expect %v CreateToken calls, got %v", e, a)
+			}
+			if e, a := "access-token", token.Value; e != a {
+				t.Errorf("expect %v token, got %v", e, a)
+			}
+			if e, a := now.Add(time.Hour), token.Expires; !e.Equal(a) {
+				t.Errorf("expect %v token expiration, got %v", e, a)
+			}
+
+			b, err := ioutil.ReadFile(cachePath)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			var cached map[string]interface{}
+			if err := json.Unmarshal(b, &cached); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			expect := map[string]interface{}{
+				"accessToken":           "access-token",
+				"expiresAt":             now.Add(time.Hour).Format(time.RFC3339),
+				"refreshToken":          "refresh-token",
+				"clientId":              "client-id",
+				"clientSecret":          "client-secret",
+				"registrationExpiresAt": "2022-12-01T00:00:00Z",
+				"startUrl":              "https://start.url",
+				"region":                "us-west-2",
+			}
+			if !reflect.DeepEqual(expect, cached) {
+				t.Errorf("expect %v cached token, got %v", expect, cached)
+			}
+
+			if runtime.GOOS != "windows" {
+				info, err := os.Stat(cachePath)
+				if err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+				if e, a := os.FileMode(0600), info.Mode().Perm(); e != a {
+					t.Errorf("expect %v file mode, got %v", e, a)
+				}
+			}
+		})
+	}
+}
+
+func TestLogin_ReuseClientRegistration(t *testing.T) {
+	tempDir, err := ioutil.TempDir(os.TempDir(), "aws-sdk-go-"+t.Name())
+	if err != nil {
+		t.Fatalf("failed to create temporary test directory, %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	now := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	var sleeps []time.Duration
+	defer swapLoginSleep(&now, &sleeps)()
+
+	client := &mockLoginClient{t: t}
+	cachePath := filepath.Join(tempDir, "cache.json")
+	login := func() {
+		t.Helper()
+		_, err := Login(aws.BackgroundContext(), client, "https://start.url", func(o *LoginOptions) {
+			o.Scopes = []string{"sso:account:access"}
+			o.CachedTokenFilepath = cachePath
+			o.OnDeviceAuthorization = func(DeviceAuthorization) error { return nil }
+		})
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+	}
+
+	login()
+	login()
+	if e, a := 1, client.RegisterCalls; e != a {
+		t.Errorf("expect %v RegisterClient calls, got %v", e, a)
+	}
+
+	// expired registration is replaced
+	now = time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC)
+	login()
+	if e, a := 2, client.RegisterCalls; e != a {
+		t.Errorf("expect %v RegisterClient calls, got %v", e, a)
+	}
+}
+
+func TestLogin_OnDeviceAuthorization(t *testing.T) {
+	client := &mockLoginClient{t: t}
+
+	_, err := Login(aws.BackgroundContext(), client, "https://start.url", func(o *LoginOptions) {
+		o.Scopes = []string{"sso:account:access"}
+		o.CachedTokenFilepath = filepath.Join("testdata", "file_not_exists")
+	})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+
+	_, err = Login(aws.BackgroundContext(), client, "https://start.url", func(o *LoginOptions) {
+		o.Scopes = []string{"sso:account:access"}
+		o.CachedTokenFilepath = filepath.Join("testdata", "file_not_exists")
+		o.OnDeviceAuthorization = func(DeviceAuthorization) error {
+			return fmt.Errorf("prompt failed")
+		}
+	})
+	if e, a := "prompt failed", fmt.Sprint(err); e != a {
+		t.Errorf("expect %v error, got %v", e, a)
+	}
+	if e, a := 0, client.CreateCalls; e != a {
+		t.Errorf("expect %v CreateToken calls, got %v", e, a)
+	}
+}
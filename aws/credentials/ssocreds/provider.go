@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/internal/sdklog"
 	"github.com/aws/aws-sdk-go/service/sso"
 	"github.com/aws/aws-sdk-go/service/sso/ssoiface"
 )
@@ -68,12 +69,23 @@ type Provider struct {
 	// Used by the SSOCredentialProvider if a token configuration
 	// profile is used in the shared config
 	TokenProvider bearer.TokenProvider
+
+	// Logger is the logger failures to retrieve credentials are logged to.
+	// NewCredentials sets the logger of the SSO client's config, if its
+	// LogLevel matches aws.LogDebugWithRequestErrors.
+	//
+	// If nil, failures are not logged.
+	Logger aws.Logger
 }
 
 // NewCredentials returns a new AWS Single Sign-On (AWS SSO) credential provider. The ConfigProvider is expected to be configured
 // for the AWS Region where the AWS SSO user portal is located.
 func NewCredentials(configProvider client.ConfigProvider, accountID, roleName, startURL string, optFns ...func(provider *Provider)) *credentials.Credentials {
-	return NewCredentialsWithClient(sso.New(configProvider), accountID, roleName, startURL, optFns...)
+	svc := sso.New(configProvider)
+	optFns = append([]func(*Provider){func(p *Provider) {
+		p.Logger = sdklog.CredentialsLogger(&svc.Config)
+	}}, optFns...)
+	return NewCredentialsWithClient(svc, accountID, roleName, startURL, optFns...)
 }
 
 // NewCredentialsWithClient returns a new AWS Single Sign-On (AWS SSO) credential provider. The provided client is expected to be configured
@@ -102,6 +114,14 @@ func (p *Provider) Retrieve() (credentials.Value, error) {
 // RetrieveWithContext retrieves temporary AWS credentials from the configured Amazon Single Sign-On (AWS SSO) user portal
 // by exchanging the accessToken present in ~/.aws/sso/cache.
 func (p *Provider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
+	v, err := p.retrieve(ctx)
+	if err != nil {
+		sdklog.LogCredentialsError(p.Logger, ProviderName, err)
+	}
+	return v, err
+}
+
+func (p *Provider) retrieve(ctx credentials.Context) (credentials.Value, error) {
 	var accessToken *string
 	if p.TokenProvider != nil {
 		token, err := p.TokenProvider.RetrieveBearerToken(ctx)
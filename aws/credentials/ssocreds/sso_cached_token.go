@@ -149,14 +149,9 @@ func getTokenFieldRFC3339(v interface{}, value **rfc3339) error {
 }
 
 func loadCachedToken(filename string) (cachedToken, error) {
-	fileBytes, err := ioutil.ReadFile(filename)
-	if err != nil {
-		return cachedToken{}, fmt.Errorf("failed to read cached SSO token file, %v", err)
-	}
-
 	var t cachedToken
-	if err := json.Unmarshal(fileBytes, &t); err != nil {
-		return cachedToken{}, fmt.Errorf("failed to parse cached SSO token file, %v", err)
+	if err := readCacheFile(filename, &t); err != nil {
+		return cachedToken{}, err
 	}
 
 	if len(t.AccessToken) == 0 || t.ExpiresAt == nil || time.Time(*t.ExpiresAt).IsZero() {
@@ -167,6 +162,19 @@ func loadCachedToken(filename string) (cachedToken, error) {
 	return t, nil
 }
 
+func readCacheFile(filename string, t *cachedToken) error {
+	fileBytes, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("failed to read cached SSO token file, %v", err)
+	}
+
+	if err := json.Unmarshal(fileBytes, t); err != nil {
+		return fmt.Errorf("failed to parse cached SSO token file, %v", err)
+	}
+
+	return nil
+}
+
 func storeCachedToken(filename string, t cachedToken, fileMode os.FileMode) (err error) {
 	tmpFilename := filename + ".tmp-" + strconv.FormatInt(nowTime().UnixNano(), 10)
 	if err := writeCacheFile(tmpFilename, fileMode, t); err != nil {
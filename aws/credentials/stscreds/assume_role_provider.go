@@ -74,12 +74,31 @@ single Credentials with an AssumeRoleProvider can be shared safely.
 	// Create service client value configured for credentials
 	// from assumed role.
 	svc := s3.New(sess, &aws.Config{Credentials: creds})
+
+# Assume Role with TOTP MFA Token Provider
+
+For automation that holds the secret of a virtual MFA device, the
+TOTPTokenProvider generates RFC 6238 TOTP MFA token codes from the device's
+base32 encoded secret. The TOTPTokenProvider will not return the same token
+code twice, and waits for the next period if needed.
+
+	totp, err := stscreds.NewTOTPTokenProvider(secret)
+	if err != nil {
+		return err
+	}
+
+	creds := stscreds.NewCredentials(sess, "myRoleArn", func(p *stscreds.AssumeRoleProvider) {
+		p.SerialNumber = aws.String("myTokenSerialNumber")
+		p.TokenProvider = totp.Token
+	})
 */
 package stscreds
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -87,6 +106,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/internal/sdklog"
 	"github.com/aws/aws-sdk-go/internal/sdkrand"
 	"github.com/aws/aws-sdk-go/service/sts"
 )
@@ -245,6 +265,25 @@ type AssumeRoleProvider struct {
 	//
 	// MaxJitterFrac should not be negative.
 	MaxJitterFrac float64
+
+	// Cache is the file cache the assumed role credentials are cached in, so
+	// they can be reused by other processes until they expire. Credentials
+	// are cached under the same key as the AWS CLI would cache them for the
+	// role, so credentials are also shared with the AWS CLI.
+	//
+	// If nil, credentials are only cached in memory.
+	Cache *credentials.FileCache
+
+	// Logger is the logger failures to retrieve credentials are logged to.
+	// NewCredentials sets the logger of the STS client's config, if its
+	// LogLevel matches aws.LogDebugWithRequestErrors.
+	//
+	// If nil, failures are not logged.
+	Logger aws.Logger
+
+	// The key credentials are cached under, computed from the provider's
+	// parameters when credentials are first retrieved.
+	cacheKey string
 }
 
 // NewCredentials returns a pointer to a new Credentials value wrapping the
@@ -260,10 +299,12 @@ type AssumeRoleProvider struct {
 // service clients. All access to the credentials and refreshing them
 // will be synchronized.
 func NewCredentials(c client.ConfigProvider, roleARN string, options ...func(*AssumeRoleProvider)) *credentials.Credentials {
+	svc := sts.New(c)
 	p := &AssumeRoleProvider{
-		Client:   sts.New(c),
+		Client:   svc,
 		RoleARN:  roleARN,
 		Duration: DefaultDuration,
+		Logger:   sdklog.CredentialsLogger(&svc.Config),
 	}
 
 	for _, option := range options {
@@ -305,6 +346,22 @@ func (p *AssumeRoleProvider) Retrieve() (credentials.Value, error) {
 
 // RetrieveWithContext generates a new set of temporary credentials using STS.
 func (p *AssumeRoleProvider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
+	v, err := p.retrieve(ctx)
+	if err != nil {
+		sdklog.LogCredentialsError(p.Logger, ProviderName, err)
+	}
+	return v, err
+}
+
+func (p *AssumeRoleProvider) retrieve(ctx credentials.Context) (credentials.Value, error) {
+	if p.Cache != nil {
+		if v, ok, err := p.loadCached(); err != nil {
+			return credentials.Value{ProviderName: ProviderName}, err
+		} else if ok {
+			return v, nil
+		}
+	}
+
 	// Apply defaults where parameters are not set.
 	if p.RoleSessionName == "" {
 		// Try to work out a role name that will hopefully end up unique.
@@ -362,10 +419,102 @@ func (p *AssumeRoleProvider) RetrieveWithContext(ctx credentials.Context) (crede
 	// We will proactively generate new credentials before they expire.
 	p.SetExpiration(*roleOutput.Credentials.Expiration, p.ExpiryWindow)
 
-	return credentials.Value{
+	v := credentials.Value{
 		AccessKeyID:     *roleOutput.Credentials.AccessKeyId,
 		SecretAccessKey: *roleOutput.Credentials.SecretAccessKey,
 		SessionToken:    *roleOutput.Credentials.SessionToken,
 		ProviderName:    ProviderName,
-	}, nil
+	}
+
+	if p.Cache != nil {
+		// Failing to cache the credentials does not prevent them from being
+		// used, they will be retrieved again by the next process.
+		p.Cache.Store(p.cacheKey, v, *roleOutput.Credentials.Expiration)
+	}
+
+	return v, nil
+}
+
+// loadCached returns the credentials cached for the provider's parameters,
+// if they have not expired.
+func (p *AssumeRoleProvider) loadCached() (credentials.Value, bool, error) {
+	if len(p.cacheKey) == 0 {
+		// The key must be computed before defaults are applied to the
+		// parameters, since the default session name is unique.
+		key, err := p.fileCacheKey()
+		if err != nil {
+			return credentials.Value{}, false, err
+		}
+		p.cacheKey = key
+	}
+
+	v, expires, ok := p.Cache.Load(p.cacheKey)
+	if !ok {
+		return credentials.Value{}, false, nil
+	}
+
+	p.SetExpiration(expires, p.ExpiryWindow)
+	if p.IsExpired() {
+		return credentials.Value{}, false, nil
+	}
+
+	v.ProviderName = ProviderName
+	return v, true, nil
+}
+
+// fileCacheKey returns the key the provider's credentials are cached under,
+// which matches the key the AWS CLI uses for the same AssumeRole parameters.
+func (p *AssumeRoleProvider) fileCacheKey() (string, error) {
+	args := map[string]interface{}{
+		"RoleArn": p.RoleARN,
+	}
+	if len(p.RoleSessionName) != 0 {
+		args["RoleSessionName"] = p.RoleSessionName
+	}
+	if p.Duration != 0 && p.Duration != DefaultDuration {
+		args["DurationSeconds"] = int64(p.Duration / time.Second)
+	}
+	if p.ExternalID != nil {
+		args["ExternalId"] = *p.ExternalID
+	}
+	if p.SerialNumber != nil {
+		args["SerialNumber"] = *p.SerialNumber
+	}
+	if p.SourceIdentity != nil {
+		args["SourceIdentity"] = *p.SourceIdentity
+	}
+	if p.Policy != nil {
+		// The policy document is decoded, so its keys are sorted in the key.
+		var policy interface{}
+		dec := json.NewDecoder(strings.NewReader(*p.Policy))
+		dec.UseNumber()
+		if err := dec.Decode(&policy); err != nil {
+			policy = *p.Policy
+		}
+		args["Policy"] = policy
+	}
+	if len(p.PolicyArns) != 0 {
+		var arns []interface{}
+		for _, arn := range p.PolicyArns {
+			arns = append(arns, map[string]interface{}{
+				"arn": aws.StringValue(arn.Arn),
+			})
+		}
+		args["PolicyArns"] = arns
+	}
+	if len(p.Tags) != 0 {
+		var tags []interface{}
+		for _, tag := range p.Tags {
+			tags = append(tags, map[string]interface{}{
+				"Key":   aws.StringValue(tag.Key),
+				"Value": aws.StringValue(tag.Value),
+			})
+		}
+		args["Tags"] = tags
+	}
+	if len(p.TransitiveTagKeys) != 0 {
+		args["TransitiveTagKeys"] = aws.StringValueSlice(p.TransitiveTagKeys)
+	}
+
+	return credentials.FileCacheKey(args)
 }
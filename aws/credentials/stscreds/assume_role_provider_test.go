@@ -1,11 +1,17 @@
 package stscreds
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/sts"
@@ -64,6 +70,38 @@ func TestAssumeRoleProvider(t *testing.T) {
 	}
 }
 
+type stubSTSError struct {
+	err error
+}
+
+func (s *stubSTSError) AssumeRole(input *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error) {
+	return nil, s.err
+}
+
+func TestAssumeRoleProvider_LogRetrieveError(t *testing.T) {
+	var buf bytes.Buffer
+	p := &AssumeRoleProvider{
+		Client:  &stubSTSError{err: awserr.New("AccessDenied", "not authorized", nil)},
+		RoleARN: "roleARN",
+		Logger:  aws.NewStructuredLogger(&buf),
+	}
+
+	if _, err := p.Retrieve(); err == nil {
+		t.Fatalf("expect error")
+	}
+
+	logged := buf.String()
+	for _, expect := range []string{
+		`level=ERROR msg="failed to retrieve credentials"`,
+		"credentials_provider=" + ProviderName,
+		"AccessDenied: not authorized",
+	} {
+		if !strings.Contains(logged, expect) {
+			t.Errorf("expect %q logged, got %v", expect, logged)
+		}
+	}
+}
+
 func TestAssumeRoleProvider_WithTokenCode(t *testing.T) {
 	stub := &stubSTS{
 		TestInput: func(in *sts.AssumeRoleInput) {
@@ -264,3 +302,87 @@ func TestAssumeRoleProvider_RetrieveWithContext(t *testing.T) {
 		t.Errorf("expect %v, got %v", e, a)
 	}
 }
+
+func TestAssumeRoleProvider_FileCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "aws-sdk-go-stscreds-cache")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	var calls int
+	newProvider := func() *AssumeRoleProvider {
+		return &AssumeRoleProvider{
+			Client: &stubSTS{
+				TestInput: func(in *sts.AssumeRoleInput) {
+					calls++
+				},
+			},
+			RoleARN:  "arn:aws:iam::123456789012:role/role_name",
+			Duration: DefaultDuration,
+			Cache:    credentials.NewFileCache(dir),
+		}
+	}
+
+	// Credentials are cached under the same key as the AWS CLI.
+	cacheFile := filepath.Join(dir, "22566ee894f9595e216cffb51319b35d7a4a2e9a.json")
+
+	for i := 0; i < 2; i++ {
+		p := newProvider()
+		creds, err := p.Retrieve()
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		if e, a := "arn:aws:iam::123456789012:role/role_name", creds.AccessKeyID; e != a {
+			t.Errorf("expect %v, got %v", e, a)
+		}
+		if e, a := ProviderName, creds.ProviderName; e != a {
+			t.Errorf("expect %v, got %v", e, a)
+		}
+		if p.IsExpired() {
+			t.Errorf("expect credentials not to be expired")
+		}
+		if _, err := os.Stat(cacheFile); err != nil {
+			t.Errorf("expect cache file, got %v", err)
+		}
+	}
+
+	if e, a := 1, calls; e != a {
+		t.Errorf("expect %v AssumeRole calls, got %v", e, a)
+	}
+
+	// Expired cached credentials are refreshed.
+	expired := credentials.Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}
+	if err := credentials.NewFileCache(dir).Store("22566ee894f9595e216cffb51319b35d7a4a2e9a",
+		expired, time.Now().Add(-time.Minute)); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	creds, err := newProvider().Retrieve()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "arn:aws:iam::123456789012:role/role_name", creds.AccessKeyID; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := 2, calls; e != a {
+		t.Errorf("expect %v AssumeRole calls, got %v", e, a)
+	}
+}
+
+func TestAssumeRoleProvider_FileCacheKey(t *testing.T) {
+	p := &AssumeRoleProvider{
+		RoleARN:         "arn:aws:iam::123456789012:role/role_name",
+		RoleSessionName: "session",
+		Duration:        time.Hour,
+		ExternalID:      aws.String("exté\""),
+		SerialNumber:    aws.String("arn:aws:iam::123456789012:mfa/user"),
+	}
+
+	key, err := p.fileCacheKey()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "082fd7cbc4bf05c68b0f6ede6acaa813e8b874f1", key; e != a {
+		t.Errorf("expect %v key, got %v", e, a)
+	}
+}
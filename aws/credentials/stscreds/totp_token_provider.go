@@ -0,0 +1,160 @@
+package stscreds
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// ErrCodeInvalidTOTPSecret is the error code returned when the secret of a
+// TOTPTokenProvider is not a valid base32 encoded value.
+const ErrCodeInvalidTOTPSecret = "InvalidTOTPSecret"
+
+const (
+	// DefaultTOTPPeriod is the default period TOTP token codes are valid for.
+	DefaultTOTPPeriod = 30 * time.Second
+
+	// DefaultTOTPDigits is the default number of digits of TOTP token codes.
+	DefaultTOTPDigits = 6
+)
+
+// TOTPTokenProvider generates RFC 6238 time-based one-time password (TOTP)
+// MFA token codes from the secret of a virtual MFA device. The token codes
+// are generated with HMAC-SHA1, as used by IAM virtual MFA devices.
+//
+// A token code cannot be used more than once to assume a role. If a token
+// code was already generated for the current period, Token will wait for the
+// next period to generate a new token code.
+//
+// The TOTPTokenProvider's Token method can be used as the TokenProvider of
+// the AssumeRoleProvider.
+//
+//	totp, err := stscreds.NewTOTPTokenProvider(secret)
+//	if err != nil {
+//		return err
+//	}
+//
+//	creds := stscreds.NewCredentials(sess, "myRoleArn", func(p *stscreds.AssumeRoleProvider) {
+//		p.SerialNumber = aws.String("myTokenSerialNumber")
+//		p.TokenProvider = totp.Token
+//	})
+type TOTPTokenProvider struct {
+	// The period token codes are valid for. Defaults to DefaultTOTPPeriod.
+	Period time.Duration
+
+	// The number of digits of the token codes. Defaults to
+	// DefaultTOTPDigits.
+	Digits int
+
+	secret []byte
+
+	m           sync.Mutex
+	lastCounter uint64
+	hasLast     bool
+
+	// used to get the current time, and wait for the next period, swapped
+	// out by tests.
+	nowTime func() time.Time
+	sleep   func(time.Duration)
+}
+
+// NewTOTPTokenProvider returns a TOTPTokenProvider generating token codes
+// from the base32 encoded secret of a virtual MFA device. The secret is
+// case insensitive, and may contain spaces and omit padding.
+//
+// Returns an error if the secret is not valid base32.
+func NewTOTPTokenProvider(secret string, options ...func(*TOTPTokenProvider)) (*TOTPTokenProvider, error) {
+	key, err := decodeTOTPSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &TOTPTokenProvider{
+		Period:  DefaultTOTPPeriod,
+		Digits:  DefaultTOTPDigits,
+		secret:  key,
+		nowTime: time.Now,
+		sleep:   time.Sleep,
+	}
+	for _, option := range options {
+		option(p)
+	}
+
+	return p, nil
+}
+
+// Token returns the token code for the current period. If a token code was
+// already returned for the current period, Token waits until the next period
+// and returns its token code instead.
+//
+// Safe to use concurrently.
+func (p *TOTPTokenProvider) Token() (string, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	period := p.Period
+	if period < time.Second {
+		period = DefaultTOTPPeriod
+	}
+	digits := p.Digits
+	if digits <= 0 {
+		digits = DefaultTOTPDigits
+	}
+
+	now := p.nowTime()
+	counter := uint64(now.Unix() / int64(period/time.Second))
+	if p.hasLast && counter <= p.lastCounter {
+		// Wait for the start of the period after the last token code's.
+		counter = p.lastCounter + 1
+		next := time.Unix(int64(counter)*int64(period/time.Second), 0)
+		p.sleep(next.Sub(now))
+	}
+
+	p.lastCounter = counter
+	p.hasLast = true
+
+	return totpCode(p.secret, counter, digits), nil
+}
+
+// totpCode returns the token code of the counter, as defined by RFC 4226.
+func totpCode(secret []byte, counter uint64, digits int) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// dynamic truncation
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < digits && i < 9; i++ {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
+
+func decodeTOTPSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.Replace(secret, " ", "", -1))
+	secret = strings.TrimRight(secret, "=")
+
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		return nil, awserr.New(ErrCodeInvalidTOTPSecret, "failed to decode TOTP secret", err)
+	}
+	if len(key) == 0 {
+		return nil, awserr.New(ErrCodeInvalidTOTPSecret, "TOTP secret is empty", nil)
+	}
+
+	return key, nil
+}
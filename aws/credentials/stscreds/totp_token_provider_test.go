@@ -0,0 +1,126 @@
+package stscreds
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// RFC 6238 test secret, "12345678901234567890", base32 encoded.
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTPTokenProvider_RFC6238(t *testing.T) {
+	cases := []struct {
+		Time   int64
+		Expect string
+	}{
+		{Time: 59, Expect: "94287082"},
+		{Time: 1111111109, Expect: "07081804"},
+		{Time: 1111111111, Expect: "14050471"},
+		{Time: 1234567890, Expect: "89005924"},
+		{Time: 2000000000, Expect: "69279037"},
+		{Time: 20000000000, Expect: "65353130"},
+	}
+
+	for _, c := range cases {
+		p, err := NewTOTPTokenProvider(rfc6238Secret, func(p *TOTPTokenProvider) {
+			p.Digits = 8
+			p.nowTime = func() time.Time { return time.Unix(c.Time, 0) }
+		})
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+
+		code, err := p.Token()
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		if e, a := c.Expect, code; e != a {
+			t.Errorf("%v: expect %v code, got %v", c.Time, e, a)
+		}
+	}
+}
+
+func TestTOTPTokenProvider_NoReuse(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+	var slept []time.Duration
+
+	p, err := NewTOTPTokenProvider(rfc6238Secret, func(p *TOTPTokenProvider) {
+		p.nowTime = func() time.Time { return now }
+		p.sleep = func(d time.Duration) {
+			slept = append(slept, d)
+			now = now.Add(d)
+		}
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	first, _ := p.Token()
+	if e, a := "081804", first; e != a {
+		t.Errorf("expect %v code, got %v", e, a)
+	}
+
+	// Second code within the same period waits for the next period.
+	second, _ := p.Token()
+	if e, a := "050471", second; e != a {
+		t.Errorf("expect %v code, got %v", e, a)
+	}
+	if e, a := []time.Duration{1 * time.Second}, slept; len(a) != 1 || e[0] != a[0] {
+		t.Errorf("expect %v sleeps, got %v", e, a)
+	}
+
+	// Later periods do not wait.
+	now = now.Add(DefaultTOTPPeriod)
+	if _, err := p.Token(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 1, len(slept); e != a {
+		t.Errorf("expect %v sleeps, got %v", e, a)
+	}
+}
+
+func TestNewTOTPTokenProvider_Secret(t *testing.T) {
+	cases := map[string]struct {
+		Secret    string
+		ExpectErr bool
+	}{
+		"lower case with spaces": {
+			Secret: "gezd gnbv gy3t qojq gezd gnbv gy3t qojq",
+		},
+		"padded": {
+			Secret: "GEZDGNBVGY======",
+		},
+		"unpadded": {
+			Secret: "GEZDGNBVGY",
+		},
+		"invalid": {
+			Secret:    "not base32!",
+			ExpectErr: true,
+		},
+		"empty": {
+			ExpectErr: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewTOTPTokenProvider(c.Secret)
+			if !c.ExpectErr {
+				if err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+				return
+			}
+
+			aerr, ok := err.(awserr.Error)
+			if !ok {
+				t.Fatalf("expect awserr.Error, got %T, %v", err, err)
+			}
+			if e, a := ErrCodeInvalidTOTPSecret, aerr.Code(); e != a {
+				t.Errorf("expect %v error code, got %v", e, a)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/internal/sdklog"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 )
@@ -67,6 +68,13 @@ type WebIdentityRoleProvider struct {
 	// window.
 	ExpiryWindow time.Duration
 
+	// Logger is the logger failures to retrieve credentials are logged to.
+	// NewWebIdentityCredentials sets the logger of the STS client's config,
+	// if its LogLevel matches aws.LogDebugWithRequestErrors.
+	//
+	// If nil, failures are not logged.
+	Logger aws.Logger
+
 	client stsiface.STSAPI
 
 	tokenFetcher    TokenFetcher
@@ -82,6 +90,7 @@ type WebIdentityRoleProvider struct {
 func NewWebIdentityCredentials(c client.ConfigProvider, roleARN, roleSessionName, path string) *credentials.Credentials {
 	svc := sts.New(c)
 	p := NewWebIdentityRoleProvider(svc, roleARN, roleSessionName, path)
+	p.Logger = sdklog.CredentialsLogger(&svc.Config)
 	return credentials.NewCredentials(p)
 }
 
@@ -138,7 +147,9 @@ func (p *WebIdentityRoleProvider) Retrieve() (credentials.Value, error) {
 func (p *WebIdentityRoleProvider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
 	b, err := p.tokenFetcher.FetchToken(ctx)
 	if err != nil {
-		return credentials.Value{}, awserr.New(ErrCodeWebIdentity, "failed fetching WebIdentity token: ", err)
+		err = awserr.New(ErrCodeWebIdentity, "failed fetching WebIdentity token: ", err)
+		sdklog.LogCredentialsError(p.Logger, WebIdentityProviderName, err)
+		return credentials.Value{}, err
 	}
 
 	sessionName := p.roleSessionName
@@ -167,7 +178,9 @@ func (p *WebIdentityRoleProvider) RetrieveWithContext(ctx credentials.Context) (
 	// when assuming an Role with a JWT web identity token.
 	req.RetryErrorCodes = append(req.RetryErrorCodes, sts.ErrCodeInvalidIdentityTokenException)
 	if err := req.Send(); err != nil {
-		return credentials.Value{}, awserr.New(ErrCodeWebIdentity, "failed to retrieve credentials", err)
+		err = awserr.New(ErrCodeWebIdentity, "failed to retrieve credentials", err)
+		sdklog.LogCredentialsError(p.Logger, WebIdentityProviderName, err)
+		return credentials.Value{}, err
 	}
 
 	p.SetExpiration(aws.TimeValue(resp.Credentials.Expiration), p.ExpiryWindow)
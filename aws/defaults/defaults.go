@@ -25,6 +25,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/ec2metadata"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/internal/sdklog"
 	"github.com/aws/aws-sdk-go/internal/shareddefaults"
 )
 
@@ -208,9 +209,10 @@ func localHTTPCredProvider(cfg aws.Config, handlers request.Handlers, u string)
 	}
 
 	if len(errMsg) > 0 {
-		if cfg.Logger != nil {
-			cfg.Logger.Log("Ignoring, HTTP credential provider", errMsg, err)
-		}
+		sdklog.Log(cfg.Logger, aws.LogSeverityWarn, "ignoring HTTP credential provider",
+			fmt.Sprintf("Ignoring, HTTP credential provider %s %v", errMsg, err),
+			sdklog.Field("reason", errMsg),
+			sdklog.Field(sdklog.FieldError, err))
 		return credentials.ErrorProvider{
 			Err:          awserr.New("CredentialsEndpointError", errMsg, err),
 			ProviderName: endpointcreds.ProviderName,
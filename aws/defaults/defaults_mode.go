@@ -0,0 +1,126 @@
+package defaults
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// ModeConfiguration is the set of default configuration values of a
+// defaults mode. Zero values are not set by the defaults mode.
+type ModeConfiguration struct {
+	// The retry mode of the SDK's default retryer.
+	RetryMode aws.RetryMode
+
+	// The STS regional endpoint flag.
+	STSRegionalEndpoint endpoints.STSRegionalEndpoint
+
+	// The S3 us-east-1 regional endpoint flag.
+	S3UsEast1RegionalEndpoint endpoints.S3UsEast1RegionalEndpoint
+
+	// The amount of time the HTTP client waits for a connection to be
+	// established.
+	ConnectTimeout time.Duration
+
+	// The amount of time the HTTP client waits for the TLS handshake.
+	TLSNegotiationTimeout time.Duration
+}
+
+// GetModeConfiguration returns the default configuration values of the
+// defaults mode. DefaultsModeLegacy, and DefaultsModeUnset, do not set any
+// configuration values. DefaultsModeAuto must be resolved with
+// ResolveDefaultsModeAuto first.
+func GetModeConfiguration(mode aws.DefaultsMode) (ModeConfiguration, error) {
+	standard := ModeConfiguration{
+		RetryMode:                 aws.RetryModeStandard,
+		STSRegionalEndpoint:       endpoints.RegionalSTSEndpoint,
+		S3UsEast1RegionalEndpoint: endpoints.RegionalS3UsEast1Endpoint,
+		ConnectTimeout:            3100 * time.Millisecond,
+		TLSNegotiationTimeout:     3100 * time.Millisecond,
+	}
+
+	switch mode {
+	case aws.DefaultsModeUnset, aws.DefaultsModeLegacy:
+		return ModeConfiguration{}, nil
+	case aws.DefaultsModeStandard, aws.DefaultsModeCrossRegion:
+		return standard, nil
+	case aws.DefaultsModeInRegion:
+		standard.ConnectTimeout = 1100 * time.Millisecond
+		standard.TLSNegotiationTimeout = 1100 * time.Millisecond
+		return standard, nil
+	case aws.DefaultsModeMobile:
+		standard.ConnectTimeout = 30 * time.Second
+		standard.TLSNegotiationTimeout = 30 * time.Second
+		return standard, nil
+	default:
+		return ModeConfiguration{}, fmt.Errorf("unsupported defaults mode, %v", mode)
+	}
+}
+
+// executionEnvironmentRegionTimeout is the amount of time
+// ResolveExecutionEnvironmentRegion waits for the EC2 Instance Metadata
+// Service to respond.
+const executionEnvironmentRegionTimeout = time.Second
+
+// ResolveExecutionEnvironmentRegion returns the region the application runs
+// in, or an empty string if the region cannot be detected.
+//
+// If the application runs in an AWS execution environment, such as AWS
+// Lambda, the region of the environment is returned. Otherwise the region is
+// retrieved from the EC2 Instance Metadata Service, which may block for up to
+// a second. Callers should cache the region instead of calling this for each
+// session or client.
+func ResolveExecutionEnvironmentRegion(cfg aws.Config, handlers request.Handlers) string {
+	if len(os.Getenv("AWS_EXECUTION_ENV")) != 0 {
+		envRegion := os.Getenv("AWS_REGION")
+		if len(envRegion) == 0 {
+			envRegion = os.Getenv("AWS_DEFAULT_REGION")
+		}
+		if len(envRegion) != 0 {
+			return envRegion
+		}
+	}
+
+	resolver := cfg.EndpointResolver
+	if resolver == nil {
+		resolver = endpoints.DefaultResolver()
+	}
+	e, err := resolver.EndpointFor(endpoints.Ec2metadataServiceID, "")
+	if err != nil {
+		return ""
+	}
+
+	ctx, cancel := context.WithTimeout(aws.BackgroundContext(), executionEnvironmentRegionTimeout)
+	defer cancel()
+
+	client := ec2metadata.NewClient(cfg, handlers, e.URL, e.SigningRegion)
+	imdsRegion, err := client.RegionWithContext(ctx)
+	if err != nil {
+		return ""
+	}
+	return imdsRegion
+}
+
+// ResolveDefaultsModeAuto returns the defaults mode DefaultsModeAuto
+// resolves to for service clients of the region, given the region the
+// application runs in returned by ResolveExecutionEnvironmentRegion.
+//
+// DefaultsModeInRegion is returned if the regions match, and
+// DefaultsModeCrossRegion if they do not. DefaultsModeStandard is returned if
+// either region is empty.
+func ResolveDefaultsModeAuto(region, envRegion string) aws.DefaultsMode {
+	switch {
+	case len(region) == 0 || len(envRegion) == 0:
+		return aws.DefaultsModeStandard
+	case region == envRegion:
+		return aws.DefaultsModeInRegion
+	default:
+		return aws.DefaultsModeCrossRegion
+	}
+}
@@ -0,0 +1,162 @@
+package defaults
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/internal/sdktesting"
+)
+
+func TestGetModeConfiguration(t *testing.T) {
+	cases := map[aws.DefaultsMode]struct {
+		ExpectConnectTimeout time.Duration
+		ExpectLegacy         bool
+		ExpectErr            bool
+	}{
+		aws.DefaultsModeUnset:       {ExpectLegacy: true},
+		aws.DefaultsModeLegacy:      {ExpectLegacy: true},
+		aws.DefaultsModeStandard:    {ExpectConnectTimeout: 3100 * time.Millisecond},
+		aws.DefaultsModeInRegion:    {ExpectConnectTimeout: 1100 * time.Millisecond},
+		aws.DefaultsModeCrossRegion: {ExpectConnectTimeout: 3100 * time.Millisecond},
+		aws.DefaultsModeMobile:      {ExpectConnectTimeout: 30 * time.Second},
+		aws.DefaultsModeAuto:        {ExpectErr: true},
+	}
+
+	for mode, c := range cases {
+		t.Run(mode.String(), func(t *testing.T) {
+			modeCfg, err := GetModeConfiguration(mode)
+			if c.ExpectErr {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if c.ExpectLegacy {
+				if e, a := (ModeConfiguration{}), modeCfg; e != a {
+					t.Errorf("expect %v configuration, got %v", e, a)
+				}
+				return
+			}
+
+			if e, a := c.ExpectConnectTimeout, modeCfg.ConnectTimeout; e != a {
+				t.Errorf("expect %v connect timeout, got %v", e, a)
+			}
+			if e, a := c.ExpectConnectTimeout, modeCfg.TLSNegotiationTimeout; e != a {
+				t.Errorf("expect %v TLS negotiation timeout, got %v", e, a)
+			}
+			if e, a := aws.RetryModeStandard, modeCfg.RetryMode; e != a {
+				t.Errorf("expect %v retry mode, got %v", e, a)
+			}
+			if e, a := endpoints.RegionalSTSEndpoint, modeCfg.STSRegionalEndpoint; e != a {
+				t.Errorf("expect %v STS regional endpoint, got %v", e, a)
+			}
+			if e, a := endpoints.RegionalS3UsEast1Endpoint, modeCfg.S3UsEast1RegionalEndpoint; e != a {
+				t.Errorf("expect %v S3 us-east-1 regional endpoint, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestResolveDefaultsModeAuto(t *testing.T) {
+	cases := map[string]struct {
+		Region     string
+		Env        map[string]string
+		IMDSRegion string
+		IMDSStatus int
+		Expect     aws.DefaultsMode
+	}{
+		"no region": {
+			Expect: aws.DefaultsModeStandard,
+		},
+		"execution env same region": {
+			Region: "us-west-2",
+			Env: map[string]string{
+				"AWS_EXECUTION_ENV": "AWS_Lambda_go1.x",
+				"AWS_REGION":        "us-west-2",
+			},
+			IMDSStatus: http.StatusInternalServerError,
+			Expect:     aws.DefaultsModeInRegion,
+		},
+		"execution env other region": {
+			Region: "us-west-2",
+			Env: map[string]string{
+				"AWS_EXECUTION_ENV":  "AWS_Lambda_go1.x",
+				"AWS_DEFAULT_REGION": "us-east-1",
+			},
+			IMDSStatus: http.StatusInternalServerError,
+			Expect:     aws.DefaultsModeCrossRegion,
+		},
+		"imds same region": {
+			Region:     "us-west-2",
+			IMDSRegion: "us-west-2",
+			Expect:     aws.DefaultsModeInRegion,
+		},
+		"imds other region": {
+			Region:     "us-west-2",
+			IMDSRegion: "eu-west-1",
+			Expect:     aws.DefaultsModeCrossRegion,
+		},
+		"imds error": {
+			Region:     "us-west-2",
+			IMDSStatus: http.StatusNotFound,
+			Expect:     aws.DefaultsModeStandard,
+		},
+		"imds disabled": {
+			Region: "us-west-2",
+			Env: map[string]string{
+				"AWS_EC2_METADATA_DISABLED": "true",
+			},
+			IMDSRegion: "us-west-2",
+			Expect:     aws.DefaultsModeStandard,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			restoreEnv := sdktesting.StashEnv()
+			defer restoreEnv()
+			for k, v := range c.Env {
+				os.Setenv(k, v)
+			}
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == "PUT" && r.URL.Path == "/latest/api/token" {
+					w.Header().Set("x-aws-ec2-metadata-token-ttl-seconds", "21600")
+					w.Write([]byte("token"))
+					return
+				}
+				if c.IMDSStatus != 0 {
+					w.WriteHeader(c.IMDSStatus)
+					return
+				}
+				if e, a := "/latest/dynamic/instance-identity/document", r.URL.Path; e != a {
+					t.Errorf("expect %v path, got %v", e, a)
+				}
+				w.Write([]byte(`{"region": "` + c.IMDSRegion + `"}`))
+			}))
+			defer server.Close()
+
+			cfg := aws.Config{
+				MaxRetries: aws.Int(0),
+				EndpointResolver: endpoints.ResolverFunc(
+					func(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+						return endpoints.ResolvedEndpoint{URL: server.URL}, nil
+					}),
+			}
+
+			mode := ResolveDefaultsModeAuto(c.Region, ResolveExecutionEnvironmentRegion(cfg, Handlers()))
+			if e, a := c.Expect, mode; e != a {
+				t.Errorf("expect %v mode, got %v", e, a)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultsMode is an enum for the set of default configuration values the
+// SDK will use for service clients, such as timeouts and the retry mode,
+// based on the environment the application is running in.
+type DefaultsMode int
+
+func (m DefaultsMode) String() string {
+	switch m {
+	case DefaultsModeLegacy:
+		return "legacy"
+	case DefaultsModeStandard:
+		return "standard"
+	case DefaultsModeInRegion:
+		return "in-region"
+	case DefaultsModeCrossRegion:
+		return "cross-region"
+	case DefaultsModeMobile:
+		return "mobile"
+	case DefaultsModeAuto:
+		return "auto"
+	case DefaultsModeUnset:
+		return ""
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	// DefaultsModeUnset represents that the defaults mode is not specified,
+	// and the SDK will use DefaultsModeLegacy.
+	DefaultsModeUnset DefaultsMode = iota
+
+	// DefaultsModeLegacy uses the SDK's default configuration values from
+	// before defaults modes were supported.
+	DefaultsModeLegacy
+
+	// DefaultsModeStandard uses the standard default configuration values,
+	// which are safe to use in most environments.
+	DefaultsModeStandard
+
+	// DefaultsModeInRegion optimizes the default configuration values for
+	// applications calling services in the same region the application runs
+	// in, such as with lower connect timeouts.
+	DefaultsModeInRegion
+
+	// DefaultsModeCrossRegion optimizes the default configuration values for
+	// applications calling services in a different region than the
+	// application runs in, such as with higher connect timeouts.
+	DefaultsModeCrossRegion
+
+	// DefaultsModeMobile optimizes the default configuration values for
+	// mobile applications, with higher timeouts for high latency networks.
+	DefaultsModeMobile
+
+	// DefaultsModeAuto selects DefaultsModeInRegion, or
+	// DefaultsModeCrossRegion, by detecting the region the application runs
+	// in from the environment and EC2 Instance Metadata Service. Falls back
+	// to DefaultsModeStandard if the region cannot be detected.
+	DefaultsModeAuto
+)
+
+// ParseDefaultsMode returns the DefaultsMode based on the input string
+// provided in env config or shared config by the user.
+//
+// `legacy`, `standard`, `in-region`, `cross-region`, `mobile`, and `auto`
+// are the only case-insensitive valid strings for the defaults mode.
+func ParseDefaultsMode(s string) (DefaultsMode, error) {
+	switch {
+	case strings.EqualFold(s, "legacy"):
+		return DefaultsModeLegacy, nil
+	case strings.EqualFold(s, "standard"):
+		return DefaultsModeStandard, nil
+	case strings.EqualFold(s, "in-region"):
+		return DefaultsModeInRegion, nil
+	case strings.EqualFold(s, "cross-region"):
+		return DefaultsModeCrossRegion, nil
+	case strings.EqualFold(s, "mobile"):
+		return DefaultsModeMobile, nil
+	case strings.EqualFold(s, "auto"):
+		return DefaultsModeAuto, nil
+	default:
+		return DefaultsModeUnset, fmt.Errorf("unable to resolve the value of DefaultsMode for %v", s)
+	}
+}
@@ -0,0 +1,44 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseDefaultsMode(t *testing.T) {
+	cases := map[string]struct {
+		Value     string
+		Expect    DefaultsMode
+		ExpectErr bool
+	}{
+		"legacy":       {Value: "legacy", Expect: DefaultsModeLegacy},
+		"standard":     {Value: "standard", Expect: DefaultsModeStandard},
+		"in-region":    {Value: "in-region", Expect: DefaultsModeInRegion},
+		"cross-region": {Value: "Cross-Region", Expect: DefaultsModeCrossRegion},
+		"mobile":       {Value: "mobile", Expect: DefaultsModeMobile},
+		"auto":         {Value: "AUTO", Expect: DefaultsModeAuto},
+		"unknown":      {Value: "other", ExpectErr: true},
+		"empty value":  {Value: "", ExpectErr: true},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			mode, err := ParseDefaultsMode(c.Value)
+			if c.ExpectErr {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.Expect, mode; e != a {
+				t.Errorf("expect %v mode, got %v", e, a)
+			}
+			if e, a := c.Value, mode.String(); !strings.EqualFold(e, a) {
+				t.Errorf("expect %v string, got %v", e, a)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/internal/sdklog"
 )
 
 // A tokenProvider struct provides access to EC2Metadata client
@@ -67,7 +68,10 @@ func (t *tokenProvider) fetchTokenHandler(r *request.Request) {
 			case http.StatusForbidden, http.StatusNotFound, http.StatusMethodNotAllowed:
 				atomic.StoreUint32(&t.disabled, 1)
 				if t.client.Config.LogLevel.Matches(aws.LogDebugWithDeprecated) {
-					t.client.Config.Logger.Log(fmt.Sprintf("WARN: failed to get session token, falling back to IMDSv1: %v", requestFailureError))
+					sdklog.Log(t.client.Config.Logger, aws.LogSeverityWarn,
+						"failed to get session token, falling back to IMDSv1",
+						fmt.Sprintf("WARN: failed to get session token, falling back to IMDSv1: %v", requestFailureError),
+						sdklog.Field(sdklog.FieldError, requestFailureError))
 				}
 			case http.StatusBadRequest:
 				r.Error = requestFailureError
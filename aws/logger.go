@@ -1,8 +1,14 @@
 package aws
 
 import (
+	"fmt"
+	"io"
 	"log"
 	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
 // A LogLevelType defines the level logging should be performed at. Used to instruct
@@ -119,3 +125,123 @@ type defaultLogger struct {
 func (l defaultLogger) Log(args ...interface{}) {
 	l.logger.Println(args...)
 }
+
+// LogSeverity is the severity of a message logged to a StructuredLogger.
+type LogSeverity int
+
+const (
+	// LogSeverityDebug is the severity of messages logged for debugging.
+	LogSeverityDebug LogSeverity = iota
+
+	// LogSeverityInfo is the severity of informational messages.
+	LogSeverityInfo
+
+	// LogSeverityWarn is the severity of messages about unexpected
+	// conditions the SDK recovered from.
+	LogSeverityWarn
+
+	// LogSeverityError is the severity of messages about errors.
+	LogSeverityError
+)
+
+func (s LogSeverity) String() string {
+	switch s {
+	case LogSeverityDebug:
+		return "DEBUG"
+	case LogSeverityInfo:
+		return "INFO"
+	case LogSeverityWarn:
+		return "WARN"
+	case LogSeverityError:
+		return "ERROR"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// A LogField is a key/value pair of a structured log message.
+type LogField struct {
+	Key   string
+	Value interface{}
+}
+
+// A StructuredLogger is a Logger which can also log messages with a severity,
+// and key/value fields. If the Logger set on a Config implements
+// StructuredLogger, the SDK will log messages with LogFields instead of Log.
+// Messages are only logged for the LogLevel set on the Config.
+//
+// Sensitive values, such as signing headers, credentials, and shape members
+// tagged as sensitive, are redacted from the messages and fields logged by
+// the SDK.
+type StructuredLogger interface {
+	Logger
+
+	// LogFields logs the message with the severity and fields provided.
+	LogFields(severity LogSeverity, msg string, fields ...LogField)
+}
+
+// NewStructuredLogger returns a StructuredLogger which writes log messages
+// to the writer in the logfmt format. Each message is written on a single
+// line with its time, severity, and fields.
+//
+//	time=2006-01-02T15:04:05Z level=DEBUG msg="retrying request" service=s3 operation=GetObject retry_count=1
+//
+// Messages logged with Log are written with the msg key.
+func NewStructuredLogger(w io.Writer) StructuredLogger {
+	return &structuredLogger{w: w, now: time.Now}
+}
+
+type structuredLogger struct {
+	mu  sync.Mutex
+	w   io.Writer
+	now func() time.Time
+}
+
+// Log logs the parameters as the message of the log line. See fmt.Sprint.
+func (l *structuredLogger) Log(args ...interface{}) {
+	l.LogFields(LogSeverityInfo, strings.TrimSuffix(fmt.Sprintln(args...), "\n"))
+}
+
+// LogFields logs the message and fields as a single logfmt line.
+func (l *structuredLogger) LogFields(severity LogSeverity, msg string, fields ...LogField) {
+	var b strings.Builder
+	b.WriteString("time=")
+	b.WriteString(l.now().UTC().Format(time.RFC3339))
+	b.WriteString(" level=")
+	b.WriteString(severity.String())
+	b.WriteString(" msg=")
+	b.WriteString(logfmtValue(msg))
+
+	for _, f := range fields {
+		b.WriteByte(' ')
+		b.WriteString(f.Key)
+		b.WriteByte('=')
+		b.WriteString(logfmtValue(f.Value))
+	}
+	b.WriteByte('\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	io.WriteString(l.w, b.String())
+}
+
+func logfmtValue(v interface{}) string {
+	var s string
+	switch tv := v.(type) {
+	case string:
+		s = tv
+	case error:
+		s = tv.Error()
+	case fmt.Stringer:
+		s = tv.String()
+	case int, int64, int32, uint, uint64, uint32, bool, float64:
+		return fmt.Sprint(tv)
+	default:
+		s = fmt.Sprint(tv)
+	}
+
+	if len(s) != 0 && !strings.ContainsAny(s, " =\"\n\t\r") {
+		return s
+	}
+	return strconv.Quote(s)
+}
@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestStructuredLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewStructuredLogger(&buf).(*structuredLogger)
+	logger.now = func() time.Time {
+		return time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	}
+
+	logger.LogFields(LogSeverityDebug, "retrying request",
+		LogField{Key: "service", Value: "s3"},
+		LogField{Key: "retry_count", Value: 2},
+		LogField{Key: "error", Value: fmt.Errorf("connection reset")},
+		LogField{Key: "empty", Value: ""},
+	)
+	logger.Log("legacy", "message")
+
+	expect := `time=2020-01-02T03:04:05Z level=DEBUG msg="retrying request" service=s3 retry_count=2 error="connection reset" empty=""
+time=2020-01-02T03:04:05Z level=INFO msg="legacy message"
+`
+	if e, a := expect, buf.String(); e != a {
+		t.Errorf("expect\n%s\ngot\n%s", e, a)
+	}
+}
+
+func TestLogSeverity_String(t *testing.T) {
+	cases := map[LogSeverity]string{
+		LogSeverityDebug: "DEBUG",
+		LogSeverityInfo:  "INFO",
+		LogSeverityWarn:  "WARN",
+		LogSeverityError: "ERROR",
+		LogSeverity(10):  "UNKNOWN",
+	}
+	for s, expect := range cases {
+		if e, a := expect, s.String(); e != a {
+			t.Errorf("expect %v, got %v", e, a)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package metrics
+
+import "sync"
+
+// MemorySink is a Sink which keeps the metrics recorded in memory. Useful for
+// testing and debugging the metrics of API clients. A MemorySink is safe for
+// concurrent use.
+type MemorySink struct {
+	mu       sync.Mutex
+	calls    []Call
+	attempts []Attempt
+}
+
+// NewMemorySink returns an initialized MemorySink.
+func NewMemorySink() *MemorySink {
+	return &MemorySink{}
+}
+
+// RecordCall saves the metrics of the API operation call.
+func (s *MemorySink) RecordCall(m Call) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls = append(s.calls, m)
+}
+
+// RecordAttempt saves the metrics of the API operation call attempt.
+func (s *MemorySink) RecordAttempt(m Attempt) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.attempts = append(s.attempts, m)
+}
+
+// Calls returns a copy of the call metrics recorded, in the order they were
+// recorded.
+func (s *MemorySink) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Call(nil), s.calls...)
+}
+
+// Attempts returns a copy of the attempt metrics recorded, in the order they
+// were recorded.
+func (s *MemorySink) Attempts() []Attempt {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Attempt(nil), s.attempts...)
+}
+
+// Reset removes all recorded metrics from the sink.
+func (s *MemorySink) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls = nil
+	s.attempts = nil
+}
@@ -0,0 +1,204 @@
+// Package metrics provides per operation metrics of the requests made by the
+// SDK's API clients. Metrics are recorded by request handlers, and reported
+// to a Sink. The package provides an in-memory Sink, and a Sink which
+// aggregates metrics and writes them in the Prometheus text exposition
+// format.
+//
+// Metrics can be enabled for all API clients created from a Session with the
+// session.Options MetricsSink field.
+//
+//	sink := metrics.NewPrometheusSink()
+//	sess := session.Must(session.NewSessionWithOptions(session.Options{
+//		MetricsSink: sink,
+//	}))
+//
+//	http.Handle("/metrics", sink)
+//
+// The handlers can also be added to a Session, or API client's handlers
+// directly with InjectHandlers.
+//
+//	metrics.InjectHandlers(&svc.Handlers, sink)
+package metrics
+
+import (
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// Call is the metrics of an API operation call, including all attempts made
+// for the call.
+type Call struct {
+	// Service ID of the API client the call was made with.
+	ServiceID string
+
+	// Name of the API operation.
+	Operation string
+
+	// Time from when the request was created until the call completed.
+	Latency time.Duration
+
+	// Number of attempts made, including retries.
+	Attempts int
+
+	// Error code the call failed with. Empty if the call succeeded.
+	ErrorCode string
+}
+
+// Attempt is the metrics of a single attempt of an API operation call.
+type Attempt struct {
+	// Service ID of the API client the attempt was made with.
+	ServiceID string
+
+	// Name of the API operation.
+	Operation string
+
+	// Time from when the attempt was started until the attempt completed.
+	Latency time.Duration
+
+	// HTTP status code of the attempt's response. Zero if no response was
+	// received.
+	StatusCode int
+
+	// Error code the attempt failed with. Empty if the attempt succeeded.
+	ErrorCode string
+
+	// True if the attempt failed because it was throttled by the service.
+	Throttled bool
+
+	// True if the attempt failed with an error that can be retried.
+	Retryable bool
+
+	// Number of bytes in the attempt's HTTP request body.
+	RequestBytes int64
+
+	// Number of bytes in the attempt's HTTP response body. If the length of
+	// the response is not known, the number of bytes read by the SDK when
+	// the attempt completed.
+	ResponseBytes int64
+}
+
+// Sink provides the interface for receiving the metrics of API operation
+// calls. A Sink must be safe for concurrent use.
+type Sink interface {
+	// RecordCall is called once when an API operation call completes.
+	RecordCall(Call)
+
+	// RecordAttempt is called when each attempt of an API operation call
+	// completes.
+	RecordAttempt(Attempt)
+}
+
+// Names of the handlers added by InjectHandlers.
+const (
+	CountResponseBytesHandlerName = "awsmetrics.CountResponseBytesHandler"
+	RecordAttemptHandlerName      = "awsmetrics.RecordAttemptHandler"
+	RecordCallHandlerName         = "awsmetrics.RecordCallHandler"
+)
+
+// InjectHandlers adds the handlers recording the metrics of API operation
+// calls to the handlers provided. Metrics are reported to the Sink.
+//
+// Calling InjectHandlers with handlers that already have metrics handlers
+// will replace the existing handlers.
+func InjectHandlers(handlers *request.Handlers, sink Sink) {
+	recordAttempt := func(counter *countingReadCloser) request.NamedHandler {
+		return request.NamedHandler{
+			Name: RecordAttemptHandlerName,
+			Fn: func(r *request.Request) {
+				sink.RecordAttempt(newAttempt(r, counter))
+			},
+		}
+	}
+
+	handlers.Send.SetBackNamed(request.NamedHandler{
+		Name: CountResponseBytesHandlerName,
+		Fn: func(r *request.Request) {
+			// Replaced for each attempt so the attempt's metrics are
+			// recorded with the counter of the attempt's response body.
+			r.Handlers.CompleteAttempt.SwapNamed(recordAttempt(countResponseBytes(r)))
+		},
+	})
+	handlers.CompleteAttempt.SetBackNamed(recordAttempt(nil))
+	handlers.Complete.SetBackNamed(request.NamedHandler{
+		Name: RecordCallHandlerName,
+		Fn: func(r *request.Request) {
+			sink.RecordCall(newCall(r))
+		},
+	})
+}
+
+func newCall(r *request.Request) Call {
+	return Call{
+		ServiceID: r.ClientInfo.ServiceID,
+		Operation: r.Operation.Name,
+		Latency:   time.Since(r.Time),
+		Attempts:  r.RetryCount + 1,
+		ErrorCode: errorCode(r.Error),
+	}
+}
+
+func newAttempt(r *request.Request, counter *countingReadCloser) Attempt {
+	m := Attempt{
+		ServiceID: r.ClientInfo.ServiceID,
+		Operation: r.Operation.Name,
+		Latency:   time.Since(r.AttemptTime),
+		ErrorCode: errorCode(r.Error),
+	}
+
+	if r.Error != nil {
+		m.Throttled = r.IsErrorThrottle()
+		m.Retryable = r.IsErrorRetryable()
+	}
+
+	if req := r.HTTPRequest; req != nil && req.ContentLength > 0 {
+		m.RequestBytes = req.ContentLength
+	}
+
+	if resp := r.HTTPResponse; resp != nil {
+		m.StatusCode = resp.StatusCode
+		if resp.ContentLength >= 0 {
+			m.ResponseBytes = resp.ContentLength
+		} else if counter != nil {
+			m.ResponseBytes = counter.count
+		}
+	}
+
+	return m
+}
+
+func errorCode(err error) string {
+	if err == nil {
+		return ""
+	}
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code()
+	}
+	return "Unknown"
+}
+
+// countResponseBytes wraps the response body of the attempt to count the
+// bytes read from it, for responses without a known content length. Returns
+// nil if the response body was not wrapped.
+func countResponseBytes(r *request.Request) *countingReadCloser {
+	resp := r.HTTPResponse
+	if r.Error != nil || resp == nil || resp.Body == nil || resp.ContentLength >= 0 {
+		return nil
+	}
+	c := &countingReadCloser{ReadCloser: resp.Body}
+	resp.Body = c
+	return c
+}
+
+type countingReadCloser struct {
+	io.ReadCloser
+	count int64
+}
+
+func (c *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	c.count += int64(n)
+	return n, err
+}
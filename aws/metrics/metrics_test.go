@@ -0,0 +1,210 @@
+package metrics_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/corehandlers"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/metrics"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func newTestClient(t *testing.T, handler http.Handler) *client.Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	def := defaults.Get()
+	def.Config.MergeIn(&aws.Config{
+		Endpoint:   aws.String(server.URL),
+		MaxRetries: aws.Int(2),
+		SleepDelay: func(time.Duration) {},
+	})
+
+	svc := client.New(*def.Config, metadata.ClientInfo{
+		ServiceID: "Mock",
+		Endpoint:  server.URL,
+	}, def.Handlers)
+	svc.Handlers.Clear()
+	svc.Handlers.Build.PushBackNamed(corehandlers.BuildContentLengthHandler)
+	svc.Handlers.Send.PushBackNamed(corehandlers.SendHandler)
+	svc.Handlers.ValidateResponse.PushBackNamed(corehandlers.ValidateResponseHandler)
+	svc.Handlers.AfterRetry.PushBackNamed(corehandlers.AfterRetryHandler)
+
+	return svc
+}
+
+func TestInjectHandlers(t *testing.T) {
+	var attempts int32
+	svc := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ioutil.ReadAll(r.Body)
+		switch atomic.AddInt32(&attempts, 1) {
+		case 1:
+			w.WriteHeader(429)
+		case 2:
+			w.WriteHeader(500)
+		default:
+			// Flushing before writing the body prevents the server from
+			// setting the response's content length.
+			w.(http.Flusher).Flush()
+			fmt.Fprint(w, "hello world")
+		}
+	}))
+
+	sink := metrics.NewMemorySink()
+	metrics.InjectHandlers(&svc.Handlers, sink)
+
+	r := svc.NewRequest(&request.Operation{Name: "Operation", HTTPMethod: "POST"}, nil, nil)
+	r.SetStringBody("request")
+	r.Handlers.Unmarshal.PushBack(func(r *request.Request) {
+		ioutil.ReadAll(r.HTTPResponse.Body)
+	})
+	if err := r.Send(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	calls := sink.Calls()
+	if e, a := 1, len(calls); e != a {
+		t.Fatalf("expect %v calls, got %v", e, a)
+	}
+	call := calls[0]
+	if e, a := "Mock", call.ServiceID; e != a {
+		t.Errorf("expect %v service ID, got %v", e, a)
+	}
+	if e, a := "Operation", call.Operation; e != a {
+		t.Errorf("expect %v operation, got %v", e, a)
+	}
+	if e, a := 3, call.Attempts; e != a {
+		t.Errorf("expect %v attempts, got %v", e, a)
+	}
+	if v := call.ErrorCode; len(v) != 0 {
+		t.Errorf("expect no error code, got %v", v)
+	}
+	if call.Latency <= 0 {
+		t.Errorf("expect call latency, got %v", call.Latency)
+	}
+
+	expect := []metrics.Attempt{
+		{StatusCode: 429, ErrorCode: "UnknownError", Throttled: true, RequestBytes: 7},
+		{StatusCode: 500, ErrorCode: "UnknownError", Retryable: true, RequestBytes: 7},
+		{StatusCode: 200, RequestBytes: 7, ResponseBytes: 11},
+	}
+	actual := sink.Attempts()
+	if e, a := len(expect), len(actual); e != a {
+		t.Fatalf("expect %v attempts, got %v", e, a)
+	}
+	for i, a := range actual {
+		e := expect[i]
+		e.ServiceID, e.Operation = "Mock", "Operation"
+		e.Latency = a.Latency
+		if a.Latency <= 0 {
+			t.Errorf("%d, expect attempt latency, got %v", i, a.Latency)
+		}
+		if e != a {
+			t.Errorf("%d, expect %+v, got %+v", i, e, a)
+		}
+	}
+}
+
+func TestInjectHandlers_Replace(t *testing.T) {
+	svc := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	first, second := metrics.NewMemorySink(), metrics.NewMemorySink()
+	metrics.InjectHandlers(&svc.Handlers, first)
+	metrics.InjectHandlers(&svc.Handlers, second)
+
+	r := svc.NewRequest(&request.Operation{Name: "Operation"}, nil, nil)
+	if err := r.Send(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := 0, len(first.Calls()); e != a {
+		t.Errorf("expect %v calls in replaced sink, got %v", e, a)
+	}
+	if e, a := 1, len(second.Calls()); e != a {
+		t.Errorf("expect %v calls, got %v", e, a)
+	}
+	if e, a := 1, len(second.Attempts()); e != a {
+		t.Errorf("expect %v attempts, got %v", e, a)
+	}
+}
+
+func TestPrometheusSink(t *testing.T) {
+	sink := metrics.NewPrometheusSink(0.1, 1)
+
+	sink.RecordAttempt(metrics.Attempt{
+		ServiceID: "Mock", Operation: "Operation",
+		Latency: 50 * time.Millisecond, ErrorCode: "Throttling",
+		Throttled: true, Retryable: true, RequestBytes: 10,
+	})
+	sink.RecordAttempt(metrics.Attempt{
+		ServiceID: "Mock", Operation: "Operation",
+		Latency: 500 * time.Millisecond, RequestBytes: 10, ResponseBytes: 20,
+	})
+	sink.RecordCall(metrics.Call{
+		ServiceID: "Mock", Operation: "Operation",
+		Latency: 2 * time.Second, Attempts: 2,
+	})
+	sink.RecordCall(metrics.Call{
+		ServiceID: "A\"B", Operation: "Operation",
+		Latency: time.Second, Attempts: 1, ErrorCode: "AccessDenied",
+	})
+
+	var sb strings.Builder
+	n, err := sink.WriteTo(&sb)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := int64(sb.Len()), n; e != a {
+		t.Errorf("expect %v bytes written, got %v", e, a)
+	}
+
+	out := sb.String()
+	for _, line := range []string{
+		`# TYPE aws_sdk_call_duration_seconds histogram`,
+		`aws_sdk_call_duration_seconds_bucket{service="A\"B",operation="Operation",le="1"} 1`,
+		`aws_sdk_call_duration_seconds_bucket{service="Mock",operation="Operation",le="1"} 0`,
+		`aws_sdk_call_duration_seconds_bucket{service="Mock",operation="Operation",le="+Inf"} 1`,
+		`aws_sdk_call_duration_seconds_sum{service="Mock",operation="Operation"} 2`,
+		`aws_sdk_call_duration_seconds_count{service="Mock",operation="Operation"} 1`,
+		`aws_sdk_attempt_duration_seconds_bucket{service="Mock",operation="Operation",le="0.1"} 1`,
+		`aws_sdk_attempt_duration_seconds_bucket{service="Mock",operation="Operation",le="1"} 2`,
+		`aws_sdk_attempt_duration_seconds_count{service="Mock",operation="Operation"} 2`,
+		`aws_sdk_call_errors_total{service="A\"B",operation="Operation"} 1`,
+		`aws_sdk_call_errors_total{service="Mock",operation="Operation"} 0`,
+		`# TYPE aws_sdk_attempts_total counter`,
+		`aws_sdk_attempts_total{service="Mock",operation="Operation"} 2`,
+		`aws_sdk_throttled_attempts_total{service="Mock",operation="Operation"} 1`,
+		`aws_sdk_retryable_errors_total{service="Mock",operation="Operation"} 1`,
+		`aws_sdk_request_bytes_total{service="Mock",operation="Operation"} 20`,
+		`aws_sdk_response_bytes_total{service="Mock",operation="Operation"} 20`,
+	} {
+		if !strings.Contains(out, line+"\n") {
+			t.Errorf("expect output to contain %q\n%s", line, out)
+		}
+	}
+}
+
+func TestPrometheusSink_ServeHTTP(t *testing.T) {
+	sink := metrics.NewPrometheusSink()
+	sink.RecordCall(metrics.Call{ServiceID: "Mock", Operation: "Operation"})
+
+	w := httptest.NewRecorder()
+	sink.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+
+	if e, a := "text/plain; version=0.0.4; charset=utf-8", w.Header().Get("Content-Type"); e != a {
+		t.Errorf("expect %v content type, got %v", e, a)
+	}
+	if e, a := `aws_sdk_call_duration_seconds_count{service="Mock",operation="Operation"} 1`, w.Body.String(); !strings.Contains(a, e) {
+		t.Errorf("expect %q in response, got %s", e, a)
+	}
+}
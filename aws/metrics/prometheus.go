@@ -0,0 +1,249 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultLatencyBuckets are the upper bounds, in seconds, of the latency
+// histogram buckets used by a PrometheusSink when no buckets are provided.
+var DefaultLatencyBuckets = []float64{
+	0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10,
+}
+
+// Names of the metrics written by a PrometheusSink.
+const (
+	PrometheusCallLatency       = "aws_sdk_call_duration_seconds"
+	PrometheusAttemptLatency    = "aws_sdk_attempt_duration_seconds"
+	PrometheusCallErrors        = "aws_sdk_call_errors_total"
+	PrometheusAttempts          = "aws_sdk_attempts_total"
+	PrometheusThrottledAttempts = "aws_sdk_throttled_attempts_total"
+	PrometheusRetryableErrors   = "aws_sdk_retryable_errors_total"
+	PrometheusRequestBytes      = "aws_sdk_request_bytes_total"
+	PrometheusResponseBytes     = "aws_sdk_response_bytes_total"
+)
+
+// PrometheusSink is a Sink which aggregates the metrics of API operation
+// calls by service and operation, and writes them in the Prometheus text
+// exposition format. A PrometheusSink is safe for concurrent use.
+//
+// The PrometheusSink implements http.Handler so it can be served directly to
+// a Prometheus server.
+type PrometheusSink struct {
+	buckets []float64
+
+	mu  sync.Mutex
+	ops map[operationKey]*operationMetrics
+}
+
+// NewPrometheusSink returns an initialized PrometheusSink. The latency
+// histograms use the bucket upper bounds, in seconds, provided. If no
+// buckets are provided DefaultLatencyBuckets will be used.
+func NewPrometheusSink(buckets ...float64) *PrometheusSink {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+
+	return &PrometheusSink{
+		buckets: buckets,
+		ops:     map[operationKey]*operationMetrics{},
+	}
+}
+
+type operationKey struct {
+	ServiceID string
+	Operation string
+}
+
+type operationMetrics struct {
+	callLatency    histogram
+	attemptLatency histogram
+
+	callErrors        int64
+	attempts          int64
+	throttledAttempts int64
+	retryableErrors   int64
+	requestBytes      int64
+	responseBytes     int64
+}
+
+type histogram struct {
+	counts []int64
+	count  int64
+	sum    float64
+}
+
+func (h *histogram) observe(buckets []float64, d time.Duration) {
+	if h.counts == nil {
+		h.counts = make([]int64, len(buckets))
+	}
+
+	v := d.Seconds()
+	for i, b := range buckets {
+		if v <= b {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += v
+}
+
+func (s *PrometheusSink) operation(serviceID, operation string) *operationMetrics {
+	k := operationKey{ServiceID: serviceID, Operation: operation}
+	m, ok := s.ops[k]
+	if !ok {
+		m = &operationMetrics{}
+		s.ops[k] = m
+	}
+	return m
+}
+
+// RecordCall aggregates the metrics of the API operation call.
+func (s *PrometheusSink) RecordCall(c Call) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m := s.operation(c.ServiceID, c.Operation)
+	m.callLatency.observe(s.buckets, c.Latency)
+	if len(c.ErrorCode) != 0 {
+		m.callErrors++
+	}
+}
+
+// RecordAttempt aggregates the metrics of the API operation call attempt.
+func (s *PrometheusSink) RecordAttempt(a Attempt) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m := s.operation(a.ServiceID, a.Operation)
+	m.attemptLatency.observe(s.buckets, a.Latency)
+	m.attempts++
+	if a.Throttled {
+		m.throttledAttempts++
+	}
+	if a.Retryable {
+		m.retryableErrors++
+	}
+	m.requestBytes += a.RequestBytes
+	m.responseBytes += a.ResponseBytes
+}
+
+// WriteTo writes the aggregated metrics to the writer in the Prometheus text
+// exposition format.
+func (s *PrometheusSink) WriteTo(w io.Writer) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]operationKey, 0, len(s.ops))
+	for k := range s.ops {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].ServiceID != keys[j].ServiceID {
+			return keys[i].ServiceID < keys[j].ServiceID
+		}
+		return keys[i].Operation < keys[j].Operation
+	})
+
+	cw := &countingWriter{w: bufio.NewWriter(w)}
+
+	s.writeHistogram(cw, keys, PrometheusCallLatency,
+		"Latency of API operation calls, including retries.",
+		func(m *operationMetrics) *histogram { return &m.callLatency })
+	s.writeHistogram(cw, keys, PrometheusAttemptLatency,
+		"Latency of API operation call attempts.",
+		func(m *operationMetrics) *histogram { return &m.attemptLatency })
+
+	counters := []struct {
+		name, help string
+		value      func(*operationMetrics) int64
+	}{
+		{PrometheusCallErrors, "Number of API operation calls that failed.",
+			func(m *operationMetrics) int64 { return m.callErrors }},
+		{PrometheusAttempts, "Number of API operation call attempts, including retries.",
+			func(m *operationMetrics) int64 { return m.attempts }},
+		{PrometheusThrottledAttempts, "Number of API operation call attempts throttled by the service.",
+			func(m *operationMetrics) int64 { return m.throttledAttempts }},
+		{PrometheusRetryableErrors, "Number of API operation call attempts that failed with a retryable error.",
+			func(m *operationMetrics) int64 { return m.retryableErrors }},
+		{PrometheusRequestBytes, "Number of bytes sent in HTTP request bodies.",
+			func(m *operationMetrics) int64 { return m.requestBytes }},
+		{PrometheusResponseBytes, "Number of bytes received in HTTP response bodies.",
+			func(m *operationMetrics) int64 { return m.responseBytes }},
+	}
+	for _, c := range counters {
+		cw.printf("# HELP %s %s\n# TYPE %s counter\n", c.name, c.help, c.name)
+		for _, k := range keys {
+			cw.printf("%s{%s} %d\n", c.name, labels(k), c.value(s.ops[k]))
+		}
+	}
+
+	if cw.err == nil {
+		cw.err = cw.w.Flush()
+	}
+	return cw.n, cw.err
+}
+
+func (s *PrometheusSink) writeHistogram(cw *countingWriter, keys []operationKey,
+	name, help string, get func(*operationMetrics) *histogram,
+) {
+	cw.printf("# HELP %s %s\n# TYPE %s histogram\n", name, help, name)
+	for _, k := range keys {
+		h := get(s.ops[k])
+		l := labels(k)
+		for i, b := range s.buckets {
+			var count int64
+			if h.counts != nil {
+				count = h.counts[i]
+			}
+			cw.printf("%s_bucket{%s,le=%q} %d\n", name, l, formatFloat(b), count)
+		}
+		cw.printf("%s_bucket{%s,le=\"+Inf\"} %d\n", name, l, h.count)
+		cw.printf("%s_sum{%s} %s\n", name, l, formatFloat(h.sum))
+		cw.printf("%s_count{%s} %d\n", name, l, h.count)
+	}
+}
+
+// ServeHTTP writes the aggregated metrics as the HTTP response in the
+// Prometheus text exposition format.
+func (s *PrometheusSink) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	s.WriteTo(w)
+}
+
+func labels(k operationKey) string {
+	return `service="` + labelEscaper.Replace(k.ServiceID) +
+		`",operation="` + labelEscaper.Replace(k.Operation) + `"`
+}
+
+// labelEscaper escapes label values as required by the Prometheus text
+// exposition format.
+var labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+type countingWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+func (w *countingWriter) printf(format string, args ...interface{}) {
+	if w.err != nil {
+		return
+	}
+	n, err := fmt.Fprintf(w.w, format, args...)
+	w.n += int64(n)
+	w.err = err
+}
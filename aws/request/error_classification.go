@@ -0,0 +1,310 @@
+package request
+
+import (
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// An ErrorCategory is the classification of an error returned by the SDK.
+type ErrorCategory int
+
+// Enumeration of the categories errors are classified as.
+const (
+	// ErrorCategoryUnknown is the category of errors which could not be
+	// classified, and of nil errors.
+	ErrorCategoryUnknown ErrorCategory = iota
+
+	// ErrorCategoryThrottle is the category of errors returned by a service
+	// throttling requests, such as "ThrottlingException", or HTTP status code
+	// 429 responses.
+	ErrorCategoryThrottle
+
+	// ErrorCategoryTransient is the category of transient network errors, such
+	// as connection resets and refused connections.
+	ErrorCategoryTransient
+
+	// ErrorCategoryServerFault is the category of errors caused by the
+	// service, such as HTTP status code 5xx responses.
+	ErrorCategoryServerFault
+
+	// ErrorCategoryClientFault is the category of errors caused by the
+	// request, such as invalid parameters, or HTTP status code 4xx responses.
+	ErrorCategoryClientFault
+
+	// ErrorCategoryExpiredCreds is the category of errors returned by a
+	// service for requests signed with expired credentials.
+	ErrorCategoryExpiredCreds
+
+	// ErrorCategoryCanceled is the category of errors for requests canceled
+	// by their context.
+	ErrorCategoryCanceled
+
+	// ErrorCategoryTimeout is the category of errors for requests, or request
+	// attempts, which timed out.
+	ErrorCategoryTimeout
+)
+
+// String returns the string representation of the ErrorCategory.
+func (c ErrorCategory) String() string {
+	switch c {
+	case ErrorCategoryThrottle:
+		return "Throttle"
+	case ErrorCategoryTransient:
+		return "Transient"
+	case ErrorCategoryServerFault:
+		return "ServerFault"
+	case ErrorCategoryClientFault:
+		return "ClientFault"
+	case ErrorCategoryExpiredCreds:
+		return "ExpiredCreds"
+	case ErrorCategoryCanceled:
+		return "Canceled"
+	case ErrorCategoryTimeout:
+		return "Timeout"
+	default:
+		return "Unknown"
+	}
+}
+
+// An ErrorClassification is the classification of an error returned by the
+// SDK.
+type ErrorClassification struct {
+	// The category the error was classified as.
+	Category ErrorCategory
+
+	// The error code of the error if the error is an awserr.Error. Empty
+	// otherwise.
+	Code string
+
+	// The HTTP status code of the API response error if the error is an
+	// awserr.RequestFailure. Zero otherwise.
+	StatusCode int
+
+	// Whether the SDK considers the error retryable.
+	Retryable bool
+}
+
+// serverFaultCodes is a collection of error codes returned by services for
+// errors caused by the service.
+var serverFaultCodes = map[string]struct{}{
+	"InternalError":               {},
+	"InternalFailure":             {},
+	"InternalServerError":         {},
+	"InternalServiceError":        {},
+	"ServiceUnavailable":          {},
+	"ServiceUnavailableException": {},
+}
+
+// timeoutCodes is a collection of error codes for requests, or request
+// attempts, which timed out.
+var timeoutCodes = map[string]struct{}{
+	"RequestTimeout":          {},
+	ErrCodeResponseTimeout:    {},
+	"RequestTimeoutException": {}, // Glacier's flavor of RequestTimeout
+	ErrCodeAttemptTimeout:     {},
+}
+
+// clientFaultCodes is a collection of error codes the SDK returns for invalid
+// requests.
+var clientFaultCodes = map[string]struct{}{
+	InvalidParameterErrCode:     {},
+	ParamRequiredErrCode:        {},
+	ParamMinValueErrCode:        {},
+	ParamMinLenErrCode:          {},
+	ParamMaxLenErrCode:          {},
+	ParamFormatErrCode:          {},
+	ErrCodeInvalidPresignExpire: {},
+}
+
+var registeredErrorCodes = struct {
+	sync.RWMutex
+	// service ID to error code to category
+	services map[string]map[string]ErrorCategory
+}{
+	services: map[string]map[string]ErrorCategory{},
+}
+
+// RegisterErrorCodes registers the error codes to be classified as the
+// category for API errors returned by the service. If the service ID is empty
+// the codes are registered for all services.
+//
+// Error codes registered as ErrorCategoryThrottle are throttled by the SDK's
+// retryers, and error codes registered as ErrorCategoryTransient,
+// ErrorCategoryTimeout, or ErrorCategoryExpiredCreds are retried. Codes
+// registered for all services are also considered by the IsErrorRetryable,
+// IsErrorThrottle, and IsErrorExpiredCreds utility functions.
+//
+// Should be called before API operations are made, such as when the
+// application is initialized.
+func RegisterErrorCodes(serviceID string, category ErrorCategory, codes ...string) {
+	registeredErrorCodes.Lock()
+	defer registeredErrorCodes.Unlock()
+
+	m, ok := registeredErrorCodes.services[serviceID]
+	if !ok {
+		m = map[string]ErrorCategory{}
+		registeredErrorCodes.services[serviceID] = m
+	}
+	for _, code := range codes {
+		m[code] = category
+	}
+}
+
+// registeredErrorCategory returns the category the error code was registered
+// as for the service, or for all services. Returns false if the code was not
+// registered.
+func registeredErrorCategory(serviceID, code string) (ErrorCategory, bool) {
+	registeredErrorCodes.RLock()
+	defer registeredErrorCodes.RUnlock()
+
+	if c, ok := registeredErrorCodes.services[serviceID][code]; ok {
+		return c, true
+	}
+	if len(serviceID) != 0 {
+		if c, ok := registeredErrorCodes.services[""][code]; ok {
+			return c, true
+		}
+	}
+	return ErrorCategoryUnknown, false
+}
+
+// isRegisteredErrorCategory returns whether the error's code was registered as
+// one of the categories for the service, or all services.
+func isRegisteredErrorCategory(err error, serviceID string, categories ...ErrorCategory) bool {
+	aerr, ok := err.(awserr.Error)
+	if !ok || aerr == nil {
+		return false
+	}
+
+	c, ok := registeredErrorCategory(serviceID, aerr.Code())
+	if !ok {
+		return false
+	}
+	for _, category := range categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// ClassifyError returns the classification of the error, using the error codes
+// registered for all services. Nil errors are classified as
+// ErrorCategoryUnknown.
+func ClassifyError(err error) ErrorClassification {
+	if err == nil {
+		return ErrorClassification{}
+	}
+
+	c := classifyError(err, "", 0)
+	c.Retryable = IsErrorRetryable(err) || IsErrorThrottle(err)
+	return c
+}
+
+// ClassifyError returns the classification of the request's error, using the
+// error codes registered for the request's service, and the request's
+// RetryErrorCodes and ThrottleErrorCodes. Requests without an Error are
+// classified as ErrorCategoryUnknown.
+func (r *Request) ClassifyError() ErrorClassification {
+	if r.Error == nil {
+		return ErrorClassification{}
+	}
+
+	var statusCode int
+	if r.HTTPResponse != nil {
+		statusCode = r.HTTPResponse.StatusCode
+	}
+
+	c := classifyError(r.Error, r.ClientInfo.ServiceID, statusCode)
+	switch {
+	case isErrCode(r.Error, r.ThrottleErrorCodes):
+		c.Category = ErrorCategoryThrottle
+	case isErrCode(r.Error, r.RetryErrorCodes):
+		c.Category = ErrorCategoryTransient
+	}
+	c.Retryable = r.IsErrorRetryable() || r.IsErrorThrottle()
+	return c
+}
+
+func classifyError(err error, serviceID string, statusCode int) ErrorClassification {
+	var c ErrorClassification
+
+	if aerr, ok := err.(awserr.Error); ok {
+		c.Code = aerr.Code()
+	}
+	if reqErr, ok := err.(awserr.RequestFailure); ok {
+		c.StatusCode = reqErr.StatusCode()
+	}
+	if c.StatusCode == 0 {
+		c.StatusCode = statusCode
+	}
+
+	c.Category = errorCategory(err, c.Code, serviceID, c.StatusCode)
+	return c
+}
+
+func errorCategory(err error, code, serviceID string, statusCode int) ErrorCategory {
+	if len(code) != 0 {
+		if c, ok := registeredErrorCategory(serviceID, code); ok {
+			return c
+		}
+	}
+
+	switch {
+	case code == CanceledErrorCode || isErrorCanceled(err):
+		return ErrorCategoryCanceled
+	case isCodeIn(code, timeoutCodes) || isErrorDeadlineExceeded(err):
+		return ErrorCategoryTimeout
+	case isCodeExpiredCreds(code):
+		return ErrorCategoryExpiredCreds
+	case isCodeThrottle(code) || statusCode == 429:
+		return ErrorCategoryThrottle
+	case isCodeIn(code, serverFaultCodes):
+		return ErrorCategoryServerFault
+	case isCodeIn(code, clientFaultCodes):
+		return ErrorCategoryClientFault
+	}
+
+	if netErr, ok := asNetError(err); ok && netErr.Timeout() {
+		return ErrorCategoryTimeout
+	}
+
+	switch {
+	case statusCode >= 500:
+		return ErrorCategoryServerFault
+	case statusCode >= 400:
+		return ErrorCategoryClientFault
+	}
+
+	if isErrorTransient(err) {
+		return ErrorCategoryTransient
+	}
+	return ErrorCategoryUnknown
+}
+
+// isErrorTransient returns whether the error was caused by a transient
+// network error, such as a connection reset, or refused connection.
+func isErrorTransient(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		switch aerr.Code() {
+		case ErrCodeRequestError, ErrCodeRead, ErrCodeSerialization:
+		default:
+			return false
+		}
+		if aerr.OrigErr() == nil {
+			return false
+		}
+		return isErrorTransient(aerr.OrigErr())
+	}
+
+	if _, ok := asNetError(err); !ok {
+		return isErrConnectionReset(err)
+	}
+	return shouldRetryError(err)
+}
+
+func isCodeIn(code string, codes map[string]struct{}) bool {
+	_, ok := codes[code]
+	return ok
+}
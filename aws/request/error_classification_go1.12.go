@@ -0,0 +1,39 @@
+//go:build !go1.13
+// +build !go1.13
+
+package request
+
+import (
+	"net"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// isErrorCanceled returns false, errors cannot be unwrapped prior to Go 1.13.
+// Canceled requests are classified by their CanceledErrorCode.
+func isErrorCanceled(err error) bool {
+	return false
+}
+
+// isErrorDeadlineExceeded returns false, errors cannot be unwrapped prior to
+// Go 1.13. Deadline exceeded errors are classified as timeouts by their
+// net.Error Timeout method.
+func isErrorDeadlineExceeded(err error) bool {
+	return false
+}
+
+// asNetError returns the first net.Error of the error, or the original errors
+// of the awserr.Error.
+func asNetError(err error) (net.Error, bool) {
+	for err != nil {
+		if netErr, ok := err.(net.Error); ok {
+			return netErr, true
+		}
+		aerr, ok := err.(awserr.Error)
+		if !ok {
+			break
+		}
+		err = aerr.OrigErr()
+	}
+	return nil, false
+}
@@ -0,0 +1,30 @@
+//go:build go1.13
+// +build go1.13
+
+package request
+
+import (
+	"context"
+	"errors"
+	"net"
+)
+
+// isErrorCanceled returns whether the error, or any error it wraps, is the
+// context canceled error.
+func isErrorCanceled(err error) bool {
+	return errors.Is(err, context.Canceled)
+}
+
+// isErrorDeadlineExceeded returns whether the error, or any error it wraps,
+// is the context deadline exceeded error.
+func isErrorDeadlineExceeded(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded)
+}
+
+// asNetError returns the first net.Error of the error, or the errors it
+// wraps.
+func asNetError(err error) (net.Error, bool) {
+	var netErr net.Error
+	ok := errors.As(err, &netErr)
+	return netErr, ok
+}
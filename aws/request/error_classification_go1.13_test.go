@@ -0,0 +1,247 @@
+//go:build go1.13
+// +build go1.13
+
+package request_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestClassifyError(t *testing.T) {
+	cases := map[string]struct {
+		Err    error
+		Expect request.ErrorClassification
+	}{
+		"nil": {},
+		"throttle": {
+			Err: awserr.NewRequestFailure(
+				awserr.New("ThrottlingException", "slow down", nil), 400, "reqID"),
+			Expect: request.ErrorClassification{
+				Category: request.ErrorCategoryThrottle, Code: "ThrottlingException",
+				StatusCode: 400, Retryable: true,
+			},
+		},
+		"throttle status code": {
+			Err: awserr.NewRequestFailure(
+				awserr.New("SlowDown", "slow down", nil), 429, "reqID"),
+			Expect: request.ErrorClassification{
+				Category: request.ErrorCategoryThrottle, Code: "SlowDown",
+				StatusCode: 429,
+			},
+		},
+		"expired creds": {
+			Err: awserr.NewRequestFailure(
+				awserr.New("ExpiredTokenException", "expired", nil), 400, "reqID"),
+			Expect: request.ErrorClassification{
+				Category: request.ErrorCategoryExpiredCreds, Code: "ExpiredTokenException",
+				StatusCode: 400, Retryable: true,
+			},
+		},
+		"server fault": {
+			Err: awserr.NewRequestFailure(
+				awserr.New("InternalFailure", "failure", nil), 500, "reqID"),
+			Expect: request.ErrorClassification{
+				Category: request.ErrorCategoryServerFault, Code: "InternalFailure",
+				StatusCode: 500,
+			},
+		},
+		"client fault": {
+			Err: awserr.NewRequestFailure(
+				awserr.New("ValidationException", "invalid", nil), 400, "reqID"),
+			Expect: request.ErrorClassification{
+				Category: request.ErrorCategoryClientFault, Code: "ValidationException",
+				StatusCode: 400,
+			},
+		},
+		"invalid params": {
+			Err: awserr.New(request.InvalidParameterErrCode, "invalid", nil),
+			Expect: request.ErrorClassification{
+				Category: request.ErrorCategoryClientFault, Code: request.InvalidParameterErrCode,
+			},
+		},
+		"canceled": {
+			Err: awserr.New(request.CanceledErrorCode, "canceled", context.Canceled),
+			Expect: request.ErrorClassification{
+				Category: request.ErrorCategoryCanceled, Code: request.CanceledErrorCode,
+			},
+		},
+		"context deadline": {
+			Err: awserr.New(request.ErrCodeRequestError, "send request failed",
+				&url.Error{Op: "Get", URL: "https://example.com", Err: context.DeadlineExceeded}),
+			Expect: request.ErrorClassification{
+				Category: request.ErrorCategoryTimeout, Code: request.ErrCodeRequestError,
+				Retryable: true,
+			},
+		},
+		"attempt timeout": {
+			Err: awserr.New(request.ErrCodeAttemptTimeout, "timed out", nil),
+			Expect: request.ErrorClassification{
+				Category: request.ErrorCategoryTimeout, Code: request.ErrCodeAttemptTimeout,
+				Retryable: true,
+			},
+		},
+		"network timeout": {
+			Err: awserr.New(request.ErrCodeRequestError, "send request failed",
+				&url.Error{Op: "Get", URL: "https://example.com", Err: timeoutError{}}),
+			Expect: request.ErrorClassification{
+				Category: request.ErrorCategoryTimeout, Code: request.ErrCodeRequestError,
+				Retryable: true,
+			},
+		},
+		"connection reset": {
+			Err: awserr.New(request.ErrCodeRequestError, "send request failed",
+				&url.Error{Op: "Put", URL: "https://example.com", Err: &net.OpError{
+					Op: "write", Net: "tcp", Err: fmt.Errorf("connection reset by peer"),
+				}}),
+			Expect: request.ErrorClassification{
+				Category: request.ErrorCategoryTransient, Code: request.ErrCodeRequestError,
+				Retryable: true,
+			},
+		},
+		"connection refused": {
+			Err: awserr.New(request.ErrCodeRequestError, "send request failed",
+				&url.Error{Op: "Get", URL: "https://example.com", Err: &net.OpError{
+					Op: "dial", Net: "tcp", Err: fmt.Errorf("connection refused"),
+				}}),
+			Expect: request.ErrorClassification{
+				Category: request.ErrorCategoryTransient, Code: request.ErrCodeRequestError,
+				Retryable: true,
+			},
+		},
+		"unknown": {
+			Err: errors.New("some error"),
+			Expect: request.ErrorClassification{
+				Category: request.ErrorCategoryUnknown, Retryable: true,
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if e, a := c.Expect, request.ClassifyError(c.Err); e != a {
+				t.Errorf("expect %+v, got %+v", e, a)
+			}
+		})
+	}
+}
+
+func TestRequestClassifyError(t *testing.T) {
+	newRequest := func(err error, statusCode int) *request.Request {
+		return &request.Request{
+			ClientInfo: metadata.ClientInfo{ServiceID: "Mock Service"},
+			Error:      err,
+			HTTPResponse: &http.Response{
+				StatusCode: statusCode,
+			},
+		}
+	}
+
+	r := newRequest(awserr.New("SerializationError", "failed", nil), 503)
+	c := r.ClassifyError()
+	if e, a := request.ErrorCategoryServerFault, c.Category; e != a {
+		t.Errorf("expect %v category, got %v", e, a)
+	}
+	if e, a := 503, c.StatusCode; e != a {
+		t.Errorf("expect %v status code, got %v", e, a)
+	}
+	if !c.Retryable {
+		t.Errorf("expect retryable")
+	}
+
+	r = newRequest(awserr.New("CustomRetryCode", "failed", nil), 400)
+	r.RetryErrorCodes = []string{"CustomRetryCode"}
+	c = r.ClassifyError()
+	if e, a := request.ErrorCategoryTransient, c.Category; e != a {
+		t.Errorf("expect %v category, got %v", e, a)
+	}
+	if !c.Retryable {
+		t.Errorf("expect retryable")
+	}
+
+	if e, a := (request.ErrorClassification{}), newRequest(nil, 200).ClassifyError(); e != a {
+		t.Errorf("expect %+v, got %+v", e, a)
+	}
+}
+
+func TestRegisterErrorCodes(t *testing.T) {
+	request.RegisterErrorCodes("Mock Service", request.ErrorCategoryThrottle, "MockSlowDown")
+	request.RegisterErrorCodes("Mock Service", request.ErrorCategoryExpiredCreds, "MockExpired")
+	request.RegisterErrorCodes("", request.ErrorCategoryTransient, "MockTransient")
+
+	cases := map[string]struct {
+		ServiceID      string
+		Code           string
+		ExpectCategory request.ErrorCategory
+		ExpectThrottle bool
+		ExpectRetry    bool
+		ExpectExpired  bool
+	}{
+		"service throttle": {
+			ServiceID: "Mock Service", Code: "MockSlowDown",
+			ExpectCategory: request.ErrorCategoryThrottle,
+			ExpectThrottle: true,
+		},
+		"other service throttle": {
+			ServiceID: "Other Service", Code: "MockSlowDown",
+			ExpectCategory: request.ErrorCategoryClientFault,
+		},
+		"service expired creds": {
+			ServiceID: "Mock Service", Code: "MockExpired",
+			ExpectCategory: request.ErrorCategoryExpiredCreds,
+			ExpectRetry:    true,
+			ExpectExpired:  true,
+		},
+		"all services transient": {
+			ServiceID: "Other Service", Code: "MockTransient",
+			ExpectCategory: request.ErrorCategoryTransient,
+			ExpectRetry:    true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &request.Request{
+				ClientInfo: metadata.ClientInfo{ServiceID: c.ServiceID},
+				Error: awserr.NewRequestFailure(
+					awserr.New(c.Code, "message", nil), 400, "reqID"),
+				HTTPResponse: &http.Response{StatusCode: 400},
+			}
+
+			if e, a := c.ExpectCategory, r.ClassifyError().Category; e != a {
+				t.Errorf("expect %v category, got %v", e, a)
+			}
+			if e, a := c.ExpectThrottle, r.IsErrorThrottle(); e != a {
+				t.Errorf("expect %v throttle, got %v", e, a)
+			}
+			if e, a := c.ExpectRetry, r.IsErrorRetryable(); e != a {
+				t.Errorf("expect %v retryable, got %v", e, a)
+			}
+			if e, a := c.ExpectExpired, r.IsErrorExpired(); e != a {
+				t.Errorf("expect %v expired, got %v", e, a)
+			}
+		})
+	}
+
+	if !request.IsErrorRetryable(awserr.New("MockTransient", "message", nil)) {
+		t.Errorf("expect code registered for all services to be retryable")
+	}
+	if request.IsErrorThrottle(awserr.New("MockSlowDown", "message", nil)) {
+		t.Errorf("expect code registered for service not to be throttle")
+	}
+}
@@ -3,6 +3,9 @@ package request
 import (
 	"fmt"
 	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/internal/sdklog"
 )
 
 // A Handlers provides a collection of request handlers for various
@@ -281,7 +284,17 @@ func HandlerListLogItem(item HandlerListRunItem) bool {
 	if item.Request.Config.Logger == nil {
 		return true
 	}
-	item.Request.Config.Logger.Log("DEBUG: RequestHandler",
+	logger := item.Request.Config.Logger
+	if sl, ok := logger.(aws.StructuredLogger); ok {
+		sl.LogFields(aws.LogSeverityDebug, "request handler",
+			append(item.Request.logFields(),
+				sdklog.Field(sdklog.FieldHandlerIndex, item.Index),
+				sdklog.Field(sdklog.FieldHandler, item.Handler.Name),
+				sdklog.Field(sdklog.FieldError, item.Request.Error),
+			)...)
+		return true
+	}
+	logger.Log("DEBUG: RequestHandler",
 		item.Index, item.Handler.Name, item.Request.Error)
 
 	return true
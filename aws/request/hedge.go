@@ -0,0 +1,332 @@
+package request
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/internal/sdkio"
+)
+
+// A Hedger determines when the hedged attempt of a request is made, and
+// observes the latency of the request's attempts.
+type Hedger interface {
+	// HedgeDelay returns the delay after which the hedged attempt of the
+	// request is made, if the request's attempt has not completed.
+	HedgeDelay(r *Request) time.Duration
+
+	// ObserveLatency is called with the time taken for the request to
+	// receive a response, measured from the start of the request's attempt
+	// whichever attempt's response is used. The latency includes the hedge
+	// delay when the hedged attempt's response is used.
+	ObserveLatency(r *Request, latency time.Duration)
+}
+
+// FixedHedgeDelay is a Hedger which makes the hedged attempt of requests
+// after a fixed delay.
+type FixedHedgeDelay time.Duration
+
+// HedgeDelay returns the fixed delay.
+func (d FixedHedgeDelay) HedgeDelay(*Request) time.Duration {
+	return time.Duration(d)
+}
+
+// ObserveLatency does nothing. The fixed delay does not depend on the
+// latency of requests.
+func (d FixedHedgeDelay) ObserveLatency(*Request, time.Duration) {}
+
+// Number of latencies the PercentileHedger keeps for each API operation, and
+// the minimum number of latencies it must have observed before using the
+// percentile as the delay.
+const (
+	percentileHedgerSamples    = 100
+	percentileHedgerMinSamples = 10
+)
+
+// A PercentileHedger is a Hedger which makes the hedged attempt of requests
+// after the latency percentile of the attempts observed for the request's API
+// operation. The PercentileHedger should be shared by the requests made for
+// the API operations, and is safe to use concurrently.
+type PercentileHedger struct {
+	percentile   float64
+	defaultDelay time.Duration
+
+	mu        sync.Mutex
+	latencies map[string]*latencySamples
+}
+
+// NewPercentileHedger returns a PercentileHedger which makes the hedged
+// attempt of requests after the percentile, between 0 and 1, of the observed
+// latencies of the API operation. The default delay is used until enough
+// latencies of the API operation have been observed.
+func NewPercentileHedger(percentile float64, defaultDelay time.Duration) *PercentileHedger {
+	return &PercentileHedger{
+		percentile:   percentile,
+		defaultDelay: defaultDelay,
+		latencies:    map[string]*latencySamples{},
+	}
+}
+
+// HedgeDelay returns the latency percentile of the request's API operation.
+func (h *PercentileHedger) HedgeDelay(r *Request) time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	s, ok := h.latencies[hedgerKey(r)]
+	if !ok || len(s.values) < percentileHedgerMinSamples {
+		return h.defaultDelay
+	}
+	return s.percentile(h.percentile)
+}
+
+// ObserveLatency records the latency of the request for the request's API
+// operation.
+func (h *PercentileHedger) ObserveLatency(r *Request, latency time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	key := hedgerKey(r)
+	s, ok := h.latencies[key]
+	if !ok {
+		s = &latencySamples{}
+		h.latencies[key] = s
+	}
+	s.add(latency)
+}
+
+func hedgerKey(r *Request) string {
+	return r.ClientInfo.ServiceID + "." + r.Operation.Name
+}
+
+// latencySamples is a ring of the most recent latencies observed.
+type latencySamples struct {
+	values []time.Duration
+	next   int
+}
+
+func (s *latencySamples) add(v time.Duration) {
+	if len(s.values) < percentileHedgerSamples {
+		s.values = append(s.values, v)
+		return
+	}
+	s.values[s.next] = v
+	s.next = (s.next + 1) % len(s.values)
+}
+
+func (s *latencySamples) percentile(p float64) time.Duration {
+	sorted := append([]time.Duration(nil), s.values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	i := int(p * float64(len(sorted)))
+	if i >= len(sorted) {
+		i = len(sorted) - 1
+	} else if i < 0 {
+		i = 0
+	}
+	return sorted[i]
+}
+
+// WithHedging is a request option that will make a second, hedged, attempt
+// of the request if the request's attempt has not received a response after
+// the delay returned by the Hedger. The response of whichever attempt
+// succeeds first, without a server error, is used, and the other attempt is
+// canceled. Hedged attempts are signed, and retried, as the request's other
+// attempts are.
+//
+// Hedging should only be used with idempotent API operations, such as S3's
+// GetObject and HeadObject, or DynamoDB's GetItem. The request's body is
+// copied for the hedged attempt. Requests with streaming, or unknown length,
+// bodies and presigned requests are not hedged.
+//
+// Canceling the losing attempt is only supported with Go 1.7 and above.
+//
+//     svc.GetObjectWithContext(ctx, params,
+//         request.WithHedging(request.FixedHedgeDelay(100 * time.Millisecond)))
+func WithHedging(hedger Hedger) Option {
+	return func(r *Request) {
+		r.hedger = hedger
+	}
+}
+
+// runSendHandlers runs the request's Send handlers, making a hedged attempt
+// if the request has a Hedger. The attempt context is the context of the
+// request's current attempt, and may be nil.
+func (r *Request) runSendHandlers(attemptCtx aws.Context) {
+	if r.hedger == nil || r.IsPresigned() || r.streamingBody != nil {
+		r.Handlers.Send.Run(r)
+		return
+	}
+
+	hedgeBody, ok := r.hedgeBody()
+	if !ok {
+		r.Handlers.Send.Run(r)
+		return
+	}
+
+	if attemptCtx == nil {
+		attemptCtx = r.attemptContext()
+	}
+	r.sendHedged(attemptCtx, hedgeBody)
+}
+
+// hedgeBody returns a copy of the request's body for the hedged attempt.
+// Returns false if the body cannot be copied.
+func (r *Request) hedgeBody() (io.ReadSeeker, bool) {
+	l, err := aws.SeekerLen(r.Body)
+	if err != nil || l < 0 {
+		return nil, false
+	}
+	if l == 0 {
+		return bytes.NewReader(nil), true
+	}
+
+	if _, err := r.Body.Seek(r.BodyStart, sdkio.SeekStart); err != nil {
+		return nil, false
+	}
+	b, err := ioutil.ReadAll(io.LimitReader(r.Body, l))
+	if _, seekErr := r.Body.Seek(r.BodyStart, sdkio.SeekStart); err != nil || seekErr != nil {
+		return nil, false
+	}
+	return bytes.NewReader(b), true
+}
+
+// hedgeAttempt is an attempt of a hedged request. Each attempt is made with a
+// copy of the request, and its own context.
+type hedgeAttempt struct {
+	req    *Request
+	cancel func()
+	done   chan struct{}
+}
+
+// newHedgeAttempt returns an attempt made with a copy of the request. If body
+// is nil the attempt uses the request's body.
+func (r *Request) newHedgeAttempt(parent aws.Context, body io.ReadSeeker) *hedgeAttempt {
+	req := r.copy()
+	req.Retryable = nil
+	if body == nil {
+		req.HTTPRequest = copyHTTPRequest(r.HTTPRequest, r.HTTPRequest.Body)
+	} else {
+		req.HTTPRequest = copyHTTPRequest(r.HTTPRequest, nil)
+		req.Body, req.BodyStart, req.safeBody = body, 0, nil
+		req.ResetBody()
+	}
+
+	ctx, cancel := newHedgeContext(parent)
+	setAttemptContext(req, ctx)
+
+	return &hedgeAttempt{
+		req:    req,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+}
+
+func (a *hedgeAttempt) send(results chan<- *hedgeAttempt) {
+	if a.req.Error == nil {
+		a.req.Handlers.Send.Run(a.req)
+	}
+	close(a.done)
+	results <- a
+}
+
+// succeeded returns whether the attempt received a response which was not a
+// server error.
+func (a *hedgeAttempt) succeeded() bool {
+	if a.req.Error != nil {
+		return false
+	}
+	return a.req.HTTPResponse == nil || a.req.HTTPResponse.StatusCode < 500
+}
+
+// release cancels the attempt, and closes its response body once the
+// attempt's Send handlers have completed.
+func (a *hedgeAttempt) release() {
+	a.cancel()
+	go func() {
+		<-a.done
+		if resp := a.req.HTTPResponse; resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+	}()
+}
+
+// sendHedged makes the request's attempt, and the hedged attempt if the
+// request's attempt has not completed after the Hedger's delay. The request
+// is updated with the response of the first attempt to succeed, or the
+// error of the request's attempt if neither succeeds.
+func (r *Request) sendHedged(parent aws.Context, hedgeBody io.ReadSeeker) {
+	results := make(chan *hedgeAttempt, 2)
+
+	start := time.Now()
+	primary := r.newHedgeAttempt(parent, nil)
+	go primary.send(results)
+	attempts := []*hedgeAttempt{primary}
+
+	timer := time.NewTimer(r.hedger.HedgeDelay(r))
+	defer timer.Stop()
+	hedgeTimer := timer.C
+
+	var winner, failed *hedgeAttempt
+	for pending := 1; pending > 0 && winner == nil; {
+		select {
+		case <-hedgeTimer:
+			hedgeTimer = nil
+			hedge := r.newHedgeAttempt(parent, hedgeBody)
+			go hedge.send(results)
+			attempts = append(attempts, hedge)
+			pending++
+
+		case a := <-results:
+			pending--
+			if a.succeeded() {
+				winner = a
+				r.hedger.ObserveLatency(r, time.Since(start))
+			} else if failed == nil || a == primary {
+				failed = a
+			}
+			if hedgeTimer != nil {
+				// The request's attempt completed before the hedged attempt
+				// was made.
+				hedgeTimer = nil
+				pending = 0
+			}
+		}
+	}
+	if winner == nil {
+		winner = failed
+	}
+
+	for _, a := range attempts {
+		if a != winner {
+			a.release()
+		}
+	}
+
+	r.HTTPResponse = winner.req.HTTPResponse
+	r.Error = winner.req.Error
+	r.Retryable = winner.req.Retryable
+	r.Handlers = winner.req.Handlers
+
+	if resp := r.HTTPResponse; resp != nil && resp.Body != nil {
+		resp.Body = &hedgeReadCloser{ReadCloser: resp.Body, cancel: winner.cancel}
+	} else {
+		winner.cancel()
+	}
+}
+
+// hedgeReadCloser wraps the response body of the winning attempt of a hedged
+// request. Closing the body releases the attempt's context.
+type hedgeReadCloser struct {
+	io.ReadCloser
+	cancel func()
+}
+
+func (r *hedgeReadCloser) Close() error {
+	err := r.ReadCloser.Close()
+	r.cancel()
+	return err
+}
@@ -0,0 +1,209 @@
+package request_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/corehandlers"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
+)
+
+func newHedgeTestClient(t *testing.T, handler http.Handler) *client.Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	svc := awstesting.NewClient(&aws.Config{
+		Endpoint: aws.String(server.URL),
+	})
+	svc.Handlers.Clear()
+	svc.Handlers.Send.PushBackNamed(corehandlers.SendHandler)
+	svc.Handlers.ValidateResponse.PushBackNamed(corehandlers.ValidateResponseHandler)
+	return svc
+}
+
+func TestRequest_Hedged(t *testing.T) {
+	var attempts int32
+	canceled := make(chan struct{})
+	svc := newHedgeTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		if e, a := "request body", string(b); e != a {
+			t.Errorf("expect %q body, got %q", e, a)
+		}
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			<-r.Context().Done()
+			close(canceled)
+			return
+		}
+		w.Write([]byte("hedged"))
+	}))
+
+	r := svc.NewRequest(&request.Operation{Name: "Operation", HTTPMethod: "POST"}, nil, nil)
+	r.SetStringBody("request body")
+	r.ApplyOptions(request.WithHedging(request.FixedHedgeDelay(10 * time.Millisecond)))
+
+	if err := r.Send(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	b, err := ioutil.ReadAll(r.HTTPResponse.Body)
+	r.HTTPResponse.Body.Close()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "hedged", string(b); e != a {
+		t.Errorf("expect %q response, got %q", e, a)
+	}
+
+	select {
+	case <-canceled:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expect first attempt to be canceled")
+	}
+	if e, a := int32(2), atomic.LoadInt32(&attempts); e != a {
+		t.Errorf("expect %v attempts, got %v", e, a)
+	}
+}
+
+func TestRequest_HedgedNotNeeded(t *testing.T) {
+	var attempts int32
+	svc := newHedgeTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Write([]byte("response"))
+	}))
+
+	hedger := request.NewPercentileHedger(0.9, time.Minute)
+	r := svc.NewRequest(&request.Operation{Name: "Operation", HTTPMethod: "GET"}, nil, nil)
+	r.ApplyOptions(request.WithHedging(hedger))
+
+	if err := r.Send(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	b, _ := ioutil.ReadAll(r.HTTPResponse.Body)
+	r.HTTPResponse.Body.Close()
+	if e, a := "response", string(b); e != a {
+		t.Errorf("expect %q response, got %q", e, a)
+	}
+	if e, a := int32(1), atomic.LoadInt32(&attempts); e != a {
+		t.Errorf("expect %v attempts, got %v", e, a)
+	}
+}
+
+func TestRequest_HedgedBothFail(t *testing.T) {
+	var attempts int32
+	svc := newHedgeTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			time.Sleep(50 * time.Millisecond)
+			w.WriteHeader(500)
+			return
+		}
+		w.WriteHeader(503)
+	}))
+
+	r := svc.NewRequest(&request.Operation{Name: "Operation", HTTPMethod: "GET"}, nil, nil)
+	r.ApplyOptions(request.WithHedging(request.FixedHedgeDelay(10 * time.Millisecond)))
+
+	err := r.Send()
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := 500, r.HTTPResponse.StatusCode; e != a {
+		t.Errorf("expect request's attempt %v status code, got %v", e, a)
+	}
+	if e, a := int32(2), atomic.LoadInt32(&attempts); e != a {
+		t.Errorf("expect %v attempts, got %v", e, a)
+	}
+}
+
+func TestRequest_HedgedSlowPrimaryLatency(t *testing.T) {
+	var attempts int32
+	svc := newHedgeTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			// The request's attempt is persistently slow, and is always
+			// canceled once the hedged attempt responds.
+			<-r.Context().Done()
+			return
+		}
+		w.Write([]byte("hedged"))
+	}))
+
+	defaultDelay := 20 * time.Millisecond
+	hedger := request.NewPercentileHedger(0.5, defaultDelay)
+
+	for i := 0; i < 20; i++ {
+		atomic.StoreInt32(&attempts, 0)
+
+		r := svc.NewRequest(&request.Operation{Name: "Operation", HTTPMethod: "GET"}, nil, nil)
+		r.ApplyOptions(request.WithHedging(hedger))
+		if err := r.Send(); err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		r.HTTPResponse.Body.Close()
+
+		if delay := hedger.HedgeDelay(r); delay < defaultDelay {
+			t.Fatalf("expect delay not to fall below %v, got %v after %d requests",
+				defaultDelay, delay, i+1)
+		}
+	}
+}
+
+func TestPercentileHedger(t *testing.T) {
+	hedger := request.NewPercentileHedger(0.9, time.Second)
+	r := &request.Request{
+		ClientInfo: metadata.ClientInfo{ServiceID: "Mock"},
+		Operation:  &request.Operation{Name: "Operation"},
+	}
+
+	if e, a := time.Second, hedger.HedgeDelay(r); e != a {
+		t.Errorf("expect default %v delay, got %v", e, a)
+	}
+
+	for i := 1; i <= 100; i++ {
+		hedger.ObserveLatency(r, time.Duration(i)*time.Millisecond)
+	}
+	if e, a := 91*time.Millisecond, hedger.HedgeDelay(r); e != a {
+		t.Errorf("expect %v delay, got %v", e, a)
+	}
+
+	// Oldest latencies are replaced.
+	for i := 0; i < 100; i++ {
+		hedger.ObserveLatency(r, 5*time.Millisecond)
+	}
+	if e, a := 5*time.Millisecond, hedger.HedgeDelay(r); e != a {
+		t.Errorf("expect %v delay, got %v", e, a)
+	}
+
+	other := &request.Request{
+		ClientInfo: metadata.ClientInfo{ServiceID: "Mock"},
+		Operation:  &request.Operation{Name: "OtherOperation"},
+	}
+	if e, a := time.Second, hedger.HedgeDelay(other); e != a {
+		t.Errorf("expect default %v delay, got %v", e, a)
+	}
+}
+
+func TestRequest_HedgedStreamingBody(t *testing.T) {
+	var attempts int32
+	svc := newHedgeTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		time.Sleep(30 * time.Millisecond)
+	}))
+
+	r := svc.NewRequest(&request.Operation{Name: "Operation", HTTPMethod: "PUT"}, nil, nil)
+	r.SetStreamingBody(ioutil.NopCloser(strings.NewReader("streaming")))
+	r.ApplyOptions(request.WithHedging(request.FixedHedgeDelay(time.Millisecond)))
+
+	if err := r.Send(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := int32(1), atomic.LoadInt32(&attempts); e != a {
+		t.Errorf("expect %v attempts, got %v", e, a)
+	}
+}
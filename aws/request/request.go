@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/client/metadata"
 	"github.com/aws/aws-sdk-go/internal/sdkio"
+	"github.com/aws/aws-sdk-go/internal/sdklog"
 )
 
 const (
@@ -41,6 +42,10 @@ const (
 	// ErrCodeRequestError is an error preventing the SDK from continuing to
 	// process the request.
 	ErrCodeRequestError = "RequestError"
+
+	// ErrCodeAttemptTimeout is the error code that will be returned when a
+	// request attempt took longer than the request's attempt timeout.
+	ErrCodeAttemptTimeout = "AttemptTimeout"
 )
 
 // A Request is the service request to be made.
@@ -93,6 +98,12 @@ type Request struct {
 	// to the HTTP request's body after the client has returned. This value is
 	// safe to use concurrently and wrap the input Body for each HTTP request.
 	safeBody *offsetReader
+
+	// Tracing state of the request, nil if the request is not being traced.
+	trace *requestTrace
+
+	// Hedger of the request, nil if the request is not hedged.
+	hedger Hedger
 }
 
 // An Operation is the service API operation to be made.
@@ -166,6 +177,10 @@ func New(cfg aws.Config, clientInfo metadata.ClientInfo, handlers Handlers,
 		Error:       err,
 		Data:        data,
 	}
+	if tracer, ok := cfg.Tracer.(Tracer); ok && tracer != nil {
+		r.trace = &requestTrace{tracer: tracer}
+	}
+
 	r.SetBufferBody([]byte{})
 
 	return r
@@ -392,13 +407,29 @@ const (
 	notRetrying = "not retrying"
 )
 
+// logFields returns the fields logged with the structured log messages of
+// the request.
+func (r *Request) logFields() []aws.LogField {
+	return []aws.LogField{
+		sdklog.Field(sdklog.FieldService, r.ClientInfo.ServiceName),
+		sdklog.Field(sdklog.FieldOperation, r.Operation.Name),
+		sdklog.Field(sdklog.FieldRetryCount, r.RetryCount),
+	}
+}
+
 func debugLogReqError(r *Request, stage, retryStr string, err error) {
 	if !r.Config.LogLevel.Matches(aws.LogDebugWithRequestErrors) {
 		return
 	}
 
-	r.Config.Logger.Log(fmt.Sprintf("DEBUG: %s %s/%s failed, %s, error %v",
-		stage, r.ClientInfo.ServiceName, r.Operation.Name, retryStr, err))
+	sdklog.Log(r.Config.Logger, aws.LogSeverityDebug, "request failed",
+		fmt.Sprintf("DEBUG: %s %s/%s failed, %s, error %v",
+			stage, r.ClientInfo.ServiceName, r.Operation.Name, retryStr, err),
+		append(r.logFields(),
+			sdklog.Field(sdklog.FieldStage, stage),
+			sdklog.Field(sdklog.FieldRetrying, retryStr != notRetrying),
+			sdklog.Field(sdklog.FieldError, err),
+		)...)
 }
 
 // Build will build the request's object so it can be signed and sent
@@ -413,14 +444,8 @@ func debugLogReqError(r *Request, stage, retryStr string, err error) {
 // which occurred will be returned.
 func (r *Request) Build() error {
 	if !r.built {
-		r.Handlers.Validate.Run(r)
+		r.tracePhase(TraceSpanBuild, r.runBuildHandlers)
 		if r.Error != nil {
-			debugLogReqError(r, "Validate Request", notRetrying, r.Error)
-			return r.Error
-		}
-		r.Handlers.Build.Run(r)
-		if r.Error != nil {
-			debugLogReqError(r, "Build Request", notRetrying, r.Error)
 			return r.Error
 		}
 		r.built = true
@@ -429,6 +454,18 @@ func (r *Request) Build() error {
 	return r.Error
 }
 
+func (r *Request) runBuildHandlers() {
+	r.Handlers.Validate.Run(r)
+	if r.Error != nil {
+		debugLogReqError(r, "Validate Request", notRetrying, r.Error)
+		return
+	}
+	r.Handlers.Build.Run(r)
+	if r.Error != nil {
+		debugLogReqError(r, "Build Request", notRetrying, r.Error)
+	}
+}
+
 // Sign will sign the request, returning error if errors are encountered.
 //
 // Sign will build the request prior to signing. All Sign Handlers will
@@ -442,7 +479,9 @@ func (r *Request) Sign() error {
 
 	SanitizeHostForHeader(r.HTTPRequest)
 
-	r.Handlers.Sign.Run(r)
+	r.tracePhase(TraceSpanSign, func() {
+		r.Handlers.Sign.Run(r)
+	})
 	return r.Error
 }
 
@@ -525,6 +564,7 @@ func (r *Request) GetBody() io.ReadSeeker {
 //
 // Send will not close the request.Request's body.
 func (r *Request) Send() error {
+	r.startOperationSpan()
 	defer func() {
 		// Ensure a non-nil HTTPResponse parameter is set to ensure handlers
 		// checking for HTTPResponse values, don't fail.
@@ -537,6 +577,7 @@ func (r *Request) Send() error {
 		// Regardless of success or failure of the request trigger the Complete
 		// request handlers.
 		r.Handlers.Complete.Run(r)
+		r.endOperationSpan()
 	}()
 
 	if err := r.Error; err != nil {
@@ -546,17 +587,24 @@ func (r *Request) Send() error {
 	for {
 		r.Error = nil
 		r.AttemptTime = time.Now()
+		r.startAttemptSpan()
 
 		if err := r.Sign(); err != nil {
 			debugLogReqError(r, "Sign Request", notRetrying, err)
+			r.endAttemptSpan(err)
 			return err
 		}
 
-		if err := r.sendRequest(); err == nil {
+		attemptErr := r.sendRequest()
+		if attemptErr == nil {
+			r.endAttemptSpan(nil)
 			return nil
 		}
-		r.Handlers.Retry.Run(r)
-		r.Handlers.AfterRetry.Run(r)
+		r.tracePhase(TraceSpanRetry, func() {
+			r.Handlers.Retry.Run(r)
+			r.Handlers.AfterRetry.Run(r)
+		})
+		r.endAttemptSpan(attemptErr)
 
 		if r.Error != nil || !aws.BoolValue(r.Retryable) {
 			return r.Error
@@ -571,8 +619,10 @@ func (r *Request) Send() error {
 
 func (r *Request) prepareRetry() error {
 	if r.Config.LogLevel.Matches(aws.LogDebugWithRequestRetries) {
-		r.Config.Logger.Log(fmt.Sprintf("DEBUG: Retrying Request %s/%s, attempt %d",
-			r.ClientInfo.ServiceName, r.Operation.Name, r.RetryCount))
+		sdklog.Log(r.Config.Logger, aws.LogSeverityDebug, "retrying request",
+			fmt.Sprintf("DEBUG: Retrying Request %s/%s, attempt %d",
+				r.ClientInfo.ServiceName, r.Operation.Name, r.RetryCount),
+			r.logFields()...)
 	}
 
 	// The previous http.Request will have a reference to the r.Body
@@ -598,8 +648,22 @@ func (r *Request) prepareRetry() error {
 func (r *Request) sendRequest() (sendErr error) {
 	defer r.Handlers.CompleteAttempt.Run(r)
 
+	var attemptCtx aws.Context
+	var cancelAttempt func()
+	if timeout := r.Config.AttemptTimeout; timeout > 0 {
+		attemptCtx, cancelAttempt = newAttemptContext(r.attemptContext(), timeout)
+		setAttemptContext(r, attemptCtx)
+		defer func() {
+			r.endAttempt(attemptCtx, cancelAttempt)
+		}()
+	} else if r.trace != nil && r.trace.attemptCtx != nil {
+		setAttemptContext(r, r.trace.attemptCtx)
+	}
+
 	r.Retryable = nil
-	r.Handlers.Send.Run(r)
+	r.tracePhase(TraceSpanSend, func() {
+		r.runSendHandlers(attemptCtx)
+	})
 	if r.Error != nil {
 		debugLogReqError(r, "Send Request",
 			fmtAttemptCount(r.RetryCount, r.MaxRetries()),
@@ -607,6 +671,20 @@ func (r *Request) sendRequest() (sendErr error) {
 		return r.Error
 	}
 
+	if attemptCtx != nil && r.HTTPResponse != nil && r.HTTPResponse.Body != nil {
+		r.HTTPResponse.Body = &attemptTimeoutReadCloser{
+			reader: r.HTTPResponse.Body,
+			ctx:    attemptCtx,
+			parent: r.Context(),
+			cancel: cancelAttempt,
+		}
+	}
+
+	r.tracePhase(TraceSpanUnmarshal, r.runUnmarshalHandlers)
+	return r.Error
+}
+
+func (r *Request) runUnmarshalHandlers() {
 	r.Handlers.UnmarshalMeta.Run(r)
 	r.Handlers.ValidateResponse.Run(r)
 	if r.Error != nil {
@@ -614,7 +692,7 @@ func (r *Request) sendRequest() (sendErr error) {
 		debugLogReqError(r, "Validate Response",
 			fmtAttemptCount(r.RetryCount, r.MaxRetries()),
 			r.Error)
-		return r.Error
+		return
 	}
 
 	r.Handlers.Unmarshal.Run(r)
@@ -622,10 +700,22 @@ func (r *Request) sendRequest() (sendErr error) {
 		debugLogReqError(r, "Unmarshal Response",
 			fmtAttemptCount(r.RetryCount, r.MaxRetries()),
 			r.Error)
-		return r.Error
 	}
+}
 
-	return nil
+// endAttempt releases the context of a failed attempt, replacing the
+// request's error with a ErrCodeAttemptTimeout error if the attempt failed
+// because it timed out. The context of a successful attempt is released when
+// its response body is closed, or the attempt timeout elapses.
+func (r *Request) endAttempt(ctx aws.Context, cancel func()) {
+	if r.Error == nil {
+		return
+	}
+
+	if isAttemptTimedOut(ctx, r.Context()) && !isErrCode(r.Error, []string{ErrCodeAttemptTimeout}) {
+		r.Error = awserr.New(ErrCodeAttemptTimeout, "request attempt timed out", r.Error)
+	}
+	cancel()
 }
 
 // copy will copy a request which will allow for local manipulation of the
@@ -636,6 +726,10 @@ func (r *Request) copy() *Request {
 	req.Handlers = r.Handlers.Copy()
 	op := *r.Operation
 	req.Operation = &op
+	if r.trace != nil {
+		trace := *r.trace
+		req.trace = &trace
+	}
 	return req
 }
 
@@ -3,7 +3,12 @@
 
 package request
 
-import "github.com/aws/aws-sdk-go/aws"
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
 
 // setContext updates the Request to use the passed in context for cancellation.
 // Context will also be used for request retry delay.
@@ -13,3 +18,22 @@ func setRequestContext(r *Request, ctx aws.Context) {
 	r.context = ctx
 	r.HTTPRequest = r.HTTPRequest.WithContext(ctx)
 }
+
+// newAttemptContext returns a context derived from the parent that will be
+// canceled once the timeout elapses, or the returned cancel func is called.
+func newAttemptContext(parent aws.Context, timeout time.Duration) (aws.Context, func()) {
+	return context.WithTimeout(parent, timeout)
+}
+
+// newHedgeContext returns a context derived from the parent that will be
+// canceled once the returned cancel func is called.
+func newHedgeContext(parent aws.Context) (aws.Context, func()) {
+	return context.WithCancel(parent)
+}
+
+// setAttemptContext updates the Request's HTTP request to use the passed in
+// context for cancellation of the current attempt. The Request's context is
+// not modified.
+func setAttemptContext(r *Request, ctx aws.Context) {
+	r.HTTPRequest = r.HTTPRequest.WithContext(ctx)
+}
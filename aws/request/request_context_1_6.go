@@ -3,7 +3,11 @@
 
 package request
 
-import "github.com/aws/aws-sdk-go/aws"
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
 
 // setContext updates the Request to use the passed in context for cancellation.
 // Context will also be used for request retry delay.
@@ -13,3 +17,22 @@ func setRequestContext(r *Request, ctx aws.Context) {
 	r.context = ctx
 	r.HTTPRequest.Cancel = ctx.Done()
 }
+
+// newAttemptContext returns the parent context unmodified. Attempt timeouts
+// are only supported with Go 1.7 and above.
+func newAttemptContext(parent aws.Context, timeout time.Duration) (aws.Context, func()) {
+	return parent, func() {}
+}
+
+// newHedgeContext returns the parent context unmodified. Canceling the losing
+// attempt of a hedged request is only supported with Go 1.7 and above.
+func newHedgeContext(parent aws.Context) (aws.Context, func()) {
+	return parent, func() {}
+}
+
+// setAttemptContext updates the Request's HTTP request to use the passed in
+// context for cancellation of the current attempt. The Request's context is
+// not modified.
+func setAttemptContext(r *Request, ctx aws.Context) {
+	r.HTTPRequest.Cancel = ctx.Done()
+}
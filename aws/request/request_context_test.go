@@ -2,9 +2,16 @@ package request_test
 
 import (
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/corehandlers"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/awstesting"
@@ -44,3 +51,95 @@ func TestRequest_SetContextPanic(t *testing.T) {
 
 	r.SetContext(nil)
 }
+
+func TestRequest_AttemptTimeoutRetried(t *testing.T) {
+	var attempts int32
+	srvWait := make(chan struct{})
+	defer close(srvWait)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			select {
+			case <-srvWait:
+			case <-r.Context().Done():
+			}
+			return
+		}
+		w.WriteHeader(200)
+	}))
+	defer server.Close()
+
+	svc := awstesting.NewClient(&aws.Config{
+		Endpoint:   aws.String(server.URL),
+		MaxRetries: aws.Int(1),
+		SleepDelay: func(time.Duration) {},
+	})
+	svc.Handlers.Clear()
+	svc.Handlers.Send.PushBackNamed(corehandlers.SendHandler)
+	svc.Handlers.ValidateResponse.PushBackNamed(corehandlers.ValidateResponseHandler)
+	svc.Handlers.AfterRetry.PushBackNamed(corehandlers.AfterRetryHandler)
+
+	var attemptErr error
+	r := svc.NewRequest(&request.Operation{Name: "Operation"}, nil, nil)
+	r.Handlers.CompleteAttempt.PushBack(func(r *request.Request) {
+		if r.RetryCount == 0 {
+			attemptErr = r.Error
+		}
+	})
+	r.ApplyOptions(request.WithAttemptTimeout(50 * time.Millisecond))
+
+	if err := r.Send(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 1, r.RetryCount; e != a {
+		t.Errorf("expect %v retries, got %v", e, a)
+	}
+	aerr, ok := attemptErr.(awserr.Error)
+	if !ok {
+		t.Fatalf("expect first attempt awserr.Error, got %T, %v", attemptErr, attemptErr)
+	}
+	if e, a := request.ErrCodeAttemptTimeout, aerr.Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+}
+
+func TestRequest_AttemptTimeoutResponseBody(t *testing.T) {
+	srvWait := make(chan struct{})
+	defer close(srvWait)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		fmt.Fprintf(w, "Hello")
+		w.(http.Flusher).Flush()
+		select {
+		case <-srvWait:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+
+	svc := awstesting.NewClient(&aws.Config{
+		Endpoint:       aws.String(server.URL),
+		AttemptTimeout: 50 * time.Millisecond,
+	})
+	svc.Handlers.Clear()
+	svc.Handlers.Send.PushBackNamed(corehandlers.SendHandler)
+
+	r := svc.NewRequest(&request.Operation{Name: "Operation"}, nil, nil)
+	if err := r.Send(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer r.HTTPResponse.Body.Close()
+
+	_, err := ioutil.ReadAll(r.HTTPResponse.Body)
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		t.Fatalf("expect awserr.Error, got %T, %v", err, err)
+	}
+	if e, a := request.ErrCodeAttemptTimeout, aerr.Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+}
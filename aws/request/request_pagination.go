@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/internal/sdklog"
 )
 
 // A Pagination provides paginating of SDK API operations which are paginatable.
@@ -193,7 +194,7 @@ func logDeprecatedf(logger aws.Logger, flag *int32, msg string) {
 		return
 	}
 	if atomic.CompareAndSwapInt32(flag, 0, 1) {
-		logger.Log(msg)
+		sdklog.Log(logger, aws.LogSeverityWarn, msg, msg)
 	}
 }
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/internal/sdklog"
 )
 
 // Retryer provides the interface drive the SDK's request retry behavior. The
@@ -38,9 +39,9 @@ type Retryer interface {
 // value for chaining. The value must not be nil.
 func WithRetryer(cfg *aws.Config, retryer Retryer) *aws.Config {
 	if retryer == nil {
-		if cfg.Logger != nil {
-			cfg.Logger.Log("ERROR: Request.WithRetryer called with nil retryer. Replacing with retry disabled Retryer.")
-		}
+		sdklog.Log(cfg.Logger, aws.LogSeverityError,
+			"WithRetryer called with nil retryer, replacing with retry disabled retryer",
+			"ERROR: Request.WithRetryer called with nil retryer. Replacing with retry disabled Retryer.")
 		retryer = noOpRetryer{}
 	}
 	cfg.Retryer = retryer
@@ -73,12 +74,9 @@ func (d noOpRetryer) RetryRules(_ *Request) time.Duration {
 }
 
 // retryableCodes is a collection of service response codes which are retry-able
-// without any further action.
+// without any further action. Timeout error codes are also retry-able.
 var retryableCodes = map[string]struct{}{
-	ErrCodeRequestError:       {},
-	"RequestTimeout":          {},
-	ErrCodeResponseTimeout:    {},
-	"RequestTimeoutException": {}, // Glacier's flavor of RequestTimeout
+	ErrCodeRequestError: {},
 }
 
 var throttleCodes = map[string]struct{}{
@@ -105,21 +103,39 @@ var credsExpiredCodes = map[string]struct{}{
 }
 
 func isCodeThrottle(code string) bool {
-	_, ok := throttleCodes[code]
-	return ok
+	if _, ok := throttleCodes[code]; ok {
+		return true
+	}
+
+	c, ok := registeredErrorCategory("", code)
+	return ok && c == ErrorCategoryThrottle
 }
 
 func isCodeRetryable(code string) bool {
 	if _, ok := retryableCodes[code]; ok {
 		return true
 	}
+	if _, ok := timeoutCodes[code]; ok {
+		return true
+	}
+
+	if c, ok := registeredErrorCategory("", code); ok {
+		switch c {
+		case ErrorCategoryTransient, ErrorCategoryTimeout:
+			return true
+		}
+	}
 
 	return isCodeExpiredCreds(code)
 }
 
 func isCodeExpiredCreds(code string) bool {
-	_, ok := credsExpiredCodes[code]
-	return ok
+	if _, ok := credsExpiredCodes[code]; ok {
+		return true
+	}
+
+	c, ok := registeredErrorCategory("", code)
+	return ok && c == ErrorCategoryExpiredCreds
 }
 
 var validParentCodes = map[string]struct{}{
@@ -247,10 +263,17 @@ func IsErrorExpiredCreds(err error) bool {
 // Returns false if the request has no Error set.
 //
 // Alias for the utility function IsErrorRetryable
+//
+// Also considers the error codes registered with RegisterErrorCodes for the
+// request's service.
 func (r *Request) IsErrorRetryable() bool {
 	if isErrCode(r.Error, r.RetryErrorCodes) {
 		return true
 	}
+	if isRegisteredErrorCategory(r.Error, r.ClientInfo.ServiceID,
+		ErrorCategoryTransient, ErrorCategoryTimeout, ErrorCategoryExpiredCreds) {
+		return true
+	}
 
 	// HTTP response status code 501 should not be retried.
 	// 501 represents Not Implemented which means the request method is not
@@ -269,10 +292,16 @@ func (r *Request) IsErrorRetryable() bool {
 // code. Returns false if the request has no Error set.
 //
 // Alias for the utility function IsErrorThrottle
+//
+// Also considers the error codes registered with RegisterErrorCodes for the
+// request's service.
 func (r *Request) IsErrorThrottle() bool {
 	if isErrCode(r.Error, r.ThrottleErrorCodes) {
 		return true
 	}
+	if isRegisteredErrorCategory(r.Error, r.ClientInfo.ServiceID, ErrorCategoryThrottle) {
+		return true
+	}
 
 	if r.HTTPResponse != nil {
 		switch r.HTTPResponse.StatusCode {
@@ -304,6 +333,12 @@ func isErrCode(err error, codes []string) bool {
 // Returns false if the request has no Error set.
 //
 // Alias for the utility function IsErrorExpiredCreds
+//
+// Also considers the error codes registered with RegisterErrorCodes for the
+// request's service.
 func (r *Request) IsErrorExpired() bool {
+	if isRegisteredErrorCategory(r.Error, r.ClientInfo.ServiceID, ErrorCategoryExpiredCreds) {
+		return true
+	}
 	return IsErrorExpiredCreds(r.Error)
 }
@@ -4,6 +4,7 @@ import (
 	"io"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 )
 
@@ -57,11 +58,15 @@ const (
 )
 
 // adaptToResponseTimeoutError is a handler that will replace any top level error
-// to a ErrCodeResponseTimeout, if its child is that.
+// to a ErrCodeResponseTimeout, or ErrCodeAttemptTimeout, if its child is that.
 func adaptToResponseTimeoutError(req *Request) {
 	if err, ok := req.Error.(awserr.Error); ok {
 		aerr, ok := err.OrigErr().(awserr.Error)
-		if ok && aerr.Code() == ErrCodeResponseTimeout {
+		if !ok {
+			return
+		}
+		switch aerr.Code() {
+		case ErrCodeResponseTimeout, ErrCodeAttemptTimeout:
 			req.Error = aerr
 		}
 	}
@@ -92,3 +97,54 @@ func WithResponseReadTimeout(duration time.Duration) Option {
 		r.Handlers.UnmarshalError.PushBack(adaptToResponseTimeoutError)
 	}
 }
+
+// attemptTimeoutReadCloser wraps the response body of a request attempt made
+// with an attempt timeout. Reads that fail because the attempt's context
+// timed out will return a ErrCodeAttemptTimeout error. Closing the body
+// releases the attempt's context.
+type attemptTimeoutReadCloser struct {
+	reader io.ReadCloser
+	ctx    aws.Context
+	parent aws.Context
+	cancel func()
+}
+
+func (r *attemptTimeoutReadCloser) Read(b []byte) (int, error) {
+	n, err := r.reader.Read(b)
+	if err != nil && err != io.EOF && isAttemptTimedOut(r.ctx, r.parent) {
+		err = awserr.New(ErrCodeAttemptTimeout,
+			"read on body has reached the attempt timeout", err)
+	}
+	return n, err
+}
+
+func (r *attemptTimeoutReadCloser) Close() error {
+	err := r.reader.Close()
+	r.cancel()
+	return err
+}
+
+// isAttemptTimedOut returns if the attempt's context was canceled, but the
+// request's parent context was not.
+func isAttemptTimedOut(ctx, parent aws.Context) bool {
+	return ctx.Err() != nil && parent.Err() == nil
+}
+
+// WithAttemptTimeout is a request option that will limit the amount of time
+// each request attempt may take, including the read of the response body.
+// When an attempt times out only that attempt is canceled, and the request
+// will be retried with a ErrCodeAttemptTimeout error if retries remain. The
+// request's context still applies to the request as a whole, including all
+// retries and retry delays.
+//
+// The response body of a successful attempt, such as the Body of S3's
+// GetObject output, must be read before the attempt timeout elapses.
+//
+// Attempt timeouts are only supported with Go 1.7 and above.
+//
+//     svc.GetObjectWithContext(ctx, params, request.WithAttemptTimeout(2 * time.Second))
+func WithAttemptTimeout(duration time.Duration) Option {
+	return func(r *Request) {
+		r.Config.AttemptTimeout = duration
+	}
+}
@@ -0,0 +1,204 @@
+package request
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// Names of the spans the SDK will start for each phase of a request.
+const (
+	// TraceSpanAttempt is the name of the span started for each attempt
+	// of a request, including retries. Attempt spans are children of the
+	// operation span, which is named after the API operation.
+	TraceSpanAttempt = "Attempt"
+
+	// TraceSpanBuild is the name of the span started while the request is
+	// validated and built.
+	TraceSpanBuild = "Build"
+
+	// TraceSpanSign is the name of the span started while the request is
+	// signed.
+	TraceSpanSign = "Sign"
+
+	// TraceSpanSend is the name of the span started while the HTTP request
+	// is sent.
+	TraceSpanSend = "Send"
+
+	// TraceSpanUnmarshal is the name of the span started while the HTTP
+	// response is validated and unmarshaled.
+	TraceSpanUnmarshal = "Unmarshal"
+
+	// TraceSpanRetry is the name of the span started while the SDK
+	// determines if a failed attempt should be retried, including the retry
+	// delay.
+	TraceSpanRetry = "Retry"
+)
+
+// Keys of the attributes the SDK will set on the spans of a request.
+const (
+	// TraceAttrServiceID is the service ID of the API client.
+	TraceAttrServiceID = "aws.service_id"
+
+	// TraceAttrOperation is the name of the API operation.
+	TraceAttrOperation = "aws.operation"
+
+	// TraceAttrRegion is the region the request is sent to.
+	TraceAttrRegion = "aws.region"
+
+	// TraceAttrRequestID is the request ID returned by the service.
+	TraceAttrRequestID = "aws.request_id"
+
+	// TraceAttrRetryCount is the number of times the request was retried.
+	TraceAttrRetryCount = "aws.retry_count"
+
+	// TraceAttrErrorCode is the error code of the error the request, or
+	// request attempt, failed with.
+	TraceAttrErrorCode = "aws.error_code"
+)
+
+// Tracer provides the interface for instrumenting the SDK's requests with
+// spans. A span is started for the API operation, with a child span for each
+// attempt of the request. Each attempt has child spans for the phases of the
+// request, Build, Sign, Send, Unmarshal, and Retry.
+//
+// The Tracer is independent of the request's handler lists. Adding, removing,
+// or reordering handlers will not change the spans that are started.
+//
+// Use the WithTracer helper to set the Tracer of a aws.Config.
+type Tracer interface {
+	// StartSpan starts a new span with the name provided. The span must be a
+	// child of the span of the parent context, if the parent context has
+	// one. Returns a context for the span that child spans will be started
+	// with.
+	//
+	// The returned context must be derived from the parent context. The
+	// context of the attempt span will be used for the attempt's HTTP
+	// request.
+	StartSpan(parent aws.Context, name string) (aws.Context, Span)
+}
+
+// Span provides the interface for a span started by a Tracer.
+type Span interface {
+	// SetAttribute sets an attribute of the span. Keys are one of the
+	// TraceAttr constants. Values are either string or int.
+	SetAttribute(key string, value interface{})
+
+	// End ends the span. The error the span's phase failed with is
+	// provided, or nil if the phase succeeded.
+	End(err error)
+}
+
+// WithTracer sets a Tracer value to the given Config returning the Config
+// value for chaining. All requests made by service clients created with the
+// Config will be instrumented with the Tracer.
+func WithTracer(cfg *aws.Config, tracer Tracer) *aws.Config {
+	cfg.Tracer = tracer
+	return cfg
+}
+
+// requestTrace is the tracing state of a request.
+type requestTrace struct {
+	tracer Tracer
+
+	opCtx  aws.Context
+	opSpan Span
+
+	attemptCtx  aws.Context
+	attemptSpan Span
+}
+
+// startOperationSpan starts the span for the request's API operation if the
+// request has a Tracer, and the span has not already been started.
+func (r *Request) startOperationSpan() {
+	t := r.trace
+	if t == nil || t.opSpan != nil {
+		return
+	}
+
+	t.opCtx, t.opSpan = t.tracer.StartSpan(r.Context(), r.Operation.Name)
+	t.opSpan.SetAttribute(TraceAttrServiceID, r.ClientInfo.ServiceID)
+	t.opSpan.SetAttribute(TraceAttrOperation, r.Operation.Name)
+	t.opSpan.SetAttribute(TraceAttrRegion, traceRegion(r))
+}
+
+// endOperationSpan ends the span of the request's API operation, if started.
+func (r *Request) endOperationSpan() {
+	t := r.trace
+	if t == nil || t.opSpan == nil {
+		return
+	}
+
+	t.opSpan.SetAttribute(TraceAttrRetryCount, r.RetryCount)
+	endSpan(t.opSpan, r.RequestID, r.Error)
+	t.opSpan = nil
+}
+
+// startAttemptSpan starts the span for the current attempt of the request.
+func (r *Request) startAttemptSpan() {
+	t := r.trace
+	if t == nil || t.opSpan == nil {
+		return
+	}
+
+	t.attemptCtx, t.attemptSpan = t.tracer.StartSpan(t.opCtx, TraceSpanAttempt)
+	t.attemptSpan.SetAttribute(TraceAttrRetryCount, r.RetryCount)
+}
+
+// endAttemptSpan ends the span of the current attempt of the request with
+// the error the attempt failed with, if any.
+func (r *Request) endAttemptSpan(err error) {
+	t := r.trace
+	if t == nil || t.attemptSpan == nil {
+		return
+	}
+
+	endSpan(t.attemptSpan, r.RequestID, err)
+	t.attemptCtx, t.attemptSpan = nil, nil
+}
+
+// attemptContext returns the context the request's current attempt should
+// be made with.
+func (r *Request) attemptContext() aws.Context {
+	if t := r.trace; t != nil && t.attemptCtx != nil {
+		return t.attemptCtx
+	}
+	return r.Context()
+}
+
+// tracePhase calls fn within a span for the phase of the request. The span
+// is a child of the current attempt's span, or the operation's span if there
+// is no attempt in progress. If the request is not being traced fn is called
+// directly.
+func (r *Request) tracePhase(name string, fn func()) {
+	t := r.trace
+	if t == nil || t.opSpan == nil {
+		fn()
+		return
+	}
+
+	parent := t.opCtx
+	if t.attemptCtx != nil {
+		parent = t.attemptCtx
+	}
+
+	_, span := t.tracer.StartSpan(parent, name)
+	fn()
+	span.End(r.Error)
+}
+
+func endSpan(span Span, requestID string, err error) {
+	if len(requestID) != 0 {
+		span.SetAttribute(TraceAttrRequestID, requestID)
+	}
+	if aerr, ok := err.(awserr.Error); ok {
+		span.SetAttribute(TraceAttrErrorCode, aerr.Code())
+	}
+	span.End(err)
+}
+
+func traceRegion(r *Request) string {
+	if v := aws.StringValue(r.Config.Region); len(v) != 0 {
+		return v
+	}
+	return r.ClientInfo.SigningRegion
+}
@@ -0,0 +1,127 @@
+package request_test
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/corehandlers"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
+)
+
+type spanKey struct{}
+
+type testSpan struct {
+	name   string
+	parent *testSpan
+	attrs  map[string]interface{}
+	ended  bool
+	err    error
+}
+
+func (s *testSpan) SetAttribute(key string, value interface{}) {
+	s.attrs[key] = value
+}
+
+func (s *testSpan) End(err error) {
+	s.ended = true
+	s.err = err
+}
+
+func (s *testSpan) path() string {
+	if s.parent == nil {
+		return s.name
+	}
+	return s.parent.path() + "/" + s.name
+}
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (t *testTracer) StartSpan(parent aws.Context, name string) (aws.Context, request.Span) {
+	span := &testSpan{name: name, attrs: map[string]interface{}{}}
+	span.parent, _ = parent.Value(spanKey{}).(*testSpan)
+	t.spans = append(t.spans, span)
+	return context.WithValue(parent, spanKey{}, span), span
+}
+
+func TestRequest_Tracer(t *testing.T) {
+	tracer := &testTracer{}
+	svc := awstesting.NewClient(request.WithTracer(&aws.Config{
+		Region:     aws.String("mock-region"),
+		MaxRetries: aws.Int(1),
+		SleepDelay: func(time.Duration) {},
+	}, tracer))
+	svc.ServiceID = "Mock"
+	svc.Handlers.Clear()
+	svc.Handlers.Send.PushBack(func(r *request.Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 200, Header: http.Header{}}
+		if r.RetryCount == 0 {
+			r.HTTPResponse.StatusCode = 500
+			r.Error = awserr.New("InternalFailure", "failed", nil)
+		}
+	})
+	svc.Handlers.UnmarshalMeta.PushBack(func(r *request.Request) {
+		r.RequestID = "request-id"
+	})
+	svc.Handlers.AfterRetry.PushBackNamed(corehandlers.AfterRetryHandler)
+
+	r := svc.NewRequest(&request.Operation{Name: "Operation"}, nil, nil)
+	if err := r.Send(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	var paths []string
+	for _, s := range tracer.spans {
+		if !s.ended {
+			t.Errorf("expect %v span to be ended", s.path())
+		}
+		paths = append(paths, s.path())
+	}
+	expect := []string{
+		"Operation",
+		"Operation/Attempt",
+		"Operation/Attempt/Build",
+		"Operation/Attempt/Sign",
+		"Operation/Attempt/Send",
+		"Operation/Attempt/Retry",
+		"Operation/Attempt",
+		"Operation/Attempt/Sign",
+		"Operation/Attempt/Send",
+		"Operation/Attempt/Unmarshal",
+	}
+	if !reflect.DeepEqual(expect, paths) {
+		t.Fatalf("expect spans %v, got %v", strings.Join(expect, ", "), strings.Join(paths, ", "))
+	}
+
+	op := tracer.spans[0]
+	for k, v := range map[string]interface{}{
+		request.TraceAttrServiceID:  "Mock",
+		request.TraceAttrOperation:  "Operation",
+		request.TraceAttrRegion:     "mock-region",
+		request.TraceAttrRequestID:  "request-id",
+		request.TraceAttrRetryCount: 1,
+	} {
+		if e, a := v, op.attrs[k]; e != a {
+			t.Errorf("expect %v operation attribute %v, got %v", k, e, a)
+		}
+	}
+
+	failedAttempt := tracer.spans[1]
+	if failedAttempt.err == nil {
+		t.Errorf("expect failed attempt span to have error")
+	}
+	if e, a := "InternalFailure", failedAttempt.attrs[request.TraceAttrErrorCode]; e != a {
+		t.Errorf("expect attempt error code %v, got %v", e, a)
+	}
+	if e, a := 1, tracer.spans[6].attrs[request.TraceAttrRetryCount]; e != a {
+		t.Errorf("expect retry attempt retry count %v, got %v", e, a)
+	}
+}
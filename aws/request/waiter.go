@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/internal/sdklog"
 )
 
 // WaiterResourceNotReadyErrorCode is the error code returned by a waiter when
@@ -171,7 +172,10 @@ func (w Waiter) WaitWithContext(ctx aws.Context) error {
 	for attempt := 1; ; attempt++ {
 		req, err := w.NewRequest(w.RequestOptions)
 		if err != nil {
-			waiterLogf(w.Logger, "unable to create request %v", err)
+			sdklog.Log(w.Logger, aws.LogSeverityError, "unable to create waiter request",
+				fmt.Sprintf("unable to create request %v", err),
+				sdklog.Field(sdklog.FieldWaiter, w.Name),
+				sdklog.Field(sdklog.FieldError, err))
 			return err
 		}
 		req.Handlers.Build.PushBack(MakeAddToUserAgentFreeFormHandler("Waiter"))
@@ -269,8 +273,10 @@ func (a *WaiterAcceptor) match(name string, l aws.Logger, req *Request, err erro
 			}
 		}
 	default:
-		waiterLogf(l, "WARNING: Waiter %s encountered unexpected matcher: %s",
-			name, a.Matcher)
+		sdklog.Log(l, aws.LogSeverityWarn, "waiter encountered unexpected matcher",
+			fmt.Sprintf("WARNING: Waiter %s encountered unexpected matcher: %s", name, a.Matcher),
+			sdklog.Field(sdklog.FieldWaiter, name),
+			sdklog.Field("matcher", a.Matcher.String()))
 	}
 
 	if !result {
@@ -291,14 +297,10 @@ func (a *WaiterAcceptor) match(name string, l aws.Logger, req *Request, err erro
 		// clear the error and retry the operation
 		return false, nil
 	default:
-		waiterLogf(l, "WARNING: Waiter %s encountered unexpected state: %s",
-			name, a.State)
+		sdklog.Log(l, aws.LogSeverityWarn, "waiter encountered unexpected state",
+			fmt.Sprintf("WARNING: Waiter %s encountered unexpected state: %s", name, a.State),
+			sdklog.Field(sdklog.FieldWaiter, name),
+			sdklog.Field(sdklog.FieldState, a.State.String()))
 		return false, nil
 	}
 }
-
-func waiterLogf(logger aws.Logger, msg string, args ...interface{}) {
-	if logger != nil {
-		logger.Log(fmt.Sprintf(msg, args...))
-	}
-}
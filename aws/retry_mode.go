@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RetryMode is an enum for the retry behavior the SDK's default retryer
+// will use when retrying failed API requests.
+type RetryMode int
+
+func (m RetryMode) String() string {
+	switch m {
+	case RetryModeLegacy:
+		return "legacy"
+	case RetryModeStandard:
+		return "standard"
+	case RetryModeAdaptive:
+		return "adaptive"
+	case RetryModeUnset:
+		return ""
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	// RetryModeUnset represents that the retry mode is not specified, and
+	// the SDK will use its legacy retry behavior.
+	RetryModeUnset RetryMode = iota
+
+	// RetryModeLegacy retries requests with exponential backoff and jitter
+	// without limiting the number of retries made by the client.
+	RetryModeLegacy
+
+	// RetryModeStandard adds a retry token bucket, shared by all requests
+	// made by a service client, which limits the number of retries the
+	// client will make when a large number of requests are failing.
+	RetryModeStandard
+
+	// RetryModeAdaptive extends RetryModeStandard with a client side send
+	// rate limiter. The send rate is reduced when the service responds with
+	// throttling errors, and slowly recovers as requests succeed.
+	RetryModeAdaptive
+)
+
+// ParseRetryMode returns the RetryMode based on the input string provided in
+// env config or shared config by the user.
+//
+// `legacy`, `standard`, and `adaptive` are the only case-insensitive valid
+// strings for the retry mode.
+func ParseRetryMode(s string) (RetryMode, error) {
+	switch {
+	case strings.EqualFold(s, "legacy"):
+		return RetryModeLegacy, nil
+	case strings.EqualFold(s, "standard"):
+		return RetryModeStandard, nil
+	case strings.EqualFold(s, "adaptive"):
+		return RetryModeAdaptive, nil
+	default:
+		return RetryModeUnset, fmt.Errorf("unable to resolve the value of RetryMode for %v", s)
+	}
+}
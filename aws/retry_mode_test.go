@@ -0,0 +1,35 @@
+package aws
+
+import "testing"
+
+func TestParseRetryMode(t *testing.T) {
+	cases := map[string]struct {
+		Value     string
+		Expect    RetryMode
+		ExpectErr bool
+	}{
+		"legacy":      {Value: "legacy", Expect: RetryModeLegacy},
+		"standard":    {Value: "standard", Expect: RetryModeStandard},
+		"adaptive":    {Value: "Adaptive", Expect: RetryModeAdaptive},
+		"unknown":     {Value: "other", ExpectErr: true},
+		"empty value": {Value: "", ExpectErr: true},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			mode, err := ParseRetryMode(c.Value)
+			if c.ExpectErr {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.Expect, mode; e != a {
+				t.Errorf("expect %v mode, got %v", e, a)
+			}
+		})
+	}
+}
@@ -2,10 +2,13 @@ package session
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/auth/bearer"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/processcreds"
@@ -13,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/defaults"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/internal/sdklog"
 	"github.com/aws/aws-sdk-go/internal/shareddefaults"
 	"github.com/aws/aws-sdk-go/service/ssooidc"
 	"github.com/aws/aws-sdk-go/service/sts"
@@ -28,11 +32,30 @@ type CredentialsProviderOptions struct {
 	// ProcessProviderOptions configures a ProcessProvider,
 	// such as setting its Timeout.
 	ProcessProviderOptions func(*processcreds.ProcessProvider)
+
+	// FileCache enables caching the credentials of profiles that assume a
+	// role, or use a credential_process, in files on disk. The cached
+	// credentials are reused by other processes until they expire. Assumed
+	// role credentials are shared with the AWS CLI when the cache's
+	// directory is the AWS CLI's cache directory, which is the default.
+	//
+	//	CredentialsProviderOptions: &session.CredentialsProviderOptions{
+	//		FileCache: credentials.NewFileCache(""),
+	//	}
+	FileCache *credentials.FileCache
+}
+
+func (o *CredentialsProviderOptions) fileCache() *credentials.FileCache {
+	if o == nil {
+		return nil
+	}
+	return o.FileCache
 }
 
 func resolveCredentials(cfg *aws.Config,
 	envCfg envConfig, sharedCfg sharedConfig,
 	handlers request.Handlers,
+	endpointURLs *endpointURLConfig,
 	sessOpts Options,
 ) (*credentials.Credentials, error) {
 
@@ -41,7 +64,7 @@ func resolveCredentials(cfg *aws.Config,
 		// User explicitly provided a Profile in the session's configuration
 		// so load that profile from shared config first.
 		// Github(aws/aws-sdk-go#2727)
-		return resolveCredsFromProfile(cfg, envCfg, sharedCfg, handlers, sessOpts)
+		return resolveCredsFromProfile(cfg, envCfg, sharedCfg, handlers, endpointURLs, sessOpts)
 
 	case envCfg.Creds.HasKeys():
 		// Environment credentials
@@ -50,7 +73,7 @@ func resolveCredentials(cfg *aws.Config,
 	case len(envCfg.WebIdentityTokenFilePath) != 0:
 		// Web identity token from environment, RoleARN required to also be
 		// set.
-		return assumeWebIdentity(cfg, handlers,
+		return assumeWebIdentity(cfg, handlers, endpointURLs,
 			envCfg.WebIdentityTokenFilePath,
 			envCfg.RoleARN,
 			envCfg.RoleSessionName,
@@ -59,7 +82,7 @@ func resolveCredentials(cfg *aws.Config,
 
 	default:
 		// Fallback to the "default" credential resolution chain.
-		return resolveCredsFromProfile(cfg, envCfg, sharedCfg, handlers, sessOpts)
+		return resolveCredsFromProfile(cfg, envCfg, sharedCfg, handlers, endpointURLs, sessOpts)
 	}
 }
 
@@ -72,6 +95,7 @@ var WebIdentityEmptyRoleARNErr = awserr.New(stscreds.ErrCodeWebIdentity, "role A
 var WebIdentityEmptyTokenFilePathErr = awserr.New(stscreds.ErrCodeWebIdentity, "token file path is not set", nil)
 
 func assumeWebIdentity(cfg *aws.Config, handlers request.Handlers,
+	endpointURLs *endpointURLConfig,
 	filepath string,
 	roleARN, sessionName string,
 	credOptions *CredentialsProviderOptions,
@@ -86,11 +110,16 @@ func assumeWebIdentity(cfg *aws.Config, handlers request.Handlers,
 	}
 
 	svc := sts.New(&Session{
-		Config:   cfg,
-		Handlers: handlers.Copy(),
+		Config:       cfg,
+		Handlers:     handlers.Copy(),
+		endpointURLs: endpointURLs,
 	})
 
-	var optFns []func(*stscreds.WebIdentityRoleProvider)
+	optFns := []func(*stscreds.WebIdentityRoleProvider){
+		func(p *stscreds.WebIdentityRoleProvider) {
+			p.Logger = sdklog.CredentialsLogger(cfg)
+		},
+	}
 	if credOptions != nil && credOptions.WebIdentityRoleProviderOptions != nil {
 		optFns = append(optFns, credOptions.WebIdentityRoleProviderOptions)
 	}
@@ -102,6 +131,7 @@ func assumeWebIdentity(cfg *aws.Config, handlers request.Handlers,
 func resolveCredsFromProfile(cfg *aws.Config,
 	envCfg envConfig, sharedCfg sharedConfig,
 	handlers request.Handlers,
+	endpointURLs *endpointURLConfig,
 	sessOpts Options,
 ) (creds *credentials.Credentials, err error) {
 
@@ -109,7 +139,7 @@ func resolveCredsFromProfile(cfg *aws.Config,
 	case sharedCfg.SourceProfile != nil:
 		// Assume IAM role with credentials source from a different profile.
 		creds, err = resolveCredsFromProfile(cfg, envCfg,
-			*sharedCfg.SourceProfile, handlers, sessOpts,
+			*sharedCfg.SourceProfile, handlers, endpointURLs, sessOpts,
 		)
 
 	case sharedCfg.Creds.HasKeys():
@@ -127,7 +157,7 @@ func resolveCredsFromProfile(cfg *aws.Config,
 		// Credentials from Assume Web Identity token require an IAM Role, and
 		// that roll will be assumed. May be wrapped with another assume role
 		// via SourceProfile.
-		return assumeWebIdentity(cfg, handlers,
+		return assumeWebIdentity(cfg, handlers, endpointURLs,
 			sharedCfg.WebIdentityTokenFile,
 			sharedCfg.RoleARN,
 			sharedCfg.RoleSessionName,
@@ -135,11 +165,20 @@ func resolveCredsFromProfile(cfg *aws.Config,
 		)
 
 	case sharedCfg.hasSSOConfiguration():
-		creds, err = resolveSSOCredentials(cfg, sharedCfg, handlers)
+		creds, err = resolveSSOCredentials(cfg, sharedCfg, handlers, endpointURLs)
 
 	case len(sharedCfg.CredentialProcess) != 0:
 		// Get credentials from CredentialProcess
-		var optFns []func(*processcreds.ProcessProvider)
+		optFns := []func(*processcreds.ProcessProvider){
+			func(p *processcreds.ProcessProvider) {
+				p.Logger = sdklog.CredentialsLogger(cfg)
+			},
+		}
+		if cache := sessOpts.CredentialsProviderOptions.fileCache(); cache != nil {
+			optFns = append(optFns, func(p *processcreds.ProcessProvider) {
+				p.Cache = cache
+			})
+		}
 		if sessOpts.CredentialsProviderOptions != nil && sessOpts.CredentialsProviderOptions.ProcessProviderOptions != nil {
 			optFns = append(optFns, sessOpts.CredentialsProviderOptions.ProcessProviderOptions)
 		}
@@ -171,13 +210,13 @@ func resolveCredsFromProfile(cfg *aws.Config,
 	if len(sharedCfg.RoleARN) > 0 {
 		cfgCp := *cfg
 		cfgCp.Credentials = creds
-		return credsFromAssumeRole(cfgCp, handlers, sharedCfg, sessOpts)
+		return credsFromAssumeRole(cfgCp, handlers, endpointURLs, sharedCfg, sessOpts)
 	}
 
 	return creds, nil
 }
 
-func resolveSSOCredentials(cfg *aws.Config, sharedCfg sharedConfig, handlers request.Handlers) (*credentials.Credentials, error) {
+func resolveSSOCredentials(cfg *aws.Config, sharedCfg sharedConfig, handlers request.Handlers, endpointURLs *endpointURLConfig) (*credentials.Credentials, error) {
 	if err := sharedCfg.validateSSOConfiguration(); err != nil {
 		return nil, err
 	}
@@ -187,16 +226,10 @@ func resolveSSOCredentials(cfg *aws.Config, sharedCfg sharedConfig, handlers req
 
 	if sharedCfg.SSOSession != nil {
 		cfgCopy.Region = &sharedCfg.SSOSession.SSORegion
-		cachedPath, err := ssocreds.StandardCachedTokenFilepath(sharedCfg.SSOSession.Name)
+		tokenProvider, cachedPath, err := resolveSSOTokenProvider(cfg, sharedCfg)
 		if err != nil {
 			return nil, err
 		}
-		// create oidcClient with AnonymousCredentials to avoid recursively resolving credentials
-		mySession := Must(NewSession(&aws.Config{
-			Credentials: credentials.AnonymousCredentials,
-		}))
-		oidcClient := ssooidc.New(mySession, cfgCopy)
-		tokenProvider := ssocreds.NewSSOTokenProvider(oidcClient, cachedPath)
 		optFns = append(optFns, func(p *ssocreds.Provider) {
 			p.TokenProvider = tokenProvider
 			p.CachedTokenFilepath = cachedPath
@@ -207,8 +240,9 @@ func resolveSSOCredentials(cfg *aws.Config, sharedCfg sharedConfig, handlers req
 
 	return ssocreds.NewCredentials(
 		&Session{
-			Config:   cfgCopy,
-			Handlers: handlers.Copy(),
+			Config:       cfgCopy,
+			Handlers:     handlers.Copy(),
+			endpointURLs: endpointURLs,
 		},
 		sharedCfg.SSOAccountID,
 		sharedCfg.SSORoleName,
@@ -217,6 +251,49 @@ func resolveSSOCredentials(cfg *aws.Config, sharedCfg sharedConfig, handlers req
 	), nil
 }
 
+// resolveSSOTokenProvider returns the provider of the SSO token cached for
+// the shared config's sso-session, and the path of the cached token file.
+func resolveSSOTokenProvider(cfg *aws.Config, sharedCfg sharedConfig) (*ssocreds.SSOTokenProvider, string, error) {
+	cachedPath, err := ssocreds.StandardCachedTokenFilepath(sharedCfg.SSOSession.Name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	cfgCopy := cfg.Copy()
+	cfgCopy.Region = &sharedCfg.SSOSession.SSORegion
+
+	// create oidcClient with AnonymousCredentials, and a static token provider,
+	// to avoid recursively resolving credentials and token providers
+	mySession := Must(NewSession(&aws.Config{
+		Credentials:   credentials.AnonymousCredentials,
+		TokenProvider: bearer.StaticTokenProvider{},
+	}))
+	oidcClient := ssooidc.New(mySession, cfgCopy)
+
+	return ssocreds.NewSSOTokenProvider(oidcClient, cachedPath), cachedPath, nil
+}
+
+// resolveTokenProvider returns the bearer token provider for the session.
+// The provider set in the session Options is used if set, otherwise the SSO
+// token of the shared config's sso-session is used if one is configured.
+// Returns nil if no token provider is available.
+func resolveTokenProvider(cfg *aws.Config, sharedCfg sharedConfig, sessOpts Options) (bearer.TokenProvider, error) {
+	if sessOpts.TokenProvider != nil {
+		return sessOpts.TokenProvider, nil
+	}
+
+	if sharedCfg.SSOSession == nil {
+		return nil, nil
+	}
+
+	provider, _, err := resolveSSOTokenProvider(cfg, sharedCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return bearer.NewTokenCache(provider), nil
+}
+
 // valid credential source values
 const (
 	credSourceEc2Metadata  = "Ec2InstanceMetadata"
@@ -255,20 +332,31 @@ func resolveCredsFromSource(cfg *aws.Config,
 
 func credsFromAssumeRole(cfg aws.Config,
 	handlers request.Handlers,
+	endpointURLs *endpointURLConfig,
 	sharedCfg sharedConfig,
 	sessOpts Options,
 ) (*credentials.Credentials, error) {
 
-	if len(sharedCfg.MFASerial) != 0 && sessOpts.AssumeRoleTokenProvider == nil {
-		// AssumeRole Token provider is required if doing Assume Role
-		// with MFA.
-		return nil, AssumeRoleTokenProviderNotSetError{}
+	tokenProvider := sessOpts.AssumeRoleTokenProvider
+	if len(sharedCfg.MFASerial) != 0 && tokenProvider == nil {
+		if len(sharedCfg.MFATOTPSecretSource) == 0 {
+			// AssumeRole Token provider is required if doing Assume Role
+			// with MFA.
+			return nil, AssumeRoleTokenProviderNotSetError{}
+		}
+
+		totp, err := totpTokenProviderFromSharedConfig(sharedCfg)
+		if err != nil {
+			return nil, err
+		}
+		tokenProvider = totp.Token
 	}
 
 	return stscreds.NewCredentials(
 		&Session{
-			Config:   &cfg,
-			Handlers: handlers.Copy(),
+			Config:       &cfg,
+			Handlers:     handlers.Copy(),
+			endpointURLs: endpointURLs,
 		},
 		sharedCfg.RoleARN,
 		func(opt *stscreds.AssumeRoleProvider) {
@@ -290,12 +378,59 @@ func credsFromAssumeRole(cfg aws.Config,
 			// Assume role with MFA
 			if len(sharedCfg.MFASerial) > 0 {
 				opt.SerialNumber = aws.String(sharedCfg.MFASerial)
-				opt.TokenProvider = sessOpts.AssumeRoleTokenProvider
+				opt.TokenProvider = tokenProvider
 			}
+
+			opt.Cache = sessOpts.CredentialsProviderOptions.fileCache()
 		},
 	), nil
 }
 
+// totpTokenProviderFromSharedConfig returns the TOTP MFA token provider for
+// the secret source of the shared config.
+func totpTokenProviderFromSharedConfig(sharedCfg sharedConfig) (*stscreds.TOTPTokenProvider, error) {
+	secret, err := loadTOTPSecret(sharedCfg.MFATOTPSecretSource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s of profile %q, %v",
+			mfaTOTPSecretSourceKey, sharedCfg.Profile, err)
+	}
+
+	return stscreds.NewTOTPTokenProvider(secret, func(p *stscreds.TOTPTokenProvider) {
+		if sharedCfg.MFATOTPPeriod != nil {
+			p.Period = *sharedCfg.MFATOTPPeriod
+		}
+		if sharedCfg.MFATOTPDigits != 0 {
+			p.Digits = sharedCfg.MFATOTPDigits
+		}
+	})
+}
+
+// loadTOTPSecret returns the TOTP secret from the secret source, either an
+// environment variable, "env:<name>", or a file, "file:<path>".
+func loadTOTPSecret(source string) (string, error) {
+	var secret string
+	switch {
+	case strings.HasPrefix(source, "env:"):
+		name := strings.TrimPrefix(source, "env:")
+		secret = os.Getenv(name)
+		if len(secret) == 0 {
+			return "", fmt.Errorf("environment variable %s is not set", name)
+		}
+
+	case strings.HasPrefix(source, "file:"):
+		b, err := ioutil.ReadFile(strings.TrimPrefix(source, "file:"))
+		if err != nil {
+			return "", err
+		}
+		secret = string(b)
+
+	default:
+		return "", fmt.Errorf("unsupported secret source %q, expect env:<name> or file:<path>", source)
+	}
+
+	return strings.TrimSpace(secret), nil
+}
+
 // AssumeRoleTokenProviderNotSetError is an error returned when creating a
 // session when the MFAToken option is not set when shared config is configured
 // load assume a role with an MFA token.
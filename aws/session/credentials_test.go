@@ -521,6 +521,94 @@ func TestSessionAssumeRole(t *testing.T) {
 	}
 }
 
+func TestSessionAssumeRole_EndpointURL(t *testing.T) {
+	restoreEnvFn := initSessionTestEnv()
+	defer restoreEnvFn()
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(fmt.Sprintf(
+			assumeRoleRespMsg,
+			time.Now().Add(15*time.Minute).Format("2006-01-02T15:04:05Z"))))
+	}))
+	defer server.Close()
+
+	os.Setenv("AWS_REGION", "us-east-1")
+	os.Setenv("AWS_SDK_LOAD_CONFIG", "1")
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", testConfigFilename)
+	os.Setenv("AWS_PROFILE", "assume_role_w_creds")
+	os.Setenv("AWS_ENDPOINT_URL_STS", server.URL)
+
+	s, err := NewSession()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	creds, err := s.Config.Credentials.Get()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "AKID", creds.AccessKeyID; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := 1, calls; e != a {
+		t.Errorf("expect %v assume role calls to the endpoint URL, got %v", e, a)
+	}
+}
+
+func TestSessionAssumeRole_FileCache(t *testing.T) {
+	restoreEnvFn := initSessionTestEnv()
+	defer restoreEnvFn()
+
+	os.Setenv("AWS_REGION", "us-east-1")
+	os.Setenv("AWS_SDK_LOAD_CONFIG", "1")
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", testConfigFilename)
+	os.Setenv("AWS_PROFILE", "assume_role_w_creds")
+
+	dir, err := ioutil.TempDir("", "aws-sdk-go-session-cache")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(fmt.Sprintf(
+			assumeRoleRespMsg,
+			time.Now().Add(15*time.Minute).Format("2006-01-02T15:04:05Z"))))
+	}))
+	defer server.Close()
+
+	for i := 0; i < 2; i++ {
+		s, err := NewSessionWithOptions(Options{
+			Config: aws.Config{
+				Endpoint:   aws.String(server.URL),
+				DisableSSL: aws.Bool(true),
+			},
+			CredentialsProviderOptions: &CredentialsProviderOptions{
+				FileCache: credentials.NewFileCache(dir),
+			},
+		})
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+
+		creds, err := s.Config.Credentials.Get()
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		if e, a := "SESSION_TOKEN", creds.SessionToken; e != a {
+			t.Errorf("expect %v, got %v", e, a)
+		}
+	}
+
+	if e, a := 1, calls; e != a {
+		t.Errorf("expect %v AssumeRole calls, got %v", e, a)
+	}
+}
+
 func TestSessionAssumeRole_WithMFA(t *testing.T) {
 	restoreEnvFn := initSessionTestEnv()
 	defer restoreEnvFn()
@@ -588,6 +676,107 @@ func TestSessionAssumeRole_WithMFA(t *testing.T) {
 	}
 }
 
+func TestSessionAssumeRole_WithMFA_TOTPSecretSource(t *testing.T) {
+	restoreEnvFn := initSessionTestEnv()
+	defer restoreEnvFn()
+
+	os.Setenv("AWS_REGION", "us-east-1")
+	os.Setenv("AWS_SDK_LOAD_CONFIG", "1")
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", testConfigFilename)
+	os.Setenv("AWS_PROFILE", "assume_role_w_creds")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if e, a := r.FormValue("SerialNumber"), "0123456789"; e != a {
+			t.Errorf("expect %v, got %v", e, a)
+		}
+		code := r.FormValue("TokenCode")
+		if _, err := strconv.Atoi(code); err != nil || len(code) != 8 {
+			t.Errorf("expect 8 digit token code, got %v", code)
+		}
+
+		w.Write([]byte(fmt.Sprintf(
+			assumeRoleRespMsg,
+			time.Now().Add(15*time.Minute).Format("2006-01-02T15:04:05Z"))))
+	}))
+	defer server.Close()
+
+	opts := Options{
+		Profile: "assume_role_w_mfa_totp",
+		Config: aws.Config{
+			Region:     aws.String("us-east-1"),
+			Endpoint:   aws.String(server.URL),
+			DisableSSL: aws.Bool(true),
+		},
+		SharedConfigState: SharedConfigEnable,
+	}
+
+	_, err := NewSessionWithOptions(opts)
+	if e, a := "AWS_SDK_TEST_MFA_SECRET is not set", fmt.Sprint(err); !strings.Contains(a, e) {
+		t.Errorf("expect %v error, got %v", e, a)
+	}
+
+	os.Setenv("AWS_SDK_TEST_MFA_SECRET", "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ")
+	sess, err := NewSessionWithOptions(opts)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	creds, err := sess.Config.Credentials.Get()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "SESSION_TOKEN", creds.SessionToken; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestLoadTOTPSecret(t *testing.T) {
+	restoreEnvFn := initSessionTestEnv()
+	defer restoreEnvFn()
+
+	dir, err := ioutil.TempDir("", "aws-sdk-go-totp")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "secret")
+	if err := ioutil.WriteFile(filename, []byte("FILESECRET\n"), 0600); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	os.Setenv("AWS_SDK_TEST_MFA_SECRET", "ENVSECRET")
+
+	cases := map[string]struct {
+		Source    string
+		Expect    string
+		ExpectErr bool
+	}{
+		"env":             {Source: "env:AWS_SDK_TEST_MFA_SECRET", Expect: "ENVSECRET"},
+		"file":            {Source: "file:" + filename, Expect: "FILESECRET"},
+		"env not set":     {Source: "env:AWS_SDK_TEST_NOT_SET", ExpectErr: true},
+		"file not exists": {Source: "file:" + filepath.Join(dir, "not_exists"), ExpectErr: true},
+		"unsupported":     {Source: "SECRET", ExpectErr: true},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			secret, err := loadTOTPSecret(c.Source)
+			if c.ExpectErr {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.Expect, secret; e != a {
+				t.Errorf("expect %v secret, got %v", e, a)
+			}
+		})
+	}
+}
+
 func TestSessionAssumeRole_WithMFA_NoTokenProvider(t *testing.T) {
 	restoreEnvFn := initSessionTestEnv()
 	defer restoreEnvFn()
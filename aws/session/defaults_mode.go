@@ -0,0 +1,97 @@
+package session
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// ErrCodeDefaultsMode is the error code returned when the defaults mode
+// cannot be applied to the session's configuration.
+const ErrCodeDefaultsMode = "DefaultsModeError"
+
+// mergeDefaultsModeConfig resolves the defaults mode of the session, and
+// applies the defaults mode's configuration values which were not
+// explicitly configured by the user, environment, or shared config.
+func mergeDefaultsModeConfig(cfg, userCfg *aws.Config,
+	envCfg envConfig, sharedCfg sharedConfig,
+	handlers request.Handlers,
+) error {
+	for _, v := range []aws.DefaultsMode{
+		userCfg.DefaultsMode,
+		envCfg.DefaultsMode,
+		sharedCfg.DefaultsMode,
+	} {
+		if v != aws.DefaultsModeUnset {
+			cfg.DefaultsMode = v
+			break
+		}
+	}
+
+	if cfg.DefaultsMode == aws.DefaultsModeAuto {
+		region := aws.StringValue(cfg.Region)
+		var envRegion string
+		if len(region) != 0 {
+			envRegion = executionEnvRegion.get(*cfg, handlers)
+		}
+		cfg.DefaultsMode = defaults.ResolveDefaultsModeAuto(region, envRegion)
+	}
+
+	modeCfg, err := defaults.GetModeConfiguration(cfg.DefaultsMode)
+	if err != nil {
+		return awserr.New(ErrCodeDefaultsMode, "failed to load defaults mode configuration", err)
+	}
+
+	if cfg.RetryMode == aws.RetryModeUnset {
+		cfg.RetryMode = modeCfg.RetryMode
+	}
+
+	if modeCfg.STSRegionalEndpoint != endpoints.UnsetSTSEndpoint &&
+		userCfg.STSRegionalEndpoint == endpoints.UnsetSTSEndpoint &&
+		envCfg.STSRegionalEndpoint == endpoints.UnsetSTSEndpoint &&
+		sharedCfg.STSRegionalEndpoint == endpoints.UnsetSTSEndpoint {
+		cfg.STSRegionalEndpoint = modeCfg.STSRegionalEndpoint
+	}
+
+	if modeCfg.S3UsEast1RegionalEndpoint != endpoints.UnsetS3UsEast1Endpoint &&
+		userCfg.S3UsEast1RegionalEndpoint == endpoints.UnsetS3UsEast1Endpoint &&
+		envCfg.S3UsEast1RegionalEndpoint == endpoints.UnsetS3UsEast1Endpoint &&
+		sharedCfg.S3UsEast1RegionalEndpoint == endpoints.UnsetS3UsEast1Endpoint {
+		cfg.S3UsEast1RegionalEndpoint = modeCfg.S3UsEast1RegionalEndpoint
+	}
+
+	// The HTTP client's timeouts are only configured if the user did not
+	// provide an HTTP client of their own. The http.DefaultClient is not
+	// modified.
+	if userCfg.HTTPClient == nil &&
+		(modeCfg.ConnectTimeout != 0 || modeCfg.TLSNegotiationTimeout != 0) {
+		cfg.HTTPClient = &http.Client{
+			Transport: getDefaultsModeTransport(modeCfg),
+		}
+	}
+
+	return nil
+}
+
+// executionEnvRegion is the region the application runs in, detected once per
+// process by the first session resolving the auto defaults mode. Detecting the
+// region may query the EC2 Instance Metadata Service, which blocks for up to a
+// second if the service is not available.
+var executionEnvRegion = &cachedExecutionEnvRegion{}
+
+type cachedExecutionEnvRegion struct {
+	once   sync.Once
+	region string
+}
+
+func (c *cachedExecutionEnvRegion) get(cfg aws.Config, handlers request.Handlers) string {
+	c.once.Do(func() {
+		c.region = defaults.ResolveExecutionEnvironmentRegion(cfg, handlers)
+	})
+	return c.region
+}
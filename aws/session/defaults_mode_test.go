@@ -0,0 +1,287 @@
+//go:build go1.7
+// +build go1.7
+
+package session
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+func TestSession_DefaultsMode(t *testing.T) {
+	configFile := filepath.Join("testdata", "defaults_mode_config")
+	customClient := &http.Client{}
+
+	cases := map[string]struct {
+		Env    map[string]string
+		Config aws.Config
+
+		ExpectErr            string
+		ExpectMode           aws.DefaultsMode
+		ExpectRetryMode      aws.RetryMode
+		ExpectSTS            endpoints.STSRegionalEndpoint
+		ExpectS3UsEast1      endpoints.S3UsEast1RegionalEndpoint
+		ExpectConnectTimeout time.Duration
+		ExpectHTTPClient     *http.Client
+	}{
+		"unset": {
+			ExpectMode:       aws.DefaultsModeUnset,
+			ExpectRetryMode:  aws.RetryModeUnset,
+			ExpectSTS:        endpoints.LegacySTSEndpoint,
+			ExpectS3UsEast1:  endpoints.LegacyS3UsEast1Endpoint,
+			ExpectHTTPClient: http.DefaultClient,
+		},
+		"legacy": {
+			Env: map[string]string{
+				"AWS_DEFAULTS_MODE": "legacy",
+			},
+			ExpectMode:       aws.DefaultsModeLegacy,
+			ExpectRetryMode:  aws.RetryModeUnset,
+			ExpectSTS:        endpoints.LegacySTSEndpoint,
+			ExpectS3UsEast1:  endpoints.LegacyS3UsEast1Endpoint,
+			ExpectHTTPClient: http.DefaultClient,
+		},
+		"env standard": {
+			Env: map[string]string{
+				"AWS_DEFAULTS_MODE": "standard",
+			},
+			ExpectMode:           aws.DefaultsModeStandard,
+			ExpectRetryMode:      aws.RetryModeStandard,
+			ExpectSTS:            endpoints.RegionalSTSEndpoint,
+			ExpectS3UsEast1:      endpoints.RegionalS3UsEast1Endpoint,
+			ExpectConnectTimeout: 3100 * time.Millisecond,
+		},
+		"shared config in-region": {
+			Env: map[string]string{
+				"AWS_PROFILE": "in_region",
+			},
+			ExpectMode:           aws.DefaultsModeInRegion,
+			ExpectRetryMode:      aws.RetryModeStandard,
+			ExpectSTS:            endpoints.RegionalSTSEndpoint,
+			ExpectS3UsEast1:      endpoints.RegionalS3UsEast1Endpoint,
+			ExpectConnectTimeout: 1100 * time.Millisecond,
+		},
+		"env overrides shared config": {
+			Env: map[string]string{
+				"AWS_PROFILE":       "in_region",
+				"AWS_DEFAULTS_MODE": "cross-region",
+			},
+			ExpectMode:           aws.DefaultsModeCrossRegion,
+			ExpectRetryMode:      aws.RetryModeStandard,
+			ExpectSTS:            endpoints.RegionalSTSEndpoint,
+			ExpectS3UsEast1:      endpoints.RegionalS3UsEast1Endpoint,
+			ExpectConnectTimeout: 3100 * time.Millisecond,
+		},
+		"explicit values take precedence": {
+			Env: map[string]string{
+				"AWS_PROFILE":                        "mobile_legacy_sts",
+				"AWS_S3_US_EAST_1_REGIONAL_ENDPOINT": "legacy",
+			},
+			Config: aws.Config{
+				RetryMode:  aws.RetryModeAdaptive,
+				HTTPClient: customClient,
+			},
+			ExpectMode:       aws.DefaultsModeMobile,
+			ExpectRetryMode:  aws.RetryModeAdaptive,
+			ExpectSTS:        endpoints.LegacySTSEndpoint,
+			ExpectS3UsEast1:  endpoints.LegacyS3UsEast1Endpoint,
+			ExpectHTTPClient: customClient,
+		},
+		"config": {
+			Env: map[string]string{
+				"AWS_DEFAULTS_MODE": "standard",
+			},
+			Config: aws.Config{
+				DefaultsMode: aws.DefaultsModeMobile,
+			},
+			ExpectMode:           aws.DefaultsModeMobile,
+			ExpectRetryMode:      aws.RetryModeStandard,
+			ExpectSTS:            endpoints.RegionalSTSEndpoint,
+			ExpectS3UsEast1:      endpoints.RegionalS3UsEast1Endpoint,
+			ExpectConnectTimeout: 30 * time.Second,
+		},
+		"invalid env": {
+			Env: map[string]string{
+				"AWS_DEFAULTS_MODE": "fast",
+			},
+			ExpectErr: "AWS_DEFAULTS_MODE",
+		},
+		"invalid shared config": {
+			Env: map[string]string{
+				"AWS_PROFILE": "invalid",
+			},
+			ExpectErr: "defaults_mode",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			restoreEnvFn := initSessionTestEnv()
+			defer restoreEnvFn()
+
+			os.Setenv("AWS_SDK_LOAD_CONFIG", "1")
+			os.Setenv("AWS_CONFIG_FILE", configFile)
+			for k, v := range c.Env {
+				os.Setenv(k, v)
+			}
+
+			s, err := NewSession(&c.Config)
+			if len(c.ExpectErr) != 0 {
+				if err == nil {
+					t.Fatalf("expect session error, got none")
+				}
+				if e, a := c.ExpectErr, err.Error(); !strings.Contains(a, e) {
+					t.Fatalf("expect session error to contain %q, got %v", e, a)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.ExpectMode, s.Config.DefaultsMode; e != a {
+				t.Errorf("expect %v defaults mode, got %v", e, a)
+			}
+			if e, a := c.ExpectRetryMode, s.Config.RetryMode; e != a {
+				t.Errorf("expect %v retry mode, got %v", e, a)
+			}
+			if e, a := c.ExpectSTS, s.Config.STSRegionalEndpoint; e != a {
+				t.Errorf("expect %v STSRegionalEndpoint, got %v", e, a)
+			}
+			if e, a := c.ExpectS3UsEast1, s.Config.S3UsEast1RegionalEndpoint; e != a {
+				t.Errorf("expect %v S3UsEast1RegionalEndpoint, got %v", e, a)
+			}
+
+			if c.ExpectHTTPClient != nil {
+				if e, a := c.ExpectHTTPClient, s.Config.HTTPClient; e != a {
+					t.Errorf("expect %p HTTP client, got %p", e, a)
+				}
+				return
+			}
+
+			if s.Config.HTTPClient == http.DefaultClient {
+				t.Fatalf("expect http.DefaultClient to not be used")
+			}
+			tr, ok := s.Config.HTTPClient.Transport.(*http.Transport)
+			if !ok {
+				t.Fatalf("expect *http.Transport, got %T", s.Config.HTTPClient.Transport)
+			}
+			if e, a := c.ExpectConnectTimeout, tr.TLSHandshakeTimeout; e != a {
+				t.Errorf("expect %v TLS handshake timeout, got %v", e, a)
+			}
+			if tr.DialContext == nil {
+				t.Errorf("expect dialer to be set")
+			}
+		})
+	}
+}
+
+func TestSession_DefaultsModeAuto(t *testing.T) {
+	cases := map[string]struct {
+		Region     string
+		IMDSRegion string
+		Expect     aws.DefaultsMode
+	}{
+		"in-region": {
+			Region:     "us-west-2",
+			IMDSRegion: "us-west-2",
+			Expect:     aws.DefaultsModeInRegion,
+		},
+		"cross-region": {
+			Region:     "us-west-2",
+			IMDSRegion: "us-east-1",
+			Expect:     aws.DefaultsModeCrossRegion,
+		},
+		"imds unavailable": {
+			Region: "us-west-2",
+			Expect: aws.DefaultsModeStandard,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			restoreEnvFn := initSessionTestEnv()
+			defer restoreEnvFn()
+			executionEnvRegion = &cachedExecutionEnvRegion{}
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == "PUT" {
+					w.Write([]byte("token"))
+					return
+				}
+				if len(c.IMDSRegion) == 0 {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Write([]byte(`{"region": "` + c.IMDSRegion + `"}`))
+			}))
+			defer server.Close()
+
+			os.Setenv("AWS_DEFAULTS_MODE", "auto")
+			os.Setenv("AWS_EC2_METADATA_SERVICE_ENDPOINT", server.URL)
+
+			s, err := NewSession(&aws.Config{
+				Region:     aws.String(c.Region),
+				MaxRetries: aws.Int(0),
+			})
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.Expect, s.Config.DefaultsMode; e != a {
+				t.Errorf("expect %v defaults mode, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestSession_DefaultsModeAutoCachesRegion(t *testing.T) {
+	restoreEnvFn := initSessionTestEnv()
+	defer restoreEnvFn()
+	executionEnvRegion = &cachedExecutionEnvRegion{}
+
+	var regionRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			w.Write([]byte("token"))
+			return
+		}
+		atomic.AddInt32(&regionRequests, 1)
+		w.Write([]byte(`{"region": "us-west-2"}`))
+	}))
+	defer server.Close()
+
+	os.Setenv("AWS_DEFAULTS_MODE", "auto")
+	os.Setenv("AWS_EC2_METADATA_SERVICE_ENDPOINT", server.URL)
+
+	for i, region := range []string{"us-west-2", "us-east-1"} {
+		s, err := NewSession(&aws.Config{
+			Region:     aws.String(region),
+			MaxRetries: aws.Int(0),
+		})
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+
+		expect := aws.DefaultsModeInRegion
+		if i > 0 {
+			expect = aws.DefaultsModeCrossRegion
+		}
+		if e, a := expect, s.Config.DefaultsMode; e != a {
+			t.Errorf("%d, expect %v defaults mode, got %v", i, e, a)
+		}
+	}
+
+	if e, a := int32(1), atomic.LoadInt32(&regionRequests); e != a {
+		t.Errorf("expect %v IMDS region requests, got %v", e, a)
+	}
+}
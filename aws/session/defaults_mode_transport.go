@@ -0,0 +1,28 @@
+//go:build go1.7
+// +build go1.7
+
+package session
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/defaults"
+)
+
+// getDefaultsModeTransport returns the transport of the SDK's default HTTP
+// client with the connect and TLS negotiation timeouts of the defaults mode.
+func getDefaultsModeTransport(modeCfg defaults.ModeConfiguration) *http.Transport {
+	t := getCustomTransport()
+	if modeCfg.ConnectTimeout != 0 {
+		t.DialContext = (&net.Dialer{
+			Timeout:   modeCfg.ConnectTimeout,
+			KeepAlive: 30 * time.Second,
+		}).DialContext
+	}
+	if modeCfg.TLSNegotiationTimeout != 0 {
+		t.TLSHandshakeTimeout = modeCfg.TLSNegotiationTimeout
+	}
+	return t
+}
@@ -363,5 +363,112 @@ To configure a DualStack endpoint programmatically
   client := s3.New(sess, &aws.Config{
       UseDualStackEndpoint: endpoints.DualStackEndpointStateEnabled,
   })
+
+Retry Mode
+
+The retry mode of the SDK's default retryer can be configured using an environment
+variable, shared config ($HOME/.aws/config), or programmatically. The standard retry
+mode limits the number of retries a client will make with a retry token bucket. The
+adaptive retry mode additionally limits the rate the client sends requests at when
+the service responds with throttling errors.
+
+To configure the retry mode set the environment variable AWS_RETRY_MODE to legacy,
+standard, or adaptive.
+
+  AWS_RETRY_MODE=adaptive
+
+To configure the retry mode using shared config, set retry_mode.
+
+  [profile myprofile]
+  region=us-west-2
+  retry_mode=adaptive
+
+To configure the retry mode programmatically
+
+  client := dynamodb.New(sess, &aws.Config{
+      RetryMode: aws.RetryModeAdaptive,
+  })
+
+Service Specific Endpoints
+
+The endpoint URL of service clients can be configured using environment variables,
+or shared config ($HOME/.aws/config), instead of an endpoints.Resolver. Such as to
+send requests to a local emulation of the service.
+
+To configure the endpoint URL of all service clients set the environment variable
+AWS_ENDPOINT_URL. To configure the endpoint URL of a single service's clients set the
+environment variable AWS_ENDPOINT_URL_<SERVICE_ID>, where the service ID is the
+ServiceID of the service's package in upper case with spaces replaced with underscores.
+
+  AWS_ENDPOINT_URL=http://localhost:4566
+  AWS_ENDPOINT_URL_DYNAMODB=http://localhost:8000
+  AWS_ENDPOINT_URL_SAGEMAKER_RUNTIME=http://localhost:8001
+
+To configure the endpoint URL using shared config, set endpoint_url in the profile, or
+reference a services section with the service specific endpoint URLs. The service keys
+of the services section are the service IDs in lower case, with spaces replaced with
+underscores.
+
+  [profile myprofile]
+  region=us-west-2
+  endpoint_url=http://localhost:4566
+  services=local-services
+
+  [services local-services]
+  dynamodb =
+    endpoint_url = http://localhost:8000
+
+The endpoint URL of a service environment variable takes precedence over
+AWS_ENDPOINT_URL, which takes precedence over the services section's endpoint URL,
+which takes precedence over the profile's endpoint_url. The aws.Config.Endpoint
+takes precedence over all of them. The configured endpoint URLs are not used if an
+aws.Config.EndpointResolver is provided to the session, or service client.
+
+The configured endpoint URLs are also used by the STS and SSO clients that
+retrieve the session's credentials, such as to assume the role of a profile.
+
+To ignore the configured endpoint URLs set the environment variable
+AWS_IGNORE_CONFIGURED_ENDPOINT_URLS, or ignore_configured_endpoint_urls in shared
+config, to true.
+
+Defaults Mode
+
+The defaults mode selects the set of default configuration values the session uses
+for service clients, based on the environment the application runs in. The defaults
+mode configures the HTTP client's connect and TLS negotiation timeouts, the retry
+mode, and the STS and S3 us-east-1 regional endpoint flags. Values configured
+explicitly take precedence over the defaults mode's, and the HTTP client's timeouts
+are only configured if the aws.Config.HTTPClient is not set.
+
+  legacy        The SDK's default configuration values, used if the defaults mode
+                is not set.
+  standard      Standard retry mode and regional endpoints, with 3.1 second timeouts.
+  in-region     The standard defaults mode with 1.1 second timeouts, for
+                applications calling services in the region they run in.
+  cross-region  The standard defaults mode, for applications calling services in
+                another region.
+  mobile        The standard defaults mode with 30 second timeouts, for mobile
+                applications.
+  auto          Resolves to in-region, or cross-region, by comparing the region of
+                the session to the region the application runs in, detected from
+                the AWS execution environment or EC2 Instance Metadata Service.
+                Resolves to standard if the region cannot be detected. The region
+                is detected once per process.
+
+To configure the defaults mode set the environment variable AWS_DEFAULTS_MODE.
+
+  AWS_DEFAULTS_MODE=in-region
+
+To configure the defaults mode using shared config, set defaults_mode.
+
+  [profile myprofile]
+  region=us-west-2
+  defaults_mode=auto
+
+To configure the defaults mode programmatically
+
+  sess, err := session.NewSession(&aws.Config{
+      DefaultsMode: aws.DefaultsModeStandard,
+  })
 */
 package session
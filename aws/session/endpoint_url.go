@@ -0,0 +1,111 @@
+package session
+
+//go:generate go run -tags codegen ../../private/model/cli/gen-endpoint-url-ids/main.go -out ./endpoint_url_ids.go ../../models/apis/*/*/api-2.json
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// endpointURLConfig is the endpoint URL configuration of service clients
+// loaded from the environment and shared config files.
+//
+// The endpoint URL of a service client is resolved in the following order,
+// where the aws.Config.Endpoint takes precedence over all of these. Configured
+// endpoint URLs are not used if an aws.Config.EndpointResolver is provided in
+// code.
+//
+//  1. AWS_ENDPOINT_URL_<SERVICE_ID> environment variable
+//  2. AWS_ENDPOINT_URL environment variable
+//  3. endpoint_url of the service in the profile's services section
+//  4. endpoint_url of the profile
+type endpointURLConfig struct {
+	envServiceURLs    map[string]string
+	envURL            string
+	sharedServiceURLs map[string]string
+	sharedURL         string
+}
+
+// newEndpointURLConfig returns the endpoint URL configuration of the
+// environment and shared config. Returns nil if no endpoint URLs are
+// configured, or configured endpoint URLs are ignored.
+func newEndpointURLConfig(envCfg envConfig, sharedCfg sharedConfig) *endpointURLConfig {
+	ignore := sharedCfg.IgnoreConfiguredEndpointURLs
+	if envCfg.IgnoreConfiguredEndpointURLs != nil {
+		ignore = envCfg.IgnoreConfiguredEndpointURLs
+	}
+	if ignore != nil && *ignore {
+		return nil
+	}
+
+	c := &endpointURLConfig{
+		envServiceURLs:    envCfg.ServiceEndpointURLs,
+		envURL:            envCfg.EndpointURL,
+		sharedServiceURLs: sharedCfg.ServiceEndpointURLs,
+		sharedURL:         sharedCfg.EndpointURL,
+	}
+	if len(c.envServiceURLs) == 0 && len(c.envURL) == 0 &&
+		len(c.sharedServiceURLs) == 0 && len(c.sharedURL) == 0 {
+		return nil
+	}
+
+	return c
+}
+
+// endpointURL returns the configured endpoint URL of the service client with
+// the endpoint ID, or an empty string if none is configured.
+func (c *endpointURLConfig) endpointURL(endpointsID string) string {
+	if c == nil || endpointsID == ec2MetadataServiceID {
+		return ""
+	}
+
+	key := serviceEndpointURLKey(endpointsID)
+	if v := c.envServiceURLs[key]; len(v) != 0 {
+		return v
+	}
+	if len(c.envURL) != 0 {
+		return c.envURL
+	}
+	if v := c.sharedServiceURLs[key]; len(v) != 0 {
+		return v
+	}
+	return c.sharedURL
+}
+
+// hasEndpointResolver returns whether any of the configs provide an endpoint
+// resolver.
+func hasEndpointResolver(cfgs []*aws.Config) bool {
+	for _, cfg := range cfgs {
+		if cfg != nil && cfg.EndpointResolver != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// serviceEndpointURLKey returns the key of the service's endpoint URL
+// configuration. Which is the service ID in upper case, with spaces, dashes,
+// and periods replaced with underscores (e.g. "SageMaker Runtime" becomes
+// SAGEMAKER_RUNTIME).
+//
+// Service clients are identified by their endpoint ID, which is mapped to
+// the service's ID. Services that share an endpoint ID with another service
+// share the endpoint URL configuration of that service. Such as Neptune, and
+// DocDB, using the RDS endpoint URL configuration.
+func serviceEndpointURLKey(endpointsID string) string {
+	if id, ok := endpointsServiceIDs[endpointsID]; ok {
+		endpointsID = id
+	}
+	return normalizeServiceEndpointURLKey(endpointsID)
+}
+
+func normalizeServiceEndpointURLKey(id string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.':
+			return '_'
+		}
+		return r
+	}, strings.ToUpper(strings.TrimSpace(id)))
+}
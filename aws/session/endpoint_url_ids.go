@@ -0,0 +1,141 @@
+// Code generated by private/model/cli/gen-endpoint-url-ids. DO NOT EDIT.
+
+package session
+
+// endpointsServiceIDs maps the endpoint IDs of services to their service IDs,
+// for services whose service ID differs from their endpoint ID.
+var endpointsServiceIDs = map[string]string{
+	"a2i-runtime.sagemaker":             "SageMaker A2I Runtime",
+	"access-analyzer":                   "AccessAnalyzer",
+	"agreement-marketplace":             "Marketplace Agreement",
+	"airflow":                           "MWAA",
+	"aoss":                              "OpenSearchServerless",
+	"api.detective":                     "Detective",
+	"api.ecr":                           "ECR",
+	"api.ecr-public":                    "ECR PUBLIC",
+	"api.elastic-inference":             "Elastic Inference",
+	"api.fleethub.iot":                  "IoTFleetHub",
+	"api.iotdeviceadvisor":              "IotDeviceAdvisor",
+	"api.iotwireless":                   "IoT Wireless",
+	"api.mediatailor":                   "MediaTailor",
+	"api.pricing":                       "Pricing",
+	"api.sagemaker":                     "SageMaker",
+	"api.tunneling.iot":                 "IoTSecureTunneling",
+	"apigateway":                        "API Gateway",
+	"app-integrations":                  "AppIntegrations",
+	"application-autoscaling":           "Application Auto Scaling",
+	"application-cost-profiler":         "ApplicationCostProfiler",
+	"applicationinsights":               "Application Insights",
+	"appmesh":                           "App Mesh",
+	"appstream2":                        "AppStream",
+	"aps":                               "amp",
+	"autoscaling":                       "Auto Scaling",
+	"autoscaling-plans":                 "Auto Scaling Plans",
+	"cases":                             "ConnectCases",
+	"cassandra":                         "Keyspaces",
+	"catalog.marketplace":               "Marketplace Catalog",
+	"ce":                                "Cost Explorer",
+	"cleanrooms-ml":                     "CleanRoomsML",
+	"cloudcontrolapi":                   "CloudControl",
+	"cloudhsmv2":                        "CloudHSM V2",
+	"cloudsearchdomain":                 "CloudSearch Domain",
+	"codeguru-profiler":                 "CodeGuruProfiler",
+	"cognito-idp":                       "Cognito Identity Provider",
+	"config":                            "Config Service",
+	"connect-campaigns":                 "ConnectCampaigns",
+	"contact-lens":                      "Connect Contact Lens",
+	"controlplane.payment-cryptography": "Payment Cryptography",
+	"cur":                               "Cost and Usage Report Service",
+	"data-ats.iot":                      "IoT Data Plane",
+	"data.iotevents":                    "IoT Events Data",
+	"data.jobs.iot":                     "IoT Jobs Data Plane",
+	"data.mediastore":                   "MediaStore Data",
+	"data.qapps":                        "QApps",
+	"datapipeline":                      "Data Pipeline",
+	"dataplane.payment-cryptography":    "Payment Cryptography Data",
+	"deployment-marketplace":            "Marketplace Deployment",
+	"devicefarm":                        "Device Farm",
+	"devices.iot1click":                 "IoT 1Click Devices Service",
+	"directconnect":                     "Direct Connect",
+	"discovery":                         "Application Discovery Service",
+	"dms":                               "Database Migration Service",
+	"ds":                                "Directory Service",
+	"edge.sagemaker":                    "Sagemaker Edge",
+	"elasticbeanstalk":                  "Elastic Beanstalk",
+	"elasticfilesystem":                 "EFS",
+	"elasticloadbalancing":              "Elastic Load Balancing",
+	"elasticmapreduce":                  "EMR",
+	"elastictranscoder":                 "Elastic Transcoder",
+	"email":                             "SES",
+	"entitlement.marketplace":           "Marketplace Entitlement Service",
+	"es":                                "Elasticsearch Service",
+	"events":                            "CloudWatch Events",
+	"execute-api":                       "ApiGatewayManagementApi",
+	"featurestore-runtime.sagemaker":    "SageMaker FeatureStore Runtime",
+	"finspace-api":                      "finspace data",
+	"geo":                               "Location",
+	"globalaccelerator":                 "Global Accelerator",
+	"identity-chime":                    "Chime SDK Identity",
+	"importexport":                      "Import Export",
+	"ingest.timestream":                 "Timestream Write",
+	"iotevents":                         "IoT Events",
+	"ivsrealtime":                       "IVS RealTime",
+	"kinesisanalytics":                  "Kinesis Analytics",
+	"kinesisvideo":                      "Kinesis Video",
+	"launchwizard":                      "Launch Wizard",
+	"logs":                              "CloudWatch Logs",
+	"machinelearning":                   "Machine Learning",
+	"mail-manager":                      "MailManager",
+	"marketplacecommerceanalytics":      "Marketplace Commerce Analytics",
+	"media-pipelines-chime":             "Chime SDK Media Pipelines",
+	"meetings-chime":                    "Chime SDK Meetings",
+	"memory-db":                         "MemoryDB",
+	"messaging-chime":                   "Chime SDK Messaging",
+	"metering.marketplace":              "Marketplace Metering",
+	"metrics.sagemaker":                 "SageMaker Metrics",
+	"mgh":                               "Migration Hub",
+	"migrationhub-orchestrator":         "MigrationHubOrchestrator",
+	"migrationhub-strategy":             "MigrationHubStrategy",
+	"mobileanalytics":                   "Mobile Analytics",
+	"models-v2-lex":                     "Lex Models V2",
+	"models.lex":                        "Lex Model Building Service",
+	"monitoring":                        "CloudWatch",
+	"mturk-requester":                   "MTurk",
+	"neptune-db":                        "neptunedata",
+	"oidc":                              "SSO OIDC",
+	"opsworks-cm":                       "OpsWorksCM",
+	"participant.connect":               "ConnectParticipant",
+	"portal.sso":                        "SSO",
+	"private-networks":                  "PrivateNetworks",
+	"profile":                           "Customer Profiles",
+	"projects.iot1click":                "IoT 1Click Projects",
+	"query.timestream":                  "Timestream Query",
+	"refactor-spaces":                   "Migration Hub Refactor Spaces",
+	"route53":                           "Route 53",
+	"route53domains":                    "Route 53 Domains",
+	"runtime-v2-lex":                    "Lex Runtime V2",
+	"runtime.lex":                       "Lex Runtime Service",
+	"runtime.sagemaker":                 "SageMaker Runtime",
+	"s3-outposts":                       "S3Outposts",
+	"scn":                               "SupplyChain",
+	"sdb":                               "SimpleDB",
+	"secretsmanager":                    "Secrets Manager",
+	"serverlessrepo":                    "ServerlessApplicationRepository",
+	"servicecatalog":                    "Service Catalog",
+	"servicecatalog-appregistry":        "Service Catalog AppRegistry",
+	"servicequotas":                     "Service Quotas",
+	"session.qldb":                      "QLDB Session",
+	"sms-voice":                         "Pinpoint SMS Voice V2",
+	"sms-voice.pinpoint":                "Pinpoint SMS Voice",
+	"sso":                               "SSO Admin",
+	"states":                            "SFN",
+	"storagegateway":                    "Storage Gateway",
+	"streams.dynamodb":                  "DynamoDB Streams",
+	"supportapp":                        "Support App",
+	"tagging":                           "Resource Groups Tagging API",
+	"tax":                               "TaxSettings",
+	"thinclient":                        "WorkSpaces Thin Client",
+	"transcribestreaming":               "Transcribe Streaming",
+	"voice-chime":                       "Chime SDK Voice",
+	"voiceid":                           "Voice ID",
+}
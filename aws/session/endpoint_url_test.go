@@ -0,0 +1,219 @@
+//go:build go1.7
+// +build go1.7
+
+package session
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+func TestSession_ServiceEndpointURLs(t *testing.T) {
+	configFile := filepath.Join("testdata", "endpoint_url_config")
+
+	cases := map[string]struct {
+		Env          map[string]string
+		Config       aws.Config
+		ClientConfig aws.Config
+		ExpectErr    string
+		Expect       map[string]string
+	}{
+		"none configured": {
+			Env: map[string]string{
+				"AWS_CONFIG_FILE": "file_not_exists",
+			},
+			Expect: map[string]string{
+				"dynamodb": "https://dynamodb.us-west-2.amazonaws.com",
+			},
+		},
+		"global env": {
+			Env: map[string]string{
+				"AWS_ENDPOINT_URL": "https://env.example.com",
+				"AWS_PROFILE":      "endpoint_url",
+			},
+			Expect: map[string]string{
+				"dynamodb":    "https://env.example.com",
+				"s3":          "https://env.example.com",
+				"ec2metadata": "http://169.254.169.254/latest",
+			},
+		},
+		"service env": {
+			Env: map[string]string{
+				"AWS_ENDPOINT_URL":                   "https://env.example.com",
+				"AWS_ENDPOINT_URL_DYNAMODB":          "https://dynamodb.example.com",
+				"AWS_ENDPOINT_URL_SAGEMAKER_RUNTIME": "https://sagemaker.example.com",
+				"AWS_PROFILE":                        "services",
+			},
+			Expect: map[string]string{
+				"dynamodb":          "https://dynamodb.example.com",
+				"runtime.sagemaker": "https://sagemaker.example.com",
+				"s3":                "https://env.example.com",
+			},
+		},
+		"profile": {
+			Env: map[string]string{
+				"AWS_PROFILE": "endpoint_url",
+			},
+			Expect: map[string]string{
+				"dynamodb": "https://profile.example.com",
+			},
+		},
+		"services section": {
+			Env: map[string]string{
+				"AWS_PROFILE": "services",
+			},
+			Expect: map[string]string{
+				"dynamodb":          "http://localhost:8000",
+				"runtime.sagemaker": "http://localhost:8001",
+				"s3":                "https://profile.example.com",
+			},
+		},
+		"services section env overrides": {
+			Env: map[string]string{
+				"AWS_ENDPOINT_URL": "https://env.example.com",
+			},
+			Expect: map[string]string{
+				"dynamodb": "https://env.example.com",
+				"s3":       "https://env.example.com",
+			},
+		},
+		"config endpoint": {
+			Env: map[string]string{
+				"AWS_ENDPOINT_URL_DYNAMODB": "https://dynamodb.example.com",
+				"AWS_PROFILE":               "services",
+			},
+			Config: aws.Config{
+				Endpoint: aws.String("https://config.example.com"),
+			},
+			Expect: map[string]string{
+				"dynamodb": "https://config.example.com",
+			},
+		},
+		"config endpoint resolver": {
+			Env: map[string]string{
+				"AWS_ENDPOINT_URL": "https://env.example.com",
+				"AWS_PROFILE":      "services",
+			},
+			Config: aws.Config{
+				EndpointResolver: testEndpointResolver("https://resolver.example.com"),
+			},
+			Expect: map[string]string{
+				"dynamodb": "https://resolver.example.com",
+				"s3":       "https://resolver.example.com",
+			},
+		},
+		"client config endpoint resolver": {
+			Env: map[string]string{
+				"AWS_ENDPOINT_URL": "https://env.example.com",
+				"AWS_PROFILE":      "services",
+			},
+			ClientConfig: aws.Config{
+				EndpointResolver: testEndpointResolver("https://resolver.example.com"),
+			},
+			Expect: map[string]string{
+				"dynamodb": "https://resolver.example.com",
+				"s3":       "https://resolver.example.com",
+			},
+		},
+		"ignore shared config": {
+			Env: map[string]string{
+				"AWS_ENDPOINT_URL": "https://env.example.com",
+				"AWS_PROFILE":      "ignore_endpoint_urls",
+			},
+			Expect: map[string]string{
+				"dynamodb": "https://dynamodb.us-west-2.amazonaws.com",
+			},
+		},
+		"ignore env": {
+			Env: map[string]string{
+				"AWS_ENDPOINT_URL":                    "https://env.example.com",
+				"AWS_IGNORE_CONFIGURED_ENDPOINT_URLS": "true",
+				"AWS_PROFILE":                         "services",
+			},
+			Expect: map[string]string{
+				"dynamodb": "https://dynamodb.us-west-2.amazonaws.com",
+			},
+		},
+		"missing services section": {
+			Env: map[string]string{
+				"AWS_PROFILE": "missing_services",
+			},
+			ExpectErr: "failed to find services section, not-found",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			restoreEnvFn := initSessionTestEnv()
+			defer restoreEnvFn()
+
+			os.Setenv("AWS_SDK_LOAD_CONFIG", "1")
+			os.Setenv("AWS_CONFIG_FILE", configFile)
+			os.Setenv("AWS_ACCESS_KEY_ID", "AKID")
+			os.Setenv("AWS_SECRET_ACCESS_KEY", "SECRET")
+			for k, v := range c.Env {
+				os.Setenv(k, v)
+			}
+
+			c.Config.Region = aws.String("us-west-2")
+			s, err := NewSession(&c.Config)
+			if len(c.ExpectErr) != 0 {
+				if err == nil {
+					t.Fatalf("expect session error, got none")
+				}
+				if e, a := c.ExpectErr, err.Error(); !strings.Contains(a, e) {
+					t.Fatalf("expect session error to contain %q, got %v", e, a)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			for service, expect := range c.Expect {
+				clientCfg := s.ClientConfig(service, &c.ClientConfig)
+				if e, a := expect, clientCfg.Endpoint; e != a {
+					t.Errorf("expect %v %v endpoint, got %v", service, e, a)
+				}
+				if service == ec2MetadataServiceID {
+					continue
+				}
+				if e, a := "us-west-2", clientCfg.SigningRegion; e != a {
+					t.Errorf("expect %v %v signing region, got %v", service, e, a)
+				}
+			}
+		})
+	}
+}
+
+func testEndpointResolver(url string) endpoints.Resolver {
+	return endpoints.ResolverFunc(
+		func(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+			return endpoints.ResolvedEndpoint{
+				URL:           url,
+				SigningRegion: region,
+			}, nil
+		})
+}
+
+func TestServiceEndpointURLKey(t *testing.T) {
+	cases := map[string]string{
+		"dynamodb":             "DYNAMODB",
+		"runtime.sagemaker":    "SAGEMAKER_RUNTIME",
+		"monitoring":           "CLOUDWATCH",
+		"elasticbeanstalk":     "ELASTIC_BEANSTALK",
+		"rds":                  "RDS",
+		"unknown-service.name": "UNKNOWN_SERVICE_NAME",
+	}
+
+	for endpointsID, expect := range cases {
+		if e, a := expect, serviceEndpointURLKey(endpointsID); e != a {
+			t.Errorf("expect %v key for %v, got %v", e, endpointsID, a)
+		}
+	}
+}
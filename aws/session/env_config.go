@@ -188,6 +188,37 @@ type envConfig struct {
 	//
 	// AWS_USE_FIPS_ENDPOINT=true
 	UseFIPSEndpoint endpoints.FIPSEndpointState
+
+	// Specifies the retry mode the SDK's default retryer should use.
+	//
+	// AWS_RETRY_MODE=standard
+	// This can take value as `legacy`, `standard`, or `adaptive`
+	RetryMode aws.RetryMode
+
+	// Specifies the endpoint URL of all service clients, unless the service
+	// client has an endpoint URL of its own configured.
+	//
+	// AWS_ENDPOINT_URL=http://localhost:4566
+	EndpointURL string
+
+	// Specifies the endpoint URLs of service clients, keyed by the service ID
+	// of the environment variable's name.
+	//
+	// AWS_ENDPOINT_URL_DYNAMODB=http://localhost:8000
+	ServiceEndpointURLs map[string]string
+
+	// Specifies that the endpoint URLs configured in the environment, and
+	// shared config files, are ignored.
+	//
+	// AWS_IGNORE_CONFIGURED_ENDPOINT_URLS=true
+	IgnoreConfiguredEndpointURLs *bool
+
+	// Specifies the defaults mode of the SDK's default configuration values.
+	//
+	// AWS_DEFAULTS_MODE=standard
+	// This can take value as `legacy`, `standard`, `in-region`,
+	// `cross-region`, `mobile`, or `auto`
+	DefaultsMode aws.DefaultsMode
 }
 
 var (
@@ -275,8 +306,24 @@ var (
 	awsUseFIPSEndpoint = []string{
 		"AWS_USE_FIPS_ENDPOINT",
 	}
+	retryModeEnvKey = []string{
+		"AWS_RETRY_MODE",
+	}
+	defaultsModeEnvKey = []string{
+		"AWS_DEFAULTS_MODE",
+	}
+	endpointURLEnvKey = []string{
+		"AWS_ENDPOINT_URL",
+	}
+	ignoreConfiguredEndpointURLsEnvKey = []string{
+		"AWS_IGNORE_CONFIGURED_ENDPOINT_URLS",
+	}
 )
 
+// serviceEndpointURLEnvPrefix is the prefix of the environment variables
+// specifying the endpoint URL of a service client.
+const serviceEndpointURLEnvPrefix = "AWS_ENDPOINT_URL_"
+
 // loadEnvConfig retrieves the SDK's environment configuration.
 // See `envConfig` for the values that will be retrieved.
 //
@@ -373,6 +420,26 @@ func envConfigLoad(enableSharedConfig bool) (envConfig, error) {
 		}
 	}
 
+	// Retry mode variable
+	for _, k := range retryModeEnvKey {
+		if v := os.Getenv(k); len(v) != 0 {
+			cfg.RetryMode, err = aws.ParseRetryMode(v)
+			if err != nil {
+				return cfg, fmt.Errorf("failed to load, %v from env config, %v", k, err)
+			}
+		}
+	}
+
+	// Defaults mode variable
+	for _, k := range defaultsModeEnvKey {
+		if v := os.Getenv(k); len(v) != 0 {
+			cfg.DefaultsMode, err = aws.ParseDefaultsMode(v)
+			if err != nil {
+				return cfg, fmt.Errorf("failed to load, %v from env config, %v", k, err)
+			}
+		}
+	}
+
 	// S3 Regional Endpoint variable
 	for _, k := range s3UsEast1RegionalEndpoint {
 		if v := os.Getenv(k); len(v) != 0 {
@@ -412,9 +479,37 @@ func envConfigLoad(enableSharedConfig bool) (envConfig, error) {
 		return cfg, err
 	}
 
+	setFromEnvVal(&cfg.EndpointURL, endpointURLEnvKey)
+	cfg.ServiceEndpointURLs = serviceEndpointURLsFromEnv()
+	setBoolPtrFromEnvVal(&cfg.IgnoreConfiguredEndpointURLs, ignoreConfiguredEndpointURLsEnvKey)
+
 	return cfg, nil
 }
 
+// serviceEndpointURLsFromEnv returns the endpoint URLs of the
+// AWS_ENDPOINT_URL_<SERVICE_ID> environment variables, keyed by service ID.
+func serviceEndpointURLsFromEnv() map[string]string {
+	var urls map[string]string
+	for _, kv := range os.Environ() {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 || len(parts[1]) == 0 ||
+			!strings.HasPrefix(parts[0], serviceEndpointURLEnvPrefix) {
+			continue
+		}
+
+		id := strings.TrimPrefix(parts[0], serviceEndpointURLEnvPrefix)
+		if len(id) == 0 {
+			continue
+		}
+		if urls == nil {
+			urls = map[string]string{}
+		}
+		urls[normalizeServiceEndpointURLKey(id)] = parts[1]
+	}
+
+	return urls
+}
+
 func setFromEnvVal(dst *string, keys []string) {
 	for _, k := range keys {
 		if v := os.Getenv(k); len(v) != 0 {
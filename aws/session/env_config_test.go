@@ -497,6 +497,32 @@ func TestLoadEnvConfig(t *testing.T) {
 				SharedConfigFile:      shareddefaults.SharedConfigFilename(),
 			},
 		},
+		36: {
+			Env: map[string]string{
+				"AWS_RETRY_MODE": "standard",
+			},
+			Config: envConfig{
+				RetryMode:             aws.RetryModeStandard,
+				SharedCredentialsFile: shareddefaults.SharedCredentialsFilename(),
+				SharedConfigFile:      shareddefaults.SharedConfigFilename(),
+			},
+		},
+		37: {
+			Env: map[string]string{
+				"AWS_RETRY_MODE": "Adaptive",
+			},
+			Config: envConfig{
+				RetryMode:             aws.RetryModeAdaptive,
+				SharedCredentialsFile: shareddefaults.SharedCredentialsFilename(),
+				SharedConfigFile:      shareddefaults.SharedConfigFilename(),
+			},
+		},
+		38: {
+			Env: map[string]string{
+				"AWS_RETRY_MODE": "invalid",
+			},
+			WantErr: true,
+		},
 	}
 
 	for i, c := range cases {
@@ -12,14 +12,18 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/auth/bearer"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/circuitbreaker"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/corehandlers"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/csm"
 	"github.com/aws/aws-sdk-go/aws/defaults"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/metrics"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/internal/sdklog"
 )
 
 const (
@@ -58,6 +62,10 @@ type Session struct {
 	Handlers request.Handlers
 
 	options Options
+
+	// Endpoint URLs of service clients configured in the environment, and
+	// shared config files. Nil if an endpoint resolver is provided in code.
+	endpointURLs *endpointURLConfig
 }
 
 // New creates a new instance of the handlers merging in the provided configs
@@ -116,9 +124,9 @@ func New(cfgs ...*aws.Config) *Session {
 	}
 
 	if csmCfg, err := loadCSMConfig(envCfg, []string{}); err != nil {
-		if l := s.Config.Logger; l != nil {
-			l.Log(fmt.Sprintf("ERROR: failed to load CSM configuration, %v", err))
-		}
+		sdklog.Log(s.Config.Logger, aws.LogSeverityError, "failed to load CSM configuration",
+			fmt.Sprintf("ERROR: failed to load CSM configuration, %v", err),
+			sdklog.Field(sdklog.FieldError, err))
 	} else if csmCfg.Enabled {
 		err := enableCSM(&s.Handlers, csmCfg, s.Config.Logger)
 		if err != nil {
@@ -208,8 +216,9 @@ type Options struct {
 
 	// When the SDK's shared config is configured to assume a role with MFA
 	// this option is required in order to provide the mechanism that will
-	// retrieve the MFA token. There is no default value for this field. If
-	// it is not set an error will be returned when creating the session.
+	// retrieve the MFA token, unless the profile sets the
+	// mfa_totp_secret_source key. There is no default value for this field.
+	// If it is not set an error will be returned when creating the session.
 	//
 	// This token provider will be called when ever the assumed role's
 	// credentials need to be refreshed. Within the context of service clients
@@ -222,6 +231,13 @@ type Options struct {
 	// stscreds.StdinTokenProvider is a basic implementation that will prompt
 	// from stdin for the MFA token code.
 	//
+	// If the profile sets mfa_totp_secret_source, and this field is not set,
+	// a stscreds.TOTPTokenProvider is used to generate the MFA token codes
+	// from the virtual MFA device secret read from the secret source,
+	// "env:<name>" or "file:<path>". The optional mfa_totp_period and
+	// mfa_totp_digits keys set the token codes' period, in seconds, and
+	// number of digits.
+	//
 	// This field is only used if the shared configuration is enabled, and
 	// the config enables assume role with MFA via the mfa_serial field.
 	AssumeRoleTokenProvider func() (string, error)
@@ -308,6 +324,29 @@ type Options struct {
 	// These are only used if the aws.Config does not already
 	// include credentials.
 	CredentialsProviderOptions *CredentialsProviderOptions
+
+	// The bearer token provider API clients created from the Session will
+	// use to sign requests of API operations authenticated with a bearer
+	// token. Only used if the aws.Config does not already include a
+	// TokenProvider.
+	//
+	// If not set, the SSO token of the shared config profile's sso-session
+	// will be used if one is configured.
+	TokenProvider bearer.TokenProvider
+
+	// MetricsSink is the sink the per operation metrics of the requests made
+	// by API clients created from the Session will be reported to. If not
+	// set, no metrics will be recorded.
+	//
+	// See the metrics package for the sinks provided by the SDK.
+	MetricsSink metrics.Sink
+
+	// CircuitBreaker is the circuit breaker the requests made by API clients
+	// created from the Session will be made through. If not set, no circuit
+	// breaker is used.
+	//
+	// See the circuitbreaker package for more information.
+	CircuitBreaker *circuitbreaker.Breaker
 }
 
 // NewSessionWithOptions returns a new Session created from SDK defaults, config files,
@@ -440,9 +479,7 @@ func deprecatedNewSession(envCfg envConfig, cfgs ...*aws.Config) *Session {
 }
 
 func enableCSM(handlers *request.Handlers, cfg csmConfig, logger aws.Logger) error {
-	if logger != nil {
-		logger.Log("Enabling CSM")
-	}
+	sdklog.Log(logger, aws.LogSeverityInfo, "enabling CSM", "Enabling CSM")
 
 	r, err := csm.Start(cfg.ClientID, csm.AddressWithDefaults(cfg.Host, cfg.Port))
 	if err != nil {
@@ -495,7 +532,14 @@ func newSession(opts Options, envCfg envConfig, cfgs ...*aws.Config) (*Session,
 		}
 	}
 
-	if err := mergeConfigSrcs(cfg, userCfg, envCfg, sharedCfg, handlers, opts); err != nil {
+	// Endpoint URLs are resolved before the credentials, such that the
+	// service clients used to retrieve credentials use them.
+	var endpointURLs *endpointURLConfig
+	if userCfg.EndpointResolver == nil {
+		endpointURLs = newEndpointURLConfig(envCfg, sharedCfg)
+	}
+
+	if err := mergeConfigSrcs(cfg, userCfg, envCfg, sharedCfg, handlers, endpointURLs, opts); err != nil {
 		return nil, err
 	}
 
@@ -504,17 +548,18 @@ func newSession(opts Options, envCfg envConfig, cfgs ...*aws.Config) (*Session,
 	}
 
 	s := &Session{
-		Config:   cfg,
-		Handlers: handlers,
-		options:  opts,
+		Config:       cfg,
+		Handlers:     handlers,
+		options:      opts,
+		endpointURLs: endpointURLs,
 	}
 
 	initHandlers(s)
 
 	if csmCfg, err := loadCSMConfig(envCfg, cfgFiles); err != nil {
-		if l := s.Config.Logger; l != nil {
-			l.Log(fmt.Sprintf("ERROR: failed to load CSM configuration, %v", err))
-		}
+		sdklog.Log(s.Config.Logger, aws.LogSeverityError, "failed to load CSM configuration",
+			fmt.Sprintf("ERROR: failed to load CSM configuration, %v", err),
+			sdklog.Field(sdklog.FieldError, err))
 	} else if csmCfg.Enabled {
 		err = enableCSM(&s.Handlers, csmCfg, s.Config.Logger)
 		if err != nil {
@@ -522,6 +567,14 @@ func newSession(opts Options, envCfg envConfig, cfgs ...*aws.Config) (*Session,
 		}
 	}
 
+	if opts.MetricsSink != nil {
+		metrics.InjectHandlers(&s.Handlers, opts.MetricsSink)
+	}
+
+	if opts.CircuitBreaker != nil {
+		opts.CircuitBreaker.InjectHandlers(&s.Handlers)
+	}
+
 	return s, nil
 }
 
@@ -715,6 +768,7 @@ func loadClientTLSCert(client *http.Client, certFile, keyFile io.Reader) error {
 func mergeConfigSrcs(cfg, userCfg *aws.Config,
 	envCfg envConfig, sharedCfg sharedConfig,
 	handlers request.Handlers,
+	endpointURLs *endpointURLConfig,
 	sessOpts Options,
 ) error {
 
@@ -751,6 +805,17 @@ func mergeConfigSrcs(cfg, userCfg *aws.Config,
 		endpoints.LegacyS3UsEast1Endpoint,
 	})
 
+	for _, v := range []aws.RetryMode{
+		userCfg.RetryMode,
+		envCfg.RetryMode,
+		sharedCfg.RetryMode,
+	} {
+		if v != aws.RetryModeUnset {
+			cfg.RetryMode = v
+			break
+		}
+	}
+
 	var ec2IMDSEndpoint string
 	for _, v := range []string{
 		sessOpts.EC2IMDSEndpoint,
@@ -809,17 +874,30 @@ func mergeConfigSrcs(cfg, userCfg *aws.Config,
 		}
 	}
 
+	if err := mergeDefaultsModeConfig(cfg, userCfg, envCfg, sharedCfg, handlers); err != nil {
+		return err
+	}
+
 	// Configure credentials if not already set by the user when creating the Session.
 	// Credentials are resolved last such that all _resolved_ config values are propagated to credential providers.
 	// ticket: P83606045
 	if cfg.Credentials == credentials.AnonymousCredentials && userCfg.Credentials == nil {
-		creds, err := resolveCredentials(cfg, envCfg, sharedCfg, handlers, sessOpts)
+		creds, err := resolveCredentials(cfg, envCfg, sharedCfg, handlers, endpointURLs, sessOpts)
 		if err != nil {
 			return err
 		}
 		cfg.Credentials = creds
 	}
 
+	// Configure the bearer token provider if not already set by the user.
+	if cfg.TokenProvider == nil {
+		provider, err := resolveTokenProvider(cfg, sharedCfg, sessOpts)
+		if err != nil {
+			return err
+		}
+		cfg.TokenProvider = provider
+	}
+
 	return nil
 }
 
@@ -861,6 +939,9 @@ func (s *Session) Copy(cfgs ...*aws.Config) *Session {
 		Handlers: s.Handlers.Copy(),
 		options:  s.options,
 	}
+	if !hasEndpointResolver(cfgs) {
+		newSession.endpointURLs = s.endpointURLs
+	}
 
 	initHandlers(newSession)
 
@@ -912,6 +993,15 @@ func (s *Session) resolveEndpoint(service, region, resolvedRegion string, cfg *a
 		}, nil
 	}
 
+	// Endpoint URL of the service configured in the environment, or
+	// shared config files.
+	if ep := s.endpointURLs.endpointURL(service); len(ep) != 0 {
+		return endpoints.ResolvedEndpoint{
+			URL:           endpoints.AddScheme(ep, aws.BoolValue(cfg.DisableSSL)),
+			SigningRegion: region,
+		}, nil
+	}
+
 	resolved, err := cfg.EndpointResolver.EndpointFor(service, region,
 		func(opt *endpoints.Options) {
 			opt.DisableSSL = aws.BoolValue(cfg.DisableSSL)
@@ -978,7 +1068,9 @@ func (s *Session) logDeprecatedNewSessionError(msg string, err error, cfgs []*aw
 	// Session creation failed, need to report the error and prevent
 	// any requests from succeeding.
 	s.Config.MergeIn(cfgs...)
-	s.Config.Logger.Log("ERROR:", msg, "Error:", err)
+	sdklog.Log(s.Config.Logger, aws.LogSeverityError, msg,
+		fmt.Sprintf("ERROR: %s Error: %v", msg, err),
+		sdklog.Field(sdklog.FieldError, err))
 	s.Handlers.Validate.PushBack(func(r *request.Request) {
 		r.Error = err
 	})
@@ -15,9 +15,12 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/auth/bearer"
+	"github.com/aws/aws-sdk-go/aws/circuitbreaker"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/defaults"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/metrics"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/s3"
 )
@@ -206,6 +209,104 @@ func TestNewSession_NoCredentials(t *testing.T) {
 	}
 }
 
+func TestNewSessionWithOptions_MetricsSink(t *testing.T) {
+	restoreEnvFn := initSessionTestEnv()
+	defer restoreEnvFn()
+
+	s, err := NewSessionWithOptions(Options{
+		MetricsSink: metrics.NewMemorySink(),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	for _, name := range []string{
+		metrics.RecordAttemptHandlerName,
+		metrics.RecordCallHandlerName,
+	} {
+		if !s.Handlers.CompleteAttempt.SwapNamed(request.NamedHandler{Name: name}) &&
+			!s.Handlers.Complete.SwapNamed(request.NamedHandler{Name: name}) {
+			t.Errorf("expect %v handler to be added", name)
+		}
+	}
+}
+
+func TestNewSessionWithOptions_CircuitBreaker(t *testing.T) {
+	restoreEnvFn := initSessionTestEnv()
+	defer restoreEnvFn()
+
+	s, err := NewSessionWithOptions(Options{
+		CircuitBreaker: circuitbreaker.New(),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if !s.Handlers.Sign.SwapNamed(request.NamedHandler{Name: circuitbreaker.AllowHandlerName}) {
+		t.Errorf("expect %v handler to be added", circuitbreaker.AllowHandlerName)
+	}
+	if !s.Handlers.Retry.SwapNamed(request.NamedHandler{Name: circuitbreaker.RetryHandlerName}) {
+		t.Errorf("expect %v handler to be added", circuitbreaker.RetryHandlerName)
+	}
+}
+
+func TestNewSessionWithOptions_TokenProvider(t *testing.T) {
+	optionsProvider := bearer.StaticTokenProvider{Token: bearer.Token{Value: "options"}}
+	configProvider := bearer.StaticTokenProvider{Token: bearer.Token{Value: "config"}}
+
+	cases := map[string]struct {
+		Profile        string
+		Options        Options
+		ExpectProvider bearer.TokenProvider
+		ExpectCache    bool
+	}{
+		"none": {},
+		"options": {
+			Options: Options{
+				TokenProvider: optionsProvider,
+			},
+			ExpectProvider: optionsProvider,
+		},
+		"config overrides options": {
+			Options: Options{
+				Config:        aws.Config{TokenProvider: configProvider},
+				TokenProvider: optionsProvider,
+			},
+			ExpectProvider: configProvider,
+		},
+		"sso-session": {
+			Profile:     "sso-session-success",
+			ExpectCache: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			restoreEnvFn := initSessionTestEnv()
+			defer restoreEnvFn()
+
+			os.Setenv("AWS_SDK_LOAD_CONFIG", "1")
+			os.Setenv("AWS_CONFIG_FILE", testConfigFilename)
+			os.Setenv("AWS_PROFILE", c.Profile)
+
+			s, err := NewSessionWithOptions(c.Options)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if c.ExpectCache {
+				if _, ok := s.Config.TokenProvider.(*bearer.TokenCache); !ok {
+					t.Errorf("expect token cache, got %T", s.Config.TokenProvider)
+				}
+				return
+			}
+			if e, a := c.ExpectProvider, s.Config.TokenProvider; e != a {
+				t.Errorf("expect %v token provider, got %v", e, a)
+			}
+		})
+	}
+}
+
 func TestNewSessionWithOptions_OverrideProfile(t *testing.T) {
 	restoreEnvFn := initSessionTestEnv()
 	defer restoreEnvFn()
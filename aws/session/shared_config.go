@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -26,6 +27,11 @@ const (
 	roleSessionNameKey     = `role_session_name` // optional
 	roleDurationSecondsKey = "duration_seconds"  // optional
 
+	// Assume Role MFA TOTP token provider group
+	mfaTOTPSecretSourceKey = "mfa_totp_secret_source" // optional
+	mfaTOTPPeriodKey       = "mfa_totp_period"        // optional
+	mfaTOTPDigitsKey       = "mfa_totp_digits"        // optional
+
 	// Prefix to be used for SSO sections. These are supposed to only exist in
 	// the shared config file, not the credentials file.
 	ssoSectionPrefix = `sso-session `
@@ -39,6 +45,9 @@ const (
 	ssoRoleNameKey  = "sso_role_name"
 	ssoStartURL     = "sso_start_url"
 
+	// AWS Single Sign-On (AWS SSO) sso-session group
+	ssoRegistrationScopesKey = "sso_registration_scopes"
+
 	// CSM options
 	csmEnabledKey  = `csm_enabled`
 	csmHostKey     = `csm_host`
@@ -88,6 +97,23 @@ const (
 
 	// Use FIPS Endpoint Resolution
 	useFIPSEndpointKey = "use_fips_endpoint"
+
+	// Retry mode of the SDK's default retryer
+	retryModeKey = "retry_mode"
+
+	// Defaults mode of the SDK's default configuration values
+	defaultsModeKey = "defaults_mode"
+
+	// Endpoint URL of service clients
+	endpointURLKey                  = "endpoint_url"
+	ignoreConfiguredEndpointURLsKey = "ignore_configured_endpoint_urls"
+
+	// Name of the services section of service specific configuration
+	servicesSectionNameKey = "services"
+
+	// Prefix to be used for services sections. These are supposed to only
+	// exist in the shared config file, not the credentials file.
+	servicesSectionPrefix = `services `
 )
 
 // sharedConfig represents the configuration fields of the SDK config files.
@@ -124,6 +150,21 @@ type sharedConfig struct {
 	MFASerial          string
 	AssumeRoleDuration *time.Duration
 
+	// The source of the base32 secret of the virtual MFA device, used to
+	// generate MFA token codes for assuming the role. Either the name of an
+	// environment variable, "env:<name>", or the path of a file,
+	// "file:<path>", containing the secret.
+	//
+	//	mfa_totp_secret_source = env:MY_MFA_SECRET
+	MFATOTPSecretSource string
+
+	// The period, in seconds, and number of digits, of the MFA token codes.
+	//
+	//	mfa_totp_period = 30
+	//	mfa_totp_digits = 6
+	MFATOTPPeriod *time.Duration
+	MFATOTPDigits int
+
 	SourceProfileName string
 	SourceProfile     *sharedConfig
 
@@ -199,6 +240,45 @@ type sharedConfig struct {
 	//
 	// use_fips_endpoint=true
 	UseFIPSEndpoint endpoints.FIPSEndpointState
+
+	// Specifies the retry mode the SDK's default retryer should use.
+	//
+	// retry_mode=standard
+	// This can take value as `legacy`, `standard`, or `adaptive`
+	RetryMode aws.RetryMode
+
+	// Specifies the defaults mode of the SDK's default configuration values.
+	//
+	// defaults_mode=standard
+	// This can take value as `legacy`, `standard`, `in-region`,
+	// `cross-region`, `mobile`, or `auto`
+	DefaultsMode aws.DefaultsMode
+
+	// Specifies the endpoint URL of all service clients, unless the service
+	// client has an endpoint URL of its own configured.
+	//
+	// endpoint_url=http://localhost:4566
+	EndpointURL string
+
+	// Specifies that the endpoint URLs configured in the environment, and
+	// shared config files, are ignored.
+	//
+	// ignore_configured_endpoint_urls=true
+	IgnoreConfiguredEndpointURLs *bool
+
+	// The name of the services section, with the service specific
+	// configuration of the profile.
+	//
+	// services=my-services
+	ServicesSectionName string
+
+	// The endpoint URLs of service clients loaded from the services section,
+	// keyed by service ID.
+	//
+	//	[services my-services]
+	//	dynamodb =
+	//	  endpoint_url = http://localhost:8000
+	ServiceEndpointURLs map[string]string
 }
 
 type sharedConfigFile struct {
@@ -209,15 +289,17 @@ type sharedConfigFile struct {
 // SSOSession provides the shared configuration parameters of the sso-session
 // section.
 type ssoSession struct {
-	Name        string
-	SSORegion   string
-	SSOStartURL string
+	Name               string
+	SSORegion          string
+	SSOStartURL        string
+	RegistrationScopes []string
 }
 
 func (s *ssoSession) setFromIniSection(section ini.Section) {
 	updateString(&s.Name, section, ssoSessionNameKey)
 	updateString(&s.SSORegion, section, ssoRegionKey)
 	updateString(&s.SSOStartURL, section, ssoStartURL)
+	updateStringList(&s.RegistrationScopes, section, ssoRegistrationScopesKey)
 }
 
 // loadSharedConfig retrieves the configuration from the list of files using
@@ -366,9 +448,42 @@ func (cfg *sharedConfig) setFromIniFiles(profiles map[string]struct{}, profile s
 		}
 	}
 
+	// If the profile references a services section, the section MUST exist
+	// in the config file.
+	if name := strings.TrimSpace(cfg.ServicesSectionName); len(name) != 0 {
+		var found bool
+		for _, f := range files {
+			section, ok := f.IniData.GetSection(servicesSectionPrefix + name)
+			if !ok {
+				continue
+			}
+			found = true
+			cfg.setServicesFromIniSection(section)
+		}
+		if !found {
+			return fmt.Errorf("failed to find services section, %v", cfg.ServicesSectionName)
+		}
+	}
+
 	return nil
 }
 
+// setServicesFromIniSection loads the service specific configuration of the
+// services section. Each service's configuration is a nested block of
+// properties keyed by the service's ID, in lower case, with spaces replaced
+// with underscores.
+func (cfg *sharedConfig) setServicesFromIniSection(section ini.Section) {
+	for _, id := range section.NestedKeys() {
+		props, _ := section.Nested(id)
+		if v := props[endpointURLKey]; len(v) != 0 {
+			if cfg.ServiceEndpointURLs == nil {
+				cfg.ServiceEndpointURLs = map[string]string{}
+			}
+			cfg.ServiceEndpointURLs[normalizeServiceEndpointURLKey(id)] = v
+		}
+	}
+}
+
 // setFromFile loads the configuration from the file using the profile
 // provided. A sharedConfig pointer type value is used so that multiple config
 // file loadings can be chained.
@@ -392,6 +507,14 @@ func (cfg *sharedConfig) setFromIniFile(profile string, file sharedConfigFile, e
 		updateString(&cfg.RoleARN, section, roleArnKey)
 		updateString(&cfg.ExternalID, section, externalIDKey)
 		updateString(&cfg.MFASerial, section, mfaSerialKey)
+		updateString(&cfg.MFATOTPSecretSource, section, mfaTOTPSecretSourceKey)
+		if v, ok := section.Int(mfaTOTPPeriodKey); ok {
+			d := time.Duration(v) * time.Second
+			cfg.MFATOTPPeriod = &d
+		}
+		if v, ok := section.Int(mfaTOTPDigitsKey); ok {
+			cfg.MFATOTPDigits = int(v)
+		}
 		updateString(&cfg.RoleSessionName, section, roleSessionNameKey)
 		updateString(&cfg.SourceProfileName, section, sourceProfileKey)
 		updateString(&cfg.CredentialSource, section, credentialSourceKey)
@@ -428,6 +551,24 @@ func (cfg *sharedConfig) setFromIniFile(profile string, file sharedConfigFile, e
 			cfg.S3UsEast1RegionalEndpoint = sre
 		}
 
+		if v := section.String(retryModeKey); len(v) != 0 {
+			mode, err := aws.ParseRetryMode(v)
+			if err != nil {
+				return fmt.Errorf("failed to load %s from shared config, %s, %v",
+					retryModeKey, file.Filename, err)
+			}
+			cfg.RetryMode = mode
+		}
+
+		if v := section.String(defaultsModeKey); len(v) != 0 {
+			mode, err := aws.ParseDefaultsMode(v)
+			if err != nil {
+				return fmt.Errorf("failed to load %s from shared config, %s, %v",
+					defaultsModeKey, file.Filename, err)
+			}
+			cfg.DefaultsMode = mode
+		}
+
 		// AWS Single Sign-On (AWS SSO)
 		// SSO session options
 		updateString(&cfg.SSOSessionName, section, ssoSessionNameKey)
@@ -448,6 +589,10 @@ func (cfg *sharedConfig) setFromIniFile(profile string, file sharedConfigFile, e
 		updateUseDualStackEndpoint(&cfg.UseDualStackEndpoint, section, useDualStackEndpoint)
 
 		updateUseFIPSEndpoint(&cfg.UseFIPSEndpoint, section, useFIPSEndpointKey)
+
+		updateString(&cfg.EndpointURL, section, endpointURLKey)
+		updateBoolPtr(&cfg.IgnoreConfiguredEndpointURLs, section, ignoreConfiguredEndpointURLsKey)
+		updateString(&cfg.ServicesSectionName, section, servicesSectionNameKey)
 	}
 
 	updateString(&cfg.CredentialProcess, section, credentialProcessKey)
@@ -578,6 +723,9 @@ func (cfg *sharedConfig) clearAssumeRoleOptions() {
 	cfg.RoleARN = ""
 	cfg.ExternalID = ""
 	cfg.MFASerial = ""
+	cfg.MFATOTPSecretSource = ""
+	cfg.MFATOTPPeriod = nil
+	cfg.MFATOTPDigits = 0
 	cfg.RoleSessionName = ""
 	cfg.SourceProfileName = ""
 }
@@ -679,6 +827,22 @@ func updateString(dst *string, section ini.Section, key string) {
 	*dst = section.String(key)
 }
 
+// updateStringList will only update the dst with the comma separated values
+// in the section key, key is present in the section.
+func updateStringList(dst *[]string, section ini.Section, key string) {
+	if !section.Has(key) {
+		return
+	}
+
+	var values []string
+	for _, v := range strings.Split(section.String(key), ",") {
+		if v = strings.TrimSpace(v); len(v) != 0 {
+			values = append(values, v)
+		}
+	}
+	*dst = values
+}
+
 // updateBool will only update the dst with the value in the section key, key
 // is present in the section.
 func updateBool(dst *bool, section ini.Section, key string) {
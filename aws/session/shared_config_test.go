@@ -401,9 +401,10 @@ func TestLoadSharedConfig(t *testing.T) {
 				SSORoleName:    "testRole",
 				SSOSessionName: "sso-session-success-dev",
 				SSOSession: &ssoSession{
-					Name:        "sso-session-success-dev",
-					SSORegion:   "us-east-1",
-					SSOStartURL: "https://d-123456789a.awsapps.com/start",
+					Name:               "sso-session-success-dev",
+					SSORegion:          "us-east-1",
+					SSOStartURL:        "https://d-123456789a.awsapps.com/start",
+					RegistrationScopes: []string{"sso:account:access"},
 				},
 			},
 		},
@@ -436,6 +437,20 @@ func TestLoadSharedConfig(t *testing.T) {
 				EC2IMDSv1Disabled: aws.Bool(false),
 			},
 		},
+		{
+			Filenames: []string{testConfigFilename},
+			Profile:   "retry-mode-adaptive",
+			Expected: sharedConfig{
+				Profile:   "retry-mode-adaptive",
+				Region:    "us-west-2",
+				RetryMode: aws.RetryModeAdaptive,
+			},
+		},
+		{
+			Filenames: []string{testConfigFilename},
+			Profile:   "retry-mode-invalid",
+			Err:       fmt.Errorf("failed to load retry_mode from shared config"),
+		},
 	}
 
 	for i, c := range cases {
@@ -0,0 +1,102 @@
+package session
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/auth/bearer"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ssocreds"
+	"github.com/aws/aws-sdk-go/service/ssooidc"
+)
+
+// SSOLogin performs the AWS SSO device authorization login flow for the
+// SSO configuration of the shared config profile, and caches the SSO token
+// so it can be used by sessions created for the profile. This is the
+// equivalent of the AWS CLI's "aws sso login" command.
+//
+// The profile, and shared config files, are selected the same as
+// NewSessionWithOptions, with the shared config file always loaded. The
+// profile's sso-session section is used if the profile has an sso_session
+// key. Otherwise the profile's legacy sso_start_url and sso_region keys are
+// used.
+//
+// The ssocreds.LoginOptions.OnDeviceAuthorization option must be provided
+// to prompt the user to approve the device authorization.
+//
+//	_, err := session.SSOLogin(ctx, session.Options{
+//	    Profile: "devsso",
+//	}, func(o *ssocreds.LoginOptions) {
+//	    o.OnDeviceAuthorization = func(auth ssocreds.DeviceAuthorization) error {
+//	        fmt.Printf("Open %v to approve the login\n", auth.VerificationURIComplete)
+//	        return nil
+//	    }
+//	})
+func SSOLogin(ctx aws.Context, opts Options, optFns ...func(*ssocreds.LoginOptions)) (bearer.Token, error) {
+	envCfg, err := loadSharedEnvConfig()
+	if err != nil {
+		return bearer.Token{}, fmt.Errorf("failed to load shared config, %v", err)
+	}
+	if len(opts.Profile) != 0 {
+		envCfg.Profile = opts.Profile
+	}
+
+	cfgFiles := opts.SharedConfigFiles
+	if cfgFiles == nil {
+		cfgFiles = []string{envCfg.SharedConfigFile, envCfg.SharedCredentialsFile}
+	}
+
+	sharedCfg, err := loadSharedConfig(envCfg.Profile, cfgFiles, true)
+	if err != nil {
+		return bearer.Token{}, err
+	}
+
+	var startURL, region string
+	var loginOptFns []func(*ssocreds.LoginOptions)
+	switch {
+	case sharedCfg.hasSSOTokenProviderConfiguration():
+		if err := sharedCfg.validateSSOTokenProviderConfiguration(); err != nil {
+			return bearer.Token{}, err
+		}
+		ssoSession := sharedCfg.SSOSession
+		startURL, region = ssoSession.SSOStartURL, ssoSession.SSORegion
+		loginOptFns = append(loginOptFns, func(o *ssocreds.LoginOptions) {
+			o.SessionName = ssoSession.Name
+			o.Scopes = ssoSession.RegistrationScopes
+		})
+
+	case len(sharedCfg.SSOStartURL) != 0 && len(sharedCfg.SSORegion) != 0:
+		startURL, region = sharedCfg.SSOStartURL, sharedCfg.SSORegion
+
+	default:
+		return bearer.Token{}, fmt.Errorf(
+			"profile %q is not configured to use SSO, requires %s, or %s and %s",
+			sharedCfg.Profile, ssoSessionNameKey, ssoStartURL, ssoRegionKey)
+	}
+
+	loginOptFns = append(loginOptFns, func(o *ssocreds.LoginOptions) {
+		o.Region = region
+	})
+	loginOptFns = append(loginOptFns, optFns...)
+
+	// create oidcClient with AnonymousCredentials, and a static token provider,
+	// to avoid resolving the credentials and token provider of the profile
+	// being logged in to.
+	sess, err := NewSessionWithOptions(Options{
+		Config: aws.Config{
+			Credentials:   credentials.AnonymousCredentials,
+			TokenProvider: bearer.StaticTokenProvider{},
+		},
+		Handlers:          opts.Handlers,
+		SharedConfigState: SharedConfigDisable,
+	})
+	if err != nil {
+		return bearer.Token{}, err
+	}
+
+	cfgCopy := opts.Config.Copy()
+	cfgCopy.Region = &region
+	oidcClient := ssooidc.New(sess, cfgCopy)
+
+	return ssocreds.Login(ctx, oidcClient, startURL, loginOptFns...)
+}
@@ -0,0 +1,111 @@
+//go:build go1.7
+// +build go1.7
+
+package session
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/ssocreds"
+)
+
+func TestSSOLogin(t *testing.T) {
+	cases := map[string]struct {
+		Profile        string
+		ExpectStartURL string
+		ExpectScopes   []string
+		ExpectErr      string
+	}{
+		"sso-session": {
+			Profile:        "sso-session-success",
+			ExpectStartURL: "https://d-123456789a.awsapps.com/start",
+			ExpectScopes:   []string{"sso:account:access"},
+		},
+		"legacy": {
+			Profile:        "sso_creds",
+			ExpectStartURL: "https://127.0.0.1/start",
+		},
+		"sso-session not exists": {
+			Profile:   "sso-session-not-exist",
+			ExpectErr: "failed to find SSO session section",
+		},
+		"not sso": {
+			Profile:   "assume_role_w_creds",
+			ExpectErr: "is not configured to use SSO",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			restoreEnvFn := initSessionTestEnv()
+			defer restoreEnvFn()
+
+			os.Setenv("AWS_CONFIG_FILE", testConfigFilename)
+			os.Setenv("AWS_PROFILE", c.Profile)
+
+			var scopes []string
+			var startURL string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]interface{}
+				b, _ := ioutil.ReadAll(r.Body)
+				json.Unmarshal(b, &body)
+
+				switch r.URL.Path {
+				case "/client/register":
+					values, _ := body["scopes"].([]interface{})
+					for _, v := range values {
+						scopes = append(scopes, v.(string))
+					}
+					w.Write([]byte(`{"clientId": "client-id", "clientSecret": "client-secret"}`))
+				case "/device_authorization":
+					startURL = body["startUrl"].(string)
+					w.Write([]byte(`{"deviceCode": "device-code", "userCode": "ABCD-EFGH", "expiresIn": 600}`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			_, err := SSOLogin(aws.BackgroundContext(), Options{
+				Config: aws.Config{
+					Endpoint: aws.String(server.URL),
+				},
+			}, func(o *ssocreds.LoginOptions) {
+				o.CachedTokenFilepath = "file_not_exists"
+				o.OnDeviceAuthorization = func(auth ssocreds.DeviceAuthorization) error {
+					return fmt.Errorf("user code %v", auth.UserCode)
+				}
+			})
+			if err == nil {
+				t.Fatalf("expect error, got none")
+			}
+
+			if len(c.ExpectErr) != 0 {
+				if e, a := c.ExpectErr, err.Error(); !strings.Contains(a, e) {
+					t.Errorf("expect %v error, got %v", e, a)
+				}
+				return
+			}
+
+			// The login is stopped once the device authorization is started.
+			if e, a := "user code ABCD-EFGH", err.Error(); e != a {
+				t.Errorf("expect %v error, got %v", e, a)
+			}
+			if e, a := c.ExpectStartURL, startURL; e != a {
+				t.Errorf("expect %v start URL, got %v", e, a)
+			}
+			if e, a := c.ExpectScopes, scopes; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v scopes, got %v", e, a)
+			}
+		})
+	}
+}
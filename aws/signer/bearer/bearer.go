@@ -0,0 +1,103 @@
+// Package bearer implements signing of requests with a bearer token.
+//
+// Provides the request handler to sign the requests of API operations that
+// are authenticated with a bearer token, such as operations of services
+// modeled with the smithy.api#httpBearerAuth auth trait. The token is
+// retrieved from the client's aws.Config.TokenProvider, and is added to the
+// request's Authorization header.
+//
+// Service clients that use bearer token authentication sign their requests
+// with the SignRequestHandler automatically. The token provider should cache
+// the token, as the provider is called for each request signed. A provider
+// can be wrapped with the aws/auth/bearer package's NewTokenCache to cache
+// its token.
+//
+//	sess := session.Must(session.NewSession(&aws.Config{
+//		TokenProvider: bearer.NewTokenCache(tokenProvider),
+//	}))
+package bearer
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/auth/bearer"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// ErrCodeMissingTokenProvider is the error code returned when a request
+	// is signed with a bearer token, but no TokenProvider is configured.
+	ErrCodeMissingTokenProvider = "MissingTokenProviderError"
+
+	// ErrCodeRetrieveBearerToken is the error code returned when the
+	// bearer token could not be retrieved from the TokenProvider.
+	ErrCodeRetrieveBearerToken = "RetrieveBearerTokenError"
+
+	// ErrCodeInsecureRequest is the error code returned when a request is
+	// signed with a bearer token, but is not sent over HTTPS.
+	ErrCodeInsecureRequest = "InsecureBearerRequestError"
+)
+
+const authorizationHeader = "Authorization"
+
+// SignRequestHandler is a named request handler the SDK will use to sign
+// service client request with a bearer token.
+var SignRequestHandler = request.NamedHandler{
+	Name: "bearer.SignRequestHandler", Fn: SignSDKRequest,
+}
+
+// SignSDKRequest signs an AWS request with the bearer token retrieved from
+// the request's Config.TokenProvider.
+//
+// The bearer token is only sent over HTTPS. Requests with any other URL
+// scheme will fail with an error, as will presigned requests since bearer
+// tokens cannot be added to the request's query string.
+func SignSDKRequest(req *request.Request) {
+	SignSDKRequestWithCurrentTime(req, time.Now)
+}
+
+// SignSDKRequestWithCurrentTime signs the request the same as SignSDKRequest,
+// using curTimeFn as the current time when checking if the token has
+// expired.
+func SignSDKRequestWithCurrentTime(req *request.Request, curTimeFn func() time.Time) {
+	provider := req.Config.TokenProvider
+	if provider == nil {
+		req.Error = awserr.New(ErrCodeMissingTokenProvider,
+			"bearer token provider not set, unable to sign request", nil)
+		return
+	}
+
+	if req.ExpireTime > 0 {
+		req.Error = awserr.New(request.ErrCodeInvalidPresignExpire,
+			"requests signed with a bearer token cannot be presigned", nil)
+		return
+	}
+
+	if req.HTTPRequest.URL.Scheme != "https" {
+		req.Error = awserr.New(ErrCodeInsecureRequest,
+			"bearer token can only be sent over HTTPS, got scheme "+req.HTTPRequest.URL.Scheme, nil)
+		return
+	}
+
+	token, err := provider.RetrieveBearerToken(req.Context())
+	if err != nil {
+		req.Error = awserr.New(ErrCodeRetrieveBearerToken,
+			"failed to retrieve bearer token", err)
+		return
+	}
+	if token.Expired(curTimeFn()) {
+		req.Error = awserr.New(ErrCodeRetrieveBearerToken,
+			"bearer token retrieved has expired", nil)
+		return
+	}
+
+	SignHTTPRequest(req.HTTPRequest, token)
+}
+
+// SignHTTPRequest adds the bearer token to the Authorization header of the
+// HTTP request, replacing any existing value.
+func SignHTTPRequest(r *http.Request, token bearer.Token) {
+	r.Header.Set(authorizationHeader, "Bearer "+token.Value)
+}
@@ -0,0 +1,111 @@
+package bearer
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/auth/bearer"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
+)
+
+func TestSignSDKRequest(t *testing.T) {
+	now := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		Endpoint      string
+		TokenProvider bearer.TokenProvider
+		ExpireTime    time.Duration
+		ExpectErrCode string
+		ExpectHeader  string
+	}{
+		"token": {
+			Endpoint: "https://endpoint",
+			TokenProvider: bearer.StaticTokenProvider{
+				Token: bearer.Token{Value: "abc123"},
+			},
+			ExpectHeader: "Bearer abc123",
+		},
+		"token not expired": {
+			Endpoint: "https://endpoint",
+			TokenProvider: bearer.StaticTokenProvider{
+				Token: bearer.Token{Value: "abc123", CanExpire: true, Expires: now.Add(time.Minute)},
+			},
+			ExpectHeader: "Bearer abc123",
+		},
+		"token expired": {
+			Endpoint: "https://endpoint",
+			TokenProvider: bearer.StaticTokenProvider{
+				Token: bearer.Token{Value: "abc123", CanExpire: true, Expires: now},
+			},
+			ExpectErrCode: ErrCodeRetrieveBearerToken,
+		},
+		"no provider": {
+			Endpoint:      "https://endpoint",
+			ExpectErrCode: ErrCodeMissingTokenProvider,
+		},
+		"provider error": {
+			Endpoint: "https://endpoint",
+			TokenProvider: bearer.TokenProviderFunc(func(aws.Context) (bearer.Token, error) {
+				return bearer.Token{}, fmt.Errorf("token error")
+			}),
+			ExpectErrCode: ErrCodeRetrieveBearerToken,
+		},
+		"http": {
+			Endpoint: "http://endpoint",
+			TokenProvider: bearer.StaticTokenProvider{
+				Token: bearer.Token{Value: "abc123"},
+			},
+			ExpectErrCode: ErrCodeInsecureRequest,
+		},
+		"presign": {
+			Endpoint: "https://endpoint",
+			TokenProvider: bearer.StaticTokenProvider{
+				Token: bearer.Token{Value: "abc123"},
+			},
+			ExpireTime:    time.Minute,
+			ExpectErrCode: request.ErrCodeInvalidPresignExpire,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			svc := awstesting.NewClient(&aws.Config{
+				Endpoint:      aws.String(c.Endpoint),
+				TokenProvider: c.TokenProvider,
+			})
+			r := svc.NewRequest(&request.Operation{
+				Name:       "OpName",
+				HTTPMethod: "GET",
+				HTTPPath:   "/",
+			}, nil, nil)
+			r.ExpireTime = c.ExpireTime
+
+			SignSDKRequestWithCurrentTime(r, func() time.Time { return now })
+
+			if len(c.ExpectErrCode) != 0 {
+				aerr, ok := r.Error.(awserr.Error)
+				if !ok {
+					t.Fatalf("expect awserr.Error, got %T, %v", r.Error, r.Error)
+				}
+				if e, a := c.ExpectErrCode, aerr.Code(); e != a {
+					t.Errorf("expect %v error code, got %v", e, a)
+				}
+				if v := r.HTTPRequest.Header.Get("Authorization"); len(v) != 0 {
+					t.Errorf("expect no Authorization header, got %v", v)
+				}
+				return
+			}
+			if r.Error != nil {
+				t.Fatalf("expect no error, got %v", r.Error)
+			}
+
+			if e, a := c.ExpectHeader, r.HTTPRequest.Header.Get("Authorization"); e != a {
+				t.Errorf("expect %v Authorization header, got %v", e, a)
+			}
+		})
+	}
+}
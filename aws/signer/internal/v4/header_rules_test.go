@@ -5,8 +5,8 @@ import (
 )
 
 func TestRuleCheckWhitelist(t *testing.T) {
-	w := allowList{
-		mapRule{
+	w := AllowList{
+		MapRule{
 			"Cache-Control": struct{}{},
 		},
 	}
@@ -20,8 +20,8 @@ func TestRuleCheckWhitelist(t *testing.T) {
 }
 
 func TestRuleCheckBlacklist(t *testing.T) {
-	b := excludeList{
-		mapRule{
+	b := ExcludeList{
+		MapRule{
 			"Cache-Control": struct{}{},
 		},
 	}
@@ -35,7 +35,7 @@ func TestRuleCheckBlacklist(t *testing.T) {
 }
 
 func TestRuleCheckPattern(t *testing.T) {
-	p := patterns{"X-Amz-Meta-"}
+	p := Patterns{"X-Amz-Meta-"}
 
 	if !p.IsValid("X-Amz-Meta-") {
 		t.Error("expected true value")
@@ -49,17 +49,17 @@ func TestRuleCheckPattern(t *testing.T) {
 }
 
 func TestRuleComplexWhitelist(t *testing.T) {
-	w := rules{
-		allowList{
-			mapRule{
+	w := Rules{
+		AllowList{
+			MapRule{
 				"Cache-Control": struct{}{},
 			},
 		},
-		patterns{"X-Amz-Meta-"},
+		Patterns{"X-Amz-Meta-"},
 	}
 
-	r := rules{
-		inclusiveRules{patterns{"X-Amz-"}, excludeList{w}},
+	r := Rules{
+		InclusiveRules{Patterns{"X-Amz-"}, ExcludeList{w}},
 	}
 
 	if !r.IsValid("X-Amz-Blah") {
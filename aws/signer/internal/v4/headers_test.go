@@ -30,7 +30,7 @@ func TestAllowedQueryHoisting(t *testing.T) {
 
 	for name, c := range cases {
 		t.Run(name, func(t *testing.T) {
-			if e, a := c.ExpectHoist, allowedQueryHoisting.IsValid(c.Header); e != a {
+			if e, a := c.ExpectHoist, AllowedQueryHoisting.IsValid(c.Header); e != a {
 				t.Errorf("expect hoist %v, was %v", e, a)
 			}
 		})
@@ -8,7 +8,8 @@ import (
 	"strings"
 )
 
-func getURIPath(u *url.URL) string {
+// GetURIPath returns the escaped URI component from the provided URL.
+func GetURIPath(u *url.URL) string {
 	var uri string
 
 	if len(u.Opaque) > 0 {
@@ -0,0 +1,83 @@
+package v4
+
+import (
+	"encoding/hex"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// An AlgorithmSigner signs the string to sign of AWS v4 signed requests
+// with a signing algorithm other than AWS4-HMAC-SHA256. Such as the X.509
+// certificate signing algorithms of IAM Roles Anywhere, which sign the
+// string to sign with the certificate's private key.
+type AlgorithmSigner interface {
+	// Algorithm returns the name of the signing algorithm, used as the
+	// first line of the string to sign, and the Authorization header's
+	// algorithm, (e.g. AWS4-X509-RSA-SHA256).
+	Algorithm() string
+
+	// CredentialID returns the identifier of the signing credentials,
+	// included in the Authorization header's Credential element before the
+	// credential scope.
+	CredentialID() string
+
+	// SignString returns the signature of the string to sign.
+	SignString(stringToSign string) ([]byte, error)
+}
+
+// SignWithAlgorithm signs the request with the AlgorithmSigner, instead of
+// AWS credentials. The canonical request, and string to sign, are built the
+// same as for AWS4-HMAC-SHA256 signed requests, and the signature is added
+// to the request's Authorization header.
+//
+// The body is only read to compute the payload hash, and is not attached to
+// the request. Returns the HTTP headers that were included in the signature.
+func SignWithAlgorithm(r *http.Request, body io.ReadSeeker, service, region string, signTime time.Time, signer AlgorithmSigner) (http.Header, error) {
+	ctx := &signingCtx{
+		Request:         r,
+		Body:            body,
+		Query:           r.URL.Query(),
+		Time:            signTime,
+		ServiceName:     service,
+		Region:          region,
+		algorithmSigner: signer,
+	}
+
+	for key := range ctx.Query {
+		sort.Strings(ctx.Query[key])
+	}
+
+	ctx.sanitizeHostForHeader()
+	if err := ctx.build(false); err != nil {
+		return nil, err
+	}
+
+	return ctx.SignedHeaderVals, nil
+}
+
+// algorithm returns the signing algorithm of the request's signature.
+func (ctx *signingCtx) algorithm() string {
+	if ctx.algorithmSigner != nil {
+		return ctx.algorithmSigner.Algorithm()
+	}
+	return authHeaderPrefix
+}
+
+// credentialID returns the identifier of the request's signing credentials.
+func (ctx *signingCtx) credentialID() string {
+	if ctx.algorithmSigner != nil {
+		return ctx.algorithmSigner.CredentialID()
+	}
+	return ctx.credValues.AccessKeyID
+}
+
+func (ctx *signingCtx) buildAlgorithmSignature() error {
+	signature, err := ctx.algorithmSigner.SignString(ctx.stringToSign)
+	if err != nil {
+		return err
+	}
+	ctx.signature = hex.EncodeToString(signature)
+	return nil
+}
@@ -0,0 +1,78 @@
+package v4
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+type stubAlgorithmSigner struct {
+	stringToSign string
+}
+
+func (s *stubAlgorithmSigner) Algorithm() string    { return "AWS4-STUB-SHA256" }
+func (s *stubAlgorithmSigner) CredentialID() string { return "1234" }
+func (s *stubAlgorithmSigner) SignString(stringToSign string) ([]byte, error) {
+	s.stringToSign = stringToSign
+	return []byte{0xab, 0xcd}, nil
+}
+
+func TestSignWithAlgorithm(t *testing.T) {
+	req, body := buildRequest("rolesanywhere", "us-east-1", `{"durationSeconds":3600}`)
+	signer := &stubAlgorithmSigner{}
+
+	signTime := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	_, err := SignWithAlgorithm(req, body, "rolesanywhere", "us-east-1", signTime, signer)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := "20221001T120000Z", req.Header.Get("X-Amz-Date"); e != a {
+		t.Errorf("expect %v date, got %v", e, a)
+	}
+
+	auth := req.Header.Get("Authorization")
+	if e, a := "AWS4-STUB-SHA256 Credential=1234/20221001/us-east-1/rolesanywhere/aws4_request, SignedHeaders=", auth; !strings.HasPrefix(a, e) {
+		t.Errorf("expect Authorization to start with %v, got %v", e, a)
+	}
+	if e, a := ", Signature=abcd", auth; !strings.HasSuffix(a, e) {
+		t.Errorf("expect Authorization to end with %v, got %v", e, a)
+	}
+
+	lines := strings.Split(signer.stringToSign, "\n")
+	if e, a := 4, len(lines); e != a {
+		t.Fatalf("expect %v string to sign lines, got %v", e, a)
+	}
+	expect := []string{"AWS4-STUB-SHA256", "20221001T120000Z", "20221001/us-east-1/rolesanywhere/aws4_request"}
+	for i, e := range expect {
+		if a := lines[i]; e != a {
+			t.Errorf("expect %v string to sign line %d, got %v", e, i, a)
+		}
+	}
+}
+
+func TestSignWithAlgorithm_SameCanonicalRequest(t *testing.T) {
+	signTime := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	// The string to sign only differs from an AWS4-HMAC-SHA256 signed
+	// request's by the algorithm.
+	req, body := buildRequest("dynamodb", "us-east-1", "{}")
+	signer := &stubAlgorithmSigner{}
+	if _, err := SignWithAlgorithm(req, body, "dynamodb", "us-east-1", signTime, signer); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	req, body = buildRequest("dynamodb", "us-east-1", "{}")
+	v4 := Signer{Credentials: credentials.NewStaticCredentials("AKID", "SECRET", "")}
+	ctx, err := v4.signRequest(req, body, "dynamodb", "us-east-1", 0, false, signTime)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expect := strings.Replace(ctx.stringToSign, authHeaderPrefix, "AWS4-STUB-SHA256", 1)
+	if e, a := expect, signer.stringToSign; e != a {
+		t.Errorf("expect %v string to sign, got %v", e, a)
+	}
+}
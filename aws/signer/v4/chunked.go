@@ -0,0 +1,415 @@
+package v4
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// DefaultChunkSize is the size of the chunks a payload is split into when
+	// it is sent with the aws-chunked content encoding.
+	DefaultChunkSize = 64 * 1024
+
+	// minChunkSize is the smallest chunk size, other than the final chunks,
+	// accepted by Amazon S3.
+	minChunkSize = 8 * 1024
+
+	streamingPayload                = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+	streamingPayloadTrailer         = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD-TRAILER"
+	streamingUnsignedPayloadTrailer = "STREAMING-UNSIGNED-PAYLOAD-TRAILER"
+
+	awsChunkedEncoding = "aws-chunked"
+
+	contentLengthHeader        = "Content-Length"
+	contentEncodingHeader      = "Content-Encoding"
+	decodedContentLengthHeader = "X-Amz-Decoded-Content-Length"
+	trailerHeader              = "X-Amz-Trailer"
+	checksumAlgorithmHeader    = "X-Amz-Sdk-Checksum-Algorithm"
+	checksumHeaderPrefix       = "x-amz-checksum-"
+
+	chunkSignatureElem      = ";chunk-signature="
+	trailerSignatureElem    = "x-amz-trailer-signature:"
+	crlf                    = "\r\n"
+	hexEncodedSignatureSize = sha256.Size * 2
+)
+
+// ChunkedPayload is a request payload that will be sent with the aws-chunked
+// content encoding. The payload is split into chunks that are signed as they
+// are read, so the payload does not need to be seekable, but its length must
+// be known before the request is signed.
+type ChunkedPayload struct {
+	// The payload of the request. If the Body is an io.Closer it will be
+	// closed when the request's body is closed.
+	Body io.Reader
+
+	// The number of bytes of the Body that will be sent.
+	Length int64
+
+	// The size of the chunks the Body is split into. Defaults to
+	// DefaultChunkSize if zero. Must be at least 8 KiB.
+	ChunkSize int
+
+	// The checksum algorithm, (e.g. CRC32, CRC32C, SHA1, or SHA256), used to
+	// compute the checksum of the Body sent as a trailing x-amz-checksum-*
+	// header after the final chunk. No checksum is sent if empty.
+	ChecksumAlgorithm string
+}
+
+// SignChunked signs AWS v4 requests whose payload is sent with the aws-chunked
+// content encoding. The request's headers are signed with a seed signature,
+// and the request's Body is replaced with a reader that encodes the payload,
+// signing each chunk with the signature of the previous chunk.
+//
+// The request's ContentLength is set to the length of the encoded payload,
+// and the Content-Encoding, X-Amz-Decoded-Content-Length, and X-Amz-Trailer
+// headers are set for the payload. The DisableRequestBodyOverwrite option is
+// ignored.
+//
+// If the Signer's UnsignedPayload option is set the chunks are not signed,
+// and the payload is sent as STREAMING-UNSIGNED-PAYLOAD-TRAILER. This
+// requires a ChecksumAlgorithm to be set so the integrity of the payload can
+// still be validated by the service.
+func (v4 Signer) SignChunked(r *http.Request, payload ChunkedPayload, service, region string, signTime time.Time) (http.Header, error) {
+	enc, err := newChunkedEncoder(payload, !v4.UnsignedPayload)
+	if err != nil {
+		return nil, err
+	}
+	enc.setHeaders(r)
+
+	ctx, err := v4.signRequest(r, nil, service, region, 0, false, signTime)
+	if err != nil {
+		return nil, err
+	}
+
+	if enc.signed {
+		seedSignature, err := hex.DecodeString(ctx.signature)
+		if err != nil {
+			return nil, err
+		}
+		// Chunks are signed with the credentials the seed signature was
+		// created with, in case the credentials are refreshed while the
+		// payload is read.
+		enc.signer = &StreamSigner{
+			region:      region,
+			service:     service,
+			credentials: staticCredentialValue(ctx.credValues),
+			prevSig:     seedSignature,
+		}
+		enc.signTime = ctx.Time
+	}
+	r.Body = enc
+
+	return ctx.SignedHeaderVals, nil
+}
+
+// signSDKRequestChunked signs the SDK request with the aws-chunked content
+// encoding. Returns false if the request should be signed normally instead.
+func (v4 Signer) signSDKRequestChunked(req *request.Request, service, region string, signTime time.Time) (http.Header, bool, error) {
+	checksumAlgorithm := req.HTTPRequest.Header.Get(checksumAlgorithmHeader)
+	if checksumAlgorithm != "" &&
+		req.HTTPRequest.Header.Get(checksumHeaderPrefix+strings.ToLower(checksumAlgorithm)) != "" {
+		// The checksum was already computed, and is sent as a header.
+		checksumAlgorithm = ""
+	}
+	if v4.UnsignedPayload && checksumAlgorithm == "" {
+		// Unsigned payloads without a trailer do not need to be encoded.
+		return nil, false, nil
+	}
+
+	// The decoded length is used if the request was already signed and its
+	// ContentLength is the length of the encoded payload.
+	length := req.HTTPRequest.ContentLength
+	if v := req.HTTPRequest.Header.Get(decodedContentLengthHeader); v != "" {
+		length, _ = strconv.ParseInt(v, 10, 64)
+	}
+	if length <= 0 {
+		return nil, true, fmt.Errorf("cannot use unseekable request body %T of unknown length, for aws-chunked request", req.Body)
+	}
+
+	// Requests re-signed before they are sent already have their body
+	// encoded.
+	body := io.Reader(req.HTTPRequest.Body)
+	if enc, ok := body.(*chunkedEncoder); ok {
+		body = enc.body
+	}
+
+	signedHeaders, err := v4.SignChunked(req.HTTPRequest, ChunkedPayload{
+		Body:              body,
+		Length:            length,
+		ChecksumAlgorithm: checksumAlgorithm,
+	}, service, region, signTime)
+
+	return signedHeaders, true, err
+}
+
+// GetTrailerSignature takes the trailing headers of an aws-chunked encoded
+// payload, and returns their signature chained to the signature of the final
+// chunk.
+func (s *StreamSigner) GetTrailerSignature(trailer []byte, date time.Time) ([]byte, error) {
+	credValue, err := s.credentials.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	sigKey := deriveSigningKey(s.region, s.service, credValue.SecretAccessKey, date)
+
+	keyPath := buildSigningScope(s.region, s.service, date)
+
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256-TRAILER",
+		formatTime(date),
+		keyPath,
+		hex.EncodeToString(s.prevSig),
+		hex.EncodeToString(hashSHA256(trailer)),
+	}, "\n")
+
+	signature := hmacSHA256(sigKey, []byte(stringToSign))
+	s.prevSig = signature
+
+	return signature, nil
+}
+
+type staticCredentialValue credentials.Value
+
+func (v staticCredentialValue) Get() (credentials.Value, error) {
+	return credentials.Value(v), nil
+}
+
+// chunkedEncoder is an io.ReadCloser that encodes the payload with the
+// aws-chunked content encoding as it is read.
+type chunkedEncoder struct {
+	body      io.Reader
+	length    int64
+	chunkSize int
+
+	checksum    hash.Hash
+	trailerName string
+
+	signed   bool
+	signer   *StreamSigner
+	signTime time.Time
+
+	read  int64
+	chunk []byte
+	buf   bytes.Buffer
+	err   error
+}
+
+func newChunkedEncoder(payload ChunkedPayload, signed bool) (*chunkedEncoder, error) {
+	chunkSize := payload.ChunkSize
+	if chunkSize == 0 {
+		chunkSize = DefaultChunkSize
+	}
+	if chunkSize < minChunkSize {
+		return nil, fmt.Errorf("aws-chunked chunk size %d is less than the minimum size %d", chunkSize, minChunkSize)
+	}
+	if payload.Length < 0 {
+		return nil, fmt.Errorf("invalid aws-chunked payload length %d", payload.Length)
+	}
+
+	enc := &chunkedEncoder{
+		body:      payload.Body,
+		length:    payload.Length,
+		chunkSize: chunkSize,
+		signed:    signed,
+	}
+
+	if payload.ChecksumAlgorithm != "" {
+		checksum, err := newChecksumHash(payload.ChecksumAlgorithm)
+		if err != nil {
+			return nil, err
+		}
+		enc.checksum = checksum
+		enc.trailerName = checksumHeaderPrefix + strings.ToLower(payload.ChecksumAlgorithm)
+	} else if !signed {
+		return nil, fmt.Errorf("unsigned aws-chunked payload requires a checksum algorithm")
+	}
+
+	return enc, nil
+}
+
+func newChecksumHash(algorithm string) (hash.Hash, error) {
+	switch strings.ToUpper(algorithm) {
+	case "CRC32":
+		return crc32.NewIEEE(), nil
+	case "CRC32C":
+		return crc32.New(crc32.MakeTable(crc32.Castagnoli)), nil
+	case "SHA1":
+		return sha1.New(), nil
+	case "SHA256":
+		return sha256.New(), nil
+	default:
+		return nil, fmt.Errorf("unsupported aws-chunked checksum algorithm, %s", algorithm)
+	}
+}
+
+// setHeaders sets the headers describing the encoded payload on the request.
+func (e *chunkedEncoder) setHeaders(r *http.Request) {
+	var payloadHash string
+	switch {
+	case !e.signed:
+		payloadHash = streamingUnsignedPayloadTrailer
+	case e.checksum != nil:
+		payloadHash = streamingPayloadTrailer
+	default:
+		payloadHash = streamingPayload
+	}
+	r.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	if v := r.Header.Get(contentEncodingHeader); v == "" {
+		r.Header.Set(contentEncodingHeader, awsChunkedEncoding)
+	} else if !strings.HasPrefix(v, awsChunkedEncoding) {
+		r.Header.Set(contentEncodingHeader, awsChunkedEncoding+","+v)
+	}
+
+	r.Header.Set(decodedContentLengthHeader, strconv.FormatInt(e.length, 10))
+	if e.checksum != nil {
+		r.Header.Set(trailerHeader, e.trailerName)
+	}
+
+	r.ContentLength = e.encodedLength()
+	if r.Header.Get(contentLengthHeader) != "" {
+		r.Header.Set(contentLengthHeader, strconv.FormatInt(r.ContentLength, 10))
+	}
+}
+
+// encodedLength returns the length of the payload once encoded.
+func (e *chunkedEncoder) encodedLength() int64 {
+	size := int64(e.chunkSize)
+
+	n := (e.length / size) * e.chunkLength(size)
+	if rem := e.length % size; rem > 0 {
+		n += e.chunkLength(rem)
+	}
+	n += e.chunkLength(0)
+
+	if e.checksum != nil {
+		n += int64(len(e.trailerName) + 1 +
+			base64.StdEncoding.EncodedLen(e.checksum.Size()) + len(crlf))
+		if e.signed {
+			n += int64(len(trailerSignatureElem) + hexEncodedSignatureSize + len(crlf))
+		}
+	}
+
+	return n + int64(len(crlf))
+}
+
+// chunkLength returns the length of an encoded chunk of size bytes.
+func (e *chunkedEncoder) chunkLength(size int64) int64 {
+	n := int64(len(strconv.FormatInt(size, 16))+len(crlf)) + size
+	if size > 0 {
+		n += int64(len(crlf))
+	}
+	if e.signed {
+		n += int64(len(chunkSignatureElem) + hexEncodedSignatureSize)
+	}
+	return n
+}
+
+func (e *chunkedEncoder) Read(p []byte) (int, error) {
+	for e.buf.Len() == 0 && e.err == nil {
+		e.err = e.encodeNext()
+	}
+	if e.buf.Len() == 0 {
+		return 0, e.err
+	}
+	return e.buf.Read(p)
+}
+
+// Close closes the payload's body if it is an io.Closer.
+func (e *chunkedEncoder) Close() error {
+	if c, ok := e.body.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// encodeNext encodes the next chunk of the payload. Returns io.EOF once the
+// final chunk and trailer have been encoded.
+func (e *chunkedEncoder) encodeNext() error {
+	size := int64(e.chunkSize)
+	if remaining := e.length - e.read; remaining < size {
+		size = remaining
+	}
+	if size == 0 {
+		return e.encodeFinal()
+	}
+
+	if e.chunk == nil {
+		e.chunk = make([]byte, e.chunkSize)
+	}
+	chunk := e.chunk[:size]
+	if _, err := io.ReadFull(e.body, chunk); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return fmt.Errorf("aws-chunked payload body ended before expected length %d", e.length)
+		}
+		return err
+	}
+	e.read += size
+
+	if e.checksum != nil {
+		e.checksum.Write(chunk)
+	}
+
+	return e.encodeChunk(chunk)
+}
+
+func (e *chunkedEncoder) encodeChunk(chunk []byte) error {
+	e.buf.WriteString(strconv.FormatInt(int64(len(chunk)), 16))
+	if e.signer != nil {
+		sig, err := e.signer.GetSignature(nil, chunk, e.signTime)
+		if err != nil {
+			return err
+		}
+		e.buf.WriteString(chunkSignatureElem)
+		e.buf.WriteString(hex.EncodeToString(sig))
+	}
+	e.buf.WriteString(crlf)
+
+	if len(chunk) > 0 {
+		e.buf.Write(chunk)
+		e.buf.WriteString(crlf)
+	}
+
+	return nil
+}
+
+func (e *chunkedEncoder) encodeFinal() error {
+	if err := e.encodeChunk(nil); err != nil {
+		return err
+	}
+
+	if e.checksum != nil {
+		trailer := e.trailerName + ":" +
+			base64.StdEncoding.EncodeToString(e.checksum.Sum(nil))
+		e.buf.WriteString(trailer)
+		e.buf.WriteString(crlf)
+
+		if e.signer != nil {
+			sig, err := e.signer.GetTrailerSignature([]byte(trailer+"\n"), e.signTime)
+			if err != nil {
+				return err
+			}
+			e.buf.WriteString(trailerSignatureElem)
+			e.buf.WriteString(hex.EncodeToString(sig))
+			e.buf.WriteString(crlf)
+		}
+	}
+	e.buf.WriteString(crlf)
+
+	return io.EOF
+}
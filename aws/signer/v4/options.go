@@ -5,3 +5,9 @@ package v4
 func WithUnsignedPayload(v4 *Signer) {
 	v4.UnsignedPayload = true
 }
+
+// WithStreamingPayload will enable and set the StreamingPayload field to
+// true of the signer.
+func WithStreamingPayload(v4 *Signer) {
+	v4.StreamingPayload = true
+}
@@ -0,0 +1,157 @@
+package v4
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+// DefaultPresignExpires is the duration requests presigned by the Transport
+// are valid for by default.
+const DefaultPresignExpires = 15 * time.Minute
+
+// Transport is an http.RoundTripper that signs requests with AWS v4
+// signatures before they are sent. This allows any HTTP client to make
+// requests to HTTP APIs authenticated with AWS credentials, (e.g. API Gateway
+// endpoints using IAM authorization, Lambda function URLs, or Amazon
+// OpenSearch Service domains).
+//
+// Request bodies that are not seekable are read into memory so the payload
+// can be signed, unless DisableBodyBuffering is set.
+//
+//	client := &http.Client{
+//		Transport: v4.NewTransport(sess.Config.Credentials, "execute-api", "us-west-2"),
+//	}
+//	resp, err := client.Get("https://abc123.execute-api.us-west-2.amazonaws.com/prod/items")
+type Transport struct {
+	// The transport that sends the signed requests. Defaults to
+	// http.DefaultTransport if nil.
+	Base http.RoundTripper
+
+	// The signer used to sign requests. The signer's Credentials are
+	// retrieved for each request, so credentials are refreshed when they
+	// expire.
+	Signer *Signer
+
+	// The service name and region requests are signed for.
+	Service string
+	Region  string
+
+	// Presign will sign requests with the query string, instead of the
+	// Authorization header.
+	Presign bool
+
+	// The duration presigned requests are valid for. Defaults to
+	// DefaultPresignExpires if zero.
+	PresignExpires time.Duration
+
+	// Disables reading request bodies that are not seekable into memory.
+	// Request bodies that are not seekable are sent with an unsigned payload
+	// instead. This will only work for services that have support for this.
+	DisableBodyBuffering bool
+
+	// currentTimeFn returns the time value which represents the current time.
+	// This value should only be used for testing. If it is nil the default
+	// time.Now will be used.
+	currentTimeFn func() time.Time
+}
+
+// NewTransport returns a Transport pointer that signs requests with the
+// credentials for the service and region. The transport's Signer is created
+// with the credentials.
+func NewTransport(credentials *credentials.Credentials, service, region string, options ...func(*Transport)) *Transport {
+	t := &Transport{
+		Signer:  NewSigner(credentials),
+		Service: service,
+		Region:  region,
+	}
+
+	for _, option := range options {
+		option(t)
+	}
+
+	return t
+}
+
+// RoundTrip signs a copy of the request, and sends it with the base
+// transport. The request passed in is not modified.
+func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	req := r.Clone(r.Context())
+
+	signer := *t.Signer
+	signer.DisableRequestBodyOverwrite = true
+
+	body, err := t.signedBody(req, &signer)
+	if err != nil {
+		closeBody(r)
+		return nil, err
+	}
+
+	currentTimeFn := t.currentTimeFn
+	if currentTimeFn == nil {
+		currentTimeFn = time.Now
+	}
+
+	if t.Presign {
+		exp := t.PresignExpires
+		if exp == 0 {
+			exp = DefaultPresignExpires
+		}
+		_, err = signer.Presign(req, body, t.Service, t.Region, exp, currentTimeFn())
+	} else {
+		_, err = signer.Sign(req, body, t.Service, t.Region, currentTimeFn())
+	}
+	if err != nil {
+		closeBody(r)
+		return nil, err
+	}
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return base.RoundTrip(req)
+}
+
+// signedBody returns the body the request is signed with. The body of the
+// request is replaced with an in-memory copy if it is not seekable, unless
+// body buffering is disabled, in which case the payload is not signed.
+func (t *Transport) signedBody(req *http.Request, signer *Signer) (io.ReadSeeker, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	if body, ok := req.Body.(io.ReadSeeker); ok {
+		return body, nil
+	}
+
+	if t.DisableBodyBuffering {
+		signer.UnsignedPayload = true
+		return nil, nil
+	}
+
+	b, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	req.ContentLength = int64(len(b))
+	req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(b)), nil
+	}
+
+	return bytes.NewReader(b), nil
+}
+
+func closeBody(r *http.Request) {
+	if r.Body != nil {
+		r.Body.Close()
+	}
+}
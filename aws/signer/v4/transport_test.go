@@ -0,0 +1,191 @@
+package v4
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+type rotatingProvider struct {
+	retrieved int
+}
+
+func (p *rotatingProvider) Retrieve() (credentials.Value, error) {
+	p.retrieved++
+	return credentials.Value{
+		AccessKeyID:     fmt.Sprintf("AKID%d", p.retrieved),
+		SecretAccessKey: fmt.Sprintf("SECRET%d", p.retrieved),
+	}, nil
+}
+
+// IsExpired always returns true so credentials are retrieved for each
+// request.
+func (p *rotatingProvider) IsExpired() bool { return true }
+
+func TestTransport(t *testing.T) {
+	cases := map[string]struct {
+		Method         string
+		Body           func() io.Reader
+		Options        func(*Transport)
+		ExpectBody     string
+		ExpectPresign  bool
+		ExpectUnsigned bool
+	}{
+		"no body": {
+			Method: "GET",
+		},
+		"seekable body": {
+			Method:     "POST",
+			Body:       func() io.Reader { return strings.NewReader(`{"a":"b"}`) },
+			ExpectBody: `{"a":"b"}`,
+		},
+		"unseekable body": {
+			Method: "PUT",
+			Body: func() io.Reader {
+				return ioutil.NopCloser(onlyReader{bytes.NewReader([]byte("unseekable content"))})
+			},
+			ExpectBody: "unseekable content",
+		},
+		"unseekable body without buffering": {
+			Method: "PUT",
+			Body: func() io.Reader {
+				return ioutil.NopCloser(onlyReader{bytes.NewReader([]byte("unseekable content"))})
+			},
+			Options: func(t *Transport) {
+				t.DisableBodyBuffering = true
+			},
+			ExpectBody:     "unseekable content",
+			ExpectUnsigned: true,
+		},
+		"presign": {
+			Method: "GET",
+			Options: func(t *Transport) {
+				t.Presign = true
+			},
+			ExpectPresign: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var verifyErr error
+			var verified *VerifiedRequest
+			var body []byte
+			var contentHash string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ = ioutil.ReadAll(r.Body)
+				contentHash = r.Header.Get("X-Amz-Content-Sha256")
+				verified, verifyErr = NewVerifier(verifierCredentials).Verify(r, bytes.NewReader(body))
+			}))
+			defer server.Close()
+
+			transport := NewTransport(credentials.NewStaticCredentials("AKID", "SECRET", ""),
+				"execute-api", "us-west-2")
+			if c.Options != nil {
+				c.Options(transport)
+			}
+			client := &http.Client{Transport: transport}
+
+			var reqBody io.Reader
+			if c.Body != nil {
+				reqBody = c.Body()
+			}
+			req, err := http.NewRequest(c.Method, server.URL+"/prod/items?b=2&a=1", reqBody)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			resp.Body.Close()
+
+			if verifyErr != nil {
+				t.Fatalf("expect no verify error, got %v", verifyErr)
+			}
+			if e, a := c.ExpectPresign, verified.Presigned; e != a {
+				t.Errorf("expect presigned %v, got %v", e, a)
+			}
+			if e, a := c.ExpectBody, string(body); e != a {
+				t.Errorf("expect body %v, got %v", e, a)
+			}
+			if e, a := c.ExpectUnsigned, contentHash == "UNSIGNED-PAYLOAD"; e != a {
+				t.Errorf("expect unsigned payload %v, got %v", e, a)
+			}
+
+			if v := req.Header.Get("Authorization"); len(v) != 0 {
+				t.Errorf("expect request not to be modified, got Authorization %v", v)
+			}
+			if e, a := "b=2&a=1", req.URL.RawQuery; e != a {
+				t.Errorf("expect request query not to be modified, got %v", a)
+			}
+		})
+	}
+}
+
+func TestTransport_RefreshCredentials(t *testing.T) {
+	var accessKeyIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		verified, err := NewVerifier(CredentialsLookupFunc(
+			func(ctx aws.Context, accessKeyID string) (credentials.Value, error) {
+				return credentials.Value{
+					SecretAccessKey: strings.Replace(accessKeyID, "AKID", "SECRET", 1),
+				}, nil
+			})).Verify(r, nil)
+		if err != nil {
+			t.Errorf("expect no verify error, got %v", err)
+			return
+		}
+		accessKeyIDs = append(accessKeyIDs, verified.AccessKeyID)
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: NewTransport(credentials.NewCredentials(&rotatingProvider{}), "lambda", "us-west-2"),
+	}
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	if e, a := "AKID1,AKID2", strings.Join(accessKeyIDs, ","); e != a {
+		t.Errorf("expect access key IDs %v, got %v", e, a)
+	}
+}
+
+func TestTransport_SignError(t *testing.T) {
+	closed := false
+	body := &closeTracker{Reader: strings.NewReader("abc"), closed: &closed}
+
+	transport := NewTransport(credentials.NewStaticCredentials("", "", ""), "execute-api", "us-west-2")
+
+	req, _ := http.NewRequest("POST", "https://example.com", body)
+	if _, err := transport.RoundTrip(req); err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if !closed {
+		t.Errorf("expect request body to be closed")
+	}
+}
+
+type closeTracker struct {
+	io.Reader
+	closed *bool
+}
+
+func (c *closeTracker) Close() error {
+	*c.closed = true
+	return nil
+}
@@ -71,7 +71,9 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/request"
+	v4Internal "github.com/aws/aws-sdk-go/aws/signer/internal/v4"
 	"github.com/aws/aws-sdk-go/internal/sdkio"
+	"github.com/aws/aws-sdk-go/internal/sdklog"
 	"github.com/aws/aws-sdk-go/private/protocol/rest"
 )
 
@@ -89,74 +91,6 @@ const (
 	emptyStringSHA256 = `e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855`
 )
 
-var ignoredHeaders = rules{
-	excludeList{
-		mapRule{
-			authorizationHeader: struct{}{},
-			"User-Agent":        struct{}{},
-			"X-Amzn-Trace-Id":   struct{}{},
-		},
-	},
-}
-
-// requiredSignedHeaders is a allow list for build canonical headers.
-var requiredSignedHeaders = rules{
-	allowList{
-		mapRule{
-			"Cache-Control":                         struct{}{},
-			"Content-Disposition":                   struct{}{},
-			"Content-Encoding":                      struct{}{},
-			"Content-Language":                      struct{}{},
-			"Content-Md5":                           struct{}{},
-			"Content-Type":                          struct{}{},
-			"Expires":                               struct{}{},
-			"If-Match":                              struct{}{},
-			"If-Modified-Since":                     struct{}{},
-			"If-None-Match":                         struct{}{},
-			"If-Unmodified-Since":                   struct{}{},
-			"Range":                                 struct{}{},
-			"X-Amz-Acl":                             struct{}{},
-			"X-Amz-Copy-Source":                     struct{}{},
-			"X-Amz-Copy-Source-If-Match":            struct{}{},
-			"X-Amz-Copy-Source-If-Modified-Since":   struct{}{},
-			"X-Amz-Copy-Source-If-None-Match":       struct{}{},
-			"X-Amz-Copy-Source-If-Unmodified-Since": struct{}{},
-			"X-Amz-Copy-Source-Range":               struct{}{},
-			"X-Amz-Copy-Source-Server-Side-Encryption-Customer-Algorithm": struct{}{},
-			"X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key":       struct{}{},
-			"X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key-Md5":   struct{}{},
-			"X-Amz-Expected-Bucket-Owner":                                 struct{}{},
-			"X-Amz-Grant-Full-control":                                    struct{}{},
-			"X-Amz-Grant-Read":                                            struct{}{},
-			"X-Amz-Grant-Read-Acp":                                        struct{}{},
-			"X-Amz-Grant-Write":                                           struct{}{},
-			"X-Amz-Grant-Write-Acp":                                       struct{}{},
-			"X-Amz-Metadata-Directive":                                    struct{}{},
-			"X-Amz-Mfa":                                                   struct{}{},
-			"X-Amz-Request-Payer":                                         struct{}{},
-			"X-Amz-Server-Side-Encryption":                                struct{}{},
-			"X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id":                 struct{}{},
-			"X-Amz-Server-Side-Encryption-Context":                        struct{}{},
-			"X-Amz-Server-Side-Encryption-Customer-Algorithm":             struct{}{},
-			"X-Amz-Server-Side-Encryption-Customer-Key":                   struct{}{},
-			"X-Amz-Server-Side-Encryption-Customer-Key-Md5":               struct{}{},
-			"X-Amz-Storage-Class":                                         struct{}{},
-			"X-Amz-Tagging":                                               struct{}{},
-			"X-Amz-Website-Redirect-Location":                             struct{}{},
-			"X-Amz-Content-Sha256":                                        struct{}{},
-		},
-	},
-	patterns{"X-Amz-Meta-"},
-	patterns{"X-Amz-Object-Lock-"},
-}
-
-// allowedHoisting is a allow list for build query headers. The boolean value
-// represents whether or not it is a pattern.
-var allowedQueryHoisting = inclusiveRules{
-	excludeList{requiredSignedHeaders},
-	patterns{"X-Amz-"},
-}
-
 // Signer applies AWS v4 signing to given request. Use this to sign requests
 // that need to be signed with AWS V4 Signatures.
 type Signer struct {
@@ -208,6 +142,14 @@ type Signer struct {
 	// UnsignedPayload will prevent signing of the payload. This will only
 	// work for services that have support for this.
 	UnsignedPayload bool
+
+	// StreamingPayload will sign SDK requests with bodies that are not
+	// seekable using the aws-chunked content encoding, instead of failing to
+	// sign them. Each chunk of the payload is signed as it is sent, and the
+	// checksum selected by the request's X-Amz-Sdk-Checksum-Algorithm header
+	// is sent as a trailing header. The length of the body must be known.
+	// This will only work for services that have support for this.
+	StreamingPayload bool
 }
 
 // NewSigner returns a Signer pointer configured with the credentials and optional
@@ -241,6 +183,9 @@ type signingCtx struct {
 	isPresign       bool
 	unsignedPayload bool
 
+	// signs the string to sign instead of the credentials, if set.
+	algorithmSigner AlgorithmSigner
+
 	bodyDigest       string
 	signedHeaders    string
 	canonicalHeaders string
@@ -315,6 +260,31 @@ func (v4 Signer) Presign(r *http.Request, body io.ReadSeeker, service, region st
 }
 
 func (v4 Signer) signWithBody(r *http.Request, body io.ReadSeeker, service, region string, exp time.Duration, isPresign bool, signTime time.Time) (http.Header, error) {
+	ctx, err := v4.signRequest(r, body, service, region, exp, isPresign, signTime)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the request is not presigned the body should be attached to it. This
+	// prevents the confusion of wanting to send a signed request without
+	// the body the request was signed for attached.
+	if !(v4.DisableRequestBodyOverwrite || ctx.isPresign) {
+		var reader io.ReadCloser
+		if body != nil {
+			var ok bool
+			if reader, ok = body.(io.ReadCloser); !ok {
+				reader = ioutil.NopCloser(body)
+			}
+		}
+		r.Body = reader
+	}
+
+	return ctx.SignedHeaderVals, nil
+}
+
+// signRequest signs the request, returning the signing context the request
+// was signed with.
+func (v4 Signer) signRequest(r *http.Request, body io.ReadSeeker, service, region string, exp time.Duration, isPresign bool, signTime time.Time) (*signingCtx, error) {
 	currentTimeFn := v4.currentTimeFn
 	if currentTimeFn == nil {
 		currentTimeFn = time.Now
@@ -345,7 +315,7 @@ func (v4 Signer) signWithBody(r *http.Request, body io.ReadSeeker, service, regi
 	var err error
 	ctx.credValues, err = v4.Credentials.GetWithContext(requestContext(r))
 	if err != nil {
-		return http.Header{}, err
+		return nil, err
 	}
 
 	ctx.sanitizeHostForHeader()
@@ -354,25 +324,11 @@ func (v4 Signer) signWithBody(r *http.Request, body io.ReadSeeker, service, regi
 		return nil, err
 	}
 
-	// If the request is not presigned the body should be attached to it. This
-	// prevents the confusion of wanting to send a signed request without
-	// the body the request was signed for attached.
-	if !(v4.DisableRequestBodyOverwrite || ctx.isPresign) {
-		var reader io.ReadCloser
-		if body != nil {
-			var ok bool
-			if reader, ok = body.(io.ReadCloser); !ok {
-				reader = ioutil.NopCloser(body)
-			}
-		}
-		r.Body = reader
-	}
-
 	if v4.Debug.Matches(aws.LogDebugWithSigning) {
 		v4.logSigningInfo(ctx)
 	}
 
-	return ctx.SignedHeaderVals, nil
+	return ctx, nil
 }
 
 func (ctx *signingCtx) sanitizeHostForHeader() {
@@ -481,9 +437,19 @@ func SignSDKRequestWithCurrentTime(req *request.Request, curTimeFn func() time.T
 	}
 
 	curTime := curTimeFn()
-	signedHeaders, err := v4.signWithBody(req.HTTPRequest, req.GetBody(),
-		name, region, req.ExpireTime, req.ExpireTime > 0, curTime,
-	)
+
+	var signedHeaders http.Header
+	var signed bool
+	var err error
+	if v4.StreamingPayload && req.ExpireTime == 0 &&
+		req.Body != nil && !aws.IsReaderSeekable(req.Body) {
+		signedHeaders, signed, err = v4.signSDKRequestChunked(req, name, region, curTime)
+	}
+	if !signed && err == nil {
+		signedHeaders, err = v4.signWithBody(req.HTTPRequest, req.GetBody(),
+			name, region, req.ExpireTime, req.ExpireTime > 0, curTime,
+		)
+	}
 	if err != nil {
 		req.Error = err
 		req.SignedHeaderVals = nil
@@ -505,12 +471,27 @@ const logSignedURLMsg = `
 %s`
 
 func (v4 *Signer) logSigningInfo(ctx *signingCtx) {
+	// The session token is included in the canonical string, and presigned
+	// URL, when it is signed.
+	redactor := sdklog.NewRedactor(nil)
+	canonicalString := sdklog.RedactString(ctx.canonicalString, ctx.credValues.SessionToken)
+
 	signedURLMsg := ""
+	signedURL := ""
 	if ctx.isPresign {
-		signedURLMsg = fmt.Sprintf(logSignedURLMsg, ctx.Request.URL.String())
+		signedURL = redactor.RedactURL(ctx.Request.URL).String()
+		signedURLMsg = fmt.Sprintf(logSignedURLMsg, signedURL)
 	}
-	msg := fmt.Sprintf(logSignInfoMsg, ctx.canonicalString, ctx.stringToSign, signedURLMsg)
-	v4.Logger.Log(msg)
+	msg := fmt.Sprintf(logSignInfoMsg, canonicalString, ctx.stringToSign, signedURLMsg)
+
+	fields := []aws.LogField{
+		sdklog.Field("canonical_string", canonicalString),
+		sdklog.Field("string_to_sign", ctx.stringToSign),
+	}
+	if ctx.isPresign {
+		fields = append(fields, sdklog.Field("signed_url", signedURL))
+	}
+	sdklog.Log(v4.Logger, aws.LogSeverityDebug, "request signature", msg, fields...)
 }
 
 func (ctx *signingCtx) build(disableHeaderHoisting bool) error {
@@ -525,23 +506,29 @@ func (ctx *signingCtx) build(disableHeaderHoisting bool) error {
 	if ctx.isPresign {
 		if !disableHeaderHoisting {
 			urlValues := url.Values{}
-			urlValues, unsignedHeaders = buildQuery(allowedQueryHoisting, unsignedHeaders) // no depends
+			urlValues, unsignedHeaders = buildQuery(v4Internal.AllowedQueryHoisting, unsignedHeaders) // no depends
 			for k := range urlValues {
 				ctx.Query[k] = urlValues[k]
 			}
 		}
 	}
 
-	ctx.buildCanonicalHeaders(ignoredHeaders, unsignedHeaders)
+	ctx.buildCanonicalHeaders(v4Internal.IgnoredHeaders, unsignedHeaders)
 	ctx.buildCanonicalString() // depends on canon headers / signed headers
 	ctx.buildStringToSign()    // depends on canon string
-	ctx.buildSignature()       // depends on string to sign
+	if ctx.algorithmSigner != nil {
+		if err := ctx.buildAlgorithmSignature(); err != nil {
+			return err
+		}
+	} else {
+		ctx.buildSignature() // depends on string to sign
+	}
 
 	if ctx.isPresign {
 		ctx.Request.URL.RawQuery += "&" + signatureQueryKey + "=" + ctx.signature
 	} else {
 		parts := []string{
-			authHeaderPrefix + " Credential=" + ctx.credValues.AccessKeyID + "/" + ctx.credentialString,
+			ctx.algorithm() + " Credential=" + ctx.credentialID() + "/" + ctx.credentialString,
 			"SignedHeaders=" + ctx.signedHeaders,
 			authHeaderSignatureElem + ctx.signature,
 		}
@@ -594,7 +581,7 @@ func (ctx *signingCtx) buildCredentialString() {
 	}
 }
 
-func buildQuery(r rule, header http.Header) (url.Values, http.Header) {
+func buildQuery(r v4Internal.Rule, header http.Header) (url.Values, http.Header) {
 	query := url.Values{}
 	unsignedHeaders := http.Header{}
 	for k, h := range header {
@@ -607,7 +594,7 @@ func buildQuery(r rule, header http.Header) (url.Values, http.Header) {
 
 	return query, unsignedHeaders
 }
-func (ctx *signingCtx) buildCanonicalHeaders(r rule, header http.Header) {
+func (ctx *signingCtx) buildCanonicalHeaders(r v4Internal.Rule, header http.Header) {
 	var headers []string
 	headers = append(headers, "host")
 	for k, v := range header {
@@ -660,7 +647,7 @@ func (ctx *signingCtx) buildCanonicalHeaders(r rule, header http.Header) {
 func (ctx *signingCtx) buildCanonicalString() {
 	ctx.Request.URL.RawQuery = strings.Replace(ctx.Query.Encode(), "+", "%20", -1)
 
-	uri := getURIPath(ctx.Request.URL)
+	uri := v4Internal.GetURIPath(ctx.Request.URL)
 
 	if !ctx.DisableURIPathEscaping {
 		uri = rest.EscapePath(uri, false)
@@ -678,7 +665,7 @@ func (ctx *signingCtx) buildCanonicalString() {
 
 func (ctx *signingCtx) buildStringToSign() {
 	ctx.stringToSign = strings.Join([]string{
-		authHeaderPrefix,
+		ctx.algorithm(),
 		formatTime(ctx.Time),
 		ctx.credentialString,
 		hex.EncodeToString(hashSHA256([]byte(ctx.canonicalString))),
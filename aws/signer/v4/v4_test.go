@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/request"
+	v4Internal "github.com/aws/aws-sdk-go/aws/signer/internal/v4"
 	"github.com/aws/aws-sdk-go/awstesting"
 )
 
@@ -702,7 +703,7 @@ func TestRequestHost(t *testing.T) {
 		ExpireTime:  5 * time.Second,
 	}
 
-	ctx.buildCanonicalHeaders(ignoredHeaders, ctx.Request.Header)
+	ctx.buildCanonicalHeaders(v4Internal.IgnoredHeaders, ctx.Request.Header)
 	if !strings.Contains(ctx.canonicalHeaders, "host:"+req.Host) {
 		t.Errorf("canonical host header invalid")
 	}
@@ -738,7 +739,7 @@ func TestSign_buildCanonicalHeaders(t *testing.T) {
 		ExpireTime:  5 * time.Second,
 	}
 
-	ctx.buildCanonicalHeaders(ignoredHeaders, ctx.Request.Header)
+	ctx.buildCanonicalHeaders(v4Internal.IgnoredHeaders, ctx.Request.Header)
 
 	expectCanonicalHeaders := strings.Join([]string{
 		`fooinnerspace:inner space`,
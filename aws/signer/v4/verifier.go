@@ -0,0 +1,506 @@
+package v4
+
+import (
+	"crypto/hmac"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	v4Internal "github.com/aws/aws-sdk-go/aws/signer/internal/v4"
+)
+
+const (
+	// ErrCodeMissingAuthentication is the error code returned if the request
+	// is not signed.
+	ErrCodeMissingAuthentication = "MissingAuthenticationToken"
+
+	// ErrCodeAuthorizationMalformed is the error code returned if the
+	// request's Authorization header or presigned query is malformed, or
+	// signed for an unexpected service or region.
+	ErrCodeAuthorizationMalformed = "AuthorizationHeaderMalformed"
+
+	// ErrCodeIncompleteSignature is the error code returned if headers of the
+	// request that must be signed were not signed, or signed headers are
+	// missing from the request.
+	ErrCodeIncompleteSignature = "IncompleteSignature"
+
+	// ErrCodeInvalidAccessKeyID is the error code returned if the credentials
+	// of the request's access key ID could not be found.
+	ErrCodeInvalidAccessKeyID = "InvalidAccessKeyId"
+
+	// ErrCodeInvalidToken is the error code returned if the request's
+	// security token does not match the credentials of the access key ID.
+	ErrCodeInvalidToken = "InvalidToken"
+
+	// ErrCodeSignatureDoesNotMatch is the error code returned if the
+	// request's signature does not match the signature computed for it.
+	ErrCodeSignatureDoesNotMatch = "SignatureDoesNotMatch"
+
+	// ErrCodeRequestTimeTooSkewed is the error code returned if the request
+	// was signed too far from the current time.
+	ErrCodeRequestTimeTooSkewed = "RequestTimeTooSkewed"
+
+	// ErrCodeRequestExpired is the error code returned if the presigned
+	// request has expired.
+	ErrCodeRequestExpired = "RequestExpired"
+
+	// ErrCodeContentSHA256Mismatch is the error code returned if the
+	// request's X-Amz-Content-Sha256 header does not match the payload.
+	ErrCodeContentSHA256Mismatch = "XAmzContentSHA256Mismatch"
+)
+
+const (
+	// DefaultMaxClockSkew is the maximum difference between the time a
+	// request was signed at, and the time it is verified at, allowed by
+	// default.
+	DefaultMaxClockSkew = 15 * time.Minute
+
+	// maxPresignExpires is the longest a presigned request can be valid for.
+	maxPresignExpires = 7 * 24 * time.Hour
+)
+
+// CredentialsLookup looks up the credentials of an access key ID, so the
+// signature of requests signed with the access key ID can be verified.
+type CredentialsLookup interface {
+	LookupCredentials(ctx aws.Context, accessKeyID string) (credentials.Value, error)
+}
+
+// CredentialsLookupFunc is a function that implements CredentialsLookup.
+type CredentialsLookupFunc func(ctx aws.Context, accessKeyID string) (credentials.Value, error)
+
+// LookupCredentials returns the credentials of the access key ID.
+func (fn CredentialsLookupFunc) LookupCredentials(ctx aws.Context, accessKeyID string) (credentials.Value, error) {
+	return fn(ctx, accessKeyID)
+}
+
+// Verifier verifies the AWS v4 signature of requests, signed with either the
+// Authorization header, or the query string of a presigned request. It is
+// the inverse of the Signer, and can be used to build, or test, services
+// compatible with AWS APIs.
+type Verifier struct {
+	// Looks up the credentials of the access key ID the request was signed
+	// with. This value must be set to verify requests.
+	Credentials CredentialsLookup
+
+	// The service name requests must be signed for. If empty the request can
+	// be signed for any service.
+	Service string
+
+	// The region requests must be signed for. If empty the request can be
+	// signed for any region.
+	Region string
+
+	// Disables the escaping of the URI path of the request for the
+	// signature's canonical string. Must match the option the request was
+	// signed with, (e.g. set for S3).
+	DisableURIPathEscaping bool
+
+	// The maximum difference between the time a request was signed at and
+	// the current time. Defaults to DefaultMaxClockSkew if zero.
+	MaxClockSkew time.Duration
+
+	// currentTimeFn returns the time value which represents the current time.
+	// This value should only be used for testing. If it is nil the default
+	// time.Now will be used.
+	currentTimeFn func() time.Time
+}
+
+// NewVerifier returns a Verifier pointer configured with the credentials
+// lookup and optional option values provided.
+func NewVerifier(credentials CredentialsLookup, options ...func(*Verifier)) *Verifier {
+	v := &Verifier{
+		Credentials: credentials,
+	}
+
+	for _, option := range options {
+		option(v)
+	}
+
+	return v
+}
+
+// VerifiedRequest is the signing information of a verified request.
+type VerifiedRequest struct {
+	// The access key ID the request was signed with.
+	AccessKeyID string
+
+	// The service and region the request was signed for.
+	Service string
+	Region  string
+
+	// The time the request was signed at.
+	SignTime time.Time
+
+	// The duration a presigned request is valid for after SignTime. Zero
+	// if the request was not presigned.
+	Expires time.Duration
+
+	// The lower case names of the headers included in the signature.
+	SignedHeaders []string
+
+	// If the request was presigned.
+	Presigned bool
+}
+
+// Verify verifies the request's signature. Returns the signing information of
+// the request, or an awserr.Error with one of the ErrCode error codes if the
+// request's signature is not valid.
+//
+// The body is the request's payload, used to compute the payload hash the
+// request was signed with. If the request has a X-Amz-Content-Sha256 header,
+// and body is nil, the header is trusted to be the hash of the payload. A nil
+// body is otherwise treated as an empty payload. Payloads signed as
+// UNSIGNED-PAYLOAD, or with the aws-chunked content encoding, are not
+// verified.
+//
+// The request is not modified.
+func (v Verifier) Verify(r *http.Request, body io.ReadSeeker) (*VerifiedRequest, error) {
+	currentTimeFn := v.currentTimeFn
+	if currentTimeFn == nil {
+		currentTimeFn = time.Now
+	}
+
+	// Copy the request's URL and headers so the request is not modified
+	// while the canonical string is built.
+	req := *r
+	u := *r.URL
+	req.URL = &u
+	req.Header = r.Header.Clone()
+
+	ctx := &signingCtx{
+		Request:                &req,
+		Body:                   body,
+		Query:                  u.Query(),
+		DisableURIPathEscaping: v.DisableURIPathEscaping,
+	}
+
+	for key := range ctx.Query {
+		sort.Strings(ctx.Query[key])
+	}
+
+	auth, err := parseRequestAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := v.validateAuth(auth, currentTimeFn()); err != nil {
+		return nil, err
+	}
+
+	ctx.Time = auth.signTime
+	ctx.ExpireTime = auth.expires
+	ctx.isPresign = auth.presigned
+	ctx.ServiceName = auth.service
+	ctx.Region = auth.region
+	ctx.credentialString = auth.scope
+
+	ctx.credValues, err = v.Credentials.LookupCredentials(requestContext(r), auth.accessKeyID)
+	if err != nil {
+		return nil, awserr.New(ErrCodeInvalidAccessKeyID,
+			"unable to find credentials of access key ID "+auth.accessKeyID, err)
+	}
+	if ctx.credValues.SessionToken != auth.securityToken {
+		return nil, awserr.New(ErrCodeInvalidToken,
+			"security token does not match the access key ID", nil)
+	}
+
+	if err := ctx.verifyBodyDigest(); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.buildSignedCanonicalHeaders(auth); err != nil {
+		return nil, err
+	}
+	ctx.buildCanonicalString()
+	ctx.buildStringToSign()
+	ctx.buildSignature()
+
+	if !hmac.Equal([]byte(ctx.signature), []byte(auth.signature)) {
+		return nil, awserr.New(ErrCodeSignatureDoesNotMatch,
+			"request signature does not match the signature computed for the request", nil)
+	}
+
+	return &VerifiedRequest{
+		AccessKeyID:   auth.accessKeyID,
+		Service:       auth.service,
+		Region:        auth.region,
+		SignTime:      auth.signTime,
+		Expires:       auth.expires,
+		SignedHeaders: auth.signedHeaders,
+		Presigned:     auth.presigned,
+	}, nil
+}
+
+// validateAuth validates the request was signed for the expected service and
+// region, and is valid at the current time.
+func (v Verifier) validateAuth(auth *requestAuth, now time.Time) error {
+	if v.Service != "" && auth.service != v.Service {
+		return awserr.New(ErrCodeAuthorizationMalformed,
+			"request signed for service "+auth.service+", expected "+v.Service, nil)
+	}
+	if v.Region != "" && auth.region != v.Region {
+		return awserr.New(ErrCodeAuthorizationMalformed,
+			"request signed for region "+auth.region+", expected "+v.Region, nil)
+	}
+
+	maxSkew := v.MaxClockSkew
+	if maxSkew == 0 {
+		maxSkew = DefaultMaxClockSkew
+	}
+
+	if auth.signTime.After(now.Add(maxSkew)) {
+		return awserr.New(ErrCodeRequestTimeTooSkewed,
+			"request signed at "+formatTime(auth.signTime)+" is too far in the future", nil)
+	}
+
+	if auth.presigned {
+		if now.After(auth.signTime.Add(auth.expires)) {
+			return awserr.New(ErrCodeRequestExpired,
+				"presigned request expired at "+formatTime(auth.signTime.Add(auth.expires)), nil)
+		}
+	} else if auth.signTime.Before(now.Add(-maxSkew)) {
+		return awserr.New(ErrCodeRequestTimeTooSkewed,
+			"request signed at "+formatTime(auth.signTime)+" is too far in the past", nil)
+	}
+
+	return nil
+}
+
+// requestAuth is the signing information parsed from a request.
+type requestAuth struct {
+	presigned     bool
+	accessKeyID   string
+	scope         string
+	service       string
+	region        string
+	signTime      time.Time
+	expires       time.Duration
+	signedHeaders []string
+	signature     string
+	securityToken string
+}
+
+// parseRequestAuth parses the signing information of the request, from either
+// the Authorization header or presigned query string. The signature is
+// removed from the query of presigned requests.
+func parseRequestAuth(ctx *signingCtx) (*requestAuth, error) {
+	authHeader := ctx.Request.Header.Get(authorizationHeader)
+	presigned := ctx.Query.Get(signatureQueryKey) != "" || ctx.Query.Get("X-Amz-Algorithm") != ""
+
+	switch {
+	case authHeader != "" && presigned:
+		return nil, awserr.New(ErrCodeAuthorizationMalformed,
+			"request must only be signed with either the Authorization header or query string", nil)
+	case authHeader == "" && !presigned:
+		return nil, awserr.New(ErrCodeMissingAuthentication, "request is not signed", nil)
+	}
+
+	auth := &requestAuth{presigned: presigned}
+
+	var algorithm, credential, signedHeaders, date string
+	if presigned {
+		algorithm = ctx.Query.Get("X-Amz-Algorithm")
+		credential = ctx.Query.Get("X-Amz-Credential")
+		signedHeaders = ctx.Query.Get("X-Amz-SignedHeaders")
+		auth.signature = ctx.Query.Get(signatureQueryKey)
+		auth.securityToken = ctx.Query.Get("X-Amz-Security-Token")
+		date = ctx.Query.Get("X-Amz-Date")
+
+		expires, err := strconv.ParseInt(ctx.Query.Get("X-Amz-Expires"), 10, 64)
+		if err != nil || expires <= 0 || time.Duration(expires)*time.Second > maxPresignExpires {
+			return nil, awserr.New(ErrCodeAuthorizationMalformed,
+				"presigned request X-Amz-Expires must be between 1 and 604800 seconds", err)
+		}
+		auth.expires = time.Duration(expires) * time.Second
+
+		ctx.Query.Del(signatureQueryKey)
+	} else {
+		var err error
+		algorithm, credential, signedHeaders, auth.signature, err = parseAuthorizationHeader(authHeader)
+		if err != nil {
+			return nil, err
+		}
+		auth.securityToken = ctx.Request.Header.Get("X-Amz-Security-Token")
+		date = ctx.Request.Header.Get("X-Amz-Date")
+	}
+
+	if algorithm != authHeaderPrefix {
+		return nil, awserr.New(ErrCodeAuthorizationMalformed,
+			"unsupported signing algorithm "+algorithm, nil)
+	}
+	if auth.signature == "" || signedHeaders == "" {
+		return nil, awserr.New(ErrCodeAuthorizationMalformed,
+			"request signature or signed headers missing", nil)
+	}
+	auth.signedHeaders = strings.Split(signedHeaders, ";")
+
+	// Credential is in the form of <access key ID>/<date>/<region>/<service>/aws4_request
+	parts := strings.SplitN(credential, "/", 2)
+	if len(parts) != 2 {
+		return nil, awserr.New(ErrCodeAuthorizationMalformed,
+			"malformed credential "+credential, nil)
+	}
+	auth.accessKeyID, auth.scope = parts[0], parts[1]
+
+	scope := strings.Split(auth.scope, "/")
+	if len(auth.accessKeyID) == 0 || len(scope) != 4 || scope[3] != awsV4Request {
+		return nil, awserr.New(ErrCodeAuthorizationMalformed,
+			"malformed credential scope "+auth.scope, nil)
+	}
+	auth.region, auth.service = scope[1], scope[2]
+
+	signTime, err := time.Parse(timeFormat, date)
+	if err != nil {
+		return nil, awserr.New(ErrCodeAuthorizationMalformed,
+			"malformed or missing X-Amz-Date "+date, err)
+	}
+	if formatShortTime(signTime) != scope[0] {
+		return nil, awserr.New(ErrCodeAuthorizationMalformed,
+			"credential scope date "+scope[0]+" does not match X-Amz-Date "+date, nil)
+	}
+	auth.signTime = signTime
+
+	return auth, nil
+}
+
+// parseAuthorizationHeader parses the elements of an Authorization header in
+// the form of:
+//
+//	AWS4-HMAC-SHA256 Credential=<credential>, SignedHeaders=<headers>, Signature=<signature>
+func parseAuthorizationHeader(header string) (algorithm, credential, signedHeaders, signature string, err error) {
+	parts := strings.SplitN(strings.TrimSpace(header), " ", 2)
+	if len(parts) != 2 {
+		return "", "", "", "", awserr.New(ErrCodeAuthorizationMalformed,
+			"malformed Authorization header", nil)
+	}
+	algorithm = parts[0]
+
+	for _, elem := range strings.Split(parts[1], ",") {
+		kv := strings.SplitN(strings.TrimSpace(elem), "=", 2)
+		if len(kv) != 2 {
+			return "", "", "", "", awserr.New(ErrCodeAuthorizationMalformed,
+				"malformed Authorization header element "+elem, nil)
+		}
+		switch kv[0] {
+		case "Credential":
+			credential = kv[1]
+		case "SignedHeaders":
+			signedHeaders = kv[1]
+		case "Signature":
+			signature = kv[1]
+		}
+	}
+
+	return algorithm, credential, signedHeaders, signature, nil
+}
+
+// verifyBodyDigest sets the body digest the request was signed with. The
+// X-Amz-Content-Sha256 header is verified to match the body if set.
+func (ctx *signingCtx) verifyBodyDigest() error {
+	hash := ctx.Request.Header.Get("X-Amz-Content-Sha256")
+	if hash == "" {
+		err := ctx.buildBodyDigest()
+		// The header is only used by the signer if it was signed.
+		ctx.Request.Header.Del("X-Amz-Content-Sha256")
+		return err
+	}
+	if ctx.Body == nil || hash == "UNSIGNED-PAYLOAD" ||
+		strings.HasPrefix(hash, "STREAMING-") {
+		ctx.bodyDigest = hash
+		return nil
+	}
+
+	if err := ctx.buildBodyDigestFromBody(); err != nil {
+		return err
+	}
+	if ctx.bodyDigest != hash {
+		return awserr.New(ErrCodeContentSHA256Mismatch,
+			"X-Amz-Content-Sha256 header does not match the request payload", nil)
+	}
+
+	return nil
+}
+
+func (ctx *signingCtx) buildBodyDigestFromBody() error {
+	if !aws.IsReaderSeekable(ctx.Body) {
+		return awserr.New(ErrCodeContentSHA256Mismatch,
+			"unable to compute hash of unseekable request payload", nil)
+	}
+	hashBytes, err := makeSha256Reader(ctx.Body)
+	if err != nil {
+		return err
+	}
+	ctx.bodyDigest = hex.EncodeToString(hashBytes)
+	return nil
+}
+
+// buildSignedCanonicalHeaders builds the canonical headers from the headers
+// the request was signed with. Headers of the request that must be signed
+// are required to be included.
+func (ctx *signingCtx) buildSignedCanonicalHeaders(auth *requestAuth) error {
+	mustSign := v4Internal.Rule(v4Internal.RequiredSignedHeaders)
+	if !auth.presigned {
+		// All X-Amz headers of requests signed with the Authorization
+		// header are signed, as they are not hoisted to the query string.
+		mustSign = v4Internal.Rules{v4Internal.RequiredSignedHeaders, v4Internal.Patterns{"X-Amz-"}}
+	}
+
+	header := ctx.Request.Header
+	signed := v4Internal.MapRule{}
+	for _, name := range auth.signedHeaders {
+		if name == "host" {
+			continue
+		}
+		if !v4Internal.IgnoredHeaders.IsValid(http.CanonicalHeaderKey(name)) {
+			return awserr.New(ErrCodeIncompleteSignature,
+				"header "+name+" cannot be signed", nil)
+		}
+		key := http.CanonicalHeaderKey(name)
+		if _, ok := header[key]; !ok && key == contentLengthHeader && ctx.Request.ContentLength >= 0 {
+			// The HTTP server may not include the Content-Length in the
+			// request's headers.
+			header.Set(key, strconv.FormatInt(ctx.Request.ContentLength, 10))
+		}
+		if _, ok := header[key]; !ok {
+			return awserr.New(ErrCodeIncompleteSignature,
+				"signed header "+name+" is missing from the request", nil)
+		}
+		signed[key] = struct{}{}
+	}
+
+	for key := range header {
+		if _, ok := signed[key]; ok || key == authorizationHeader {
+			continue
+		}
+		if mustSign.IsValid(key) {
+			return awserr.New(ErrCodeIncompleteSignature,
+				"header "+key+" is present in the request but not signed", nil)
+		}
+	}
+
+	// Non-canonical header keys are signed by the signer, and are
+	// canonicalized here to match the signed header names.
+	canonicalHeader := http.Header{}
+	for k, v := range header {
+		key := http.CanonicalHeaderKey(k)
+		canonicalHeader[key] = append(canonicalHeader[key], v...)
+	}
+
+	ctx.buildCanonicalHeaders(signed, canonicalHeader)
+
+	expected := append([]string{}, auth.signedHeaders...)
+	sort.Strings(expected)
+	if ctx.signedHeaders != strings.Join(expected, ";") {
+		return awserr.New(ErrCodeIncompleteSignature,
+			"signed headers "+strings.Join(auth.signedHeaders, ";")+" are not valid", nil)
+	}
+
+	return nil
+}
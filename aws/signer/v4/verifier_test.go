@@ -0,0 +1,358 @@
+package v4
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+var verifierCredentials = CredentialsLookupFunc(
+	func(ctx aws.Context, accessKeyID string) (credentials.Value, error) {
+		switch accessKeyID {
+		case "AKID":
+			return credentials.Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}, nil
+		case "ASIA":
+			return credentials.Value{AccessKeyID: "ASIA", SecretAccessKey: "SECRET", SessionToken: "SESSION"}, nil
+		}
+		return credentials.Value{}, fmt.Errorf("unknown access key ID")
+	})
+
+func TestVerifier_SignedRequests(t *testing.T) {
+	signTime := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		Service    string
+		Creds      *credentials.Credentials
+		Body       string
+		Presign    bool
+		Unsigned   bool
+		Path       string
+		Query      string
+		Header     http.Header
+		DisableEsc bool
+	}{
+		"header": {
+			Service: "sqs",
+			Creds:   credentials.NewStaticCredentials("AKID", "SECRET", ""),
+			Body:    "Action=ListQueues",
+			Header:  http.Header{"Content-Type": []string{"application/x-www-form-urlencoded"}},
+		},
+		"header with session token": {
+			Service: "sqs",
+			Creds:   credentials.NewStaticCredentials("ASIA", "SECRET", "SESSION"),
+			Body:    "Action=ListQueues",
+		},
+		"header s3": {
+			Service:    "s3",
+			Creds:      credentials.NewStaticCredentials("AKID", "SECRET", ""),
+			Body:       "object content",
+			Path:       "/bucket/some key+with&chars",
+			Query:      "b=2&a=1&a=0",
+			Header:     http.Header{"X-Amz-Meta-Other": []string{"  some   value "}},
+			DisableEsc: true,
+		},
+		"header unsigned payload": {
+			Service:    "s3",
+			Creds:      credentials.NewStaticCredentials("AKID", "SECRET", ""),
+			Body:       "object content",
+			Unsigned:   true,
+			DisableEsc: true,
+		},
+		"header escaped path": {
+			Service: "execute-api",
+			Creds:   credentials.NewStaticCredentials("AKID", "SECRET", ""),
+			Path:    "/stage/path with spaces",
+		},
+		"presigned": {
+			Service: "sqs",
+			Creds:   credentials.NewStaticCredentials("AKID", "SECRET", ""),
+			Presign: true,
+			Query:   "Action=ListQueues",
+		},
+		"presigned s3 with session token": {
+			Service:    "s3",
+			Creds:      credentials.NewStaticCredentials("ASIA", "SECRET", "SESSION"),
+			Presign:    true,
+			Path:       "/bucket/key",
+			Header:     http.Header{"X-Amz-Acl": []string{"private"}},
+			DisableEsc: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var verified *VerifiedRequest
+			var verifyErr error
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				verifier := NewVerifier(verifierCredentials, func(v *Verifier) {
+					v.Service = c.Service
+					v.Region = "us-west-2"
+					v.DisableURIPathEscaping = c.DisableEsc
+					v.currentTimeFn = func() time.Time { return signTime.Add(time.Minute) }
+				})
+				verified, verifyErr = verifier.Verify(r, bytes.NewReader(body))
+			}))
+			defer server.Close()
+
+			path := c.Path
+			if path == "" {
+				path = "/"
+			}
+			req, _ := http.NewRequest("PUT", server.URL, nil)
+			req.URL.Path = path
+			req.URL.RawQuery = c.Query
+			for k, v := range c.Header {
+				req.Header[k] = v
+			}
+
+			signer := NewSigner(c.Creds, func(s *Signer) {
+				s.DisableURIPathEscaping = c.DisableEsc
+				s.UnsignedPayload = c.Unsigned
+			})
+			var err error
+			if c.Presign {
+				_, err = signer.Presign(req, nil, c.Service, "us-west-2", time.Hour, signTime)
+			} else {
+				_, err = signer.Sign(req, strings.NewReader(c.Body), c.Service, "us-west-2", signTime)
+			}
+			if err != nil {
+				t.Fatalf("expect no sign error, got %v", err)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			resp.Body.Close()
+
+			if verifyErr != nil {
+				t.Fatalf("expect no verify error, got %v", verifyErr)
+			}
+			creds, _ := c.Creds.Get()
+			if e, a := creds.AccessKeyID, verified.AccessKeyID; e != a {
+				t.Errorf("expect access key ID %v, got %v", e, a)
+			}
+			if e, a := c.Service, verified.Service; e != a {
+				t.Errorf("expect service %v, got %v", e, a)
+			}
+			if e, a := "us-west-2", verified.Region; e != a {
+				t.Errorf("expect region %v, got %v", e, a)
+			}
+			if e, a := signTime, verified.SignTime; !e.Equal(a) {
+				t.Errorf("expect sign time %v, got %v", e, a)
+			}
+			if e, a := c.Presign, verified.Presigned; e != a {
+				t.Errorf("expect presigned %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestVerifier_Errors(t *testing.T) {
+	signTime := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	sign := func(creds *credentials.Credentials, body string, modify func(*http.Request)) func() (*http.Request, string) {
+		return func() (*http.Request, string) {
+			req, _ := http.NewRequest("POST", "https://sqs.us-west-2.amazonaws.com/", nil)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			NewSigner(creds).Sign(req, strings.NewReader(body), "sqs", "us-west-2", signTime)
+			if modify != nil {
+				modify(req)
+			}
+			return req, body
+		}
+	}
+	presign := func(exp time.Duration, modify func(*http.Request)) func() (*http.Request, string) {
+		return func() (*http.Request, string) {
+			req, _ := http.NewRequest("GET", "https://sqs.us-west-2.amazonaws.com/?Action=ListQueues", nil)
+			NewSigner(credentials.NewStaticCredentials("AKID", "SECRET", "")).
+				Presign(req, nil, "sqs", "us-west-2", exp, signTime)
+			if modify != nil {
+				modify(req)
+			}
+			return req, ""
+		}
+	}
+	creds := credentials.NewStaticCredentials("AKID", "SECRET", "")
+
+	cases := map[string]struct {
+		Request func() (*http.Request, string)
+		Now     time.Time
+		Region  string
+		Code    string
+	}{
+		"not signed": {
+			Request: func() (*http.Request, string) {
+				req, _ := http.NewRequest("GET", "https://sqs.us-west-2.amazonaws.com/", nil)
+				return req, ""
+			},
+			Code: ErrCodeMissingAuthentication,
+		},
+		"malformed authorization": {
+			Request: sign(creds, "", func(r *http.Request) {
+				r.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential")
+			}),
+			Code: ErrCodeAuthorizationMalformed,
+		},
+		"unsupported algorithm": {
+			Request: sign(creds, "", func(r *http.Request) {
+				r.Header.Set("Authorization", strings.Replace(r.Header.Get("Authorization"),
+					"AWS4-HMAC-SHA256", "AWS4-ECDSA-P256-SHA256", 1))
+			}),
+			Code: ErrCodeAuthorizationMalformed,
+		},
+		"unexpected region": {
+			Request: sign(creds, "", nil),
+			Region:  "us-east-1",
+			Code:    ErrCodeAuthorizationMalformed,
+		},
+		"scope date mismatch": {
+			Request: sign(creds, "", func(r *http.Request) {
+				r.Header.Set("X-Amz-Date", formatTime(signTime.Add(48*time.Hour)))
+			}),
+			Now:  signTime.Add(48 * time.Hour),
+			Code: ErrCodeAuthorizationMalformed,
+		},
+		"unknown access key": {
+			Request: sign(credentials.NewStaticCredentials("UNKNOWN", "SECRET", ""), "", nil),
+			Code:    ErrCodeInvalidAccessKeyID,
+		},
+		"missing session token": {
+			Request: sign(credentials.NewStaticCredentials("ASIA", "SECRET", ""), "", nil),
+			Code:    ErrCodeInvalidToken,
+		},
+		"wrong secret": {
+			Request: sign(credentials.NewStaticCredentials("AKID", "OTHER", ""), "", nil),
+			Code:    ErrCodeSignatureDoesNotMatch,
+		},
+		"modified signed header": {
+			Request: sign(creds, "", func(r *http.Request) {
+				r.Header.Set("Content-Type", "application/json")
+			}),
+			Code: ErrCodeSignatureDoesNotMatch,
+		},
+		"modified path": {
+			Request: sign(creds, "", func(r *http.Request) {
+				r.URL.Path = "/other"
+			}),
+			Code: ErrCodeSignatureDoesNotMatch,
+		},
+		"modified body": {
+			Request: func() (*http.Request, string) {
+				req, _ := sign(creds, "Action=ListQueues", nil)()
+				return req, "Action=DeleteQueue"
+			},
+			Code: ErrCodeSignatureDoesNotMatch,
+		},
+		"content sha256 mismatch": {
+			Request: func() (*http.Request, string) {
+				req, _ := sign(creds, "Action=ListQueues", func(r *http.Request) {
+					r.Header.Set("X-Amz-Content-Sha256", emptyStringSHA256)
+				})()
+				return req, "Action=ListQueues"
+			},
+			Code: ErrCodeContentSHA256Mismatch,
+		},
+		"unsigned amz header": {
+			Request: sign(creds, "", func(r *http.Request) {
+				r.Header.Set("X-Amz-Target", "Other")
+			}),
+			Code: ErrCodeIncompleteSignature,
+		},
+		"missing signed header": {
+			Request: sign(creds, "", func(r *http.Request) {
+				r.Header.Del("Content-Type")
+			}),
+			Code: ErrCodeIncompleteSignature,
+		},
+		"too skewed": {
+			Request: sign(creds, "", nil),
+			Now:     signTime.Add(20 * time.Minute),
+			Code:    ErrCodeRequestTimeTooSkewed,
+		},
+		"signed in the future": {
+			Request: sign(creds, "", nil),
+			Now:     signTime.Add(-20 * time.Minute),
+			Code:    ErrCodeRequestTimeTooSkewed,
+		},
+		"presign expired": {
+			Request: presign(time.Hour, nil),
+			Now:     signTime.Add(time.Hour + time.Second),
+			Code:    ErrCodeRequestExpired,
+		},
+		"presign expires too long": {
+			Request: presign(8*24*time.Hour, nil),
+			Code:    ErrCodeAuthorizationMalformed,
+		},
+		"presign modified query": {
+			Request: presign(time.Hour, func(r *http.Request) {
+				r.URL.RawQuery = strings.Replace(r.URL.RawQuery, "ListQueues", "DeleteQueue", 1)
+			}),
+			Code: ErrCodeSignatureDoesNotMatch,
+		},
+		"presign and header": {
+			Request: presign(time.Hour, func(r *http.Request) {
+				r.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential=AKID")
+			}),
+			Code: ErrCodeAuthorizationMalformed,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			req, body := c.Request()
+
+			now := c.Now
+			if now.IsZero() {
+				now = signTime.Add(time.Minute)
+			}
+			verifier := Verifier{
+				Credentials:   verifierCredentials,
+				Region:        c.Region,
+				currentTimeFn: func() time.Time { return now },
+			}
+
+			_, err := verifier.Verify(req, strings.NewReader(body))
+			if err == nil {
+				t.Fatalf("expect error, got none")
+			}
+			aerr, ok := err.(awserr.Error)
+			if !ok {
+				t.Fatalf("expect awserr.Error, got %T", err)
+			}
+			if e, a := c.Code, aerr.Code(); e != a {
+				t.Errorf("expect %v error code, got %v, %v", e, a, err)
+			}
+		})
+	}
+}
+
+func TestVerifier_DoesNotModifyRequest(t *testing.T) {
+	signTime := time.Now()
+	req, _ := http.NewRequest("GET", "https://sqs.us-west-2.amazonaws.com/?b=2&a=1", nil)
+	NewSigner(credentials.NewStaticCredentials("AKID", "SECRET", "")).
+		Presign(req, nil, "sqs", "us-west-2", time.Hour, signTime)
+
+	rawQuery := req.URL.RawQuery
+	header := req.Header.Clone()
+
+	if _, err := NewVerifier(verifierCredentials).Verify(req, nil); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := rawQuery, req.URL.RawQuery; e != a {
+		t.Errorf("expect query %v, got %v", e, a)
+	}
+	if e, a := len(header), len(req.Header); e != a {
+		t.Errorf("expect %v headers, got %v", e, a)
+	}
+}
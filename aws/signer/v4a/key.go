@@ -0,0 +1,141 @@
+package v4a
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"sync"
+)
+
+var (
+	p256          = elliptic.P256()
+	nMinusTwoP256 = new(big.Int).Sub(p256.Params().N, big.NewInt(2))
+)
+
+// DeriveKey derives the ECDSA P-256 private key used to sign requests with
+// SigV4a from the access key ID and secret access key of AWS credentials.
+//
+// The key is derived with the NIST SP 800-108 counter mode KDF using
+// HMAC-SHA256, based on FIPS 186-4 Appendix B.4.2.
+func DeriveKey(accessKeyID, secretAccessKey string) (*ecdsa.PrivateKey, error) {
+	bitLen := p256.Params().BitSize
+	inputKey := []byte("AWS4A" + secretAccessKey)
+	nMinusTwo := leftPad(nMinusTwoP256.Bytes(), bitLen/8)
+
+	var kdfContext bytes.Buffer
+	for counter := 1; counter <= 0xFF; counter++ {
+		kdfContext.Reset()
+		kdfContext.WriteString(accessKeyID)
+		kdfContext.WriteByte(byte(counter))
+
+		candidate := hmacKeyDerivation(bitLen, inputKey, []byte(signingAlgorithm), kdfContext.Bytes())
+
+		// Candidates are compared in constant-time so the time taken does not
+		// depend on the secret access key.
+		if constantTimeCompare(candidate, nMinusTwo) < 0 {
+			d := new(big.Int).SetBytes(candidate)
+			d.Add(d, big.NewInt(1))
+
+			priv := &ecdsa.PrivateKey{D: d}
+			priv.PublicKey.Curve = p256
+			priv.PublicKey.X, priv.PublicKey.Y = p256.ScalarBaseMult(d.Bytes())
+			return priv, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unable to derive SigV4a key, exhausted single byte external counter")
+}
+
+// hmacKeyDerivation is the NIST SP 800-108 KDF in counter mode, with
+// HMAC-SHA256 as the PRF and a 32-bit counter.
+func hmacKeyDerivation(bitLen int, key, label, context []byte) []byte {
+	var fixedInput bytes.Buffer
+	fixedInput.Write(label)
+	fixedInput.WriteByte(0x00)
+	fixedInput.Write(context)
+	binary.Write(&fixedInput, binary.BigEndian, int32(bitLen))
+
+	n := (bitLen/8 + sha256.Size - 1) / sha256.Size
+
+	var output []byte
+	h := hmac.New(sha256.New, key)
+	for i := 1; i <= n; i++ {
+		h.Reset()
+		binary.Write(h, binary.BigEndian, int32(i))
+		h.Write(fixedInput.Bytes())
+		output = h.Sum(output)
+	}
+
+	return output[:bitLen/8]
+}
+
+// constantTimeCompare compares the big-endian numbers x and y of equal length
+// in constant-time. Returns -1 if x < y, 0 if x == y, and 1 if x > y.
+func constantTimeCompare(x, y []byte) int {
+	var xLarger, yLarger int
+	for i := 0; i < len(x); i++ {
+		xb, yb := int(x[i]), int(y[i])
+
+		xl := ((yb - xb) >> 8) & 1
+		yl := ((xb - yb) >> 8) & 1
+
+		xLarger |= xl &^ yLarger
+		yLarger |= yl &^ xLarger
+	}
+	return xLarger - yLarger
+}
+
+func leftPad(b []byte, n int) []byte {
+	if len(b) >= n {
+		return b
+	}
+	padded := make([]byte, n)
+	copy(padded[n-len(b):], b)
+	return padded
+}
+
+// maxCachedKeys is the number of derived keys cached before the cache is
+// reset.
+const maxCachedKeys = 64
+
+// keyCache caches the keys derived from credentials, since deriving a key is
+// considerably more expensive than signing a request.
+type keyCache struct {
+	mu   sync.Mutex
+	keys map[string]cachedKey
+}
+
+type cachedKey struct {
+	secretAccessKey string
+	key             *ecdsa.PrivateKey
+}
+
+var derivedKeys = &keyCache{keys: map[string]cachedKey{}}
+
+// get returns the key derived from the credentials, deriving the key if it
+// is not cached.
+func (c *keyCache) get(accessKeyID, secretAccessKey string) (*ecdsa.PrivateKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if k, ok := c.keys[accessKeyID]; ok && k.secretAccessKey == secretAccessKey {
+		return k.key, nil
+	}
+
+	key, err := DeriveKey(accessKeyID, secretAccessKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.keys) >= maxCachedKeys {
+		c.keys = map[string]cachedKey{}
+	}
+	c.keys[accessKeyID] = cachedKey{secretAccessKey: secretAccessKey, key: key}
+
+	return key, nil
+}
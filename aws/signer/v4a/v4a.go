@@ -0,0 +1,711 @@
+// Package v4a implements signing for AWS V4a signer
+//
+// Provides request signing for requests that need to be signed with the
+// asymmetric AWS Signature Version 4a (SigV4a). SigV4a signs requests with an
+// ECDSA P-256 key derived from the AWS credentials, for a set of regions
+// rather than a single region. Requests signed with SigV4a are valid in all
+// regions of the region set, such as requests made to S3 Multi-Region Access
+// Points.
+//
+// The S3 API client signs requests for Multi-Region Access Point ARNs with
+// SigV4a automatically. The SignRequestHandler can be used to sign the
+// requests of other API clients with SigV4a, for the region set of the
+// client's signing region.
+//
+//	svc.Handlers.Sign.Swap(v4.SignRequestHandler.Name, v4a.SignRequestHandler)
+//
+// # Standalone Signer
+//
+// The Signer escapes the URI path of requests the same as the AWS V4 signer.
+// See the documentation of the aws/signer/v4 package for the use of the
+// URL.Opaque and URL.RawPath fields when signing requests outside of the SDK.
+package v4a
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	v4Internal "github.com/aws/aws-sdk-go/aws/signer/internal/v4"
+	"github.com/aws/aws-sdk-go/internal/sdkio"
+	"github.com/aws/aws-sdk-go/internal/sdklog"
+	"github.com/aws/aws-sdk-go/private/protocol/rest"
+)
+
+const (
+	// RegionSetHeader is the HTTP header, and query string key of presigned
+	// requests, the region set of SigV4a signed requests is sent in.
+	RegionSetHeader = "X-Amz-Region-Set"
+
+	authorizationHeader     = "Authorization"
+	authHeaderSignatureElem = "Signature="
+	signatureQueryKey       = "X-Amz-Signature"
+
+	signingAlgorithm = "AWS4-ECDSA-P256-SHA256"
+	timeFormat       = "20060102T150405Z"
+	shortTimeFormat  = "20060102"
+	awsV4Request     = "aws4_request"
+
+	// emptyStringSHA256 is a SHA256 of an empty string
+	emptyStringSHA256 = `e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855`
+)
+
+// ignoredHeaders extends the v4 ignored headers with Transfer-Encoding, which
+// is not signed by v4a.
+var ignoredHeaders = v4Internal.InclusiveRules{
+	v4Internal.IgnoredHeaders,
+	v4Internal.ExcludeList{
+		Rule: v4Internal.MapRule{
+			"Transfer-Encoding": struct{}{},
+		},
+	},
+}
+
+// Signer applies AWS v4a signing to given request. Use this to sign requests
+// that need to be signed with AWS V4a Signatures.
+type Signer struct {
+	// The authentication credentials the request will be signed against.
+	// The signing key is derived from the credentials. This value must be
+	// set to sign requests.
+	Credentials *credentials.Credentials
+
+	// Sets the log level the signer should use when reporting information to
+	// the logger. If the logger is nil nothing will be logged. See
+	// aws.LogLevelType for more information on available logging levels
+	//
+	// By default nothing will be logged.
+	Debug aws.LogLevelType
+
+	// The logger loging information will be written to. If there the logger
+	// is nil, nothing will be logged.
+	Logger aws.Logger
+
+	// Disables the Signer's moving HTTP header key/value pairs from the HTTP
+	// request header to the request's query string. This is most commonly used
+	// with pre-signed requests preventing headers from being added to the
+	// request's query string.
+	DisableHeaderHoisting bool
+
+	// Disables the automatic escaping of the URI path of the request for the
+	// siganture's canonical string's path. For services that do not need additional
+	// escaping then use this to disable the signer escaping the path.
+	//
+	// S3 is an example of a service that does not need additional escaping.
+	DisableURIPathEscaping bool
+
+	// Disables the automatical setting of the HTTP request's Body field with the
+	// io.ReadSeeker passed in to the signer.
+	DisableRequestBodyOverwrite bool
+
+	// currentTimeFn returns the time value which represents the current time.
+	// This value should only be used for testing. If it is nil the default
+	// time.Now will be used.
+	currentTimeFn func() time.Time
+
+	// UnsignedPayload will prevent signing of the payload. This will only
+	// work for services that have support for this.
+	UnsignedPayload bool
+}
+
+// NewSigner returns a Signer pointer configured with the credentials and optional
+// option values provided. If not options are provided the Signer will use its
+// default configuration.
+func NewSigner(credentials *credentials.Credentials, options ...func(*Signer)) *Signer {
+	v4a := &Signer{
+		Credentials: credentials,
+	}
+
+	for _, option := range options {
+		option(v4a)
+	}
+
+	return v4a
+}
+
+// WithUnsignedPayload will enable and set the UnsignedPayload field to
+// true of the signer.
+func WithUnsignedPayload(v4a *Signer) {
+	v4a.UnsignedPayload = true
+}
+
+type signingCtx struct {
+	ServiceName      string
+	RegionSet        []string
+	Request          *http.Request
+	Body             io.ReadSeeker
+	Query            url.Values
+	Time             time.Time
+	ExpireTime       time.Duration
+	SignedHeaderVals http.Header
+
+	DisableURIPathEscaping bool
+
+	credValues      credentials.Value
+	isPresign       bool
+	unsignedPayload bool
+
+	bodyDigest       string
+	signedHeaders    string
+	canonicalHeaders string
+	canonicalString  string
+	credentialString string
+	stringToSign     string
+	signature        string
+}
+
+// Sign signs the request with SigV4a for the service name, and set of regions
+// the request is valid in, with the provided body, at the time the request
+// is signed. The region set may include wildcards, such as "*" for all
+// regions.
+//
+// Returns a list of HTTP headers that were included in the signature or an
+// error if signing the request failed.
+//
+// Sign will set the request's Body to be the `body` parameter passed in,
+// unless DisableRequestBodyOverwrite is set. The SHA256 of the body is
+// computed unless the "X-Amz-Content-Sha256" header is set.
+func (v4a Signer) Sign(r *http.Request, body io.ReadSeeker, service string, regionSet []string, signTime time.Time) (http.Header, error) {
+	return v4a.signWithBody(r, body, service, regionSet, 0, false, signTime)
+}
+
+// Presign presigns the request with SigV4a for the service name, and set of
+// regions the request is valid in, with the provided body, at the time the
+// request is signed. The presigned request is valid for the exp duration
+// after the signing time.
+//
+// Returns a list of HTTP headers that were included in the signature or an
+// error if signing the request failed. For presigned requests these headers
+// and their values must be included on the HTTP request when it is made.
+//
+// Presigning a S3 request will not compute the body's SHA256 hash by default.
+func (v4a Signer) Presign(r *http.Request, body io.ReadSeeker, service string, regionSet []string, exp time.Duration, signTime time.Time) (http.Header, error) {
+	return v4a.signWithBody(r, body, service, regionSet, exp, true, signTime)
+}
+
+func (v4a Signer) signWithBody(r *http.Request, body io.ReadSeeker, service string, regionSet []string, exp time.Duration, isPresign bool, signTime time.Time) (http.Header, error) {
+	currentTimeFn := v4a.currentTimeFn
+	if currentTimeFn == nil {
+		currentTimeFn = time.Now
+	}
+
+	if len(regionSet) == 0 {
+		return nil, fmt.Errorf("region set required to sign request with SigV4a")
+	}
+
+	ctx := &signingCtx{
+		Request:                r,
+		Body:                   body,
+		Query:                  r.URL.Query(),
+		Time:                   signTime,
+		ExpireTime:             exp,
+		isPresign:              isPresign,
+		ServiceName:            service,
+		RegionSet:              regionSet,
+		DisableURIPathEscaping: v4a.DisableURIPathEscaping,
+		unsignedPayload:        v4a.UnsignedPayload,
+	}
+
+	for key := range ctx.Query {
+		sort.Strings(ctx.Query[key])
+	}
+
+	if ctx.isRequestSigned() {
+		ctx.Time = currentTimeFn()
+		ctx.handlePresignRemoval()
+	}
+
+	var err error
+	ctx.credValues, err = v4a.Credentials.GetWithContext(r.Context())
+	if err != nil {
+		return http.Header{}, err
+	}
+
+	request.SanitizeHostForHeader(ctx.Request)
+	ctx.assignAmzQueryValues()
+	if err := ctx.build(v4a.DisableHeaderHoisting); err != nil {
+		return nil, err
+	}
+
+	// If the request is not presigned the body should be attached to it. This
+	// prevents the confusion of wanting to send a signed request without
+	// the body the request was signed for attached.
+	if !(v4a.DisableRequestBodyOverwrite || ctx.isPresign) {
+		var reader io.ReadCloser
+		if body != nil {
+			var ok bool
+			if reader, ok = body.(io.ReadCloser); !ok {
+				reader = ioutil.NopCloser(body)
+			}
+		}
+		r.Body = reader
+	}
+
+	if v4a.Debug.Matches(aws.LogDebugWithSigning) {
+		v4a.logSigningInfo(ctx)
+	}
+
+	return ctx.SignedHeaderVals, nil
+}
+
+func (ctx *signingCtx) handlePresignRemoval() {
+	if !ctx.isPresign {
+		return
+	}
+
+	// The current signing is invalid, and needs to be removed because the
+	// request will be signed again.
+	ctx.removePresign()
+
+	// Update the request's query string to ensure the values stays in
+	// sync in the case retrieving the new credentials fails.
+	ctx.Request.URL.RawQuery = ctx.Query.Encode()
+}
+
+func (ctx *signingCtx) assignAmzQueryValues() {
+	regionSet := strings.Join(ctx.RegionSet, ",")
+
+	if ctx.isPresign {
+		ctx.Query.Set("X-Amz-Algorithm", signingAlgorithm)
+		ctx.Query.Set(RegionSetHeader, regionSet)
+		if ctx.credValues.SessionToken != "" {
+			ctx.Query.Set("X-Amz-Security-Token", ctx.credValues.SessionToken)
+		} else {
+			ctx.Query.Del("X-Amz-Security-Token")
+		}
+
+		return
+	}
+
+	ctx.Request.Header.Set(RegionSetHeader, regionSet)
+	if ctx.credValues.SessionToken != "" {
+		ctx.Request.Header.Set("X-Amz-Security-Token", ctx.credValues.SessionToken)
+	}
+}
+
+// SignRequestHandler is a named request handler the SDK will use to sign
+// service client request with using the V4a signature.
+var SignRequestHandler = request.NamedHandler{
+	Name: "v4a.SignRequestHandler", Fn: SignSDKRequest,
+}
+
+// SignSDKRequest signs an AWS request with the V4a signature, for the region
+// set of the request's signing region. The signing region may be a comma
+// separated list of regions, or "*" for all regions. This request handler
+// should only be used with the SDK's built in service client's API
+// operation requests.
+//
+// To sign a standalone request not created by a service client's API
+// operation method use the "Sign" or "Presign" functions of the "Signer"
+// type.
+//
+// If the credentials of the request's config are set to
+// credentials.AnonymousCredentials the request will not be signed.
+func SignSDKRequest(req *request.Request) {
+	SignSDKRequestWithCurrentTime(req, time.Now)
+}
+
+// BuildNamedHandler will build a generic handler for signing.
+func BuildNamedHandler(name string, opts ...func(*Signer)) request.NamedHandler {
+	return request.NamedHandler{
+		Name: name,
+		Fn: func(req *request.Request) {
+			SignSDKRequestWithCurrentTime(req, time.Now, opts...)
+		},
+	}
+}
+
+// SignSDKRequestWithCurrentTime will sign the SDK's request using the time
+// function passed in. Behaves the same as SignSDKRequest with the exception
+// the request is signed with the value returned by the current time function.
+func SignSDKRequestWithCurrentTime(req *request.Request, curTimeFn func() time.Time, opts ...func(*Signer)) {
+	// If the request does not need to be signed ignore the signing of the
+	// request if the AnonymousCredentials object is used.
+	if req.Config.Credentials == credentials.AnonymousCredentials {
+		return
+	}
+
+	region := req.ClientInfo.SigningRegion
+	if region == "" {
+		region = aws.StringValue(req.Config.Region)
+	}
+
+	name := req.ClientInfo.SigningName
+	if name == "" {
+		name = req.ClientInfo.ServiceName
+	}
+
+	v4a := NewSigner(req.Config.Credentials, func(v4a *Signer) {
+		v4a.Debug = req.Config.LogLevel.Value()
+		v4a.Logger = req.Config.Logger
+		v4a.DisableHeaderHoisting = req.NotHoist
+		v4a.currentTimeFn = curTimeFn
+		if name == "s3" {
+			// S3 service should not have any escaping applied
+			v4a.DisableURIPathEscaping = true
+		}
+		// Prevents setting the HTTPRequest's Body. Since the Body could be
+		// wrapped in a custom io.Closer that we do not want to be stompped
+		// on top of by the signer.
+		v4a.DisableRequestBodyOverwrite = true
+	})
+
+	for _, opt := range opts {
+		opt(v4a)
+	}
+
+	curTime := curTimeFn()
+	signedHeaders, err := v4a.signWithBody(req.HTTPRequest, req.GetBody(),
+		name, strings.Split(region, ","), req.ExpireTime, req.ExpireTime > 0, curTime,
+	)
+	if err != nil {
+		req.Error = err
+		req.SignedHeaderVals = nil
+		return
+	}
+
+	req.SignedHeaderVals = signedHeaders
+	req.LastSignedAt = curTime
+}
+
+const logSignInfoMsg = `DEBUG: Request Signature:
+---[ CANONICAL STRING  ]-----------------------------
+%s
+---[ STRING TO SIGN ]--------------------------------
+%s%s
+-----------------------------------------------------`
+const logSignedURLMsg = `
+---[ SIGNED URL ]------------------------------------
+%s`
+
+func (v4a *Signer) logSigningInfo(ctx *signingCtx) {
+	// The session token is included in the canonical string, and presigned
+	// URL, when it is signed.
+	redactor := sdklog.NewRedactor(nil)
+	canonicalString := sdklog.RedactString(ctx.canonicalString, ctx.credValues.SessionToken)
+
+	signedURLMsg := ""
+	signedURL := ""
+	if ctx.isPresign {
+		signedURL = redactor.RedactURL(ctx.Request.URL).String()
+		signedURLMsg = fmt.Sprintf(logSignedURLMsg, signedURL)
+	}
+	msg := fmt.Sprintf(logSignInfoMsg, canonicalString, ctx.stringToSign, signedURLMsg)
+
+	fields := []aws.LogField{
+		sdklog.Field("canonical_string", canonicalString),
+		sdklog.Field("string_to_sign", ctx.stringToSign),
+	}
+	if ctx.isPresign {
+		fields = append(fields, sdklog.Field("signed_url", signedURL))
+	}
+	sdklog.Log(v4a.Logger, aws.LogSeverityDebug, "request signature", msg, fields...)
+}
+
+func (ctx *signingCtx) build(disableHeaderHoisting bool) error {
+	ctx.buildTime()             // no depends
+	ctx.buildCredentialString() // no depends
+
+	if err := ctx.buildBodyDigest(); err != nil {
+		return err
+	}
+
+	unsignedHeaders := ctx.Request.Header
+	if ctx.isPresign {
+		if !disableHeaderHoisting {
+			urlValues := url.Values{}
+			urlValues, unsignedHeaders = buildQuery(v4Internal.AllowedQueryHoisting, unsignedHeaders) // no depends
+			for k := range urlValues {
+				ctx.Query[k] = urlValues[k]
+			}
+		}
+	}
+
+	ctx.buildCanonicalHeaders(ignoredHeaders, unsignedHeaders)
+	ctx.buildCanonicalString() // depends on canon headers / signed headers
+	ctx.buildStringToSign()    // depends on canon string
+	if err := ctx.buildSignature(); err != nil {
+		return err
+	}
+
+	if ctx.isPresign {
+		ctx.Request.URL.RawQuery += "&" + signatureQueryKey + "=" + ctx.signature
+	} else {
+		parts := []string{
+			signingAlgorithm + " Credential=" + ctx.credValues.AccessKeyID + "/" + ctx.credentialString,
+			"SignedHeaders=" + ctx.signedHeaders,
+			authHeaderSignatureElem + ctx.signature,
+		}
+		ctx.Request.Header.Set(authorizationHeader, strings.Join(parts, ", "))
+	}
+
+	return nil
+}
+
+func (ctx *signingCtx) buildTime() {
+	if ctx.isPresign {
+		duration := int64(ctx.ExpireTime / time.Second)
+		ctx.Query.Set("X-Amz-Date", formatTime(ctx.Time))
+		ctx.Query.Set("X-Amz-Expires", strconv.FormatInt(duration, 10))
+	} else {
+		ctx.Request.Header.Set("X-Amz-Date", formatTime(ctx.Time))
+	}
+}
+
+func (ctx *signingCtx) buildCredentialString() {
+	// The credential scope of SigV4a does not include the region, the region
+	// set is signed as the X-Amz-Region-Set header instead.
+	ctx.credentialString = strings.Join([]string{
+		formatShortTime(ctx.Time),
+		ctx.ServiceName,
+		awsV4Request,
+	}, "/")
+
+	if ctx.isPresign {
+		ctx.Query.Set("X-Amz-Credential", ctx.credValues.AccessKeyID+"/"+ctx.credentialString)
+	}
+}
+
+func buildQuery(r v4Internal.Rule, header http.Header) (url.Values, http.Header) {
+	query := url.Values{}
+	unsignedHeaders := http.Header{}
+	for k, h := range header {
+		if r.IsValid(k) {
+			query[k] = h
+		} else {
+			unsignedHeaders[k] = h
+		}
+	}
+
+	return query, unsignedHeaders
+}
+
+func (ctx *signingCtx) buildCanonicalHeaders(r v4Internal.Rule, header http.Header) {
+	var headers []string
+	headers = append(headers, "host")
+	for k, v := range header {
+		if !r.IsValid(k) {
+			continue // ignored header
+		}
+		if ctx.SignedHeaderVals == nil {
+			ctx.SignedHeaderVals = make(http.Header)
+		}
+
+		lowerCaseKey := strings.ToLower(k)
+		if _, ok := ctx.SignedHeaderVals[lowerCaseKey]; ok {
+			// include additional values
+			ctx.SignedHeaderVals[lowerCaseKey] = append(ctx.SignedHeaderVals[lowerCaseKey], v...)
+			continue
+		}
+
+		headers = append(headers, lowerCaseKey)
+		ctx.SignedHeaderVals[lowerCaseKey] = v
+	}
+	sort.Strings(headers)
+
+	ctx.signedHeaders = strings.Join(headers, ";")
+
+	if ctx.isPresign {
+		ctx.Query.Set("X-Amz-SignedHeaders", ctx.signedHeaders)
+	}
+
+	headerItems := make([]string, len(headers))
+	for i, k := range headers {
+		if k == "host" {
+			if ctx.Request.Host != "" {
+				headerItems[i] = "host:" + ctx.Request.Host
+			} else {
+				headerItems[i] = "host:" + ctx.Request.URL.Host
+			}
+		} else {
+			headerValues := make([]string, len(ctx.SignedHeaderVals[k]))
+			for i, v := range ctx.SignedHeaderVals[k] {
+				headerValues[i] = strings.TrimSpace(stripExcessSpaces(v))
+			}
+			headerItems[i] = k + ":" +
+				strings.Join(headerValues, ",")
+		}
+	}
+	ctx.canonicalHeaders = strings.Join(headerItems, "\n")
+}
+
+func (ctx *signingCtx) buildCanonicalString() {
+	ctx.Request.URL.RawQuery = strings.Replace(ctx.Query.Encode(), "+", "%20", -1)
+
+	uri := v4Internal.GetURIPath(ctx.Request.URL)
+
+	if !ctx.DisableURIPathEscaping {
+		uri = rest.EscapePath(uri, false)
+	}
+
+	ctx.canonicalString = strings.Join([]string{
+		ctx.Request.Method,
+		uri,
+		ctx.Request.URL.RawQuery,
+		ctx.canonicalHeaders + "\n",
+		ctx.signedHeaders,
+		ctx.bodyDigest,
+	}, "\n")
+}
+
+func (ctx *signingCtx) buildStringToSign() {
+	ctx.stringToSign = strings.Join([]string{
+		signingAlgorithm,
+		formatTime(ctx.Time),
+		ctx.credentialString,
+		hex.EncodeToString(hashSHA256([]byte(ctx.canonicalString))),
+	}, "\n")
+}
+
+func (ctx *signingCtx) buildSignature() error {
+	key, err := derivedKeys.get(ctx.credValues.AccessKeyID, ctx.credValues.SecretAccessKey)
+	if err != nil {
+		return err
+	}
+
+	signature, err := key.Sign(rand.Reader, hashSHA256([]byte(ctx.stringToSign)), crypto.SHA256)
+	if err != nil {
+		return err
+	}
+	ctx.signature = hex.EncodeToString(signature)
+
+	return nil
+}
+
+func (ctx *signingCtx) buildBodyDigest() error {
+	hash := ctx.Request.Header.Get("X-Amz-Content-Sha256")
+	if hash == "" {
+		includeSHA256Header := ctx.unsignedPayload ||
+			ctx.ServiceName == "s3" ||
+			ctx.ServiceName == "s3-object-lambda" ||
+			ctx.ServiceName == "glacier" ||
+			ctx.ServiceName == "s3-outposts"
+
+		s3Presign := ctx.isPresign &&
+			(ctx.ServiceName == "s3" ||
+				ctx.ServiceName == "s3-object-lambda")
+
+		if ctx.unsignedPayload || s3Presign {
+			hash = "UNSIGNED-PAYLOAD"
+			includeSHA256Header = !s3Presign
+		} else if ctx.Body == nil {
+			hash = emptyStringSHA256
+		} else {
+			if !aws.IsReaderSeekable(ctx.Body) {
+				return fmt.Errorf("cannot use unseekable request body %T, for signed request with body", ctx.Body)
+			}
+			hashBytes, err := makeSha256Reader(ctx.Body)
+			if err != nil {
+				return err
+			}
+			hash = hex.EncodeToString(hashBytes)
+		}
+
+		if includeSHA256Header {
+			ctx.Request.Header.Set("X-Amz-Content-Sha256", hash)
+		}
+	}
+	ctx.bodyDigest = hash
+
+	return nil
+}
+
+// isRequestSigned returns if the request is currently signed or presigned
+func (ctx *signingCtx) isRequestSigned() bool {
+	if ctx.isPresign && ctx.Query.Get(signatureQueryKey) != "" {
+		return true
+	}
+	if ctx.Request.Header.Get(authorizationHeader) != "" {
+		return true
+	}
+
+	return false
+}
+
+// removePresign removes signing flags for presigned requests.
+func (ctx *signingCtx) removePresign() {
+	ctx.Query.Del("X-Amz-Algorithm")
+	ctx.Query.Del(signatureQueryKey)
+	ctx.Query.Del("X-Amz-Security-Token")
+	ctx.Query.Del("X-Amz-Date")
+	ctx.Query.Del("X-Amz-Expires")
+	ctx.Query.Del("X-Amz-Credential")
+	ctx.Query.Del("X-Amz-SignedHeaders")
+	ctx.Query.Del(RegionSetHeader)
+}
+
+func hashSHA256(data []byte) []byte {
+	hash := sha256.New()
+	hash.Write(data)
+	return hash.Sum(nil)
+}
+
+func makeSha256Reader(reader io.ReadSeeker) (hashBytes []byte, err error) {
+	hash := sha256.New()
+	start, err := reader.Seek(0, sdkio.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		// ensure error is return if unable to seek back to start of payload.
+		_, err = reader.Seek(start, sdkio.SeekStart)
+	}()
+
+	// Use CopyN to avoid allocating the 32KB buffer in io.Copy for bodies
+	// smaller than 32KB. Fall back to io.Copy if we fail to determine the size.
+	size, err := aws.SeekerLen(reader)
+	if err != nil {
+		io.Copy(hash, reader)
+	} else {
+		io.CopyN(hash, reader, size)
+	}
+
+	return hash.Sum(nil), nil
+}
+
+const doubleSpace = "  "
+
+// stripExcessSpaces returns the string value without leading and trailing
+// spaces, and with multiple side-by-side spaces replaced by a single space.
+func stripExcessSpaces(str string) string {
+	str = strings.Trim(str, " ")
+	if !strings.Contains(str, doubleSpace) {
+		return str
+	}
+
+	var b strings.Builder
+	b.Grow(len(str))
+	var space bool
+	for i := 0; i < len(str); i++ {
+		if str[i] == ' ' {
+			if space {
+				continue
+			}
+			space = true
+		} else {
+			space = false
+		}
+		b.WriteByte(str[i])
+	}
+	return b.String()
+}
+
+func formatShortTime(dt time.Time) string {
+	return dt.UTC().Format(shortTimeFormat)
+}
+
+func formatTime(dt time.Time) string {
+	return dt.UTC().Format(timeFormat)
+}
@@ -0,0 +1,382 @@
+package v4a
+
+import (
+	"crypto/ecdsa"
+	"encoding/asn1"
+	"encoding/hex"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+)
+
+const (
+	testAccessKeyID     = "AKISORANDOMAASORANDOM"
+	testSecretAccessKey = "q+jcrXGc+0zWN6uzclKVhvMmUsIfRPa4rlRandom"
+)
+
+func epochTime() time.Time { return time.Unix(0, 0) }
+
+func buildRequest(serviceName, region string) *http.Request {
+	endpoint := "https://" + serviceName + "." + region + ".amazonaws.com"
+	req, _ := http.NewRequest("POST", endpoint, nil)
+	req.URL.Opaque = "//example.org/bucket/key-._~,!@%23$%25^&*()"
+	req.Header.Set("X-Amz-Target", "prefix.Operation")
+	req.Header.Set("Content-Type", "application/x-amz-json-1.0")
+	req.Header.Set("Content-Length", strconv.Itoa(1024))
+
+	req.Header.Set("X-Amz-Meta-Other-Header", "some-value=!@#$%^&* (+)")
+	req.Header.Add("X-Amz-Meta-Other-Header_With_Underscore", "some-value=!@#$%^&* (+)")
+	req.Header.Add("X-amz-Meta-Other-Header_With_Underscore", "some-value=!@#$%^&* (+)")
+	return req
+}
+
+func buildSigner(sessionToken string) Signer {
+	return Signer{
+		Credentials: credentials.NewStaticCredentials(testAccessKeyID, testSecretAccessKey, sessionToken),
+	}
+}
+
+// verifySignature verifies the hex encoded signature was made for the hex
+// encoded SHA256 of the string to sign, with the key derived from the test
+// credentials.
+func verifySignature(t *testing.T, expectStringToSignHash, signature string) {
+	t.Helper()
+
+	key, err := DeriveKey(testAccessKeyID, testSecretAccessKey)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	hash, err := hex.DecodeString(expectStringToSignHash)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	var ecdsaSig struct{ R, S *big.Int }
+	if _, err := asn1.Unmarshal(sig, &ecdsaSig); err != nil {
+		t.Fatalf("expect ASN.1 signature, got %v", err)
+	}
+	if !ecdsa.Verify(&key.PublicKey, hash, ecdsaSig.R, ecdsaSig.S) {
+		t.Errorf("expect signature to be verified")
+	}
+}
+
+func TestDeriveKey(t *testing.T) {
+	key, err := DeriveKey(testAccessKeyID, testSecretAccessKey)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expectX, _ := new(big.Int).SetString("15D242CEEBF8D8169FD6A8B5A746C41140414C3B07579038DA06AF89190FFFCB", 16)
+	expectY, _ := new(big.Int).SetString("515242CEDD82E94799482E4C0514B505AFCCF2C0C98D6A553BF539F424C5EC0", 16)
+
+	if e, a := expectX, key.X; e.Cmp(a) != 0 {
+		t.Errorf("expect X %X, got %X", e, a)
+	}
+	if e, a := expectY, key.Y; e.Cmp(a) != 0 {
+		t.Errorf("expect Y %X, got %X", e, a)
+	}
+}
+
+func TestConstantTimeCompare(t *testing.T) {
+	cases := []struct {
+		X, Y   []byte
+		Expect int
+	}{
+		{X: []byte{0x00, 0x01}, Y: []byte{0x00, 0x01}, Expect: 0},
+		{X: []byte{0x00, 0x01}, Y: []byte{0x00, 0x02}, Expect: -1},
+		{X: []byte{0x01, 0x00}, Y: []byte{0x00, 0xFF}, Expect: 1},
+		{X: []byte{0x00, 0xFF}, Y: []byte{0x01, 0x00}, Expect: -1},
+	}
+
+	for i, c := range cases {
+		if e, a := c.Expect, constantTimeCompare(c.X, c.Y); e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+	}
+}
+
+func TestSignRequest(t *testing.T) {
+	cases := map[string]struct {
+		SessionToken          string
+		ExpectSignedHeaders   string
+		ExpectStringToSignSHA string
+	}{
+		"with session token": {
+			SessionToken:          "TOKEN",
+			ExpectSignedHeaders:   "content-length;content-type;host;x-amz-date;x-amz-meta-other-header;x-amz-meta-other-header_with_underscore;x-amz-region-set;x-amz-security-token;x-amz-target",
+			ExpectStringToSignSHA: "4ba7d0482cf4d5450cefdc067a00de1a4a715e444856fa3e1d85c35fb34d9730",
+		},
+		"without session token": {
+			ExpectSignedHeaders:   "content-length;content-type;host;x-amz-date;x-amz-meta-other-header;x-amz-meta-other-header_with_underscore;x-amz-region-set;x-amz-target",
+			ExpectStringToSignSHA: "1aeefb422ae6aa0de7aec829da813e55cff35553cac212dffd5f9474c71e47ee",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := buildRequest("dynamodb", "us-east-1")
+
+			// ignored headers
+			req.Header.Set("User-Agent", "foo")
+			req.Header.Set("X-Amzn-Trace-Id", "bar")
+			req.Header.Set("Transfer-Encoding", "qux")
+
+			signer := buildSigner(c.SessionToken)
+			_, err := signer.Sign(req, nil, "dynamodb", []string{"us-east-1"}, epochTime())
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := "19700101T000000Z", req.Header.Get("X-Amz-Date"); e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+			if e, a := "us-east-1", req.Header.Get(RegionSetHeader); e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+
+			auth := req.Header.Get("Authorization")
+			prefix := "AWS4-ECDSA-P256-SHA256 Credential=AKISORANDOMAASORANDOM/19700101/dynamodb/aws4_request, SignedHeaders=" +
+				c.ExpectSignedHeaders + ", Signature="
+			if !strings.HasPrefix(auth, prefix) {
+				t.Fatalf("expect authorization header prefix\n%v\ngot\n%v", prefix, auth)
+			}
+			verifySignature(t, c.ExpectStringToSignSHA, auth[len(prefix):])
+		})
+	}
+}
+
+func TestPresignRequest(t *testing.T) {
+	cases := map[string]struct {
+		RawQuery              string
+		SessionToken          string
+		Expires               time.Duration
+		ExpectStringToSignSHA string
+	}{
+		"without session token": {
+			Expires:               18000 * time.Second,
+			ExpectStringToSignSHA: "d7ffbd2fab644384c056957e6ac38de4ae68246764b5f5df171b3824153b6397",
+		},
+		"body with array": {
+			RawQuery:              "Foo=z&Foo=o&Foo=m&Foo=a",
+			SessionToken:          "TOKEN",
+			Expires:               300 * time.Second,
+			ExpectStringToSignSHA: "acff64fd3689be96259d4112c3742ff79f4da0d813bc58a285dc1c4449760bec",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := buildRequest("dynamodb", "us-east-1")
+			req.URL.RawQuery = c.RawQuery
+
+			signer := buildSigner(c.SessionToken)
+			_, err := signer.Presign(req, nil, "dynamodb", []string{"us-east-1"}, c.Expires, epochTime())
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			q := req.URL.Query()
+
+			expect := map[string]string{
+				"X-Amz-Algorithm":     "AWS4-ECDSA-P256-SHA256",
+				"X-Amz-Credential":    "AKISORANDOMAASORANDOM/19700101/dynamodb/aws4_request",
+				"X-Amz-SignedHeaders": "content-length;content-type;host;x-amz-meta-other-header;x-amz-meta-other-header_with_underscore",
+				"X-Amz-Date":          "19700101T000000Z",
+				"X-Amz-Target":        "prefix.Operation",
+				"X-Amz-Region-Set":    "us-east-1",
+			}
+			for k, e := range expect {
+				if a := q.Get(k); e != a {
+					t.Errorf("expect %v to be %v, got %v", k, e, a)
+				}
+			}
+			if a := q.Get("X-Amz-Meta-Other-Header"); len(a) != 0 {
+				t.Errorf("expect %v to be empty", a)
+			}
+
+			verifySignature(t, c.ExpectStringToSignSHA, q.Get("X-Amz-Signature"))
+		})
+	}
+}
+
+func TestBuildCanonicalHeaders(t *testing.T) {
+	req, _ := http.NewRequest("POST", "https://mockAPI.mock-region.amazonaws.com", nil)
+	req.Header.Set("FooInnerSpace", "   inner      space    ")
+	req.Header.Set("FooLeadingSpace", "    leading-space")
+	req.Header.Add("FooMultipleSpace", "no-space")
+	req.Header.Add("FooMultipleSpace", "\ttab-space")
+	req.Header.Add("FooMultipleSpace", "trailing-space    ")
+	req.Header.Set("FooNoSpace", "no-space")
+	req.Header.Set("FooTabSpace", "\ttab-space\t")
+	req.Header.Set("FooTrailingSpace", "trailing-space    ")
+	req.Header.Set("FooWrappedSpace", "   wrapped-space    ")
+
+	ctx := &signingCtx{
+		ServiceName: "mockAPI",
+		RegionSet:   []string{"mock-region"},
+		Request:     req,
+		Query:       req.URL.Query(),
+		Time:        time.Date(2021, 10, 20, 12, 42, 0, 0, time.UTC),
+		credValues: credentials.Value{
+			AccessKeyID:     testAccessKeyID,
+			SecretAccessKey: testSecretAccessKey,
+		},
+	}
+	ctx.assignAmzQueryValues()
+	if err := ctx.build(false); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expect := strings.Join([]string{
+		`POST`,
+		`/`,
+		``,
+		`fooinnerspace:inner space`,
+		`fooleadingspace:leading-space`,
+		`foomultiplespace:no-space,tab-space,trailing-space`,
+		`foonospace:no-space`,
+		`footabspace:tab-space`,
+		`footrailingspace:trailing-space`,
+		`foowrappedspace:wrapped-space`,
+		`host:mockAPI.mock-region.amazonaws.com`,
+		`x-amz-date:20211020T124200Z`,
+		`x-amz-region-set:mock-region`,
+		``,
+		`fooinnerspace;fooleadingspace;foomultiplespace;foonospace;footabspace;footrailingspace;foowrappedspace;host;x-amz-date;x-amz-region-set`,
+		emptyStringSHA256,
+	}, "\n")
+	if e, a := expect, ctx.canonicalString; e != a {
+		t.Errorf("expect canonical string\n%v\ngot\n%v", e, a)
+	}
+}
+
+func TestSignRequest_RegionSetRequired(t *testing.T) {
+	req := buildRequest("dynamodb", "us-east-1")
+	signer := buildSigner("")
+
+	if _, err := signer.Sign(req, nil, "dynamodb", nil, epochTime()); err == nil {
+		t.Fatalf("expect error, got none")
+	}
+}
+
+func TestSignSDKRequest(t *testing.T) {
+	cases := map[string]struct {
+		SigningRegion   string
+		ExpireTime      time.Duration
+		ExpectRegionSet string
+	}{
+		"client region": {
+			ExpectRegionSet: "mock-region",
+		},
+		"wildcard": {
+			SigningRegion:   "*",
+			ExpectRegionSet: "*",
+		},
+		"region list": {
+			SigningRegion:   "us-east-1,us-west-2",
+			ExpectRegionSet: "us-east-1,us-west-2",
+		},
+		"presign": {
+			SigningRegion:   "*",
+			ExpireTime:      5 * time.Minute,
+			ExpectRegionSet: "*",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			svc := unit.Session.Copy(&aws.Config{
+				Credentials: credentials.NewStaticCredentials(testAccessKeyID, testSecretAccessKey, ""),
+			})
+			r := request.New(*svc.Config, metadataFor("dynamodb", c.SigningRegion), svc.Handlers, nil,
+				&request.Operation{Name: "Op", HTTPMethod: "POST", HTTPPath: "/"}, nil, nil)
+			r.ExpireTime = c.ExpireTime
+
+			SignSDKRequestWithCurrentTime(r, epochTime)
+			if r.Error != nil {
+				t.Fatalf("expect no error, got %v", r.Error)
+			}
+
+			var regionSet, algorithm string
+			if c.ExpireTime > 0 {
+				q := r.HTTPRequest.URL.Query()
+				regionSet, algorithm = q.Get(RegionSetHeader), q.Get("X-Amz-Algorithm")
+				if e, a := "300", q.Get("X-Amz-Expires"); e != a {
+					t.Errorf("expect %v, got %v", e, a)
+				}
+			} else {
+				regionSet = r.HTTPRequest.Header.Get(RegionSetHeader)
+				algorithm = strings.SplitN(r.HTTPRequest.Header.Get("Authorization"), " ", 2)[0]
+			}
+			if e, a := c.ExpectRegionSet, regionSet; e != a {
+				t.Errorf("expect %v region set, got %v", e, a)
+			}
+			if e, a := signingAlgorithm, algorithm; e != a {
+				t.Errorf("expect %v algorithm, got %v", e, a)
+			}
+			if e, a := epochTime(), r.LastSignedAt; !e.Equal(a) {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestSignSDKRequest_AnonymousCredentials(t *testing.T) {
+	svc := unit.Session.Copy(&aws.Config{Credentials: credentials.AnonymousCredentials})
+	r := request.New(*svc.Config, metadataFor("dynamodb", ""), svc.Handlers, nil,
+		&request.Operation{Name: "Op", HTTPMethod: "POST", HTTPPath: "/"}, nil, nil)
+
+	SignSDKRequest(r)
+	if r.Error != nil {
+		t.Fatalf("expect no error, got %v", r.Error)
+	}
+	if a := r.HTTPRequest.Header.Get("Authorization"); len(a) != 0 {
+		t.Errorf("expect request not to be signed, got %v", a)
+	}
+}
+
+func TestKeyCache(t *testing.T) {
+	cache := &keyCache{keys: map[string]cachedKey{}}
+
+	k1, err := cache.get(testAccessKeyID, testSecretAccessKey)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	k2, err := cache.get(testAccessKeyID, testSecretAccessKey)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if k1 != k2 {
+		t.Errorf("expect cached key to be reused")
+	}
+
+	k3, err := cache.get(testAccessKeyID, "other-secret")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if k1.D.Cmp(k3.D) == 0 {
+		t.Errorf("expect key derived for the new secret")
+	}
+}
+
+func metadataFor(service, signingRegion string) metadata.ClientInfo {
+	return metadata.ClientInfo{
+		ServiceName:   service,
+		SigningRegion: signingRegion,
+		Endpoint:      "https://" + service + ".mock-region.amazonaws.com",
+	}
+}
@@ -0,0 +1,277 @@
+// Package fault provides request handlers which inject faults into the
+// requests made by the SDK's API clients. Faults are used to test how an
+// application behaves when requests are slow, or fail, such as during a
+// partial service outage.
+//
+// An Injector is configured with Rules. Each rule selects the operations,
+// and the attempts of those operations, its Fault is injected into. Rules
+// can be triggered with a probability, or for specific attempts of a
+// request.
+//
+//	injector := fault.New(
+//		fault.Rule{
+//			Operations:  []string{"GetObject"},
+//			Probability: 0.1,
+//			Fault:       fault.ServerError(503),
+//		},
+//		fault.Rule{
+//			Attempts: []int{0, 1},
+//			Fault:    fault.Throttle("Throttling"),
+//		},
+//	)
+//	injector.Inject(&sess.Handlers)
+//
+// The Injector wraps the SDK's SendHandler, which must be present in the
+// handlers faults are injected into.
+package fault
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/corehandlers"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting/internal/httpresponse"
+)
+
+// SendHandlerName is the name of the Send handler installed by Inject.
+const SendHandlerName = "awstesting.fault.SendHandler"
+
+// Fault provides the interface for a fault injected into an attempt of a
+// request.
+type Fault interface {
+	// Inject injects the fault into the request attempt. The send function
+	// sends the attempt's HTTP request with the SDK's SendHandler. Faults
+	// which replace the HTTP request must not call send.
+	Inject(r *request.Request, send func(*request.Request))
+}
+
+// FaultFunc is a function which implements the Fault interface.
+type FaultFunc func(r *request.Request, send func(*request.Request))
+
+// Inject calls the function.
+func (fn FaultFunc) Inject(r *request.Request, send func(*request.Request)) {
+	fn(r, send)
+}
+
+// Rule selects the request attempts a Fault is injected into.
+type Rule struct {
+	// Operations are the names of the API operations the fault is injected
+	// into. The fault is injected into all operations if empty.
+	Operations []string
+
+	// Attempts are the attempts of the request, counted from zero, the fault
+	// is injected into. If empty, the fault is injected with Probability
+	// into all attempts.
+	Attempts []int
+
+	// Probability is the probability, between 0 and 1, the fault is injected
+	// into an attempt selected by the rule. If zero, and Attempts is set, the
+	// fault is always injected into the selected attempts.
+	Probability float64
+
+	// Fault is the fault to inject.
+	Fault Fault
+}
+
+func (rule Rule) selects(r *request.Request) bool {
+	if len(rule.Operations) != 0 && !containsString(rule.Operations, r.Operation.Name) {
+		return false
+	}
+	if len(rule.Attempts) != 0 && !containsInt(rule.Attempts, r.RetryCount) {
+		return false
+	}
+	return true
+}
+
+// Injector injects faults into request attempts based on its rules. An
+// Injector is safe for concurrent use.
+type Injector struct {
+	rules []Rule
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// New returns an Injector with the rules provided. Rules are evaluated in
+// order, and the fault of the first rule triggered for an attempt is
+// injected.
+func New(rules ...Rule) *Injector {
+	return NewWithSeed(time.Now().UnixNano(), rules...)
+}
+
+// NewWithSeed returns an Injector like New, with the random source used for
+// rule probabilities seeded with the value provided. Useful for reproducing
+// the faults injected.
+func NewWithSeed(seed int64, rules ...Rule) *Injector {
+	return &Injector{
+		rules: append([]Rule(nil), rules...),
+		rand:  rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Inject replaces the SDK's SendHandler in the handlers with a handler
+// which injects faults into the requests sent. Returns false if the handlers
+// do not include the SDK's SendHandler.
+func (i *Injector) Inject(handlers *request.Handlers) bool {
+	return handlers.Send.Swap(corehandlers.SendHandler.Name, request.NamedHandler{
+		Name: SendHandlerName,
+		Fn:   i.send,
+	})
+}
+
+func (i *Injector) send(r *request.Request) {
+	if f := i.fault(r); f != nil {
+		f.Inject(r, corehandlers.SendHandler.Fn)
+		return
+	}
+	corehandlers.SendHandler.Fn(r)
+}
+
+// fault returns the fault of the first rule triggered for the request
+// attempt, or nil if no rules were triggered.
+func (i *Injector) fault(r *request.Request) Fault {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, rule := range i.rules {
+		if !rule.selects(r) {
+			continue
+		}
+		if len(rule.Attempts) != 0 && rule.Probability == 0 {
+			return rule.Fault
+		}
+		if i.rand.Float64() < rule.Probability {
+			return rule.Fault
+		}
+	}
+	return nil
+}
+
+// Latency returns a Fault which delays the attempt's HTTP request by the
+// duration provided. The request is sent after the delay, unless the
+// request's context is canceled.
+func Latency(d time.Duration) Fault {
+	return FaultFunc(func(r *request.Request, send func(*request.Request)) {
+		if err := aws.SleepWithContext(r.Context(), d); err != nil {
+			r.HTTPResponse = httpresponse.New(r, 0)
+			r.Error = awserr.New(request.CanceledErrorCode, "request context canceled", err)
+			r.Retryable = aws.Bool(false)
+			return
+		}
+		send(r)
+	})
+}
+
+// ConnectionReset returns a Fault which fails the attempt with a connection
+// reset error, in the same way the SDK's SendHandler reports connection
+// resets returned by the HTTP client. The HTTP request is not sent.
+func ConnectionReset() Fault {
+	return FaultFunc(func(r *request.Request, send func(*request.Request)) {
+		// Formatted like the errors returned by the HTTP client, e.g.
+		// "Post "https://...": write tcp: connection reset by peer".
+		method := r.HTTPRequest.Method
+		err := &url.Error{
+			Op:  method[:1] + strings.ToLower(method[1:]),
+			URL: r.HTTPRequest.URL.String(),
+			Err: &net.OpError{
+				Op:  "write",
+				Net: "tcp",
+				Err: errors.New("connection reset by peer"),
+			},
+		}
+
+		r.HTTPResponse = httpresponse.New(r, 0)
+		r.Error = awserr.New(request.ErrCodeRequestError, "send request failed", err)
+	})
+}
+
+// ServerError returns a Fault which fails the attempt with a server error
+// response with the status code provided, such as 500 or 503. The HTTP
+// request is not sent.
+func ServerError(statusCode int) Fault {
+	code := "InternalError"
+	if statusCode == http.StatusServiceUnavailable {
+		code = "ServiceUnavailable"
+	}
+	return ErrorResponse(statusCode, code)
+}
+
+// Throttle returns a Fault which fails the attempt with a throttling error
+// response with the error code provided, such as Throttling, or
+// ThrottlingException. The HTTP request is not sent.
+func Throttle(code string) Fault {
+	return ErrorResponse(http.StatusBadRequest, code)
+}
+
+// ErrorResponse returns a Fault which fails the attempt with an error
+// response with the status code and error code provided. The HTTP request is
+// not sent.
+func ErrorResponse(statusCode int, code string) Fault {
+	return FaultFunc(func(r *request.Request, send func(*request.Request)) {
+		r.HTTPResponse = httpresponse.New(r, statusCode)
+		r.Error = awserr.NewRequestFailure(
+			awserr.New(code, "fault injected error response", nil),
+			statusCode, "")
+	})
+}
+
+// TruncatedBody returns a Fault which sends the attempt's HTTP request, and
+// truncates the response body after n bytes. Reading past the truncated
+// length fails with io.ErrUnexpectedEOF.
+func TruncatedBody(n int64) Fault {
+	return FaultFunc(func(r *request.Request, send func(*request.Request)) {
+		send(r)
+		if r.Error != nil || r.HTTPResponse == nil || r.HTTPResponse.Body == nil {
+			return
+		}
+		r.HTTPResponse.Body = &truncatedReadCloser{
+			ReadCloser: r.HTTPResponse.Body,
+			remaining:  n,
+		}
+	})
+}
+
+type truncatedReadCloser struct {
+	io.ReadCloser
+	remaining int64
+}
+
+func (t *truncatedReadCloser) Read(p []byte) (int, error) {
+	if t.remaining <= 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if int64(len(p)) > t.remaining {
+		p = p[:t.remaining]
+	}
+	n, err := t.ReadCloser.Read(p)
+	t.remaining -= int64(n)
+	return n, err
+}
+
+func containsString(vs []string, v string) bool {
+	for _, s := range vs {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt(vs []int, v int) bool {
+	for _, i := range vs {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
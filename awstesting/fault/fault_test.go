@@ -0,0 +1,191 @@
+package fault_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/awstesting"
+	"github.com/aws/aws-sdk-go/awstesting/fault"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+func newClient(t *testing.T, injector *fault.Injector) (*dynamodb.DynamoDB, *int32) {
+	t.Helper()
+
+	env := awstesting.StashEnv()
+	t.Cleanup(func() { awstesting.PopEnv(env) })
+
+	var sent int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&sent, 1)
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		fmt.Fprint(w, `{"TableNames":["table-one","table-two"]}`)
+	}))
+	t.Cleanup(server.Close)
+
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String("us-west-2"),
+		Endpoint:    aws.String(server.URL),
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		MaxRetries:  aws.Int(3),
+		SleepDelay:  func(time.Duration) {},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	svc := dynamodb.New(sess)
+	if !injector.Inject(&svc.Handlers) {
+		t.Fatalf("expect fault injector to be injected")
+	}
+	return svc, &sent
+}
+
+func TestInjector_RetriedFaults(t *testing.T) {
+	cases := map[string]struct {
+		Fault  fault.Fault
+		Expect func(*testing.T, *request.Request)
+	}{
+		"connection reset": {
+			Fault: fault.ConnectionReset(),
+			Expect: func(t *testing.T, r *request.Request) {
+				if e, a := request.ErrCodeRequestError, r.Error.(awserr.Error).Code(); e != a {
+					t.Errorf("expect %v error code, got %v", e, a)
+				}
+				if !r.IsErrorRetryable() {
+					t.Errorf("expect connection reset to be retryable")
+				}
+			},
+		},
+		"server error": {
+			Fault: fault.ServerError(503),
+			Expect: func(t *testing.T, r *request.Request) {
+				if e, a := 503, r.Error.(awserr.RequestFailure).StatusCode(); e != a {
+					t.Errorf("expect %v status code, got %v", e, a)
+				}
+				if !r.IsErrorThrottle() {
+					t.Errorf("expect 503 to be throttled")
+				}
+			},
+		},
+		"throttle": {
+			Fault: fault.Throttle("ThrottlingException"),
+			Expect: func(t *testing.T, r *request.Request) {
+				if e, a := "ThrottlingException", r.Error.(awserr.Error).Code(); e != a {
+					t.Errorf("expect %v error code, got %v", e, a)
+				}
+				if !r.IsErrorThrottle() {
+					t.Errorf("expect throttling error")
+				}
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			svc, sent := newClient(t, fault.New(fault.Rule{
+				Operations: []string{"ListTables"},
+				Attempts:   []int{0, 1},
+				Fault:      c.Fault,
+			}))
+
+			req, out := svc.ListTablesRequest(&dynamodb.ListTablesInput{})
+			var attempts int
+			req.Handlers.CompleteAttempt.PushBack(func(r *request.Request) {
+				if attempts++; r.RetryCount < 2 {
+					c.Expect(t, r)
+				}
+			})
+			if err := req.Send(); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := 3, attempts; e != a {
+				t.Errorf("expect %v attempts, got %v", e, a)
+			}
+			if e, a := int32(1), atomic.LoadInt32(sent); e != a {
+				t.Errorf("expect %v requests sent, got %v", e, a)
+			}
+			if e, a := 2, len(out.TableNames); e != a {
+				t.Errorf("expect %v tables, got %v", e, a)
+			}
+
+			// Rules only apply to the operations selected.
+			if _, err := svc.DescribeLimits(&dynamodb.DescribeLimitsInput{}); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestInjector_TruncatedBody(t *testing.T) {
+	svc, _ := newClient(t, fault.New(fault.Rule{
+		Probability: 1,
+		Fault:       fault.TruncatedBody(10),
+	}))
+
+	req, _ := svc.ListTablesRequest(&dynamodb.ListTablesInput{})
+	err := req.Send()
+	if err == nil {
+		t.Fatalf("expect error")
+	}
+	if e, a := request.ErrCodeSerialization, err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+}
+
+func TestInjector_Latency(t *testing.T) {
+	svc, sent := newClient(t, fault.New(fault.Rule{
+		Probability: 1,
+		Fault:       fault.Latency(time.Minute),
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := svc.ListTablesWithContext(ctx, &dynamodb.ListTablesInput{})
+	if err == nil {
+		t.Fatalf("expect error")
+	}
+	if e, a := request.CanceledErrorCode, err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+	if e, a := int32(0), atomic.LoadInt32(sent); e != a {
+		t.Errorf("expect %v requests sent, got %v", e, a)
+	}
+}
+
+func TestInjector_Probability(t *testing.T) {
+	svc, sent := newClient(t, fault.NewWithSeed(1,
+		fault.Rule{Probability: 0, Fault: fault.ServerError(500)},
+	))
+
+	for i := 0; i < 10; i++ {
+		if _, err := svc.ListTables(&dynamodb.ListTablesInput{}); err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+	}
+	if e, a := int32(10), atomic.LoadInt32(sent); e != a {
+		t.Errorf("expect %v requests sent, got %v", e, a)
+	}
+
+	svc, _ = newClient(t, fault.NewWithSeed(1,
+		fault.Rule{Probability: 1, Fault: fault.ServerError(500)},
+	))
+	req, _ := svc.ListTablesRequest(&dynamodb.ListTablesInput{})
+	if err := req.Send(); err == nil {
+		t.Fatalf("expect error")
+	}
+	if e, a := 3, req.RetryCount; e != a {
+		t.Errorf("expect %v retries, got %v", e, a)
+	}
+}
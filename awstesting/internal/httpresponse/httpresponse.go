@@ -0,0 +1,24 @@
+// Package httpresponse provides the HTTP responses the awstesting packages
+// set on requests in place of responses sent by the service.
+package httpresponse
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// New returns an HTTP response with the status code, and an empty header and
+// body, for the request.
+func New(r *request.Request, statusCode int) *http.Response {
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode: statusCode,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+		Request:    r.HTTPRequest,
+	}
+}
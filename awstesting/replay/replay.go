@@ -0,0 +1,540 @@
+// Package replay provides an http.RoundTripper which records the HTTP
+// requests made by the SDK's API clients, and their responses, to a fixture
+// file, and replays the recorded responses later. Tests using the replayed
+// responses run offline and deterministically with any API client.
+//
+// In ModeRecord requests are sent with the underlying Transport, and the
+// request and response pairs are saved to the fixture file by Save.
+//
+//	rec, err := replay.New("testdata/get_object.json", replay.ModeRecord)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer rec.Save()
+//
+//	sess := session.Must(session.NewSession(&aws.Config{
+//		HTTPClient: rec.HTTPClient(),
+//	}))
+//
+// In ModeReplay the recorded responses are returned for requests matching a
+// recorded request. Requests are matched by their operation, canonical body,
+// and the headers selected with Options.MatchHeaders. Requests without a
+// matching recorded request fail with an error.
+//
+// Signing headers, presign query parameters, and credentials in request and
+// response bodies are redacted from the fixture file when it is recorded.
+package replay
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Mode is the mode a Recorder operates in.
+type Mode int
+
+const (
+	// ModeReplay returns the recorded responses of the fixture file, without
+	// sending requests.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends requests with the underlying Transport, and records
+	// the request and response pairs.
+	ModeRecord
+)
+
+// RedactedValue is the value redacted headers, query parameters, and body
+// fields are replaced with in recorded fixtures.
+const RedactedValue = "REDACTED"
+
+// Options provides the options for a Recorder.
+type Options struct {
+	// Transport is used to send requests in ModeRecord. Defaults to
+	// http.DefaultTransport.
+	Transport http.RoundTripper
+
+	// MatchHeaders are the names of the request headers which must be equal
+	// for a request to match a recorded request, in addition to its
+	// operation and body. Redacted headers cannot be matched.
+	MatchHeaders []string
+
+	// RedactHeaders are the names of the request and response headers whose
+	// values are redacted from recorded fixtures. Defaults to
+	// DefaultRedactHeaders.
+	RedactHeaders []string
+
+	// RedactQuery are the names of the query parameters whose values are
+	// redacted from recorded request URLs. Defaults to DefaultRedactQuery.
+	RedactQuery []string
+
+	// RedactBodyFields are the names of the XML elements, JSON fields, and
+	// form parameters whose values are redacted from recorded request and
+	// response bodies. The string values of JSON object fields, such as
+	// Cognito's Logins, are redacted. Defaults to DefaultRedactBodyFields.
+	RedactBodyFields []string
+
+	// IgnoreBodyFields are the names of the top level JSON fields, and form
+	// parameters, ignored when matching request bodies. Used for values that
+	// differ between requests, such as generated idempotency tokens.
+	// Defaults to DefaultIgnoreBodyFields.
+	IgnoreBodyFields []string
+}
+
+// DefaultRedactHeaders are the headers redacted from recorded fixtures when
+// Options.RedactHeaders is not set.
+var DefaultRedactHeaders = []string{
+	"Authorization",
+	"X-Amz-Security-Token",
+	"X-Amz-Date",
+	"X-Amz-Content-Sha256",
+	"X-Amz-Decoded-Content-Length",
+}
+
+// DefaultRedactQuery are the query parameters redacted from recorded request
+// URLs when Options.RedactQuery is not set.
+var DefaultRedactQuery = []string{
+	"X-Amz-Algorithm",
+	"X-Amz-Credential",
+	"X-Amz-Date",
+	"X-Amz-Expires",
+	"X-Amz-Security-Token",
+	"X-Amz-Signature",
+	"X-Amz-SignedHeaders",
+}
+
+// DefaultRedactBodyFields are the request and response body fields redacted
+// from recorded fixtures when Options.RedactBodyFields is not set.
+var DefaultRedactBodyFields = []string{
+	"AccessKeyId",
+	"SecretAccessKey",
+	"SecretKey",
+	"SessionToken",
+	"WebIdentityToken",
+	"clientSecret",
+	"refreshToken",
+	"Logins",
+}
+
+// DefaultIgnoreBodyFields are the request body fields ignored when matching
+// requests when Options.IgnoreBodyFields is not set.
+var DefaultIgnoreBodyFields = []string{
+	"ClientToken",
+	"ClientRequestToken",
+	"IdempotencyToken",
+}
+
+// Recorder is an http.RoundTripper which records, or replays, the HTTP
+// requests made with it. A Recorder is safe for concurrent use.
+type Recorder struct {
+	filename string
+	mode     Mode
+	options  Options
+
+	// redactBodyFields are the expressions redacting each of the
+	// RedactBodyFields, compiled when the Recorder is created.
+	redactBodyFields []bodyFieldRedactor
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	used         []bool
+}
+
+// New returns a Recorder for the fixture file provided. In ModeReplay the
+// fixture file is loaded, and an error is returned if it cannot be read. In
+// ModeRecord the fixture file will be written by Save.
+func New(filename string, mode Mode, optFns ...func(*Options)) (*Recorder, error) {
+	opts := Options{
+		Transport:        http.DefaultTransport,
+		RedactHeaders:    DefaultRedactHeaders,
+		RedactQuery:      DefaultRedactQuery,
+		RedactBodyFields: DefaultRedactBodyFields,
+		IgnoreBodyFields: DefaultIgnoreBodyFields,
+	}
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	r := &Recorder{
+		filename: filename,
+		mode:     mode,
+		options:  opts,
+	}
+	for _, name := range opts.RedactBodyFields {
+		r.redactBodyFields = append(r.redactBodyFields, newBodyFieldRedactor(name))
+	}
+
+	if mode == ModeReplay {
+		b, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read replay fixture, %v", err)
+		}
+		var f fixture
+		if err := json.Unmarshal(b, &f); err != nil {
+			return nil, fmt.Errorf("failed to decode replay fixture %s, %v", filename, err)
+		}
+		r.interactions = f.Interactions
+		r.used = make([]bool, len(f.Interactions))
+	}
+
+	return r, nil
+}
+
+// HTTPClient returns an http.Client using the Recorder as its transport.
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Interactions returns the request and response pairs recorded, or loaded
+// from the fixture file.
+func (r *Recorder) Interactions() []*Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*Interaction(nil), r.interactions...)
+}
+
+// Save writes the recorded request and response pairs to the fixture file.
+// The fixture file's directory is created if it does not exist. Save does
+// nothing in ModeReplay.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	b, err := json.MarshalIndent(fixture{Interactions: r.interactions}, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to encode replay fixture, %v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.filename), 0755); err != nil {
+		return fmt.Errorf("failed to create replay fixture directory, %v", err)
+	}
+	if err := ioutil.WriteFile(r.filename, append(b, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write replay fixture, %v", err)
+	}
+	return nil
+}
+
+// RoundTrip records, or replays, the HTTP request depending on the mode of
+// the Recorder.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.mode == ModeRecord {
+		return r.record(req, body)
+	}
+	return r.replay(req, body)
+}
+
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	// The request must not be modified by the RoundTripper, and a copy of
+	// the request is sent with the body that was read.
+	sendReq := req.Clone(req.Context())
+	sendReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+	resp, err := r.options.Transport.RoundTrip(sendReq)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	interaction := &Interaction{
+		Request: Request{
+			Operation: operationName(req, body),
+			Method:    req.Method,
+			URL:       r.redactURL(req.URL),
+			Header:    r.redactHeader(req.Header),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     r.redactHeader(resp.Header),
+		},
+	}
+	interaction.Request.Body, interaction.Request.BodyEncoding = encodeBody(r.redactBody(body))
+	interaction.Response.Body, interaction.Response.BodyEncoding = encodeBody(r.redactBody(respBody))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.interactions = append(r.interactions, interaction)
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	op := operationName(req, body)
+	// Recorded request bodies are redacted, and are matched with the
+	// request's redacted body.
+	canonical := r.canonicalBody(req.Header, r.redactBody(body))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.interactions {
+		if r.used[i] || !r.matches(interaction, req, op, canonical) {
+			continue
+		}
+		r.used[i] = true
+		return interaction.Response.httpResponse(req)
+	}
+
+	return nil, fmt.Errorf("replay: no recorded request matches %s %s, operation %q",
+		req.Method, req.URL.String(), op)
+}
+
+func (r *Recorder) matches(i *Interaction, req *http.Request, op, canonical string) bool {
+	if i.Request.Operation != op || i.Request.Method != req.Method {
+		return false
+	}
+
+	for _, name := range r.options.MatchHeaders {
+		if i.Request.Header.Get(name) != req.Header.Get(name) {
+			return false
+		}
+	}
+
+	body, err := decodeBody(i.Request.Body, i.Request.BodyEncoding)
+	if err != nil {
+		return false
+	}
+	return r.canonicalBody(i.Request.Header, body) == canonical
+}
+
+// canonicalBody returns the request body in a form which does not depend on
+// the ordering of JSON fields and form parameters, with the ignored body
+// fields removed.
+func (r *Recorder) canonicalBody(header http.Header, body []byte) string {
+	contentType := header.Get("Content-Type")
+
+	switch {
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			break
+		}
+		for _, name := range r.options.IgnoreBodyFields {
+			values.Del(name)
+		}
+		return values.Encode()
+
+	case strings.Contains(contentType, "json"):
+		dec := json.NewDecoder(bytes.NewReader(body))
+		dec.UseNumber()
+		var v interface{}
+		if err := dec.Decode(&v); err != nil {
+			break
+		}
+		if m, ok := v.(map[string]interface{}); ok {
+			for _, name := range r.options.IgnoreBodyFields {
+				delete(m, name)
+			}
+		}
+		// Maps are marshaled with their keys sorted.
+		b, err := json.Marshal(v)
+		if err != nil {
+			break
+		}
+		return string(b)
+	}
+
+	return string(body)
+}
+
+func (r *Recorder) redactHeader(header http.Header) http.Header {
+	redacted := make(http.Header, len(header))
+	for k, v := range header {
+		redacted[k] = append([]string(nil), v...)
+	}
+	for _, name := range r.options.RedactHeaders {
+		if _, ok := redacted[http.CanonicalHeaderKey(name)]; ok {
+			redacted.Set(name, RedactedValue)
+		}
+	}
+	return redacted
+}
+
+func (r *Recorder) redactURL(u *url.URL) string {
+	redacted := *u
+	query := redacted.Query()
+	var changed bool
+	for _, name := range r.options.RedactQuery {
+		if _, ok := query[name]; ok {
+			query.Set(name, RedactedValue)
+			changed = true
+		}
+	}
+	if changed {
+		redacted.RawQuery = query.Encode()
+	}
+	return redacted.String()
+}
+
+// jsonStringValue matches the string values of JSON object fields.
+var jsonStringValue = regexp.MustCompile(`("(?:[^"\\]|\\.)*"\s*:\s*")(?:[^"\\]|\\.)*(")`)
+
+// bodyFieldRedactor redacts the value of a field from XML, JSON, and form
+// encoded bodies.
+type bodyFieldRedactor struct {
+	xmlField        *regexp.Regexp
+	jsonField       *regexp.Regexp
+	jsonObjectField *regexp.Regexp
+	formField       *regexp.Regexp
+}
+
+func newBodyFieldRedactor(name string) bodyFieldRedactor {
+	name = regexp.QuoteMeta(name)
+	return bodyFieldRedactor{
+		xmlField:        regexp.MustCompile(`(<` + name + `>)[^<]*(</` + name + `>)`),
+		jsonField:       regexp.MustCompile(`("` + name + `"\s*:\s*")(?:[^"\\]|\\.)*(")`),
+		jsonObjectField: regexp.MustCompile(`"` + name + `"\s*:\s*\{[^{}]*\}`),
+		formField:       regexp.MustCompile(`((?:^|&)` + name + `=)[^&]*`),
+	}
+}
+
+func (f bodyFieldRedactor) redact(body []byte) []byte {
+	body = f.xmlField.ReplaceAll(body, []byte("${1}"+RedactedValue+"${2}"))
+	body = f.jsonField.ReplaceAll(body, []byte("${1}"+RedactedValue+"${2}"))
+	body = f.jsonObjectField.ReplaceAllFunc(body, func(b []byte) []byte {
+		return jsonStringValue.ReplaceAll(b, []byte("${1}"+RedactedValue+"${2}"))
+	})
+	body = f.formField.ReplaceAll(body, []byte("${1}"+RedactedValue))
+	return body
+}
+
+func (r *Recorder) redactBody(body []byte) []byte {
+	for _, f := range r.redactBodyFields {
+		body = f.redact(body)
+	}
+	return body
+}
+
+// operationName returns the name of the API operation the request is made
+// for. JSON protocol operations are identified by their X-Amz-Target header,
+// and query protocol operations by their Action parameter. REST protocol
+// operations are identified by their method, host, and path.
+func operationName(req *http.Request, body []byte) string {
+	if v := req.Header.Get("X-Amz-Target"); len(v) != 0 {
+		return v
+	}
+	if v := req.URL.Query().Get("Action"); len(v) != 0 {
+		return v
+	}
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			if v := values.Get("Action"); len(v) != 0 {
+				return v
+			}
+		}
+	}
+
+	return req.Method + " " + req.URL.Host + req.URL.EscapedPath()
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	defer req.Body.Close()
+
+	b, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("replay: failed to read request body, %v", err)
+	}
+	return b, nil
+}
+
+// bodyEncodingBase64 is the encoding of recorded bodies which are not valid
+// UTF-8.
+const bodyEncodingBase64 = "base64"
+
+func encodeBody(b []byte) (string, string) {
+	if utf8.Valid(b) {
+		return string(b), ""
+	}
+	return base64.StdEncoding.EncodeToString(b), bodyEncodingBase64
+}
+
+func decodeBody(body, encoding string) ([]byte, error) {
+	switch encoding {
+	case "":
+		return []byte(body), nil
+	case bodyEncodingBase64:
+		return base64.StdEncoding.DecodeString(body)
+	default:
+		return nil, fmt.Errorf("replay: unknown body encoding %q", encoding)
+	}
+}
+
+type fixture struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded HTTP request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded HTTP request.
+type Request struct {
+	Operation    string      `json:"operation"`
+	Method       string      `json:"method"`
+	URL          string      `json:"url"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         string      `json:"body,omitempty"`
+	BodyEncoding string      `json:"bodyEncoding,omitempty"`
+}
+
+// Response is a recorded HTTP response.
+type Response struct {
+	StatusCode   int         `json:"statusCode"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         string      `json:"body,omitempty"`
+	BodyEncoding string      `json:"bodyEncoding,omitempty"`
+}
+
+func (r Response) httpResponse(req *http.Request) (*http.Response, error) {
+	body, err := decodeBody(r.Body, r.BodyEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make(http.Header, len(r.Header))
+	for k, v := range r.Header {
+		header[k] = append([]string(nil), v...)
+	}
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+
+	return &http.Response{
+		Status:        strconv.Itoa(r.StatusCode) + " " + http.StatusText(r.StatusCode),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
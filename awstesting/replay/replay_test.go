@@ -0,0 +1,284 @@
+package replay_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/awstesting"
+	"github.com/aws/aws-sdk-go/awstesting/replay"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+const assumeRoleResponse = `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>AKIDRECORDED</AccessKeyId>
+      <SecretAccessKey>secret-recorded</SecretAccessKey>
+      <SessionToken>token-recorded</SessionToken>
+      <Expiration>2014-10-24T23:00:23Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+  <ResponseMetadata><RequestId>request-id</RequestId></ResponseMetadata>
+</AssumeRoleResponse>`
+
+func newSession(t *testing.T, rec *replay.Recorder, endpoint string) *session.Session {
+	t.Helper()
+
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String("us-west-2"),
+		Endpoint:    aws.String(endpoint),
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", "SESSION"),
+		HTTPClient:  rec.HTTPClient(),
+		MaxRetries:  aws.Int(0),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	return sess
+}
+
+func TestRecorder_RecordReplay(t *testing.T) {
+	env := awstesting.StashEnv()
+	defer awstesting.PopEnv(env)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.Header.Get("X-Amz-Target"), "GetItem") {
+			b, _ := ioutil.ReadAll(r.Body)
+			w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+			fmt.Fprintf(w, `{"Item":{"echo":{"S":%q}}}`, b)
+			return
+		}
+		fmt.Fprint(w, assumeRoleResponse)
+	}))
+	filename := filepath.Join(t.TempDir(), "testdata", "fixture.json")
+
+	rec, err := replay.New(filename, replay.ModeRecord)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	sess := newSession(t, rec, server.URL)
+	recordedItem := makeRequests(t, sess)
+	if err := rec.Save(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	server.Close()
+
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	for _, secret := range []string{"SECRET", "SESSION", "secret-recorded", "token-recorded", "AKIDRECORDED", "Signature="} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("expect %q to be redacted from fixture\n%s", secret, b)
+		}
+	}
+
+	rec, err = replay.New(filename, replay.ModeReplay)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	sess = newSession(t, rec, server.URL)
+	if e, a := recordedItem, makeRequests(t, sess); e != a {
+		t.Errorf("expect %v replayed item, got %v", e, a)
+	}
+
+	// All recorded responses have been replayed.
+	_, err = sts.New(sess).AssumeRole(&sts.AssumeRoleInput{
+		RoleArn:         aws.String("arn:aws:iam::123456789012:role/role"),
+		RoleSessionName: aws.String("session"),
+	})
+	if err == nil {
+		t.Fatalf("expect error for request without recorded response")
+	}
+	if e, a := "no recorded request matches", err.Error(); !strings.Contains(a, e) {
+		t.Errorf("expect %q in error, got %v", e, a)
+	}
+}
+
+func makeRequests(t *testing.T, sess *session.Session) string {
+	t.Helper()
+
+	out, err := sts.New(sess).AssumeRole(&sts.AssumeRoleInput{
+		RoleArn:         aws.String("arn:aws:iam::123456789012:role/role"),
+		RoleSessionName: aws.String("session"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if out.Credentials == nil || out.Credentials.Expiration == nil {
+		t.Fatalf("expect credentials, got %v", out)
+	}
+
+	item, err := dynamodb.New(sess).GetItem(&dynamodb.GetItemInput{
+		TableName: aws.String("table"),
+		Key: map[string]*dynamodb.AttributeValue{
+			"a": {S: aws.String("1")},
+			"b": {S: aws.String("2")},
+			"c": {S: aws.String("3")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	return aws.StringValue(item.Item["echo"].S)
+}
+
+func TestRecorder_ReplayMatching(t *testing.T) {
+	filename := filepath.Join("testdata", "match.json")
+	rec, err := replay.New(filename, replay.ModeReplay, func(o *replay.Options) {
+		o.MatchHeaders = []string{"X-Test"}
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	cases := []struct {
+		Header     string
+		Body       string
+		ExpectBody string
+		ExpectErr  bool
+	}{
+		{Header: "one", Body: `{"b":2, "a":1, "ClientToken":"abc"}`, ExpectBody: "first"},
+		{Header: "two", Body: `{"a":1,"b":2}`, ExpectBody: "second"},
+		{Header: "one", Body: `{"a":1,"b":3}`, ExpectErr: true},
+		// Each recorded response is only replayed once.
+		{Header: "one", Body: `{"a":1,"b":2}`, ExpectErr: true},
+	}
+
+	for i, c := range cases {
+		req, _ := http.NewRequest("POST", "https://dynamodb.us-west-2.amazonaws.com/", strings.NewReader(c.Body))
+		req.Header.Set("X-Amz-Target", "DynamoDB_20120810.GetItem")
+		req.Header.Set("Content-Type", "application/x-amz-json-1.0")
+		req.Header.Set("X-Test", c.Header)
+
+		resp, err := rec.RoundTrip(req)
+		if c.ExpectErr {
+			if err == nil {
+				t.Errorf("%d, expect error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		b, _ := ioutil.ReadAll(resp.Body)
+		if e, a := c.ExpectBody, string(b); e != a {
+			t.Errorf("%d, expect %v body, got %v", i, e, a)
+		}
+		if e, a := int64(len(b)), resp.ContentLength; e != a {
+			t.Errorf("%d, expect %v content length, got %v", i, e, a)
+		}
+	}
+}
+
+func TestRecorder_RedactRequestBody(t *testing.T) {
+	env := awstesting.StashEnv()
+	defer awstesting.PopEnv(env)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.Header.Get("X-Amz-Target"), "GetCredentialsForIdentity") {
+			w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+			fmt.Fprint(w, `{"IdentityId":"identity-id"}`)
+			return
+		}
+		fmt.Fprint(w, `<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult></AssumeRoleWithWebIdentityResult>
+</AssumeRoleWithWebIdentityResponse>`)
+	}))
+	defer server.Close()
+	filename := filepath.Join(t.TempDir(), "fixture.json")
+
+	makeRequests := func(sess *session.Session) {
+		_, err := sts.New(sess).AssumeRoleWithWebIdentity(&sts.AssumeRoleWithWebIdentityInput{
+			RoleArn:          aws.String("arn:aws:iam::123456789012:role/role"),
+			RoleSessionName:  aws.String("session"),
+			WebIdentityToken: aws.String("web-identity-token"),
+		})
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		_, err = cognitoidentity.New(sess).GetCredentialsForIdentity(&cognitoidentity.GetCredentialsForIdentityInput{
+			IdentityId: aws.String("identity-id"),
+			Logins: map[string]*string{
+				"accounts.google.com": aws.String("logins-token"),
+			},
+		})
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+	}
+
+	rec, err := replay.New(filename, replay.ModeRecord)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	makeRequests(newSession(t, rec, server.URL))
+	if err := rec.Save(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	for _, secret := range []string{"web-identity-token", "logins-token"} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("expect %q to be redacted from fixture\n%s", secret, b)
+		}
+	}
+	for _, expect := range []string{"accounts.google.com", "identity-id"} {
+		if !strings.Contains(string(b), expect) {
+			t.Errorf("expect %q to be recorded in fixture\n%s", expect, b)
+		}
+	}
+
+	// Requests with redacted body fields match their recorded requests.
+	rec, err = replay.New(filename, replay.ModeReplay)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	makeRequests(newSession(t, rec, server.URL))
+}
+
+func TestRecorder_RecordDoesNotModifyRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		w.Write(b)
+	}))
+	defer server.Close()
+
+	rec, err := replay.New(filepath.Join(t.TempDir(), "fixture.json"), replay.ModeRecord)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	req, err := http.NewRequest("POST", server.URL, strings.NewReader("request body"))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	body := req.Body
+
+	resp, err := rec.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer resp.Body.Close()
+
+	if req.Body != body {
+		t.Errorf("expect request body not to be replaced")
+	}
+	b, _ := ioutil.ReadAll(resp.Body)
+	if e, a := "request body", string(b); e != a {
+		t.Errorf("expect %q response body, got %q", e, a)
+	}
+}
@@ -0,0 +1,289 @@
+// Package stub provides stubbed API operation responses for the SDK's API
+// clients, without sending HTTP requests. The stubbed responses are returned
+// by a handler installed on the client's Send handlers, replacing the SDK's
+// HTTP send handler. The client's Validate, Build, Sign, Retry, and Complete
+// handlers continue to run for stubbed operations. The client's Unmarshal and
+// UnmarshalError handlers also run, with only the protocol's unmarshaler
+// replaced by one returning the stubbed output or error. Stubbed errors are
+// converted into the operation's modeled exceptions, the same as errors
+// returned by the service.
+//
+//	svc := s3.New(sess)
+//
+//	stubs := stub.New()
+//	stubs.On("GetObject", &s3.GetObjectOutput{
+//		Body: ioutil.NopCloser(strings.NewReader("hello")),
+//	})
+//	stubs.Inject(&svc.Handlers)
+//
+// A sequence of responses can be stubbed for an operation, to test retries.
+// Each attempt of the operation returns the next response in the sequence,
+// and the last response is returned once the sequence is exhausted.
+//
+//	stubs.On("PutObject",
+//		awserr.NewRequestFailure(awserr.New("SlowDown", "slow down", nil), 503, "req-1"),
+//		&s3.PutObjectOutput{},
+//	)
+package stub
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/corehandlers"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting/internal/httpresponse"
+	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/private/protocol/ec2query"
+	"github.com/aws/aws-sdk-go/private/protocol/jsonrpc"
+	"github.com/aws/aws-sdk-go/private/protocol/query"
+	"github.com/aws/aws-sdk-go/private/protocol/rest"
+	"github.com/aws/aws-sdk-go/private/protocol/restjson"
+	"github.com/aws/aws-sdk-go/private/protocol/restxml"
+)
+
+// ErrCodeNoStub is the error code returned for operations which do not
+// have a stubbed response.
+const ErrCodeNoStub = "NoStubbedResponse"
+
+// SendHandlerName is the name of the Send handler installed by Inject.
+const SendHandlerName = "awstesting.stub.SendHandler"
+
+// UnmarshalHandlerName is the name of the Unmarshal handler replacing the
+// protocol unmarshaler for stubbed outputs.
+const UnmarshalHandlerName = "awstesting.stub.UnmarshalHandler"
+
+// UnmarshalErrorHandlerName is the name of the UnmarshalError handler
+// replacing the protocol error unmarshaler for stubbed errors.
+const UnmarshalErrorHandlerName = "awstesting.stub.UnmarshalErrorHandler"
+
+// unmarshalHandlerNames are the names of the protocol unmarshalers replaced
+// for stubbed outputs.
+var unmarshalHandlerNames = []string{
+	ec2query.UnmarshalHandler.Name,
+	jsonrpc.UnmarshalHandler.Name,
+	query.UnmarshalHandler.Name,
+	rest.UnmarshalHandler.Name,
+	restjson.UnmarshalHandler.Name,
+	restxml.UnmarshalHandler.Name,
+	protocol.UnmarshalDiscardBodyHandler.Name,
+}
+
+// statusOKErrorHandlerNames are the names of the Unmarshal handlers checking
+// the body of successful responses for errors. Stubbed outputs do not have a
+// response body, and these handlers are removed for stubbed outputs.
+var statusOKErrorHandlerNames = []string{
+	"awssdk.s3.CopyMultipartStatusOKUnmarshalError",
+}
+
+// unmarshalErrorHandlerNames are the names of the protocol error
+// unmarshalers replaced for stubbed errors, including the services with
+// custom error unmarshaling.
+var unmarshalErrorHandlerNames = []string{
+	ec2query.UnmarshalErrorHandler.Name,
+	jsonrpc.UnmarshalErrorHandler.Name,
+	query.UnmarshalErrorHandler.Name,
+	restjson.UnmarshalErrorHandler.Name,
+	restxml.UnmarshalErrorHandler.Name,
+	protocol.UnmarshalErrorHandlerName,
+	"awssdk.s3.UnmarshalError",
+	"awssdk.simpledb.UnmarshalError",
+}
+
+// ResponseFunc returns the stubbed response of an operation's request. The
+// output returned must be the operation's output type, or nil.
+type ResponseFunc func(r *request.Request) (output interface{}, err error)
+
+// Stubber provides stubbed responses to the operations of an API client. A
+// Stubber is safe for concurrent use.
+type Stubber struct {
+	mu     sync.Mutex
+	stubs  map[string]*responses
+	inputs map[string][]interface{}
+}
+
+type responses struct {
+	fns []ResponseFunc
+}
+
+// New returns an initialized Stubber without any stubbed responses.
+func New() *Stubber {
+	return &Stubber{
+		stubs:  map[string]*responses{},
+		inputs: map[string][]interface{}{},
+	}
+}
+
+// On stubs the responses of the operation. Each response is either the
+// operation's output, such as *s3.GetObjectOutput, or an error. Each
+// attempt of the operation will return the next response. The last
+// response will be returned for all attempts after the sequence is
+// exhausted.
+//
+// Calling On for an operation with stubbed responses replaces its
+// responses.
+func (s *Stubber) On(operation string, resps ...interface{}) *Stubber {
+	fns := make([]ResponseFunc, 0, len(resps))
+	for _, resp := range resps {
+		resp := resp
+		fns = append(fns, func(*request.Request) (interface{}, error) {
+			if err, ok := resp.(error); ok {
+				return nil, err
+			}
+			return resp, nil
+		})
+	}
+	return s.OnFunc(operation, fns...)
+}
+
+// OnFunc stubs the responses of the operation with functions returning the
+// response for the operation's request. Each attempt of the operation will
+// call the next function. The last function will be called for all attempts
+// after the sequence is exhausted.
+func (s *Stubber) OnFunc(operation string, fns ...ResponseFunc) *Stubber {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stubs[operation] = &responses{fns: fns}
+	return s
+}
+
+// Calls returns the input parameters of each attempt made for the
+// operation, in the order the attempts were made.
+func (s *Stubber) Calls(operation string) []interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]interface{}(nil), s.inputs[operation]...)
+}
+
+// Inject installs the Stubber's Send handler on the handlers provided. The
+// SDK's HTTP send handler is replaced, if present, so that no requests are
+// sent over the network.
+func (s *Stubber) Inject(handlers *request.Handlers) {
+	h := request.NamedHandler{Name: SendHandlerName, Fn: s.send}
+
+	handlers.Send.Remove(h)
+	if !handlers.Send.Swap(corehandlers.SendHandler.Name, h) {
+		handlers.Send.PushBackNamed(h)
+	}
+}
+
+// next returns the function for the next stubbed response of the operation,
+// and records the input of the request.
+func (s *Stubber) next(r *request.Request) (ResponseFunc, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name := r.Operation.Name
+	s.inputs[name] = append(s.inputs[name], r.Params)
+
+	resps, ok := s.stubs[name]
+	if !ok || len(resps.fns) == 0 {
+		return nil, false
+	}
+
+	fn := resps.fns[0]
+	if len(resps.fns) > 1 {
+		resps.fns = resps.fns[1:]
+	}
+	return fn, true
+}
+
+func (s *Stubber) send(r *request.Request) {
+	fn, ok := s.next(r)
+	if !ok {
+		r.Error = awserr.New(ErrCodeNoStub,
+			fmt.Sprintf("no stubbed response for operation %s", r.Operation.Name), nil)
+		r.Retryable = aws.Bool(false)
+		return
+	}
+
+	output, err := fn(r)
+	if err != nil {
+		statusCode := http.StatusBadRequest
+		if reqErr, ok := err.(awserr.RequestFailure); ok {
+			statusCode = reqErr.StatusCode()
+			r.RequestID = reqErr.RequestID()
+		}
+		r.HTTPResponse = httpresponse.New(r, statusCode)
+
+		// Successful status codes are not failed by response validation, and
+		// would not run the UnmarshalError handlers.
+		if statusCode < 300 {
+			r.Error = err
+			return
+		}
+
+		// The protocol error unmarshaler cannot unmarshal the stubbed error,
+		// and is replaced for this attempt of the request. The other
+		// UnmarshalError handlers, such as those converting the error into
+		// the operation's modeled exception, continue to run.
+		swapHandler(&r.Handlers.UnmarshalError, unmarshalErrorHandlerNames, request.NamedHandler{
+			Name: UnmarshalErrorHandlerName,
+			Fn: func(r *request.Request) {
+				r.HTTPResponse.Body.Close()
+				if reqErr, ok := err.(awserr.RequestFailure); ok {
+					r.RequestID = reqErr.RequestID()
+				}
+				r.Error = err
+			},
+		})
+		return
+	}
+
+	r.HTTPResponse = httpresponse.New(r, http.StatusOK)
+
+	// The protocol unmarshaler cannot unmarshal the stubbed output, and is
+	// replaced for this attempt of the request. The other Unmarshal handlers
+	// continue to run, except those checking the response body for errors.
+	for _, name := range statusOKErrorHandlerNames {
+		r.Handlers.Unmarshal.RemoveByName(name)
+	}
+	swapHandler(&r.Handlers.Unmarshal, unmarshalHandlerNames, request.NamedHandler{
+		Name: UnmarshalHandlerName,
+		Fn: func(r *request.Request) {
+			r.HTTPResponse.Body.Close()
+			if err := setOutput(r.Data, output); err != nil {
+				r.Error = awserr.New(request.ErrCodeSerialization,
+					fmt.Sprintf("failed to set stubbed output of operation %s", r.Operation.Name), err)
+			}
+		},
+	})
+}
+
+// swapHandler replaces the handlers of the list with any of the names with
+// the handler provided. The handler is added to the end of the list if none
+// of the names are present.
+func swapHandler(l *request.HandlerList, names []string, h request.NamedHandler) {
+	l.Remove(h)
+	for _, name := range names {
+		if l.Swap(name, h) {
+			return
+		}
+	}
+	l.PushBackNamed(h)
+}
+
+// setOutput copies the stubbed output into the request's output value.
+func setOutput(data, output interface{}) error {
+	if output == nil || data == nil {
+		return nil
+	}
+
+	dst := reflect.ValueOf(data)
+	src := reflect.ValueOf(output)
+	if dst.Type() != src.Type() {
+		return fmt.Errorf("expect stubbed output of type %v, got %v", dst.Type(), src.Type())
+	}
+	if dst.Kind() != reflect.Ptr || dst.IsNil() || src.IsNil() {
+		return nil
+	}
+
+	dst.Elem().Set(src.Elem())
+	return nil
+}
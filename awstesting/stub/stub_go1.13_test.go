@@ -0,0 +1,42 @@
+//go:build go1.13
+// +build go1.13
+
+package stub_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/awstesting/stub"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func TestStubber_ModeledException(t *testing.T) {
+	svc := s3.New(newSession(t))
+
+	stubs := stub.New().On("GetObject",
+		awserr.NewRequestFailure(awserr.New(s3.ErrCodeNoSuchKey, "not found", nil), 404, "req-1"),
+	)
+	stubs.Inject(&svc.Handlers)
+
+	_, err := svc.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+	if err == nil {
+		t.Fatalf("expect error")
+	}
+
+	var noSuchKey *s3.NoSuchKey
+	if !errors.As(err, &noSuchKey) {
+		t.Fatalf("expect %T error, got %T, %v", noSuchKey, err, err)
+	}
+	if e, a := s3.ErrCodeNoSuchKey, noSuchKey.Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+	if e, a := "req-1", noSuchKey.RequestID(); e != a {
+		t.Errorf("expect %v request id, got %v", e, a)
+	}
+}
@@ -0,0 +1,190 @@
+package stub_test
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/awstesting"
+	"github.com/aws/aws-sdk-go/awstesting/stub"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func newSession(t *testing.T) *session.Session {
+	t.Helper()
+
+	env := awstesting.StashEnv()
+	t.Cleanup(func() { awstesting.PopEnv(env) })
+
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String("us-west-2"),
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String("http://127.0.0.1:0"),
+		SleepDelay:  func(time.Duration) {},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	return sess
+}
+
+func TestStubber_Output(t *testing.T) {
+	svc := s3.New(newSession(t))
+
+	stubs := stub.New().On("GetObject", &s3.GetObjectOutput{
+		Body:        ioutil.NopCloser(strings.NewReader("hello")),
+		ContentType: aws.String("text/plain"),
+	})
+	stubs.Inject(&svc.Handlers)
+
+	// Unmarshal handlers other than the protocol's continue to run.
+	var unmarshaled string
+	svc.Handlers.Unmarshal.PushBack(func(r *request.Request) {
+		unmarshaled = aws.StringValue(r.Data.(*s3.GetObjectOutput).ContentType)
+	})
+
+	out, err := svc.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "text/plain", aws.StringValue(out.ContentType); e != a {
+		t.Errorf("expect %v content type, got %v", e, a)
+	}
+	if e, a := "text/plain", unmarshaled; e != a {
+		t.Errorf("expect %v unmarshaled content type, got %v", e, a)
+	}
+	b, _ := ioutil.ReadAll(out.Body)
+	if e, a := "hello", string(b); e != a {
+		t.Errorf("expect %v body, got %v", e, a)
+	}
+
+	calls := stubs.Calls("GetObject")
+	if e, a := 1, len(calls); e != a {
+		t.Fatalf("expect %v calls, got %v", e, a)
+	}
+	if e, a := "key", aws.StringValue(calls[0].(*s3.GetObjectInput).Key); e != a {
+		t.Errorf("expect %v key, got %v", e, a)
+	}
+}
+
+func TestStubber_ValidationRuns(t *testing.T) {
+	svc := s3.New(newSession(t))
+
+	stubs := stub.New().On("GetObject", &s3.GetObjectOutput{})
+	stubs.Inject(&svc.Handlers)
+
+	_, err := svc.GetObject(&s3.GetObjectInput{Bucket: aws.String("bucket")})
+	if err == nil {
+		t.Fatalf("expect validation error")
+	}
+	if e, a := request.InvalidParameterErrCode, err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+	if e, a := 0, len(stubs.Calls("GetObject")); e != a {
+		t.Errorf("expect %v calls, got %v", e, a)
+	}
+}
+
+func TestStubber_Sequence(t *testing.T) {
+	svc := dynamodb.New(newSession(t))
+
+	stubs := stub.New().On("GetItem",
+		awserr.NewRequestFailure(awserr.New(dynamodb.ErrCodeProvisionedThroughputExceededException, "throttled", nil), 400, "req-1"),
+		awserr.NewRequestFailure(awserr.New("InternalServerError", "failed", nil), 500, "req-2"),
+		&dynamodb.GetItemOutput{Item: map[string]*dynamodb.AttributeValue{
+			"id": {S: aws.String("abc")},
+		}},
+	)
+	stubs.Inject(&svc.Handlers)
+
+	req, out := svc.GetItemRequest(&dynamodb.GetItemInput{
+		TableName: aws.String("table"),
+		Key: map[string]*dynamodb.AttributeValue{
+			"id": {S: aws.String("abc")},
+		},
+	})
+	if err := req.Send(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 2, req.RetryCount; e != a {
+		t.Errorf("expect %v retries, got %v", e, a)
+	}
+	if e, a := "abc", aws.StringValue(out.Item["id"].S); e != a {
+		t.Errorf("expect %v item, got %v", e, a)
+	}
+
+	// The last response is repeated once the sequence is exhausted.
+	if _, err := svc.GetItem(&dynamodb.GetItemInput{
+		TableName: aws.String("table"),
+		Key: map[string]*dynamodb.AttributeValue{
+			"id": {S: aws.String("abc")},
+		},
+	}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 4, len(stubs.Calls("GetItem")); e != a {
+		t.Errorf("expect %v calls, got %v", e, a)
+	}
+}
+
+func TestStubber_Errors(t *testing.T) {
+	svc := dynamodb.New(newSession(t))
+
+	stubs := stub.New().
+		On("DeleteTable", awserr.NewRequestFailure(awserr.New(dynamodb.ErrCodeResourceNotFoundException, "not found", nil), 400, "req-1")).
+		On("DescribeTable", &dynamodb.GetItemOutput{})
+	stubs.Inject(&svc.Handlers)
+
+	cases := map[string]struct {
+		Fn         func() error
+		ExpectCode string
+	}{
+		"stubbed error": {
+			Fn: func() error {
+				_, err := svc.DeleteTable(&dynamodb.DeleteTableInput{TableName: aws.String("table")})
+				return err
+			},
+			ExpectCode: dynamodb.ErrCodeResourceNotFoundException,
+		},
+		"no stub": {
+			Fn: func() error {
+				_, err := svc.ListTables(&dynamodb.ListTablesInput{})
+				return err
+			},
+			ExpectCode: stub.ErrCodeNoStub,
+		},
+		"wrong output type": {
+			Fn: func() error {
+				_, err := svc.DescribeTable(&dynamodb.DescribeTableInput{TableName: aws.String("table")})
+				return err
+			},
+			ExpectCode: request.ErrCodeSerialization,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := c.Fn()
+			if err == nil {
+				t.Fatalf("expect error")
+			}
+			if e, a := c.ExpectCode, err.(awserr.Error).Code(); e != a {
+				t.Errorf("expect %v error code, got %v", e, a)
+			}
+		})
+	}
+
+	if e, a := 1, len(stubs.Calls("ListTables")); e != a {
+		t.Errorf("expect %v calls, got %v", e, a)
+	}
+}
@@ -166,10 +166,20 @@ loop:
 			// being in a skip state with no tokens will break out of
 			// the parse loop since there is nothing left to process.
 			if len(tokens) == 0 {
+				if k.Kind == ASTKindSkipStatement && len(k.GetChildren()) != 0 {
+					stack.MarkComplete(k)
+				}
 				break loop
 			}
 			// if should skip is true, we skip the tokens until should skip is set to false.
 			step = SkipTokenState
+
+			// The skipped tokens of a nested block are retained as the
+			// raw value of the skip statement, so the nested properties
+			// can be retrieved.
+			if k.Kind == ASTKindSkipStatement && k.GetRoot().Kind == ASTKindEqualExpr {
+				k = appendNestedRaw(k, tok)
+			}
 		}
 
 		switch step {
@@ -324,6 +334,23 @@ loop:
 	return stack.List(), nil
 }
 
+// appendNestedRaw appends the raw value of the token to the nested block of
+// the skip statement.
+func appendNestedRaw(k AST, tok Token) AST {
+	children := k.GetChildren()
+	if len(children) == 0 {
+		raw := append([]rune{}, tok.Raw()...)
+		k.AppendChild(newExpression(newToken(TokenLit, raw, StringType)))
+		return k
+	}
+
+	nested := children[len(children)-1]
+	nested.Root.raw = append(nested.Root.raw, tok.Raw()...)
+	children[len(children)-1] = nested
+	k.SetChildren(children)
+	return k
+}
+
 // trimSpaces will trim spaces on the left and right hand side of
 // the literal.
 func trimSpaces(k AST) AST {
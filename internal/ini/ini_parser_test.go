@@ -14,6 +14,7 @@ import (
 func TestParser(t *testing.T) {
 	xID, _, _ := newLitToken([]rune("x = 1234"))
 	s3ID, _, _ := newLitToken([]rune("s3 = 1234"))
+	s3NestedExpr := newExpression(newToken(TokenLit, []rune("\tfoo=bar\n\tbar=baz\n"), StringType))
 	fooSlashes, _, _ := newLitToken([]rune("//foo"))
 
 	regionID, _, _ := newLitToken([]rune("region"))
@@ -261,7 +262,7 @@ region = us-west-2
 				newCompletedSectionStatement(
 					defaultProfileStmt,
 				),
-				newSkipStatement(newEqualExpr(newExpression(s3ID), equalOp)),
+				newSkipStatement(newEqualExpr(newExpression(s3ID), equalOp), s3NestedExpr),
 				newExprStatement(noQuotesRegionEQRegion),
 				newExprStatement(credEQExpr),
 				newExprStatement(outputEQExpr),
@@ -292,7 +293,7 @@ region = us-west-2
 				),
 				newExprStatement(noQuotesRegionEQRegion),
 				newExprStatement(credEQExpr),
-				newSkipStatement(newEqualExpr(newExpression(s3ID), equalOp)),
+				newSkipStatement(newEqualExpr(newExpression(s3ID), equalOp), s3NestedExpr),
 				newExprStatement(outputEQExpr),
 				newCompletedSectionStatement(
 					assumeProfileStmt,
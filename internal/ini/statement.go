@@ -30,6 +30,6 @@ func newCompletedSectionStatement(ast AST) AST {
 }
 
 // SkipStatement is used to skip whole statements
-func newSkipStatement(ast AST) AST {
-	return newAST(ASTKindSkipStatement, ast)
+func newSkipStatement(ast AST, nested ...AST) AST {
+	return newAST(ASTKindSkipStatement, ast, nested...)
 }
@@ -3,6 +3,7 @@ package ini
 import (
 	"fmt"
 	"sort"
+	"strings"
 )
 
 // Visitor is an interface used by walkers that will
@@ -67,6 +68,26 @@ func (v *DefaultVisitor) VisitExpr(expr AST) error {
 		default:
 			return NewParseError(fmt.Sprintf("unsupported expression %v", expr))
 		}
+	case ASTKindSkipStatement:
+		// Nested blocks of properties are skipped as values, but are
+		// retained as nested properties of the section.
+		//
+		//	s3 =
+		//		max_concurrent_requests = 10
+		key := EqualExprKey(expr.GetRoot())
+		if len(key) == 0 {
+			return nil
+		}
+
+		var raw []rune
+		if children := expr.GetChildren(); len(children) != 0 {
+			raw = children[len(children)-1].Root.Raw()
+		}
+
+		if t.nested == nil {
+			t.nested = map[string]map[string]string{}
+		}
+		t.nested[key] = parseNestedProperties(string(raw))
 	default:
 		return NewParseError(fmt.Sprintf("unsupported expression %v", expr))
 	}
@@ -129,6 +150,7 @@ func (t Sections) List() []string {
 type Section struct {
 	Name   string
 	values values
+	nested map[string]map[string]string
 }
 
 // Has will return whether or not an entry exists in a given section
@@ -167,3 +189,53 @@ func (t Section) String(k string) string {
 	}
 	return t.values[k].StringValue()
 }
+
+// Nested returns the nested properties of the value at k. If k is not a
+// nested block of properties, false will be returned in the second
+// parameter.
+//
+//	[services my-services]
+//	dynamodb =
+//		endpoint_url = http://localhost:8000
+func (t Section) Nested(k string) (map[string]string, bool) {
+	v, ok := t.nested[k]
+	return v, ok
+}
+
+// NestedKeys returns the keys of the section's nested blocks of properties.
+func (t Section) NestedKeys() []string {
+	keys := make([]string, 0, len(t.nested))
+	for k := range t.nested {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// parseNestedProperties parses the key value pairs of a nested block of
+// properties, one pair per line. Lines without a key, and comments, are
+// ignored.
+func parseNestedProperties(raw string) map[string]string {
+	props := map[string]string{}
+	for _, line := range strings.Split(raw, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+
+		parts := strings.SplitN(line, "=", 2)
+		key := strings.TrimSpace(parts[0])
+		if len(key) == 0 {
+			continue
+		}
+
+		var value string
+		if len(parts) == 2 {
+			value = strings.TrimSpace(parts[1])
+		}
+		props[key] = value
+	}
+
+	return props
+}
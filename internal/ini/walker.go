@@ -5,7 +5,8 @@ func Walk(tree []AST, v Visitor) error {
 	for _, node := range tree {
 		switch node.Kind {
 		case ASTKindExpr,
-			ASTKindExprStatement:
+			ASTKindExprStatement,
+			ASTKindSkipStatement:
 
 			if err := v.VisitExpr(node); err != nil {
 				return err
@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -140,3 +141,91 @@ func TestInvalidDataFiles(t *testing.T) {
 		})
 	}
 }
+
+func TestNestedProperties(t *testing.T) {
+	cases := map[string]struct {
+		ini     string
+		section string
+		key     string
+		expect  map[string]string
+		noKey   bool
+	}{
+		"nested block": {
+			ini: `[services my-services]
+dynamodb =
+  endpoint_url = http://localhost:8000
+  ; comment
+  region=us-west-2
+s3 = 
+  endpoint_url = http://localhost:9000
+`,
+			section: "services my-services",
+			key:     "dynamodb",
+			expect: map[string]string{
+				"endpoint_url": "http://localhost:8000",
+				"region":       "us-west-2",
+			},
+		},
+		"nested block at end of file": {
+			ini: `[services my-services]
+s3 =
+  endpoint_url = http://localhost:9000`,
+			section: "services my-services",
+			key:     "s3",
+			expect: map[string]string{
+				"endpoint_url": "http://localhost:9000",
+			},
+		},
+		"followed by values": {
+			ini: `[default]
+s3 =
+	max_concurrent_requests = 10
+region = us-west-2
+`,
+			section: "default",
+			key:     "s3",
+			expect: map[string]string{
+				"max_concurrent_requests": "10",
+			},
+		},
+		"not nested": {
+			ini: `[default]
+region = us-west-2
+`,
+			section: "default",
+			key:     "region",
+			noKey:   true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			sections, err := ParseBytes([]byte(c.ini))
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			section, ok := sections.GetSection(c.section)
+			if !ok {
+				t.Fatalf("expect %v section", c.section)
+			}
+
+			nested, ok := section.Nested(c.key)
+			if c.noKey {
+				if ok {
+					t.Errorf("expect no nested properties, got %v", nested)
+				}
+				return
+			}
+			if !ok {
+				t.Fatalf("expect nested properties for %v", c.key)
+			}
+			if e, a := c.expect, nested; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+			if section.Has(c.key) {
+				t.Errorf("expect %v to not have a value", c.key)
+			}
+		})
+	}
+}
@@ -48,3 +48,43 @@ func ParseAccessPointResource(a arn.ARN, resParts []string) (AccessPointARN, err
 		AccessPointName: resID,
 	}, nil
 }
+
+// MultiRegionAccessPointARN provides representation of a Multi-Region Access
+// Point ARN. Multi-Region Access Point ARNs do not include a region.
+type MultiRegionAccessPointARN struct {
+	AccessPointARN
+}
+
+// ParseMultiRegionAccessPointResource attempts to parse the ARN's resource as
+// a Multi-Region Access Point resource.
+//
+// Supported Multi-Region Access point resource format:
+//	- Multi-Region Access point format: arn:{partition}:s3::{accountId}:accesspoint/{accesspointAlias}
+//	- example: arn:aws:s3::012345678901:accesspoint/mfzwi23gnjvgw.mrap
+//
+func ParseMultiRegionAccessPointResource(a arn.ARN, resParts []string) (MultiRegionAccessPointARN, error) {
+	if len(a.Region) != 0 {
+		return MultiRegionAccessPointARN{}, InvalidARNError{ARN: a, Reason: "region set for multi-region access point"}
+	}
+	if len(a.AccountID) == 0 {
+		return MultiRegionAccessPointARN{}, InvalidARNError{ARN: a, Reason: "account-id not set"}
+	}
+	if len(resParts) == 0 {
+		return MultiRegionAccessPointARN{}, InvalidARNError{ARN: a, Reason: "resource-id not set"}
+	}
+	if len(resParts) > 1 {
+		return MultiRegionAccessPointARN{}, InvalidARNError{ARN: a, Reason: "sub resource not supported"}
+	}
+
+	resID := resParts[0]
+	if len(strings.TrimSpace(resID)) == 0 {
+		return MultiRegionAccessPointARN{}, InvalidARNError{ARN: a, Reason: "resource-id not set"}
+	}
+
+	return MultiRegionAccessPointARN{
+		AccessPointARN: AccessPointARN{
+			ARN:             a,
+			AccessPointName: resID,
+		},
+	}, nil
+}
@@ -108,3 +108,89 @@ func TestParseAccessPointResource(t *testing.T) {
 		})
 	}
 }
+
+func TestParseMultiRegionAccessPointResource(t *testing.T) {
+	cases := map[string]struct {
+		ARN       arn.ARN
+		ExpectErr string
+		ExpectARN MultiRegionAccessPointARN
+	}{
+		"region set": {
+			ARN: arn.ARN{
+				Partition: "aws",
+				Service:   "s3",
+				Region:    "us-west-2",
+				AccountID: "012345678901",
+				Resource:  "accesspoint/mfzwi23gnjvgw.mrap",
+			},
+			ExpectErr: "region set for multi-region access point",
+		},
+		"account-id not set": {
+			ARN: arn.ARN{
+				Partition: "aws",
+				Service:   "s3",
+				Resource:  "accesspoint/mfzwi23gnjvgw.mrap",
+			},
+			ExpectErr: "account-id not set",
+		},
+		"resource-id not set": {
+			ARN: arn.ARN{
+				Partition: "aws",
+				Service:   "s3",
+				AccountID: "012345678901",
+				Resource:  "accesspoint",
+			},
+			ExpectErr: "resource-id not set",
+		},
+		"resource not supported": {
+			ARN: arn.ARN{
+				Partition: "aws",
+				Service:   "s3",
+				AccountID: "012345678901",
+				Resource:  "accesspoint/mfzwi23gnjvgw.mrap/object/key",
+			},
+			ExpectErr: "sub resource not supported",
+		},
+		"valid resource-id": {
+			ARN: arn.ARN{
+				Partition: "aws",
+				Service:   "s3",
+				AccountID: "012345678901",
+				Resource:  "accesspoint/mfzwi23gnjvgw.mrap",
+			},
+			ExpectARN: MultiRegionAccessPointARN{
+				AccessPointARN: AccessPointARN{
+					ARN: arn.ARN{
+						Partition: "aws",
+						Service:   "s3",
+						AccountID: "012345678901",
+						Resource:  "accesspoint/mfzwi23gnjvgw.mrap",
+					},
+					AccessPointName: "mfzwi23gnjvgw.mrap",
+				},
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			resParts := SplitResource(c.ARN.Resource)
+			a, err := ParseMultiRegionAccessPointResource(c.ARN, resParts[1:])
+
+			if len(c.ExpectErr) == 0 && err != nil {
+				t.Fatalf("expect no error but got %v", err)
+			} else if len(c.ExpectErr) != 0 && err == nil {
+				t.Fatalf("expect error %q, but got nil", c.ExpectErr)
+			} else if len(c.ExpectErr) != 0 && err != nil {
+				if e, a := c.ExpectErr, err.Error(); !strings.Contains(a, e) {
+					t.Fatalf("expect error %q, got %q", e, a)
+				}
+				return
+			}
+
+			if e, a := c.ExpectARN, a; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+		})
+	}
+}
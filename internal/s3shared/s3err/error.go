@@ -35,6 +35,11 @@ func (r RequestFailure) HostID() string {
 	return r.hostID
 }
 
+// Unwrap returns the wrapped awserr.RequestFailure.
+func (r RequestFailure) Unwrap() error {
+	return r.RequestFailure
+}
+
 // RequestFailureWrapperHandler returns a handler to rap an
 // awserr.RequestFailure with the  S3 request ID 2 from the response.
 func RequestFailureWrapperHandler() request.NamedHandler {
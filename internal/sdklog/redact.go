@@ -0,0 +1,230 @@
+package sdklog
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// RedactedValue replaces the sensitive values redacted from log messages.
+// Matches the value the SDK's shape String methods use for sensitive
+// members.
+const RedactedValue = "<sensitive>"
+
+// signingHeaders are the request headers which carry request signatures and
+// credentials.
+var signingHeaders = []string{
+	"Authorization",
+	"X-Amz-Security-Token",
+}
+
+// signingQuery are the query parameters of presigned requests which carry
+// request signatures and credentials.
+var signingQuery = []string{
+	"X-Amz-Credential",
+	"X-Amz-Security-Token",
+	"X-Amz-Signature",
+}
+
+// A Redactor redacts signing headers, credentials, and the members of API
+// shapes tagged as sensitive from HTTP requests, responses, and bodies.
+type Redactor struct {
+	headers []string
+	query   []string
+
+	jsonBody *regexp.Regexp
+	xmlBody  []*regexp.Regexp
+	formBody *regexp.Regexp
+}
+
+var redactors sync.Map // map[reflect.Type]*Redactor
+
+// NewRedactor returns a Redactor for the API shape provided, such as the
+// input or output parameters of a request. Members of the shape, and nested
+// shapes, with the `sensitive:"true"` tag will be redacted. Members nested
+// within a sensitive member are also redacted. The shape may be nil.
+func NewRedactor(shape interface{}) *Redactor {
+	t := reflect.TypeOf(shape)
+	if v, ok := redactors.Load(t); ok {
+		return v.(*Redactor)
+	}
+
+	var n sensitiveNames
+	if t != nil {
+		n.collect(t, false, map[visitKey]bool{})
+	}
+
+	r := &Redactor{
+		headers: append(append([]string(nil), signingHeaders...), n.headers...),
+		query:   append(append([]string(nil), signingQuery...), n.query...),
+	}
+	if len(n.body) != 0 {
+		names := make([]string, 0, len(n.body))
+		for _, name := range n.body {
+			names = append(names, regexp.QuoteMeta(name))
+		}
+		alt := strings.Join(names, "|")
+
+		r.jsonBody = regexp.MustCompile(`("(?:` + alt + `)"\s*:\s*)(?:"(?:[^"\\]|\\.)*"|\[[^\[\]]*\]|[-+.0-9eE]+|true|false)`)
+		r.formBody = regexp.MustCompile(`(?m)((?:^|[&.])(?:` + alt + `)=)[^&\s]*`)
+
+		// XML elements are matched by name individually, since the closing
+		// element must have the same name as the opening element. Parent
+		// elements are matched first.
+		for _, name := range names {
+			r.xmlBody = append(r.xmlBody,
+				regexp.MustCompile(`(?s)(<`+name+`(?:\s[^>]*)?>).*?(</`+name+`>)`))
+		}
+	}
+
+	v, _ := redactors.LoadOrStore(t, r)
+	return v.(*Redactor)
+}
+
+// RedactHeader returns a copy of the header with the values of sensitive
+// headers redacted.
+func (r *Redactor) RedactHeader(header http.Header) http.Header {
+	redacted := make(http.Header, len(header))
+	for k, v := range header {
+		redacted[k] = v
+	}
+	for _, name := range r.headers {
+		if _, ok := redacted[http.CanonicalHeaderKey(name)]; ok {
+			redacted.Set(name, RedactedValue)
+		}
+	}
+	return redacted
+}
+
+// RedactURL returns a copy of the URL with the values of sensitive query
+// parameters redacted.
+func (r *Redactor) RedactURL(u *url.URL) *url.URL {
+	redacted := *u
+	if len(u.RawQuery) == 0 {
+		return &redacted
+	}
+
+	query := u.Query()
+	var changed bool
+	for _, name := range r.query {
+		if _, ok := query[name]; ok {
+			query.Set(name, RedactedValue)
+			changed = true
+		}
+	}
+	if changed {
+		redacted.RawQuery = query.Encode()
+	}
+	return &redacted
+}
+
+// RedactRequest returns a shallow copy of the HTTP request with the values
+// of sensitive headers and query parameters redacted. The request's body is
+// not copied.
+func (r *Redactor) RedactRequest(req *http.Request) *http.Request {
+	redacted := *req
+	redacted.Header = r.RedactHeader(req.Header)
+	if req.URL != nil {
+		redacted.URL = r.RedactURL(req.URL)
+	}
+	return &redacted
+}
+
+// RedactResponse returns a shallow copy of the HTTP response with the
+// values of sensitive headers redacted.
+func (r *Redactor) RedactResponse(resp *http.Response) *http.Response {
+	redacted := *resp
+	redacted.Header = r.RedactHeader(resp.Header)
+	return &redacted
+}
+
+// RedactBody returns the body with the values of sensitive JSON fields, XML
+// elements, and form parameters redacted.
+func (r *Redactor) RedactBody(body []byte) []byte {
+	if r.jsonBody == nil {
+		return body
+	}
+
+	body = r.jsonBody.ReplaceAll(body, []byte(`${1}"`+RedactedValue+`"`))
+	for _, re := range r.xmlBody {
+		body = re.ReplaceAll(body, []byte(`${1}`+RedactedValue+`${2}`))
+	}
+	body = r.formBody.ReplaceAll(body, []byte(`${1}`+RedactedValue))
+	return body
+}
+
+// RedactString returns the string with each occurrence of the secret values
+// provided redacted. Empty secrets are ignored.
+func RedactString(s string, secrets ...string) string {
+	for _, secret := range secrets {
+		if len(secret) != 0 {
+			s = strings.Replace(s, secret, RedactedValue, -1)
+		}
+	}
+	return s
+}
+
+type sensitiveNames struct {
+	headers []string
+	query   []string
+	body    []string
+}
+
+type visitKey struct {
+	t         reflect.Type
+	sensitive bool
+}
+
+func (n *sensitiveNames) collect(t reflect.Type, sensitive bool, visited map[visitKey]bool) {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return
+	}
+
+	key := visitKey{t: t, sensitive: sensitive}
+	if visited[key] {
+		return
+	}
+	visited[key] = true
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" || f.Name == "_" {
+			continue
+		}
+
+		fieldSensitive := sensitive || f.Tag.Get("sensitive") == "true"
+		if fieldSensitive {
+			name := f.Tag.Get("locationName")
+			if len(name) == 0 {
+				name = f.Name
+			}
+
+			switch f.Tag.Get("location") {
+			case "header":
+				n.headers = appendUnique(n.headers, name)
+			case "querystring":
+				n.query = appendUnique(n.query, name)
+			case "uri", "headers", "statusCode":
+			default:
+				n.body = appendUnique(n.body, name)
+			}
+		}
+
+		n.collect(f.Type, fieldSensitive, visited)
+	}
+}
+
+func appendUnique(vs []string, v string) []string {
+	for _, s := range vs {
+		if s == v {
+			return vs
+		}
+	}
+	return append(vs, v)
+}
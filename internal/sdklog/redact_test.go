@@ -0,0 +1,164 @@
+package sdklog
+
+import (
+	"bytes"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+type mockCredentials struct {
+	_ struct{} `type:"structure"`
+
+	AccessKeyId     *string `type:"string"`
+	SecretAccessKey *string `type:"string" sensitive:"true"`
+}
+
+type mockShape struct {
+	_ struct{} `type:"structure"`
+
+	Name          *string          `locationName:"name" type:"string"`
+	Password      *string          `locationName:"password" type:"string" sensitive:"true"`
+	CustomerKey   *string          `location:"header" locationName:"x-amz-customer-key" type:"string" sensitive:"true"`
+	Token         *string          `location:"querystring" locationName:"token" type:"string" sensitive:"true"`
+	Credentials   *mockCredentials `type:"structure"`
+	Secret        *mockSecret      `type:"structure" sensitive:"true"`
+	Nested        []*mockShape     `type:"list"`
+	Authorization *string          `type:"string"`
+}
+
+type mockSecret struct {
+	_ struct{} `type:"structure"`
+
+	Value *string `type:"string"`
+}
+
+func TestRedactor_RedactBody(t *testing.T) {
+	r := NewRedactor(&mockShape{})
+
+	cases := map[string]struct {
+		Body, Expect string
+	}{
+		"json": {
+			Body:   `{"name":"n","password": "p\"w","Credentials":{"AccessKeyId":"akid","SecretAccessKey":"secret"},"Secret":{"Value":"v"}}`,
+			Expect: `{"name":"n","password": "<sensitive>","Credentials":{"AccessKeyId":"akid","SecretAccessKey":"<sensitive>"},"Secret":{"Value":"<sensitive>"}}`,
+		},
+		"xml": {
+			Body:   `<Shape><name>n</name><password>pw</password><Credentials><AccessKeyId>akid</AccessKeyId><SecretAccessKey>secret</SecretAccessKey></Credentials><Secret><Value>v</Value></Secret></Shape>`,
+			Expect: `<Shape><name>n</name><password><sensitive></password><Credentials><AccessKeyId>akid</AccessKeyId><SecretAccessKey><sensitive></SecretAccessKey></Credentials><Secret><sensitive></Secret></Shape>`,
+		},
+		"form": {
+			Body:   `Action=Op&name=n&password=pw&Nested.member.1.password=pw2&Secret.Value=v`,
+			Expect: `Action=Op&name=n&password=<sensitive>&Nested.member.1.password=<sensitive>&Secret.Value=<sensitive>`,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if e, a := c.Expect, string(r.RedactBody([]byte(c.Body))); e != a {
+				t.Errorf("expect\n%s\ngot\n%s", e, a)
+			}
+		})
+	}
+}
+
+func TestRedactor_RedactRequest(t *testing.T) {
+	r := NewRedactor(&mockShape{})
+
+	u, _ := url.Parse("https://example.amazonaws.com/path?token=abc&X-Amz-Signature=sig&other=1")
+	req := &http.Request{
+		Method: "GET",
+		URL:    u,
+		Header: http.Header{
+			"Authorization":        []string{"AWS4-HMAC-SHA256 Credential=AKID/..."},
+			"X-Amz-Security-Token": []string{"session"},
+			"X-Amz-Customer-Key":   []string{"key"},
+			"X-Other":              []string{"value"},
+		},
+	}
+
+	redacted := r.RedactRequest(req)
+	for _, name := range []string{"Authorization", "X-Amz-Security-Token", "X-Amz-Customer-Key"} {
+		if e, a := RedactedValue, redacted.Header.Get(name); e != a {
+			t.Errorf("expect %v header to be %v, got %v", name, e, a)
+		}
+	}
+	if e, a := "value", redacted.Header.Get("X-Other"); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	query := redacted.URL.Query()
+	if e, a := RedactedValue, query.Get("token"); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := RedactedValue, query.Get("X-Amz-Signature"); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "1", query.Get("other"); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	// The original request is not modified.
+	if e, a := "session", req.Header.Get("X-Amz-Security-Token"); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if strings.Contains(req.URL.RawQuery, RedactedValue) {
+		t.Errorf("expect original URL not to be redacted, got %v", req.URL)
+	}
+}
+
+func TestRedactor_NilShape(t *testing.T) {
+	r := NewRedactor(nil)
+
+	body := []byte(`{"password":"pw"}`)
+	if e, a := body, r.RedactBody(body); !bytes.Equal(e, a) {
+		t.Errorf("expect %s, got %s", e, a)
+	}
+	h := r.RedactHeader(http.Header{"Authorization": []string{"sig"}})
+	if e, a := RedactedValue, h.Get("Authorization"); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+type bufLogger struct {
+	logs []string
+}
+
+func (l *bufLogger) Log(args ...interface{}) {
+	l.logs = append(l.logs, args[0].(string))
+}
+
+type structuredBufLogger struct {
+	bufLogger
+	msgs   []string
+	fields [][]aws.LogField
+}
+
+func (l *structuredBufLogger) LogFields(severity aws.LogSeverity, msg string, fields ...aws.LogField) {
+	l.msgs = append(l.msgs, severity.String()+" "+msg)
+	l.fields = append(l.fields, fields)
+}
+
+func TestLog(t *testing.T) {
+	Log(nil, aws.LogSeverityDebug, "msg", "legacy")
+
+	var legacy bufLogger
+	Log(&legacy, aws.LogSeverityDebug, "msg", "legacy", Field("key", "value"))
+	if e, a := []string{"legacy"}, legacy.logs; len(a) != 1 || e[0] != a[0] {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	var structured structuredBufLogger
+	Log(&structured, aws.LogSeverityWarn, "msg", "legacy", Field("key", "value"))
+	if e, a := 0, len(structured.logs); e != a {
+		t.Errorf("expect %v legacy logs, got %v", e, a)
+	}
+	if e, a := "WARN msg", structured.msgs[0]; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := (aws.LogField{Key: "key", Value: "value"}), structured.fields[0][0]; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
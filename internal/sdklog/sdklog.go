@@ -0,0 +1,71 @@
+// Package sdklog provides the helpers the SDK uses to log messages to the
+// aws.Logger set on a Config, and to redact sensitive values from the
+// messages logged.
+package sdklog
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// Keys of the fields the SDK logs with structured log messages.
+const (
+	FieldService      = "service"
+	FieldOperation    = "operation"
+	FieldRetryCount   = "retry_count"
+	FieldStage        = "stage"
+	FieldRetrying     = "retrying"
+	FieldError        = "error"
+	FieldHTTPRequest  = "http_request"
+	FieldHTTPResponse = "http_response"
+	FieldHTTPBody     = "http_body"
+	FieldWaiter       = "waiter"
+	FieldState        = "state"
+	FieldHandler      = "handler"
+	FieldHandlerIndex = "handler_index"
+
+	FieldCredentialsProvider = "credentials_provider"
+)
+
+// Log logs the message to the logger. If the logger is an
+// aws.StructuredLogger the message is logged with the severity and fields
+// provided. Otherwise the legacy message is logged, preserving the format of
+// messages logged by previous versions of the SDK. Does nothing if the
+// logger is nil.
+func Log(logger aws.Logger, severity aws.LogSeverity, msg, legacy string, fields ...aws.LogField) {
+	if logger == nil {
+		return
+	}
+
+	if sl, ok := logger.(aws.StructuredLogger); ok {
+		sl.LogFields(severity, msg, fields...)
+		return
+	}
+	logger.Log(legacy)
+}
+
+// Field returns a log field with the key and value provided.
+func Field(key string, value interface{}) aws.LogField {
+	return aws.LogField{Key: key, Value: value}
+}
+
+// CredentialsLogger returns the logger credential providers created from the
+// config log failures to retrieve credentials to. Returns nil if the
+// config's log level does not match aws.LogDebugWithRequestErrors, the same
+// as the request errors logged by API clients.
+func CredentialsLogger(cfg *aws.Config) aws.Logger {
+	if cfg == nil || !cfg.LogLevel.Matches(aws.LogDebugWithRequestErrors) {
+		return nil
+	}
+	return cfg.Logger
+}
+
+// LogCredentialsError logs the failure of the credential provider to
+// retrieve credentials. Does nothing if the logger is nil.
+func LogCredentialsError(logger aws.Logger, provider string, err error) {
+	Log(logger, aws.LogSeverityError, "failed to retrieve credentials",
+		fmt.Sprintf("ERROR: %s failed to retrieve credentials, %v", provider, err),
+		Field(FieldCredentialsProvider, provider),
+		Field(FieldError, err))
+}
@@ -87,6 +87,7 @@ type Metadata struct {
 	ServiceAbbreviation string
 	ServiceFullName     string
 	SignatureVersion    string
+	Auth                []string `json:"auth"`
 	JSONVersion         string
 	TargetPrefix        string
 	Protocol            string
@@ -268,6 +269,27 @@ func (a *API) ShapeListErrors() []*Shape {
 	return list
 }
 
+// ShapeListRequestFailures returns the exception shapes of an API which are
+// not generated as typed errors, and are instead wrapped around the
+// awserr.RequestFailure unmarshaled by the API's protocol. Only the first
+// shape, by name, is included for an error code modeled by multiple shapes.
+func (a *API) ShapeListRequestFailures() []*Shape {
+	if a.WithGeneratedTypedErrors {
+		return nil
+	}
+
+	list := []*Shape{}
+	codes := map[string]bool{}
+	for _, s := range a.ShapeListErrors() {
+		if s.EventFor != nil || codes[s.ErrorName()] {
+			continue
+		}
+		codes[s.ErrorName()] = true
+		list = append(list, s)
+	}
+	return list
+}
+
 // resetImports resets the import map to default values.
 func (a *API) resetImports() {
 	a.imports = map[string]bool{}
@@ -628,6 +650,8 @@ func newClient(cfg aws.Config, handlers request.Handlers, partitionID, endpoint,
 			v4.BuildNamedHandler(v4.SignRequestHandler.Name, func(s *v4.Signer) {
 				s.DisableURIPathEscaping = true
 			})
+		{{- else if eq .ServiceAuthType "bearer" -}}
+			bearer.SignRequestHandler
 		{{- else -}}
 			v4.SignRequestHandler
 		{{- end -}}
@@ -650,6 +674,10 @@ func newClient(cfg aws.Config, handlers request.Handlers, partitionID, endpoint,
 		)
 	{{- else }}
 		svc.Handlers.UnmarshalError.PushBackNamed({{ .ProtocolPackage }}.UnmarshalErrorHandler)
+		{{- if gt (len $.ShapeListRequestFailures) 0 }}
+			{{- $_ := $.AddSDKImport "private/protocol" }}
+			svc.Handlers.UnmarshalError.PushBackNamed(protocol.NewTypedRequestFailureHandler(exceptionFromCode))
+		{{- end }}
 	{{- end }}
 
 	{{- if .HasEventStream }}
@@ -710,6 +738,8 @@ func (a *API) ServiceGoCode() string {
 	if a.Metadata.SignatureVersion == "v2" {
 		a.AddSDKImport("private/signer/v2")
 		a.AddSDKImport("aws/corehandlers")
+	} else if a.ServiceAuthType() == BearerAuthType {
+		a.AddSDKImport("aws/signer/bearer")
 	} else {
 		a.AddSDKImport("aws/signer/v4")
 	}
@@ -947,6 +977,42 @@ const (
 		{{- end }}
 	}
 	{{- end }}
+{{- else if gt (len $.ShapeListRequestFailures) 0 }}
+	{{- $_ := $.AddSDKImport "aws/awserr" }}
+	{{- range $_, $s := $.ShapeListRequestFailures }}
+
+		// {{ $s.ShapeName }} is the modeled exception returned for the
+		// {{ printf "%q" $s.ErrorName }} error code. Use errors.As to match the error
+		// returned by an API operation against this type.
+		type {{ $s.ShapeName }} struct {
+			awserr.RequestFailure
+		}
+
+		func newError{{ $s.ShapeName }}(err awserr.RequestFailure) error {
+			return &{{ $s.ShapeName }}{RequestFailure: err}
+		}
+
+		// Unwrap returns the awserr.RequestFailure the exception wraps.
+		func (e *{{ $s.ShapeName }}) Unwrap() error {
+			return e.RequestFailure
+		}
+
+		// OrigErrs returns the original errors of the awserr.RequestFailure
+		// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+		// wrapped error's original error is returned.
+		func (e *{{ $s.ShapeName }}) OrigErrs() []error {
+			if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+				return b.OrigErrs()
+			}
+			return []error{e.OrigErr()}
+		}
+	{{- end }}
+
+	var exceptionFromCode = map[string]func(awserr.RequestFailure)error {
+		{{- range $_, $s := $.ShapeListRequestFailures }}
+			"{{ $s.ErrorName }}": newError{{ $s.ShapeName }},
+		{{- end }}
+	}
 {{- end }}
 `))
 
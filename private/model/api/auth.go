@@ -0,0 +1,70 @@
+//go:build codegen
+// +build codegen
+
+package api
+
+// Auth scheme identifiers of the service and operation auth traits, in
+// order of preference.
+const (
+	sigV4AuthTrait  = "aws.auth#sigv4"
+	bearerAuthTrait = "smithy.api#httpBearerAuth"
+	noAuthTrait     = "smithy.api#noAuth"
+)
+
+// authTypeFromTraits returns the AuthType of the first auth scheme in the
+// list that is supported by the SDK. Returns an empty AuthType if no scheme
+// is supported.
+func authTypeFromTraits(schemes []string) AuthType {
+	for _, scheme := range schemes {
+		switch scheme {
+		case sigV4AuthTrait:
+			return V4AuthType
+		case bearerAuthTrait:
+			return BearerAuthType
+		case noAuthTrait:
+			return NoneAuthType
+		}
+	}
+
+	return ""
+}
+
+// ServiceAuthType returns the AuthType the service's API client signs
+// requests with by default. Resolved from the service's auth trait, falling
+// back to the signature version of the service if the trait is not modeled.
+func (a *API) ServiceAuthType() AuthType {
+	if typ := authTypeFromTraits(a.Metadata.Auth); len(typ) != 0 {
+		return typ
+	}
+
+	if a.Metadata.SignatureVersion == "bearer" {
+		return BearerAuthType
+	}
+
+	return V4AuthType
+}
+
+// resolveOperationAuthTypes sets the AuthType of operations whose auth trait
+// selects a different auth scheme than the service's. Operations with an
+// explicit authtype trait are not modified.
+func (a *API) resolveOperationAuthTypes() {
+	serviceAuthType := a.ServiceAuthType()
+
+	for _, op := range a.Operations {
+		if len(op.AuthType) != 0 {
+			continue
+		}
+
+		typ := authTypeFromTraits(op.Auth)
+		if len(typ) == 0 || typ == serviceAuthType {
+			continue
+		}
+		if typ == V4AuthType && serviceAuthType != BearerAuthType {
+			// Clients of services not using bearer auth sign with their
+			// own signature version by default.
+			continue
+		}
+
+		op.AuthType = typ
+	}
+}
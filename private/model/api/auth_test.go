@@ -0,0 +1,162 @@
+//go:build go1.8 && codegen
+// +build go1.8,codegen
+
+package api
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAPI_resolveOperationAuthTypes(t *testing.T) {
+	cases := map[string]struct {
+		Metadata        Metadata
+		Operation       Operation
+		ExpectService   AuthType
+		ExpectOperation AuthType
+		ExpectSigner    string
+	}{
+		"sigv4 service": {
+			Metadata: Metadata{
+				SignatureVersion: "v4",
+				Auth:             []string{sigV4AuthTrait},
+			},
+			Operation: Operation{
+				Auth: []string{sigV4AuthTrait},
+			},
+			ExpectService: V4AuthType,
+		},
+		"sigv4 service, bearer operation": {
+			Metadata: Metadata{
+				SignatureVersion: "v4",
+				Auth:             []string{sigV4AuthTrait},
+			},
+			Operation: Operation{
+				Auth: []string{bearerAuthTrait, sigV4AuthTrait},
+			},
+			ExpectService:   V4AuthType,
+			ExpectOperation: BearerAuthType,
+			ExpectSigner:    "req.Handlers.Sign.Swap(v4.SignRequestHandler.Name, bearer.SignRequestHandler)",
+		},
+		"sigv4 service, no auth operation": {
+			Metadata: Metadata{
+				SignatureVersion: "v4",
+			},
+			Operation: Operation{
+				Auth: []string{noAuthTrait},
+			},
+			ExpectService:   V4AuthType,
+			ExpectOperation: NoneAuthType,
+			ExpectSigner:    "req.Config.Credentials = credentials.AnonymousCredentials",
+		},
+		"bearer service": {
+			Metadata: Metadata{
+				SignatureVersion: "bearer",
+			},
+			ExpectService: BearerAuthType,
+		},
+		"bearer service trait": {
+			Metadata: Metadata{
+				SignatureVersion: "v4",
+				Auth:             []string{"unsupported#auth", bearerAuthTrait},
+			},
+			Operation: Operation{
+				Auth: []string{bearerAuthTrait},
+			},
+			ExpectService: BearerAuthType,
+		},
+		"bearer service, sigv4 operation": {
+			Metadata: Metadata{
+				Auth: []string{bearerAuthTrait},
+			},
+			Operation: Operation{
+				Auth: []string{sigV4AuthTrait},
+			},
+			ExpectService:   BearerAuthType,
+			ExpectOperation: V4AuthType,
+			ExpectSigner:    "req.Handlers.Sign.Swap(bearer.SignRequestHandler.Name, v4.SignRequestHandler)",
+		},
+		"bearer service, no auth operation": {
+			Metadata: Metadata{
+				Auth: []string{bearerAuthTrait},
+			},
+			Operation: Operation{
+				AuthType: NoneAuthType,
+			},
+			ExpectService:   BearerAuthType,
+			ExpectOperation: NoneAuthType,
+			ExpectSigner:    "req.Handlers.Sign.Remove(bearer.SignRequestHandler)",
+		},
+		"authtype not overridden": {
+			Metadata: Metadata{
+				SignatureVersion: "v4",
+			},
+			Operation: Operation{
+				AuthType: V4UnsignedBodyAuthType,
+				Auth:     []string{bearerAuthTrait},
+			},
+			ExpectService:   V4AuthType,
+			ExpectOperation: V4UnsignedBodyAuthType,
+			ExpectSigner:    "v4.WithUnsignedPayload",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			a := &API{
+				Metadata:   c.Metadata,
+				Operations: map[string]*Operation{},
+			}
+			op := c.Operation
+			op.API = a
+			a.Operations["Op"] = &op
+			a.resetImports()
+
+			a.resolveOperationAuthTypes()
+
+			if e, a := c.ExpectService, a.ServiceAuthType(); e != a {
+				t.Errorf("expect service auth type %q, got %q", e, a)
+			}
+			if e, a := c.ExpectOperation, op.AuthType; e != a {
+				t.Errorf("expect operation auth type %q, got %q", e, a)
+			}
+
+			signer := op.GetSigner()
+			if len(c.ExpectSigner) == 0 && len(signer) != 0 {
+				t.Errorf("expect no signer, got %v", signer)
+			}
+			if !strings.Contains(signer, c.ExpectSigner) {
+				t.Errorf("expect signer to contain %v, got %v", c.ExpectSigner, signer)
+			}
+		})
+	}
+}
+
+func TestAPI_ServiceGoCode_bearerAuth(t *testing.T) {
+	a := &API{
+		Metadata: Metadata{
+			APIVersion:       "2022-09-28",
+			EndpointPrefix:   "codecatalyst",
+			ServiceID:        "CodeCatalyst",
+			SignatureVersion: "bearer",
+			Protocol:         "rest-json",
+		},
+		Operations: map[string]*Operation{},
+		Shapes:     map[string]*Shape{},
+	}
+	if err := a.Setup(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	code := a.ServiceGoCode()
+
+	if e, a := "svc.Handlers.Sign.PushBackNamed(bearer.SignRequestHandler)", code; !strings.Contains(a, e) {
+		t.Errorf("expect code to contain %v, got %v", e, a)
+	}
+	if e, a := `"github.com/aws/aws-sdk-go/aws/signer/bearer"`, code; !strings.Contains(a, e) {
+		t.Errorf("expect code to import %v, got %v", e, a)
+	}
+	if e, a := "signer/v4", code; strings.Contains(a, e) {
+		t.Errorf("expect code not to import %v, got %v", e, a)
+	}
+}
@@ -214,7 +214,21 @@ func s3Customizations(a *API) error {
 		"UploadPartInput": {},
 	}
 
+	// Payloads of these shapes can be sent with the aws-chunked content
+	// encoding, so the Body does not need to be seekable if the
+	// ContentLength is known.
+	var streamingReaderBodyRef = map[string]struct{}{
+		"PutObjectInput":  {},
+		"UploadPartInput": {},
+	}
+
 	for name, s := range a.Shapes {
+		if _, ok := streamingReaderBodyRef[name]; ok {
+			if ref, ok := s.MemberRefs["Body"]; ok {
+				ref.StreamingReader = true
+			}
+		}
+
 		// Remove ContentMD5 members unless specified otherwise.
 		if _, keep := keepContentMD5Ref[name]; !keep {
 			if _, have := s.MemberRefs["ContentMD5"]; have {
@@ -250,6 +250,8 @@ func (a *API) Setup() error {
 		return err
 	}
 
+	a.resolveOperationAuthTypes()
+
 	a.addHeaderMapDocumentation()
 
 	if !a.NoRemoveUnusedShapes {
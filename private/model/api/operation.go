@@ -27,6 +27,7 @@ type Operation struct {
 	Deprecated          bool     `json:"deprecated"`
 	DeprecatedMsg       string   `json:"deprecatedMessage"`
 	AuthType            AuthType `json:"authtype"`
+	Auth                []string `json:"auth"`
 	imports             map[string]bool
 	CustomBuildHandlers []string
 
@@ -127,6 +128,11 @@ type AuthType string
 const (
 	NoneAuthType           AuthType = "none"
 	V4UnsignedBodyAuthType AuthType = "v4-unsigned-body"
+
+	// Resolved from the operation's auth trait, when the operation's auth
+	// differs from the service's.
+	V4AuthType     AuthType = "v4"
+	BearerAuthType AuthType = "bearer"
 )
 
 // ShouldSignRequestBody returns if the operation request body should be signed
@@ -146,9 +152,26 @@ func (o *Operation) GetSigner() string {
 
 	switch o.AuthType {
 	case NoneAuthType:
+		if o.API.ServiceAuthType() == BearerAuthType {
+			o.API.AddSDKImport("aws/signer/bearer")
+
+			buf.WriteString("req.Handlers.Sign.Remove(bearer.SignRequestHandler)")
+			break
+		}
+
 		o.API.AddSDKImport("aws/credentials")
 
 		buf.WriteString("req.Config.Credentials = credentials.AnonymousCredentials")
+	case V4AuthType:
+		o.API.AddSDKImport("aws/signer/bearer")
+		o.API.AddSDKImport("aws/signer/v4")
+
+		buf.WriteString("req.Handlers.Sign.Swap(bearer.SignRequestHandler.Name, v4.SignRequestHandler)")
+	case BearerAuthType:
+		o.API.AddSDKImport("aws/signer/bearer")
+		o.API.AddSDKImport("aws/signer/v4")
+
+		buf.WriteString("req.Handlers.Sign.Swap(v4.SignRequestHandler.Name, bearer.SignRequestHandler)")
 	case V4UnsignedBodyAuthType:
 		o.API.AddSDKImport("aws/signer/v4")
 
@@ -78,6 +78,11 @@ type ShapeRef struct {
 
 	// Flags that the member was modeled as JSONValue but suppressed by the SDK.
 	SuppressedJSONValue bool `json:"-"`
+
+	// Flags that the streaming input payload member is a io.Reader instead of
+	// a io.ReadSeeker, because the length of the payload is provided by
+	// another member and the payload does not need to be seeked to be signed.
+	StreamingReader bool `json:"-"`
 }
 
 // A Shape defines the definition of a shape type
@@ -356,6 +361,8 @@ func (s *Shape) GoStructType(name string, ref *ShapeRef) string {
 		rtype := "io.ReadSeeker"
 		if strings.HasSuffix(s.ShapeName, "Output") {
 			rtype = "io.ReadCloser"
+		} else if ref.StreamingReader {
+			rtype = "io.Reader"
 		}
 
 		s.API.imports["io"] = true
@@ -0,0 +1,157 @@
+//go:build codegen
+// +build codegen
+
+// Command gen-endpoint-url-ids parses the API models and generates a Go file
+// mapping the endpoint IDs of services to their service IDs, used by the
+// session package to look up the service specific endpoint URL configuration.
+//
+//	gen-endpoint-url-ids -out aws/session/endpoint_url_ids.go models/apis/*/*/api-2.json
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/aws/aws-sdk-go/private/model/api"
+)
+
+// Generates the endpoint ID to service ID mapping from API model files.
+//
+// Flags:
+// -out The file to write the generated mapping to.
+func main() {
+	var outName string
+	flag.StringVar(&outName, "out", "", "File to write generated endpoint IDs to.")
+	flag.Parse()
+
+	if len(outName) == 0 {
+		exitErrorf("out is required.")
+	}
+
+	globs := flag.Args()
+	for i, g := range globs {
+		globs[i] = filepath.FromSlash(g)
+	}
+
+	modelPaths, err := api.ExpandModelGlobPath(globs...)
+	if err != nil {
+		exitErrorf("failed to glob file pattern, %v", err)
+	}
+	modelPaths, _ = api.TrimModelServiceVersions(modelPaths)
+
+	loader := api.Loader{
+		BaseImport:            api.SDKImportRoot + "/service",
+		IgnoreUnsupportedAPIs: true,
+		StrictServiceId:       true,
+	}
+	apis, err := loader.Load(modelPaths)
+	if err != nil {
+		exitErrorf("failed to load API models, %v", err)
+	}
+	if len(apis) == 0 {
+		exitErrorf("expected to load models, but found none")
+	}
+
+	ids := endpointsServiceIDs(apis)
+
+	var buf bytes.Buffer
+	if err := tplEndpointURLIDs.Execute(&buf, ids); err != nil {
+		exitErrorf("failed to generate endpoint IDs, %v", err)
+	}
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		exitErrorf("failed to format generated endpoint IDs, %v", err)
+	}
+	if err := ioutil.WriteFile(outName, b, 0644); err != nil {
+		exitErrorf("failed to write %q file, %v", outName, err)
+	}
+}
+
+type endpointsServiceID struct {
+	EndpointsID string
+	ServiceID   string
+}
+
+// endpointsServiceIDs returns the service ID of each endpoint ID whose endpoint
+// URL configuration key differs from the service ID's, sorted by endpoint ID.
+//
+// Services may share an endpoint ID, such as Neptune and DocDB with RDS. The
+// endpoint ID is mapped to the service ID of the service the endpoint ID is
+// named after if there is one, otherwise the service ID of the service with
+// the oldest API version.
+func endpointsServiceIDs(apis api.APIs) []endpointsServiceID {
+	services := map[string][]*api.API{}
+	for _, a := range apis {
+		id := a.Metadata.EndpointsID
+		services[id] = append(services[id], a)
+	}
+
+	var ids []endpointsServiceID
+	for endpointsID, svcs := range services {
+		sort.Slice(svcs, func(i, j int) bool {
+			if a, b := svcs[i].Metadata.APIVersion, svcs[j].Metadata.APIVersion; a != b {
+				return a < b
+			}
+			return api.ServiceID(svcs[i]) < api.ServiceID(svcs[j])
+		})
+
+		serviceID := api.ServiceID(svcs[0])
+		for _, a := range svcs {
+			if id := api.ServiceID(a); endpointURLKey(id) == endpointURLKey(endpointsID) {
+				serviceID = id
+				break
+			}
+		}
+		if endpointURLKey(serviceID) == endpointURLKey(endpointsID) {
+			continue
+		}
+		ids = append(ids, endpointsServiceID{
+			EndpointsID: endpointsID,
+			ServiceID:   serviceID,
+		})
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i].EndpointsID < ids[j].EndpointsID
+	})
+
+	return ids
+}
+
+// endpointURLKey returns the endpoint URL configuration key of the ID, which
+// is the ID in upper case, with spaces, dashes, and periods replaced with
+// underscores.
+func endpointURLKey(id string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.':
+			return '_'
+		}
+		return r
+	}, strings.ToUpper(strings.TrimSpace(id)))
+}
+
+var tplEndpointURLIDs = template.Must(template.New("endpointURLIDs").Parse(`// Code generated by private/model/cli/gen-endpoint-url-ids. DO NOT EDIT.
+
+package session
+
+// endpointsServiceIDs maps the endpoint IDs of services to their service IDs,
+// for services whose service ID differs from their endpoint ID.
+var endpointsServiceIDs = map[string]string{
+{{- range . }}
+	{{ printf "%q" .EndpointsID }}: {{ printf "%q" .ServiceID }},
+{{- end }}
+}
+`))
+
+func exitErrorf(msg string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, msg+"\n", args...)
+	os.Exit(1)
+}
@@ -149,6 +149,8 @@ func buildBody(r *request.Request, v reflect.Value) {
 					switch reader := payload.Interface().(type) {
 					case io.ReadSeeker:
 						r.SetReaderBody(reader)
+					case io.Reader:
+						r.SetReaderBody(aws.ReadSeekCloser(reader))
 					case []byte:
 						r.SetBufferBody(reader)
 					case string:
@@ -63,3 +63,27 @@ func (u *UnmarshalErrorHandler) UnmarshalError(r *request.Request) {
 
 	r.Error = v
 }
+
+// TypedRequestFailureHandlerName is the name of the named handler returned by
+// NewTypedRequestFailureHandler.
+const TypedRequestFailureHandlerName = "awssdk.protocol.TypedRequestFailure"
+
+// NewTypedRequestFailureHandler returns a named handler that replaces the
+// request's awserr.RequestFailure error with the modeled exception for the
+// error's code. Used by protocols that unmarshal API response errors into a
+// generic awserr.RequestFailure instead of a typed error.
+func NewTypedRequestFailureHandler(exceptionFromCode map[string]func(awserr.RequestFailure) error) request.NamedHandler {
+	return request.NamedHandler{
+		Name: TypedRequestFailureHandlerName,
+		Fn: func(r *request.Request) {
+			reqErr, ok := r.Error.(awserr.RequestFailure)
+			if !ok || reqErr == nil {
+				return
+			}
+
+			if fn, ok := exceptionFromCode[reqErr.Code()]; ok {
+				r.Error = fn(reqErr)
+			}
+		},
+	}
+}
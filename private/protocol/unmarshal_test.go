@@ -163,3 +163,54 @@ func TestUnmarshalSeriaizationError(t *testing.T) {
 		}
 	}
 }
+
+type mockTypedRequestFailure struct {
+	awserr.RequestFailure
+}
+
+func TestTypedRequestFailureHandler(t *testing.T) {
+	handler := protocol.NewTypedRequestFailureHandler(map[string]func(awserr.RequestFailure) error{
+		"ModeledError": func(err awserr.RequestFailure) error {
+			return &mockTypedRequestFailure{RequestFailure: err}
+		},
+	})
+
+	cases := map[string]struct {
+		Err         error
+		ExpectTyped bool
+	}{
+		"modeled": {
+			Err: awserr.NewRequestFailure(
+				awserr.New("ModeledError", "message", nil), 400, "reqID"),
+			ExpectTyped: true,
+		},
+		"not modeled": {
+			Err: awserr.NewRequestFailure(
+				awserr.New("OtherError", "message", nil), 400, "reqID"),
+		},
+		"not request failure": {
+			Err: awserr.New("ModeledError", "message", nil),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &request.Request{Error: c.Err}
+			handler.Fn(r)
+
+			typed, ok := r.Error.(*mockTypedRequestFailure)
+			if e, a := c.ExpectTyped, ok; e != a {
+				t.Fatalf("expect typed %v, got %v, %T", e, a, r.Error)
+			}
+			if !ok {
+				if e, a := c.Err, r.Error; e != a {
+					t.Errorf("expect error unchanged, got %v", a)
+				}
+				return
+			}
+			if e, a := c.Err, typed.RequestFailure; e != a {
+				t.Errorf("expect %v wrapped, got %v", e, a)
+			}
+		})
+	}
+}
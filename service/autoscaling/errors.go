@@ -2,6 +2,10 @@
 
 package autoscaling
 
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
 const (
 
 	// ErrCodeActiveInstanceRefreshNotFoundFault for service response error code
@@ -75,3 +79,276 @@ const (
 	// The service-linked role is not yet ready for use.
 	ErrCodeServiceLinkedRoleFailure = "ServiceLinkedRoleFailure"
 )
+
+// ActiveInstanceRefreshNotFoundFault is the modeled exception returned for the
+// "ActiveInstanceRefreshNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ActiveInstanceRefreshNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorActiveInstanceRefreshNotFoundFault(err awserr.RequestFailure) error {
+	return &ActiveInstanceRefreshNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ActiveInstanceRefreshNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ActiveInstanceRefreshNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// AlreadyExistsFault is the modeled exception returned for the
+// "AlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &AlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InstanceRefreshInProgressFault is the modeled exception returned for the
+// "InstanceRefreshInProgress" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InstanceRefreshInProgressFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInstanceRefreshInProgressFault(err awserr.RequestFailure) error {
+	return &InstanceRefreshInProgressFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InstanceRefreshInProgressFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InstanceRefreshInProgressFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidNextToken is the modeled exception returned for the
+// "InvalidNextToken" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidNextToken struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidNextToken(err awserr.RequestFailure) error {
+	return &InvalidNextToken{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidNextToken) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidNextToken) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// IrreversibleInstanceRefreshFault is the modeled exception returned for the
+// "IrreversibleInstanceRefresh" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type IrreversibleInstanceRefreshFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorIrreversibleInstanceRefreshFault(err awserr.RequestFailure) error {
+	return &IrreversibleInstanceRefreshFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *IrreversibleInstanceRefreshFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *IrreversibleInstanceRefreshFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// LimitExceededFault is the modeled exception returned for the
+// "LimitExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type LimitExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorLimitExceededFault(err awserr.RequestFailure) error {
+	return &LimitExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *LimitExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *LimitExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ResourceContentionFault is the modeled exception returned for the
+// "ResourceContention" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ResourceContentionFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorResourceContentionFault(err awserr.RequestFailure) error {
+	return &ResourceContentionFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ResourceContentionFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ResourceContentionFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ResourceInUseFault is the modeled exception returned for the
+// "ResourceInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ResourceInUseFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorResourceInUseFault(err awserr.RequestFailure) error {
+	return &ResourceInUseFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ResourceInUseFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ResourceInUseFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ScalingActivityInProgressFault is the modeled exception returned for the
+// "ScalingActivityInProgress" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ScalingActivityInProgressFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorScalingActivityInProgressFault(err awserr.RequestFailure) error {
+	return &ScalingActivityInProgressFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ScalingActivityInProgressFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ScalingActivityInProgressFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ServiceLinkedRoleFailure is the modeled exception returned for the
+// "ServiceLinkedRoleFailure" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ServiceLinkedRoleFailure struct {
+	awserr.RequestFailure
+}
+
+func newErrorServiceLinkedRoleFailure(err awserr.RequestFailure) error {
+	return &ServiceLinkedRoleFailure{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ServiceLinkedRoleFailure) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ServiceLinkedRoleFailure) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+var exceptionFromCode = map[string]func(awserr.RequestFailure) error{
+	"ActiveInstanceRefreshNotFound": newErrorActiveInstanceRefreshNotFoundFault,
+	"AlreadyExists":                 newErrorAlreadyExistsFault,
+	"InstanceRefreshInProgress":     newErrorInstanceRefreshInProgressFault,
+	"InvalidNextToken":              newErrorInvalidNextToken,
+	"IrreversibleInstanceRefresh":   newErrorIrreversibleInstanceRefreshFault,
+	"LimitExceeded":                 newErrorLimitExceededFault,
+	"ResourceContention":            newErrorResourceContentionFault,
+	"ResourceInUse":                 newErrorResourceInUseFault,
+	"ScalingActivityInProgress":     newErrorScalingActivityInProgressFault,
+	"ServiceLinkedRoleFailure":      newErrorServiceLinkedRoleFailure,
+}
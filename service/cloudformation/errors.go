@@ -2,6 +2,10 @@
 
 package cloudformation
 
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
 const (
 
 	// ErrCodeAlreadyExistsException for service response error code
@@ -192,3 +196,735 @@ const (
 	// The specified extension doesn't exist in the CloudFormation registry.
 	ErrCodeTypeNotFoundException = "TypeNotFoundException"
 )
+
+// AlreadyExistsException is the modeled exception returned for the
+// "AlreadyExistsException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AlreadyExistsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorAlreadyExistsException(err awserr.RequestFailure) error {
+	return &AlreadyExistsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AlreadyExistsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AlreadyExistsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CFNRegistryException is the modeled exception returned for the
+// "CFNRegistryException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CFNRegistryException struct {
+	awserr.RequestFailure
+}
+
+func newErrorCFNRegistryException(err awserr.RequestFailure) error {
+	return &CFNRegistryException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CFNRegistryException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CFNRegistryException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ChangeSetNotFoundException is the modeled exception returned for the
+// "ChangeSetNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ChangeSetNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorChangeSetNotFoundException(err awserr.RequestFailure) error {
+	return &ChangeSetNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ChangeSetNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ChangeSetNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ConcurrentResourcesLimitExceededException is the modeled exception returned for the
+// "ConcurrentResourcesLimitExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ConcurrentResourcesLimitExceededException struct {
+	awserr.RequestFailure
+}
+
+func newErrorConcurrentResourcesLimitExceededException(err awserr.RequestFailure) error {
+	return &ConcurrentResourcesLimitExceededException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ConcurrentResourcesLimitExceededException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ConcurrentResourcesLimitExceededException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CreatedButModifiedException is the modeled exception returned for the
+// "CreatedButModifiedException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CreatedButModifiedException struct {
+	awserr.RequestFailure
+}
+
+func newErrorCreatedButModifiedException(err awserr.RequestFailure) error {
+	return &CreatedButModifiedException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CreatedButModifiedException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CreatedButModifiedException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// GeneratedTemplateNotFoundException is the modeled exception returned for the
+// "GeneratedTemplateNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type GeneratedTemplateNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorGeneratedTemplateNotFoundException(err awserr.RequestFailure) error {
+	return &GeneratedTemplateNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *GeneratedTemplateNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *GeneratedTemplateNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InsufficientCapabilitiesException is the modeled exception returned for the
+// "InsufficientCapabilitiesException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InsufficientCapabilitiesException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInsufficientCapabilitiesException(err awserr.RequestFailure) error {
+	return &InsufficientCapabilitiesException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InsufficientCapabilitiesException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InsufficientCapabilitiesException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidChangeSetStatusException is the modeled exception returned for the
+// "InvalidChangeSetStatus" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidChangeSetStatusException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidChangeSetStatusException(err awserr.RequestFailure) error {
+	return &InvalidChangeSetStatusException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidChangeSetStatusException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidChangeSetStatusException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidOperationException is the modeled exception returned for the
+// "InvalidOperationException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidOperationException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidOperationException(err awserr.RequestFailure) error {
+	return &InvalidOperationException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidOperationException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidOperationException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidStateTransitionException is the modeled exception returned for the
+// "InvalidStateTransition" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidStateTransitionException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidStateTransitionException(err awserr.RequestFailure) error {
+	return &InvalidStateTransitionException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidStateTransitionException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidStateTransitionException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// LimitExceededException is the modeled exception returned for the
+// "LimitExceededException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type LimitExceededException struct {
+	awserr.RequestFailure
+}
+
+func newErrorLimitExceededException(err awserr.RequestFailure) error {
+	return &LimitExceededException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *LimitExceededException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *LimitExceededException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NameAlreadyExistsException is the modeled exception returned for the
+// "NameAlreadyExistsException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NameAlreadyExistsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorNameAlreadyExistsException(err awserr.RequestFailure) error {
+	return &NameAlreadyExistsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NameAlreadyExistsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NameAlreadyExistsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// OperationIdAlreadyExistsException is the modeled exception returned for the
+// "OperationIdAlreadyExistsException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type OperationIdAlreadyExistsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorOperationIdAlreadyExistsException(err awserr.RequestFailure) error {
+	return &OperationIdAlreadyExistsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *OperationIdAlreadyExistsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *OperationIdAlreadyExistsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// OperationInProgressException is the modeled exception returned for the
+// "OperationInProgressException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type OperationInProgressException struct {
+	awserr.RequestFailure
+}
+
+func newErrorOperationInProgressException(err awserr.RequestFailure) error {
+	return &OperationInProgressException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *OperationInProgressException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *OperationInProgressException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// OperationNotFoundException is the modeled exception returned for the
+// "OperationNotFoundException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type OperationNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorOperationNotFoundException(err awserr.RequestFailure) error {
+	return &OperationNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *OperationNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *OperationNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// OperationStatusCheckFailedException is the modeled exception returned for the
+// "ConditionalCheckFailed" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type OperationStatusCheckFailedException struct {
+	awserr.RequestFailure
+}
+
+func newErrorOperationStatusCheckFailedException(err awserr.RequestFailure) error {
+	return &OperationStatusCheckFailedException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *OperationStatusCheckFailedException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *OperationStatusCheckFailedException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ResourceScanInProgressException is the modeled exception returned for the
+// "ResourceScanInProgress" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ResourceScanInProgressException struct {
+	awserr.RequestFailure
+}
+
+func newErrorResourceScanInProgressException(err awserr.RequestFailure) error {
+	return &ResourceScanInProgressException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ResourceScanInProgressException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ResourceScanInProgressException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ResourceScanLimitExceededException is the modeled exception returned for the
+// "ResourceScanLimitExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ResourceScanLimitExceededException struct {
+	awserr.RequestFailure
+}
+
+func newErrorResourceScanLimitExceededException(err awserr.RequestFailure) error {
+	return &ResourceScanLimitExceededException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ResourceScanLimitExceededException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ResourceScanLimitExceededException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ResourceScanNotFoundException is the modeled exception returned for the
+// "ResourceScanNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ResourceScanNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorResourceScanNotFoundException(err awserr.RequestFailure) error {
+	return &ResourceScanNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ResourceScanNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ResourceScanNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// StackInstanceNotFoundException is the modeled exception returned for the
+// "StackInstanceNotFoundException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type StackInstanceNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorStackInstanceNotFoundException(err awserr.RequestFailure) error {
+	return &StackInstanceNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *StackInstanceNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *StackInstanceNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// StackNotFoundException is the modeled exception returned for the
+// "StackNotFoundException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type StackNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorStackNotFoundException(err awserr.RequestFailure) error {
+	return &StackNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *StackNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *StackNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// StackSetNotEmptyException is the modeled exception returned for the
+// "StackSetNotEmptyException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type StackSetNotEmptyException struct {
+	awserr.RequestFailure
+}
+
+func newErrorStackSetNotEmptyException(err awserr.RequestFailure) error {
+	return &StackSetNotEmptyException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *StackSetNotEmptyException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *StackSetNotEmptyException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// StackSetNotFoundException is the modeled exception returned for the
+// "StackSetNotFoundException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type StackSetNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorStackSetNotFoundException(err awserr.RequestFailure) error {
+	return &StackSetNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *StackSetNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *StackSetNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// StaleRequestException is the modeled exception returned for the
+// "StaleRequestException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type StaleRequestException struct {
+	awserr.RequestFailure
+}
+
+func newErrorStaleRequestException(err awserr.RequestFailure) error {
+	return &StaleRequestException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *StaleRequestException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *StaleRequestException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TokenAlreadyExistsException is the modeled exception returned for the
+// "TokenAlreadyExistsException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TokenAlreadyExistsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTokenAlreadyExistsException(err awserr.RequestFailure) error {
+	return &TokenAlreadyExistsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TokenAlreadyExistsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TokenAlreadyExistsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TypeConfigurationNotFoundException is the modeled exception returned for the
+// "TypeConfigurationNotFoundException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TypeConfigurationNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTypeConfigurationNotFoundException(err awserr.RequestFailure) error {
+	return &TypeConfigurationNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TypeConfigurationNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TypeConfigurationNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TypeNotFoundException is the modeled exception returned for the
+// "TypeNotFoundException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TypeNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTypeNotFoundException(err awserr.RequestFailure) error {
+	return &TypeNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TypeNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TypeNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+var exceptionFromCode = map[string]func(awserr.RequestFailure) error{
+	"AlreadyExistsException":             newErrorAlreadyExistsException,
+	"CFNRegistryException":               newErrorCFNRegistryException,
+	"ChangeSetNotFound":                  newErrorChangeSetNotFoundException,
+	"ConcurrentResourcesLimitExceeded":   newErrorConcurrentResourcesLimitExceededException,
+	"CreatedButModifiedException":        newErrorCreatedButModifiedException,
+	"GeneratedTemplateNotFound":          newErrorGeneratedTemplateNotFoundException,
+	"InsufficientCapabilitiesException":  newErrorInsufficientCapabilitiesException,
+	"InvalidChangeSetStatus":             newErrorInvalidChangeSetStatusException,
+	"InvalidOperationException":          newErrorInvalidOperationException,
+	"InvalidStateTransition":             newErrorInvalidStateTransitionException,
+	"LimitExceededException":             newErrorLimitExceededException,
+	"NameAlreadyExistsException":         newErrorNameAlreadyExistsException,
+	"OperationIdAlreadyExistsException":  newErrorOperationIdAlreadyExistsException,
+	"OperationInProgressException":       newErrorOperationInProgressException,
+	"OperationNotFoundException":         newErrorOperationNotFoundException,
+	"ConditionalCheckFailed":             newErrorOperationStatusCheckFailedException,
+	"ResourceScanInProgress":             newErrorResourceScanInProgressException,
+	"ResourceScanLimitExceeded":          newErrorResourceScanLimitExceededException,
+	"ResourceScanNotFound":               newErrorResourceScanNotFoundException,
+	"StackInstanceNotFoundException":     newErrorStackInstanceNotFoundException,
+	"StackNotFoundException":             newErrorStackNotFoundException,
+	"StackSetNotEmptyException":          newErrorStackSetNotEmptyException,
+	"StackSetNotFoundException":          newErrorStackSetNotFoundException,
+	"StaleRequestException":              newErrorStaleRequestException,
+	"TokenAlreadyExistsException":        newErrorTokenAlreadyExistsException,
+	"TypeConfigurationNotFoundException": newErrorTypeConfigurationNotFoundException,
+	"TypeNotFoundException":              newErrorTypeNotFoundException,
+}
@@ -2,6 +2,10 @@
 
 package cloudfront
 
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
 const (
 
 	// ErrCodeAccessDenied for service response error code
@@ -1016,3 +1020,4029 @@ const (
 	// This operation is not supported in this region.
 	ErrCodeUnsupportedOperation = "UnsupportedOperation"
 )
+
+// AccessDenied is the modeled exception returned for the
+// "AccessDenied" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AccessDenied struct {
+	awserr.RequestFailure
+}
+
+func newErrorAccessDenied(err awserr.RequestFailure) error {
+	return &AccessDenied{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AccessDenied) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AccessDenied) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// BatchTooLarge is the modeled exception returned for the
+// "BatchTooLarge" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type BatchTooLarge struct {
+	awserr.RequestFailure
+}
+
+func newErrorBatchTooLarge(err awserr.RequestFailure) error {
+	return &BatchTooLarge{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *BatchTooLarge) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *BatchTooLarge) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CNAMEAlreadyExists is the modeled exception returned for the
+// "CNAMEAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CNAMEAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorCNAMEAlreadyExists(err awserr.RequestFailure) error {
+	return &CNAMEAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CNAMEAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CNAMEAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CachePolicyAlreadyExists is the modeled exception returned for the
+// "CachePolicyAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CachePolicyAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorCachePolicyAlreadyExists(err awserr.RequestFailure) error {
+	return &CachePolicyAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CachePolicyAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CachePolicyAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CachePolicyInUse is the modeled exception returned for the
+// "CachePolicyInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CachePolicyInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorCachePolicyInUse(err awserr.RequestFailure) error {
+	return &CachePolicyInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CachePolicyInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CachePolicyInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CannotChangeImmutablePublicKeyFields is the modeled exception returned for the
+// "CannotChangeImmutablePublicKeyFields" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CannotChangeImmutablePublicKeyFields struct {
+	awserr.RequestFailure
+}
+
+func newErrorCannotChangeImmutablePublicKeyFields(err awserr.RequestFailure) error {
+	return &CannotChangeImmutablePublicKeyFields{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CannotChangeImmutablePublicKeyFields) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CannotChangeImmutablePublicKeyFields) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CannotDeleteEntityWhileInUse is the modeled exception returned for the
+// "CannotDeleteEntityWhileInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CannotDeleteEntityWhileInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorCannotDeleteEntityWhileInUse(err awserr.RequestFailure) error {
+	return &CannotDeleteEntityWhileInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CannotDeleteEntityWhileInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CannotDeleteEntityWhileInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ContinuousDeploymentPolicyAlreadyExists is the modeled exception returned for the
+// "ContinuousDeploymentPolicyAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ContinuousDeploymentPolicyAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorContinuousDeploymentPolicyAlreadyExists(err awserr.RequestFailure) error {
+	return &ContinuousDeploymentPolicyAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ContinuousDeploymentPolicyAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ContinuousDeploymentPolicyAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ContinuousDeploymentPolicyInUse is the modeled exception returned for the
+// "ContinuousDeploymentPolicyInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ContinuousDeploymentPolicyInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorContinuousDeploymentPolicyInUse(err awserr.RequestFailure) error {
+	return &ContinuousDeploymentPolicyInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ContinuousDeploymentPolicyInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ContinuousDeploymentPolicyInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DistributionAlreadyExists is the modeled exception returned for the
+// "DistributionAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DistributionAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorDistributionAlreadyExists(err awserr.RequestFailure) error {
+	return &DistributionAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DistributionAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DistributionAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DistributionNotDisabled is the modeled exception returned for the
+// "DistributionNotDisabled" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DistributionNotDisabled struct {
+	awserr.RequestFailure
+}
+
+func newErrorDistributionNotDisabled(err awserr.RequestFailure) error {
+	return &DistributionNotDisabled{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DistributionNotDisabled) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DistributionNotDisabled) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// EntityAlreadyExists is the modeled exception returned for the
+// "EntityAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type EntityAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorEntityAlreadyExists(err awserr.RequestFailure) error {
+	return &EntityAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *EntityAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *EntityAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// EntityLimitExceeded is the modeled exception returned for the
+// "EntityLimitExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type EntityLimitExceeded struct {
+	awserr.RequestFailure
+}
+
+func newErrorEntityLimitExceeded(err awserr.RequestFailure) error {
+	return &EntityLimitExceeded{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *EntityLimitExceeded) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *EntityLimitExceeded) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// EntityNotFound is the modeled exception returned for the
+// "EntityNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type EntityNotFound struct {
+	awserr.RequestFailure
+}
+
+func newErrorEntityNotFound(err awserr.RequestFailure) error {
+	return &EntityNotFound{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *EntityNotFound) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *EntityNotFound) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// EntitySizeLimitExceeded is the modeled exception returned for the
+// "EntitySizeLimitExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type EntitySizeLimitExceeded struct {
+	awserr.RequestFailure
+}
+
+func newErrorEntitySizeLimitExceeded(err awserr.RequestFailure) error {
+	return &EntitySizeLimitExceeded{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *EntitySizeLimitExceeded) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *EntitySizeLimitExceeded) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// FieldLevelEncryptionConfigAlreadyExists is the modeled exception returned for the
+// "FieldLevelEncryptionConfigAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type FieldLevelEncryptionConfigAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorFieldLevelEncryptionConfigAlreadyExists(err awserr.RequestFailure) error {
+	return &FieldLevelEncryptionConfigAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *FieldLevelEncryptionConfigAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *FieldLevelEncryptionConfigAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// FieldLevelEncryptionConfigInUse is the modeled exception returned for the
+// "FieldLevelEncryptionConfigInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type FieldLevelEncryptionConfigInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorFieldLevelEncryptionConfigInUse(err awserr.RequestFailure) error {
+	return &FieldLevelEncryptionConfigInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *FieldLevelEncryptionConfigInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *FieldLevelEncryptionConfigInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// FieldLevelEncryptionProfileAlreadyExists is the modeled exception returned for the
+// "FieldLevelEncryptionProfileAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type FieldLevelEncryptionProfileAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorFieldLevelEncryptionProfileAlreadyExists(err awserr.RequestFailure) error {
+	return &FieldLevelEncryptionProfileAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *FieldLevelEncryptionProfileAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *FieldLevelEncryptionProfileAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// FieldLevelEncryptionProfileInUse is the modeled exception returned for the
+// "FieldLevelEncryptionProfileInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type FieldLevelEncryptionProfileInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorFieldLevelEncryptionProfileInUse(err awserr.RequestFailure) error {
+	return &FieldLevelEncryptionProfileInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *FieldLevelEncryptionProfileInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *FieldLevelEncryptionProfileInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// FieldLevelEncryptionProfileSizeExceeded is the modeled exception returned for the
+// "FieldLevelEncryptionProfileSizeExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type FieldLevelEncryptionProfileSizeExceeded struct {
+	awserr.RequestFailure
+}
+
+func newErrorFieldLevelEncryptionProfileSizeExceeded(err awserr.RequestFailure) error {
+	return &FieldLevelEncryptionProfileSizeExceeded{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *FieldLevelEncryptionProfileSizeExceeded) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *FieldLevelEncryptionProfileSizeExceeded) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// FunctionAlreadyExists is the modeled exception returned for the
+// "FunctionAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type FunctionAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorFunctionAlreadyExists(err awserr.RequestFailure) error {
+	return &FunctionAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *FunctionAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *FunctionAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// FunctionInUse is the modeled exception returned for the
+// "FunctionInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type FunctionInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorFunctionInUse(err awserr.RequestFailure) error {
+	return &FunctionInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *FunctionInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *FunctionInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// FunctionSizeLimitExceeded is the modeled exception returned for the
+// "FunctionSizeLimitExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type FunctionSizeLimitExceeded struct {
+	awserr.RequestFailure
+}
+
+func newErrorFunctionSizeLimitExceeded(err awserr.RequestFailure) error {
+	return &FunctionSizeLimitExceeded{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *FunctionSizeLimitExceeded) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *FunctionSizeLimitExceeded) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// IllegalDelete is the modeled exception returned for the
+// "IllegalDelete" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type IllegalDelete struct {
+	awserr.RequestFailure
+}
+
+func newErrorIllegalDelete(err awserr.RequestFailure) error {
+	return &IllegalDelete{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *IllegalDelete) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *IllegalDelete) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// IllegalFieldLevelEncryptionConfigAssociationWithCacheBehavior is the modeled exception returned for the
+// "IllegalFieldLevelEncryptionConfigAssociationWithCacheBehavior" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type IllegalFieldLevelEncryptionConfigAssociationWithCacheBehavior struct {
+	awserr.RequestFailure
+}
+
+func newErrorIllegalFieldLevelEncryptionConfigAssociationWithCacheBehavior(err awserr.RequestFailure) error {
+	return &IllegalFieldLevelEncryptionConfigAssociationWithCacheBehavior{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *IllegalFieldLevelEncryptionConfigAssociationWithCacheBehavior) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *IllegalFieldLevelEncryptionConfigAssociationWithCacheBehavior) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// IllegalOriginAccessConfiguration is the modeled exception returned for the
+// "IllegalOriginAccessConfiguration" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type IllegalOriginAccessConfiguration struct {
+	awserr.RequestFailure
+}
+
+func newErrorIllegalOriginAccessConfiguration(err awserr.RequestFailure) error {
+	return &IllegalOriginAccessConfiguration{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *IllegalOriginAccessConfiguration) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *IllegalOriginAccessConfiguration) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// IllegalUpdate is the modeled exception returned for the
+// "IllegalUpdate" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type IllegalUpdate struct {
+	awserr.RequestFailure
+}
+
+func newErrorIllegalUpdate(err awserr.RequestFailure) error {
+	return &IllegalUpdate{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *IllegalUpdate) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *IllegalUpdate) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InconsistentQuantities is the modeled exception returned for the
+// "InconsistentQuantities" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InconsistentQuantities struct {
+	awserr.RequestFailure
+}
+
+func newErrorInconsistentQuantities(err awserr.RequestFailure) error {
+	return &InconsistentQuantities{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InconsistentQuantities) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InconsistentQuantities) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidArgument is the modeled exception returned for the
+// "InvalidArgument" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidArgument struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidArgument(err awserr.RequestFailure) error {
+	return &InvalidArgument{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidArgument) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidArgument) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDefaultRootObject is the modeled exception returned for the
+// "InvalidDefaultRootObject" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDefaultRootObject struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDefaultRootObject(err awserr.RequestFailure) error {
+	return &InvalidDefaultRootObject{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDefaultRootObject) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDefaultRootObject) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDomainNameForOriginAccessControl is the modeled exception returned for the
+// "InvalidDomainNameForOriginAccessControl" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDomainNameForOriginAccessControl struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDomainNameForOriginAccessControl(err awserr.RequestFailure) error {
+	return &InvalidDomainNameForOriginAccessControl{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDomainNameForOriginAccessControl) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDomainNameForOriginAccessControl) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidErrorCode is the modeled exception returned for the
+// "InvalidErrorCode" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidErrorCode struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidErrorCode(err awserr.RequestFailure) error {
+	return &InvalidErrorCode{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidErrorCode) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidErrorCode) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidForwardCookies is the modeled exception returned for the
+// "InvalidForwardCookies" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidForwardCookies struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidForwardCookies(err awserr.RequestFailure) error {
+	return &InvalidForwardCookies{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidForwardCookies) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidForwardCookies) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidFunctionAssociation is the modeled exception returned for the
+// "InvalidFunctionAssociation" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidFunctionAssociation struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidFunctionAssociation(err awserr.RequestFailure) error {
+	return &InvalidFunctionAssociation{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidFunctionAssociation) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidFunctionAssociation) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidGeoRestrictionParameter is the modeled exception returned for the
+// "InvalidGeoRestrictionParameter" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidGeoRestrictionParameter struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidGeoRestrictionParameter(err awserr.RequestFailure) error {
+	return &InvalidGeoRestrictionParameter{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidGeoRestrictionParameter) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidGeoRestrictionParameter) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidHeadersForS3Origin is the modeled exception returned for the
+// "InvalidHeadersForS3Origin" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidHeadersForS3Origin struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidHeadersForS3Origin(err awserr.RequestFailure) error {
+	return &InvalidHeadersForS3Origin{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidHeadersForS3Origin) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidHeadersForS3Origin) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidIfMatchVersion is the modeled exception returned for the
+// "InvalidIfMatchVersion" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidIfMatchVersion struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidIfMatchVersion(err awserr.RequestFailure) error {
+	return &InvalidIfMatchVersion{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidIfMatchVersion) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidIfMatchVersion) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidLambdaFunctionAssociation is the modeled exception returned for the
+// "InvalidLambdaFunctionAssociation" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidLambdaFunctionAssociation struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidLambdaFunctionAssociation(err awserr.RequestFailure) error {
+	return &InvalidLambdaFunctionAssociation{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidLambdaFunctionAssociation) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidLambdaFunctionAssociation) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidLocationCode is the modeled exception returned for the
+// "InvalidLocationCode" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidLocationCode struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidLocationCode(err awserr.RequestFailure) error {
+	return &InvalidLocationCode{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidLocationCode) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidLocationCode) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidMinimumProtocolVersion is the modeled exception returned for the
+// "InvalidMinimumProtocolVersion" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidMinimumProtocolVersion struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidMinimumProtocolVersion(err awserr.RequestFailure) error {
+	return &InvalidMinimumProtocolVersion{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidMinimumProtocolVersion) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidMinimumProtocolVersion) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidOrigin is the modeled exception returned for the
+// "InvalidOrigin" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidOrigin struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidOrigin(err awserr.RequestFailure) error {
+	return &InvalidOrigin{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidOrigin) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidOrigin) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidOriginAccessControl is the modeled exception returned for the
+// "InvalidOriginAccessControl" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidOriginAccessControl struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidOriginAccessControl(err awserr.RequestFailure) error {
+	return &InvalidOriginAccessControl{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidOriginAccessControl) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidOriginAccessControl) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidOriginAccessIdentity is the modeled exception returned for the
+// "InvalidOriginAccessIdentity" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidOriginAccessIdentity struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidOriginAccessIdentity(err awserr.RequestFailure) error {
+	return &InvalidOriginAccessIdentity{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidOriginAccessIdentity) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidOriginAccessIdentity) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidOriginKeepaliveTimeout is the modeled exception returned for the
+// "InvalidOriginKeepaliveTimeout" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidOriginKeepaliveTimeout struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidOriginKeepaliveTimeout(err awserr.RequestFailure) error {
+	return &InvalidOriginKeepaliveTimeout{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidOriginKeepaliveTimeout) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidOriginKeepaliveTimeout) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidOriginReadTimeout is the modeled exception returned for the
+// "InvalidOriginReadTimeout" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidOriginReadTimeout struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidOriginReadTimeout(err awserr.RequestFailure) error {
+	return &InvalidOriginReadTimeout{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidOriginReadTimeout) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidOriginReadTimeout) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidProtocolSettings is the modeled exception returned for the
+// "InvalidProtocolSettings" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidProtocolSettings struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidProtocolSettings(err awserr.RequestFailure) error {
+	return &InvalidProtocolSettings{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidProtocolSettings) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidProtocolSettings) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidQueryStringParameters is the modeled exception returned for the
+// "InvalidQueryStringParameters" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidQueryStringParameters struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidQueryStringParameters(err awserr.RequestFailure) error {
+	return &InvalidQueryStringParameters{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidQueryStringParameters) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidQueryStringParameters) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidRelativePath is the modeled exception returned for the
+// "InvalidRelativePath" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidRelativePath struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidRelativePath(err awserr.RequestFailure) error {
+	return &InvalidRelativePath{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidRelativePath) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidRelativePath) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidRequiredProtocol is the modeled exception returned for the
+// "InvalidRequiredProtocol" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidRequiredProtocol struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidRequiredProtocol(err awserr.RequestFailure) error {
+	return &InvalidRequiredProtocol{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidRequiredProtocol) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidRequiredProtocol) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidResponseCode is the modeled exception returned for the
+// "InvalidResponseCode" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidResponseCode struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidResponseCode(err awserr.RequestFailure) error {
+	return &InvalidResponseCode{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidResponseCode) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidResponseCode) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidTTLOrder is the modeled exception returned for the
+// "InvalidTTLOrder" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidTTLOrder struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidTTLOrder(err awserr.RequestFailure) error {
+	return &InvalidTTLOrder{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidTTLOrder) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidTTLOrder) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidTagging is the modeled exception returned for the
+// "InvalidTagging" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidTagging struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidTagging(err awserr.RequestFailure) error {
+	return &InvalidTagging{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidTagging) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidTagging) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidViewerCertificate is the modeled exception returned for the
+// "InvalidViewerCertificate" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidViewerCertificate struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidViewerCertificate(err awserr.RequestFailure) error {
+	return &InvalidViewerCertificate{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidViewerCertificate) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidViewerCertificate) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidWebACLId is the modeled exception returned for the
+// "InvalidWebACLId" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidWebACLId struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidWebACLId(err awserr.RequestFailure) error {
+	return &InvalidWebACLId{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidWebACLId) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidWebACLId) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// KeyGroupAlreadyExists is the modeled exception returned for the
+// "KeyGroupAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type KeyGroupAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorKeyGroupAlreadyExists(err awserr.RequestFailure) error {
+	return &KeyGroupAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *KeyGroupAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *KeyGroupAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// MissingBody is the modeled exception returned for the
+// "MissingBody" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type MissingBody struct {
+	awserr.RequestFailure
+}
+
+func newErrorMissingBody(err awserr.RequestFailure) error {
+	return &MissingBody{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *MissingBody) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *MissingBody) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// MonitoringSubscriptionAlreadyExists is the modeled exception returned for the
+// "MonitoringSubscriptionAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type MonitoringSubscriptionAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorMonitoringSubscriptionAlreadyExists(err awserr.RequestFailure) error {
+	return &MonitoringSubscriptionAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *MonitoringSubscriptionAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *MonitoringSubscriptionAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchCachePolicy is the modeled exception returned for the
+// "NoSuchCachePolicy" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchCachePolicy struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchCachePolicy(err awserr.RequestFailure) error {
+	return &NoSuchCachePolicy{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchCachePolicy) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchCachePolicy) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchCloudFrontOriginAccessIdentity is the modeled exception returned for the
+// "NoSuchCloudFrontOriginAccessIdentity" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchCloudFrontOriginAccessIdentity struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchCloudFrontOriginAccessIdentity(err awserr.RequestFailure) error {
+	return &NoSuchCloudFrontOriginAccessIdentity{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchCloudFrontOriginAccessIdentity) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchCloudFrontOriginAccessIdentity) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchContinuousDeploymentPolicy is the modeled exception returned for the
+// "NoSuchContinuousDeploymentPolicy" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchContinuousDeploymentPolicy struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchContinuousDeploymentPolicy(err awserr.RequestFailure) error {
+	return &NoSuchContinuousDeploymentPolicy{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchContinuousDeploymentPolicy) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchContinuousDeploymentPolicy) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchDistribution is the modeled exception returned for the
+// "NoSuchDistribution" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchDistribution struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchDistribution(err awserr.RequestFailure) error {
+	return &NoSuchDistribution{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchDistribution) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchDistribution) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchFieldLevelEncryptionConfig is the modeled exception returned for the
+// "NoSuchFieldLevelEncryptionConfig" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchFieldLevelEncryptionConfig struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchFieldLevelEncryptionConfig(err awserr.RequestFailure) error {
+	return &NoSuchFieldLevelEncryptionConfig{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchFieldLevelEncryptionConfig) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchFieldLevelEncryptionConfig) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchFieldLevelEncryptionProfile is the modeled exception returned for the
+// "NoSuchFieldLevelEncryptionProfile" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchFieldLevelEncryptionProfile struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchFieldLevelEncryptionProfile(err awserr.RequestFailure) error {
+	return &NoSuchFieldLevelEncryptionProfile{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchFieldLevelEncryptionProfile) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchFieldLevelEncryptionProfile) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchFunctionExists is the modeled exception returned for the
+// "NoSuchFunctionExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchFunctionExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchFunctionExists(err awserr.RequestFailure) error {
+	return &NoSuchFunctionExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchFunctionExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchFunctionExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchInvalidation is the modeled exception returned for the
+// "NoSuchInvalidation" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchInvalidation struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchInvalidation(err awserr.RequestFailure) error {
+	return &NoSuchInvalidation{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchInvalidation) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchInvalidation) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchMonitoringSubscription is the modeled exception returned for the
+// "NoSuchMonitoringSubscription" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchMonitoringSubscription struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchMonitoringSubscription(err awserr.RequestFailure) error {
+	return &NoSuchMonitoringSubscription{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchMonitoringSubscription) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchMonitoringSubscription) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchOrigin is the modeled exception returned for the
+// "NoSuchOrigin" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchOrigin struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchOrigin(err awserr.RequestFailure) error {
+	return &NoSuchOrigin{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchOrigin) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchOrigin) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchOriginAccessControl is the modeled exception returned for the
+// "NoSuchOriginAccessControl" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchOriginAccessControl struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchOriginAccessControl(err awserr.RequestFailure) error {
+	return &NoSuchOriginAccessControl{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchOriginAccessControl) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchOriginAccessControl) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchOriginRequestPolicy is the modeled exception returned for the
+// "NoSuchOriginRequestPolicy" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchOriginRequestPolicy struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchOriginRequestPolicy(err awserr.RequestFailure) error {
+	return &NoSuchOriginRequestPolicy{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchOriginRequestPolicy) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchOriginRequestPolicy) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchPublicKey is the modeled exception returned for the
+// "NoSuchPublicKey" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchPublicKey struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchPublicKey(err awserr.RequestFailure) error {
+	return &NoSuchPublicKey{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchPublicKey) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchPublicKey) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchRealtimeLogConfig is the modeled exception returned for the
+// "NoSuchRealtimeLogConfig" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchRealtimeLogConfig struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchRealtimeLogConfig(err awserr.RequestFailure) error {
+	return &NoSuchRealtimeLogConfig{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchRealtimeLogConfig) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchRealtimeLogConfig) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchResource is the modeled exception returned for the
+// "NoSuchResource" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchResource struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchResource(err awserr.RequestFailure) error {
+	return &NoSuchResource{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchResource) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchResource) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchResponseHeadersPolicy is the modeled exception returned for the
+// "NoSuchResponseHeadersPolicy" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchResponseHeadersPolicy struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchResponseHeadersPolicy(err awserr.RequestFailure) error {
+	return &NoSuchResponseHeadersPolicy{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchResponseHeadersPolicy) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchResponseHeadersPolicy) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchStreamingDistribution is the modeled exception returned for the
+// "NoSuchStreamingDistribution" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchStreamingDistribution struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchStreamingDistribution(err awserr.RequestFailure) error {
+	return &NoSuchStreamingDistribution{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchStreamingDistribution) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchStreamingDistribution) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// OriginAccessControlAlreadyExists is the modeled exception returned for the
+// "OriginAccessControlAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type OriginAccessControlAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorOriginAccessControlAlreadyExists(err awserr.RequestFailure) error {
+	return &OriginAccessControlAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *OriginAccessControlAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *OriginAccessControlAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// OriginAccessControlInUse is the modeled exception returned for the
+// "OriginAccessControlInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type OriginAccessControlInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorOriginAccessControlInUse(err awserr.RequestFailure) error {
+	return &OriginAccessControlInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *OriginAccessControlInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *OriginAccessControlInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// OriginAccessIdentityAlreadyExists is the modeled exception returned for the
+// "CloudFrontOriginAccessIdentityAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type OriginAccessIdentityAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorOriginAccessIdentityAlreadyExists(err awserr.RequestFailure) error {
+	return &OriginAccessIdentityAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *OriginAccessIdentityAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *OriginAccessIdentityAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// OriginAccessIdentityInUse is the modeled exception returned for the
+// "CloudFrontOriginAccessIdentityInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type OriginAccessIdentityInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorOriginAccessIdentityInUse(err awserr.RequestFailure) error {
+	return &OriginAccessIdentityInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *OriginAccessIdentityInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *OriginAccessIdentityInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// OriginRequestPolicyAlreadyExists is the modeled exception returned for the
+// "OriginRequestPolicyAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type OriginRequestPolicyAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorOriginRequestPolicyAlreadyExists(err awserr.RequestFailure) error {
+	return &OriginRequestPolicyAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *OriginRequestPolicyAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *OriginRequestPolicyAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// OriginRequestPolicyInUse is the modeled exception returned for the
+// "OriginRequestPolicyInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type OriginRequestPolicyInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorOriginRequestPolicyInUse(err awserr.RequestFailure) error {
+	return &OriginRequestPolicyInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *OriginRequestPolicyInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *OriginRequestPolicyInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// PreconditionFailed is the modeled exception returned for the
+// "PreconditionFailed" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type PreconditionFailed struct {
+	awserr.RequestFailure
+}
+
+func newErrorPreconditionFailed(err awserr.RequestFailure) error {
+	return &PreconditionFailed{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *PreconditionFailed) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *PreconditionFailed) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// PublicKeyAlreadyExists is the modeled exception returned for the
+// "PublicKeyAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type PublicKeyAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorPublicKeyAlreadyExists(err awserr.RequestFailure) error {
+	return &PublicKeyAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *PublicKeyAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *PublicKeyAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// PublicKeyInUse is the modeled exception returned for the
+// "PublicKeyInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type PublicKeyInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorPublicKeyInUse(err awserr.RequestFailure) error {
+	return &PublicKeyInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *PublicKeyInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *PublicKeyInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// QueryArgProfileEmpty is the modeled exception returned for the
+// "QueryArgProfileEmpty" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type QueryArgProfileEmpty struct {
+	awserr.RequestFailure
+}
+
+func newErrorQueryArgProfileEmpty(err awserr.RequestFailure) error {
+	return &QueryArgProfileEmpty{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *QueryArgProfileEmpty) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *QueryArgProfileEmpty) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// RealtimeLogConfigAlreadyExists is the modeled exception returned for the
+// "RealtimeLogConfigAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type RealtimeLogConfigAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorRealtimeLogConfigAlreadyExists(err awserr.RequestFailure) error {
+	return &RealtimeLogConfigAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *RealtimeLogConfigAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *RealtimeLogConfigAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// RealtimeLogConfigInUse is the modeled exception returned for the
+// "RealtimeLogConfigInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type RealtimeLogConfigInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorRealtimeLogConfigInUse(err awserr.RequestFailure) error {
+	return &RealtimeLogConfigInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *RealtimeLogConfigInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *RealtimeLogConfigInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// RealtimeLogConfigOwnerMismatch is the modeled exception returned for the
+// "RealtimeLogConfigOwnerMismatch" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type RealtimeLogConfigOwnerMismatch struct {
+	awserr.RequestFailure
+}
+
+func newErrorRealtimeLogConfigOwnerMismatch(err awserr.RequestFailure) error {
+	return &RealtimeLogConfigOwnerMismatch{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *RealtimeLogConfigOwnerMismatch) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *RealtimeLogConfigOwnerMismatch) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ResourceInUse is the modeled exception returned for the
+// "ResourceInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ResourceInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorResourceInUse(err awserr.RequestFailure) error {
+	return &ResourceInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ResourceInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ResourceInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ResponseHeadersPolicyAlreadyExists is the modeled exception returned for the
+// "ResponseHeadersPolicyAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ResponseHeadersPolicyAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorResponseHeadersPolicyAlreadyExists(err awserr.RequestFailure) error {
+	return &ResponseHeadersPolicyAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ResponseHeadersPolicyAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ResponseHeadersPolicyAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ResponseHeadersPolicyInUse is the modeled exception returned for the
+// "ResponseHeadersPolicyInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ResponseHeadersPolicyInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorResponseHeadersPolicyInUse(err awserr.RequestFailure) error {
+	return &ResponseHeadersPolicyInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ResponseHeadersPolicyInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ResponseHeadersPolicyInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// StagingDistributionInUse is the modeled exception returned for the
+// "StagingDistributionInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type StagingDistributionInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorStagingDistributionInUse(err awserr.RequestFailure) error {
+	return &StagingDistributionInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *StagingDistributionInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *StagingDistributionInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// StreamingDistributionAlreadyExists is the modeled exception returned for the
+// "StreamingDistributionAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type StreamingDistributionAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorStreamingDistributionAlreadyExists(err awserr.RequestFailure) error {
+	return &StreamingDistributionAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *StreamingDistributionAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *StreamingDistributionAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// StreamingDistributionNotDisabled is the modeled exception returned for the
+// "StreamingDistributionNotDisabled" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type StreamingDistributionNotDisabled struct {
+	awserr.RequestFailure
+}
+
+func newErrorStreamingDistributionNotDisabled(err awserr.RequestFailure) error {
+	return &StreamingDistributionNotDisabled{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *StreamingDistributionNotDisabled) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *StreamingDistributionNotDisabled) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TestFunctionFailed is the modeled exception returned for the
+// "TestFunctionFailed" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TestFunctionFailed struct {
+	awserr.RequestFailure
+}
+
+func newErrorTestFunctionFailed(err awserr.RequestFailure) error {
+	return &TestFunctionFailed{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TestFunctionFailed) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TestFunctionFailed) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooLongCSPInResponseHeadersPolicy is the modeled exception returned for the
+// "TooLongCSPInResponseHeadersPolicy" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooLongCSPInResponseHeadersPolicy struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooLongCSPInResponseHeadersPolicy(err awserr.RequestFailure) error {
+	return &TooLongCSPInResponseHeadersPolicy{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooLongCSPInResponseHeadersPolicy) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooLongCSPInResponseHeadersPolicy) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyCacheBehaviors is the modeled exception returned for the
+// "TooManyCacheBehaviors" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyCacheBehaviors struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyCacheBehaviors(err awserr.RequestFailure) error {
+	return &TooManyCacheBehaviors{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyCacheBehaviors) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyCacheBehaviors) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyCachePolicies is the modeled exception returned for the
+// "TooManyCachePolicies" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyCachePolicies struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyCachePolicies(err awserr.RequestFailure) error {
+	return &TooManyCachePolicies{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyCachePolicies) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyCachePolicies) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyCertificates is the modeled exception returned for the
+// "TooManyCertificates" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyCertificates struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyCertificates(err awserr.RequestFailure) error {
+	return &TooManyCertificates{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyCertificates) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyCertificates) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyCloudFrontOriginAccessIdentities is the modeled exception returned for the
+// "TooManyCloudFrontOriginAccessIdentities" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyCloudFrontOriginAccessIdentities struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyCloudFrontOriginAccessIdentities(err awserr.RequestFailure) error {
+	return &TooManyCloudFrontOriginAccessIdentities{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyCloudFrontOriginAccessIdentities) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyCloudFrontOriginAccessIdentities) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyContinuousDeploymentPolicies is the modeled exception returned for the
+// "TooManyContinuousDeploymentPolicies" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyContinuousDeploymentPolicies struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyContinuousDeploymentPolicies(err awserr.RequestFailure) error {
+	return &TooManyContinuousDeploymentPolicies{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyContinuousDeploymentPolicies) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyContinuousDeploymentPolicies) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyCookieNamesInWhiteList is the modeled exception returned for the
+// "TooManyCookieNamesInWhiteList" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyCookieNamesInWhiteList struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyCookieNamesInWhiteList(err awserr.RequestFailure) error {
+	return &TooManyCookieNamesInWhiteList{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyCookieNamesInWhiteList) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyCookieNamesInWhiteList) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyCookiesInCachePolicy is the modeled exception returned for the
+// "TooManyCookiesInCachePolicy" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyCookiesInCachePolicy struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyCookiesInCachePolicy(err awserr.RequestFailure) error {
+	return &TooManyCookiesInCachePolicy{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyCookiesInCachePolicy) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyCookiesInCachePolicy) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyCookiesInOriginRequestPolicy is the modeled exception returned for the
+// "TooManyCookiesInOriginRequestPolicy" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyCookiesInOriginRequestPolicy struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyCookiesInOriginRequestPolicy(err awserr.RequestFailure) error {
+	return &TooManyCookiesInOriginRequestPolicy{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyCookiesInOriginRequestPolicy) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyCookiesInOriginRequestPolicy) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyCustomHeadersInResponseHeadersPolicy is the modeled exception returned for the
+// "TooManyCustomHeadersInResponseHeadersPolicy" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyCustomHeadersInResponseHeadersPolicy struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyCustomHeadersInResponseHeadersPolicy(err awserr.RequestFailure) error {
+	return &TooManyCustomHeadersInResponseHeadersPolicy{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyCustomHeadersInResponseHeadersPolicy) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyCustomHeadersInResponseHeadersPolicy) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyDistributionCNAMEs is the modeled exception returned for the
+// "TooManyDistributionCNAMEs" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyDistributionCNAMEs struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyDistributionCNAMEs(err awserr.RequestFailure) error {
+	return &TooManyDistributionCNAMEs{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyDistributionCNAMEs) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyDistributionCNAMEs) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyDistributions is the modeled exception returned for the
+// "TooManyDistributions" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyDistributions struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyDistributions(err awserr.RequestFailure) error {
+	return &TooManyDistributions{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyDistributions) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyDistributions) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyDistributionsAssociatedToCachePolicy is the modeled exception returned for the
+// "TooManyDistributionsAssociatedToCachePolicy" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyDistributionsAssociatedToCachePolicy struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyDistributionsAssociatedToCachePolicy(err awserr.RequestFailure) error {
+	return &TooManyDistributionsAssociatedToCachePolicy{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyDistributionsAssociatedToCachePolicy) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyDistributionsAssociatedToCachePolicy) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyDistributionsAssociatedToFieldLevelEncryptionConfig is the modeled exception returned for the
+// "TooManyDistributionsAssociatedToFieldLevelEncryptionConfig" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyDistributionsAssociatedToFieldLevelEncryptionConfig struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyDistributionsAssociatedToFieldLevelEncryptionConfig(err awserr.RequestFailure) error {
+	return &TooManyDistributionsAssociatedToFieldLevelEncryptionConfig{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyDistributionsAssociatedToFieldLevelEncryptionConfig) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyDistributionsAssociatedToFieldLevelEncryptionConfig) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyDistributionsAssociatedToKeyGroup is the modeled exception returned for the
+// "TooManyDistributionsAssociatedToKeyGroup" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyDistributionsAssociatedToKeyGroup struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyDistributionsAssociatedToKeyGroup(err awserr.RequestFailure) error {
+	return &TooManyDistributionsAssociatedToKeyGroup{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyDistributionsAssociatedToKeyGroup) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyDistributionsAssociatedToKeyGroup) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyDistributionsAssociatedToOriginAccessControl is the modeled exception returned for the
+// "TooManyDistributionsAssociatedToOriginAccessControl" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyDistributionsAssociatedToOriginAccessControl struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyDistributionsAssociatedToOriginAccessControl(err awserr.RequestFailure) error {
+	return &TooManyDistributionsAssociatedToOriginAccessControl{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyDistributionsAssociatedToOriginAccessControl) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyDistributionsAssociatedToOriginAccessControl) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyDistributionsAssociatedToOriginRequestPolicy is the modeled exception returned for the
+// "TooManyDistributionsAssociatedToOriginRequestPolicy" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyDistributionsAssociatedToOriginRequestPolicy struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyDistributionsAssociatedToOriginRequestPolicy(err awserr.RequestFailure) error {
+	return &TooManyDistributionsAssociatedToOriginRequestPolicy{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyDistributionsAssociatedToOriginRequestPolicy) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyDistributionsAssociatedToOriginRequestPolicy) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyDistributionsAssociatedToResponseHeadersPolicy is the modeled exception returned for the
+// "TooManyDistributionsAssociatedToResponseHeadersPolicy" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyDistributionsAssociatedToResponseHeadersPolicy struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyDistributionsAssociatedToResponseHeadersPolicy(err awserr.RequestFailure) error {
+	return &TooManyDistributionsAssociatedToResponseHeadersPolicy{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyDistributionsAssociatedToResponseHeadersPolicy) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyDistributionsAssociatedToResponseHeadersPolicy) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyDistributionsWithFunctionAssociations is the modeled exception returned for the
+// "TooManyDistributionsWithFunctionAssociations" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyDistributionsWithFunctionAssociations struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyDistributionsWithFunctionAssociations(err awserr.RequestFailure) error {
+	return &TooManyDistributionsWithFunctionAssociations{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyDistributionsWithFunctionAssociations) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyDistributionsWithFunctionAssociations) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyDistributionsWithLambdaAssociations is the modeled exception returned for the
+// "TooManyDistributionsWithLambdaAssociations" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyDistributionsWithLambdaAssociations struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyDistributionsWithLambdaAssociations(err awserr.RequestFailure) error {
+	return &TooManyDistributionsWithLambdaAssociations{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyDistributionsWithLambdaAssociations) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyDistributionsWithLambdaAssociations) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyDistributionsWithSingleFunctionARN is the modeled exception returned for the
+// "TooManyDistributionsWithSingleFunctionARN" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyDistributionsWithSingleFunctionARN struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyDistributionsWithSingleFunctionARN(err awserr.RequestFailure) error {
+	return &TooManyDistributionsWithSingleFunctionARN{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyDistributionsWithSingleFunctionARN) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyDistributionsWithSingleFunctionARN) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyFieldLevelEncryptionConfigs is the modeled exception returned for the
+// "TooManyFieldLevelEncryptionConfigs" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyFieldLevelEncryptionConfigs struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyFieldLevelEncryptionConfigs(err awserr.RequestFailure) error {
+	return &TooManyFieldLevelEncryptionConfigs{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyFieldLevelEncryptionConfigs) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyFieldLevelEncryptionConfigs) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyFieldLevelEncryptionContentTypeProfiles is the modeled exception returned for the
+// "TooManyFieldLevelEncryptionContentTypeProfiles" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyFieldLevelEncryptionContentTypeProfiles struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyFieldLevelEncryptionContentTypeProfiles(err awserr.RequestFailure) error {
+	return &TooManyFieldLevelEncryptionContentTypeProfiles{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyFieldLevelEncryptionContentTypeProfiles) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyFieldLevelEncryptionContentTypeProfiles) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyFieldLevelEncryptionEncryptionEntities is the modeled exception returned for the
+// "TooManyFieldLevelEncryptionEncryptionEntities" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyFieldLevelEncryptionEncryptionEntities struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyFieldLevelEncryptionEncryptionEntities(err awserr.RequestFailure) error {
+	return &TooManyFieldLevelEncryptionEncryptionEntities{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyFieldLevelEncryptionEncryptionEntities) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyFieldLevelEncryptionEncryptionEntities) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyFieldLevelEncryptionFieldPatterns is the modeled exception returned for the
+// "TooManyFieldLevelEncryptionFieldPatterns" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyFieldLevelEncryptionFieldPatterns struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyFieldLevelEncryptionFieldPatterns(err awserr.RequestFailure) error {
+	return &TooManyFieldLevelEncryptionFieldPatterns{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyFieldLevelEncryptionFieldPatterns) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyFieldLevelEncryptionFieldPatterns) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyFieldLevelEncryptionProfiles is the modeled exception returned for the
+// "TooManyFieldLevelEncryptionProfiles" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyFieldLevelEncryptionProfiles struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyFieldLevelEncryptionProfiles(err awserr.RequestFailure) error {
+	return &TooManyFieldLevelEncryptionProfiles{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyFieldLevelEncryptionProfiles) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyFieldLevelEncryptionProfiles) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyFieldLevelEncryptionQueryArgProfiles is the modeled exception returned for the
+// "TooManyFieldLevelEncryptionQueryArgProfiles" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyFieldLevelEncryptionQueryArgProfiles struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyFieldLevelEncryptionQueryArgProfiles(err awserr.RequestFailure) error {
+	return &TooManyFieldLevelEncryptionQueryArgProfiles{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyFieldLevelEncryptionQueryArgProfiles) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyFieldLevelEncryptionQueryArgProfiles) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyFunctionAssociations is the modeled exception returned for the
+// "TooManyFunctionAssociations" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyFunctionAssociations struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyFunctionAssociations(err awserr.RequestFailure) error {
+	return &TooManyFunctionAssociations{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyFunctionAssociations) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyFunctionAssociations) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyFunctions is the modeled exception returned for the
+// "TooManyFunctions" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyFunctions struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyFunctions(err awserr.RequestFailure) error {
+	return &TooManyFunctions{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyFunctions) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyFunctions) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyHeadersInCachePolicy is the modeled exception returned for the
+// "TooManyHeadersInCachePolicy" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyHeadersInCachePolicy struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyHeadersInCachePolicy(err awserr.RequestFailure) error {
+	return &TooManyHeadersInCachePolicy{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyHeadersInCachePolicy) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyHeadersInCachePolicy) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyHeadersInForwardedValues is the modeled exception returned for the
+// "TooManyHeadersInForwardedValues" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyHeadersInForwardedValues struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyHeadersInForwardedValues(err awserr.RequestFailure) error {
+	return &TooManyHeadersInForwardedValues{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyHeadersInForwardedValues) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyHeadersInForwardedValues) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyHeadersInOriginRequestPolicy is the modeled exception returned for the
+// "TooManyHeadersInOriginRequestPolicy" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyHeadersInOriginRequestPolicy struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyHeadersInOriginRequestPolicy(err awserr.RequestFailure) error {
+	return &TooManyHeadersInOriginRequestPolicy{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyHeadersInOriginRequestPolicy) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyHeadersInOriginRequestPolicy) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyInvalidationsInProgress is the modeled exception returned for the
+// "TooManyInvalidationsInProgress" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyInvalidationsInProgress struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyInvalidationsInProgress(err awserr.RequestFailure) error {
+	return &TooManyInvalidationsInProgress{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyInvalidationsInProgress) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyInvalidationsInProgress) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyKeyGroups is the modeled exception returned for the
+// "TooManyKeyGroups" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyKeyGroups struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyKeyGroups(err awserr.RequestFailure) error {
+	return &TooManyKeyGroups{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyKeyGroups) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyKeyGroups) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyKeyGroupsAssociatedToDistribution is the modeled exception returned for the
+// "TooManyKeyGroupsAssociatedToDistribution" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyKeyGroupsAssociatedToDistribution struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyKeyGroupsAssociatedToDistribution(err awserr.RequestFailure) error {
+	return &TooManyKeyGroupsAssociatedToDistribution{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyKeyGroupsAssociatedToDistribution) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyKeyGroupsAssociatedToDistribution) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyLambdaFunctionAssociations is the modeled exception returned for the
+// "TooManyLambdaFunctionAssociations" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyLambdaFunctionAssociations struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyLambdaFunctionAssociations(err awserr.RequestFailure) error {
+	return &TooManyLambdaFunctionAssociations{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyLambdaFunctionAssociations) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyLambdaFunctionAssociations) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyOriginAccessControls is the modeled exception returned for the
+// "TooManyOriginAccessControls" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyOriginAccessControls struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyOriginAccessControls(err awserr.RequestFailure) error {
+	return &TooManyOriginAccessControls{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyOriginAccessControls) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyOriginAccessControls) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyOriginCustomHeaders is the modeled exception returned for the
+// "TooManyOriginCustomHeaders" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyOriginCustomHeaders struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyOriginCustomHeaders(err awserr.RequestFailure) error {
+	return &TooManyOriginCustomHeaders{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyOriginCustomHeaders) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyOriginCustomHeaders) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyOriginGroupsPerDistribution is the modeled exception returned for the
+// "TooManyOriginGroupsPerDistribution" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyOriginGroupsPerDistribution struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyOriginGroupsPerDistribution(err awserr.RequestFailure) error {
+	return &TooManyOriginGroupsPerDistribution{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyOriginGroupsPerDistribution) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyOriginGroupsPerDistribution) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyOriginRequestPolicies is the modeled exception returned for the
+// "TooManyOriginRequestPolicies" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyOriginRequestPolicies struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyOriginRequestPolicies(err awserr.RequestFailure) error {
+	return &TooManyOriginRequestPolicies{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyOriginRequestPolicies) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyOriginRequestPolicies) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyOrigins is the modeled exception returned for the
+// "TooManyOrigins" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyOrigins struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyOrigins(err awserr.RequestFailure) error {
+	return &TooManyOrigins{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyOrigins) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyOrigins) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyPublicKeys is the modeled exception returned for the
+// "TooManyPublicKeys" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyPublicKeys struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyPublicKeys(err awserr.RequestFailure) error {
+	return &TooManyPublicKeys{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyPublicKeys) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyPublicKeys) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyPublicKeysInKeyGroup is the modeled exception returned for the
+// "TooManyPublicKeysInKeyGroup" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyPublicKeysInKeyGroup struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyPublicKeysInKeyGroup(err awserr.RequestFailure) error {
+	return &TooManyPublicKeysInKeyGroup{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyPublicKeysInKeyGroup) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyPublicKeysInKeyGroup) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyQueryStringParameters is the modeled exception returned for the
+// "TooManyQueryStringParameters" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyQueryStringParameters struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyQueryStringParameters(err awserr.RequestFailure) error {
+	return &TooManyQueryStringParameters{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyQueryStringParameters) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyQueryStringParameters) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyQueryStringsInCachePolicy is the modeled exception returned for the
+// "TooManyQueryStringsInCachePolicy" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyQueryStringsInCachePolicy struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyQueryStringsInCachePolicy(err awserr.RequestFailure) error {
+	return &TooManyQueryStringsInCachePolicy{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyQueryStringsInCachePolicy) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyQueryStringsInCachePolicy) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyQueryStringsInOriginRequestPolicy is the modeled exception returned for the
+// "TooManyQueryStringsInOriginRequestPolicy" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyQueryStringsInOriginRequestPolicy struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyQueryStringsInOriginRequestPolicy(err awserr.RequestFailure) error {
+	return &TooManyQueryStringsInOriginRequestPolicy{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyQueryStringsInOriginRequestPolicy) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyQueryStringsInOriginRequestPolicy) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyRealtimeLogConfigs is the modeled exception returned for the
+// "TooManyRealtimeLogConfigs" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyRealtimeLogConfigs struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyRealtimeLogConfigs(err awserr.RequestFailure) error {
+	return &TooManyRealtimeLogConfigs{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyRealtimeLogConfigs) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyRealtimeLogConfigs) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyRemoveHeadersInResponseHeadersPolicy is the modeled exception returned for the
+// "TooManyRemoveHeadersInResponseHeadersPolicy" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyRemoveHeadersInResponseHeadersPolicy struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyRemoveHeadersInResponseHeadersPolicy(err awserr.RequestFailure) error {
+	return &TooManyRemoveHeadersInResponseHeadersPolicy{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyRemoveHeadersInResponseHeadersPolicy) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyRemoveHeadersInResponseHeadersPolicy) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyResponseHeadersPolicies is the modeled exception returned for the
+// "TooManyResponseHeadersPolicies" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyResponseHeadersPolicies struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyResponseHeadersPolicies(err awserr.RequestFailure) error {
+	return &TooManyResponseHeadersPolicies{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyResponseHeadersPolicies) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyResponseHeadersPolicies) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyStreamingDistributionCNAMEs is the modeled exception returned for the
+// "TooManyStreamingDistributionCNAMEs" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyStreamingDistributionCNAMEs struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyStreamingDistributionCNAMEs(err awserr.RequestFailure) error {
+	return &TooManyStreamingDistributionCNAMEs{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyStreamingDistributionCNAMEs) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyStreamingDistributionCNAMEs) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyStreamingDistributions is the modeled exception returned for the
+// "TooManyStreamingDistributions" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyStreamingDistributions struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyStreamingDistributions(err awserr.RequestFailure) error {
+	return &TooManyStreamingDistributions{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyStreamingDistributions) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyStreamingDistributions) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyTrustedSigners is the modeled exception returned for the
+// "TooManyTrustedSigners" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyTrustedSigners struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyTrustedSigners(err awserr.RequestFailure) error {
+	return &TooManyTrustedSigners{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyTrustedSigners) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyTrustedSigners) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TrustedKeyGroupDoesNotExist is the modeled exception returned for the
+// "TrustedKeyGroupDoesNotExist" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TrustedKeyGroupDoesNotExist struct {
+	awserr.RequestFailure
+}
+
+func newErrorTrustedKeyGroupDoesNotExist(err awserr.RequestFailure) error {
+	return &TrustedKeyGroupDoesNotExist{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TrustedKeyGroupDoesNotExist) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TrustedKeyGroupDoesNotExist) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TrustedSignerDoesNotExist is the modeled exception returned for the
+// "TrustedSignerDoesNotExist" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TrustedSignerDoesNotExist struct {
+	awserr.RequestFailure
+}
+
+func newErrorTrustedSignerDoesNotExist(err awserr.RequestFailure) error {
+	return &TrustedSignerDoesNotExist{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TrustedSignerDoesNotExist) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TrustedSignerDoesNotExist) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// UnsupportedOperation is the modeled exception returned for the
+// "UnsupportedOperation" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type UnsupportedOperation struct {
+	awserr.RequestFailure
+}
+
+func newErrorUnsupportedOperation(err awserr.RequestFailure) error {
+	return &UnsupportedOperation{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *UnsupportedOperation) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *UnsupportedOperation) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+var exceptionFromCode = map[string]func(awserr.RequestFailure) error{
+	"AccessDenied":                                                  newErrorAccessDenied,
+	"BatchTooLarge":                                                 newErrorBatchTooLarge,
+	"CNAMEAlreadyExists":                                            newErrorCNAMEAlreadyExists,
+	"CachePolicyAlreadyExists":                                      newErrorCachePolicyAlreadyExists,
+	"CachePolicyInUse":                                              newErrorCachePolicyInUse,
+	"CannotChangeImmutablePublicKeyFields":                          newErrorCannotChangeImmutablePublicKeyFields,
+	"CannotDeleteEntityWhileInUse":                                  newErrorCannotDeleteEntityWhileInUse,
+	"ContinuousDeploymentPolicyAlreadyExists":                       newErrorContinuousDeploymentPolicyAlreadyExists,
+	"ContinuousDeploymentPolicyInUse":                               newErrorContinuousDeploymentPolicyInUse,
+	"DistributionAlreadyExists":                                     newErrorDistributionAlreadyExists,
+	"DistributionNotDisabled":                                       newErrorDistributionNotDisabled,
+	"EntityAlreadyExists":                                           newErrorEntityAlreadyExists,
+	"EntityLimitExceeded":                                           newErrorEntityLimitExceeded,
+	"EntityNotFound":                                                newErrorEntityNotFound,
+	"EntitySizeLimitExceeded":                                       newErrorEntitySizeLimitExceeded,
+	"FieldLevelEncryptionConfigAlreadyExists":                       newErrorFieldLevelEncryptionConfigAlreadyExists,
+	"FieldLevelEncryptionConfigInUse":                               newErrorFieldLevelEncryptionConfigInUse,
+	"FieldLevelEncryptionProfileAlreadyExists":                      newErrorFieldLevelEncryptionProfileAlreadyExists,
+	"FieldLevelEncryptionProfileInUse":                              newErrorFieldLevelEncryptionProfileInUse,
+	"FieldLevelEncryptionProfileSizeExceeded":                       newErrorFieldLevelEncryptionProfileSizeExceeded,
+	"FunctionAlreadyExists":                                         newErrorFunctionAlreadyExists,
+	"FunctionInUse":                                                 newErrorFunctionInUse,
+	"FunctionSizeLimitExceeded":                                     newErrorFunctionSizeLimitExceeded,
+	"IllegalDelete":                                                 newErrorIllegalDelete,
+	"IllegalFieldLevelEncryptionConfigAssociationWithCacheBehavior": newErrorIllegalFieldLevelEncryptionConfigAssociationWithCacheBehavior,
+	"IllegalOriginAccessConfiguration":                              newErrorIllegalOriginAccessConfiguration,
+	"IllegalUpdate":                                                 newErrorIllegalUpdate,
+	"InconsistentQuantities":                                        newErrorInconsistentQuantities,
+	"InvalidArgument":                                               newErrorInvalidArgument,
+	"InvalidDefaultRootObject":                                      newErrorInvalidDefaultRootObject,
+	"InvalidDomainNameForOriginAccessControl":                       newErrorInvalidDomainNameForOriginAccessControl,
+	"InvalidErrorCode":                                              newErrorInvalidErrorCode,
+	"InvalidForwardCookies":                                         newErrorInvalidForwardCookies,
+	"InvalidFunctionAssociation":                                    newErrorInvalidFunctionAssociation,
+	"InvalidGeoRestrictionParameter":                                newErrorInvalidGeoRestrictionParameter,
+	"InvalidHeadersForS3Origin":                                     newErrorInvalidHeadersForS3Origin,
+	"InvalidIfMatchVersion":                                         newErrorInvalidIfMatchVersion,
+	"InvalidLambdaFunctionAssociation":                              newErrorInvalidLambdaFunctionAssociation,
+	"InvalidLocationCode":                                           newErrorInvalidLocationCode,
+	"InvalidMinimumProtocolVersion":                                 newErrorInvalidMinimumProtocolVersion,
+	"InvalidOrigin":                                                 newErrorInvalidOrigin,
+	"InvalidOriginAccessControl":                                    newErrorInvalidOriginAccessControl,
+	"InvalidOriginAccessIdentity":                                   newErrorInvalidOriginAccessIdentity,
+	"InvalidOriginKeepaliveTimeout":                                 newErrorInvalidOriginKeepaliveTimeout,
+	"InvalidOriginReadTimeout":                                      newErrorInvalidOriginReadTimeout,
+	"InvalidProtocolSettings":                                       newErrorInvalidProtocolSettings,
+	"InvalidQueryStringParameters":                                  newErrorInvalidQueryStringParameters,
+	"InvalidRelativePath":                                           newErrorInvalidRelativePath,
+	"InvalidRequiredProtocol":                                       newErrorInvalidRequiredProtocol,
+	"InvalidResponseCode":                                           newErrorInvalidResponseCode,
+	"InvalidTTLOrder":                                               newErrorInvalidTTLOrder,
+	"InvalidTagging":                                                newErrorInvalidTagging,
+	"InvalidViewerCertificate":                                      newErrorInvalidViewerCertificate,
+	"InvalidWebACLId":                                               newErrorInvalidWebACLId,
+	"KeyGroupAlreadyExists":                                         newErrorKeyGroupAlreadyExists,
+	"MissingBody":                                                   newErrorMissingBody,
+	"MonitoringSubscriptionAlreadyExists":                           newErrorMonitoringSubscriptionAlreadyExists,
+	"NoSuchCachePolicy":                                             newErrorNoSuchCachePolicy,
+	"NoSuchCloudFrontOriginAccessIdentity":                          newErrorNoSuchCloudFrontOriginAccessIdentity,
+	"NoSuchContinuousDeploymentPolicy":                              newErrorNoSuchContinuousDeploymentPolicy,
+	"NoSuchDistribution":                                            newErrorNoSuchDistribution,
+	"NoSuchFieldLevelEncryptionConfig":                              newErrorNoSuchFieldLevelEncryptionConfig,
+	"NoSuchFieldLevelEncryptionProfile":                             newErrorNoSuchFieldLevelEncryptionProfile,
+	"NoSuchFunctionExists":                                          newErrorNoSuchFunctionExists,
+	"NoSuchInvalidation":                                            newErrorNoSuchInvalidation,
+	"NoSuchMonitoringSubscription":                                  newErrorNoSuchMonitoringSubscription,
+	"NoSuchOrigin":                                                  newErrorNoSuchOrigin,
+	"NoSuchOriginAccessControl":                                     newErrorNoSuchOriginAccessControl,
+	"NoSuchOriginRequestPolicy":                                     newErrorNoSuchOriginRequestPolicy,
+	"NoSuchPublicKey":                                               newErrorNoSuchPublicKey,
+	"NoSuchRealtimeLogConfig":                                       newErrorNoSuchRealtimeLogConfig,
+	"NoSuchResource":                                                newErrorNoSuchResource,
+	"NoSuchResponseHeadersPolicy":                                   newErrorNoSuchResponseHeadersPolicy,
+	"NoSuchStreamingDistribution":                                   newErrorNoSuchStreamingDistribution,
+	"OriginAccessControlAlreadyExists":                              newErrorOriginAccessControlAlreadyExists,
+	"OriginAccessControlInUse":                                      newErrorOriginAccessControlInUse,
+	"CloudFrontOriginAccessIdentityAlreadyExists":                   newErrorOriginAccessIdentityAlreadyExists,
+	"CloudFrontOriginAccessIdentityInUse":                           newErrorOriginAccessIdentityInUse,
+	"OriginRequestPolicyAlreadyExists":                              newErrorOriginRequestPolicyAlreadyExists,
+	"OriginRequestPolicyInUse":                                      newErrorOriginRequestPolicyInUse,
+	"PreconditionFailed":                                            newErrorPreconditionFailed,
+	"PublicKeyAlreadyExists":                                        newErrorPublicKeyAlreadyExists,
+	"PublicKeyInUse":                                                newErrorPublicKeyInUse,
+	"QueryArgProfileEmpty":                                          newErrorQueryArgProfileEmpty,
+	"RealtimeLogConfigAlreadyExists":                                newErrorRealtimeLogConfigAlreadyExists,
+	"RealtimeLogConfigInUse":                                        newErrorRealtimeLogConfigInUse,
+	"RealtimeLogConfigOwnerMismatch":                                newErrorRealtimeLogConfigOwnerMismatch,
+	"ResourceInUse":                                                 newErrorResourceInUse,
+	"ResponseHeadersPolicyAlreadyExists":                            newErrorResponseHeadersPolicyAlreadyExists,
+	"ResponseHeadersPolicyInUse":                                    newErrorResponseHeadersPolicyInUse,
+	"StagingDistributionInUse":                                      newErrorStagingDistributionInUse,
+	"StreamingDistributionAlreadyExists":                            newErrorStreamingDistributionAlreadyExists,
+	"StreamingDistributionNotDisabled":                              newErrorStreamingDistributionNotDisabled,
+	"TestFunctionFailed":                                            newErrorTestFunctionFailed,
+	"TooLongCSPInResponseHeadersPolicy":                             newErrorTooLongCSPInResponseHeadersPolicy,
+	"TooManyCacheBehaviors":                                         newErrorTooManyCacheBehaviors,
+	"TooManyCachePolicies":                                          newErrorTooManyCachePolicies,
+	"TooManyCertificates":                                           newErrorTooManyCertificates,
+	"TooManyCloudFrontOriginAccessIdentities":                       newErrorTooManyCloudFrontOriginAccessIdentities,
+	"TooManyContinuousDeploymentPolicies":                           newErrorTooManyContinuousDeploymentPolicies,
+	"TooManyCookieNamesInWhiteList":                                 newErrorTooManyCookieNamesInWhiteList,
+	"TooManyCookiesInCachePolicy":                                   newErrorTooManyCookiesInCachePolicy,
+	"TooManyCookiesInOriginRequestPolicy":                           newErrorTooManyCookiesInOriginRequestPolicy,
+	"TooManyCustomHeadersInResponseHeadersPolicy":                   newErrorTooManyCustomHeadersInResponseHeadersPolicy,
+	"TooManyDistributionCNAMEs":                                     newErrorTooManyDistributionCNAMEs,
+	"TooManyDistributions":                                          newErrorTooManyDistributions,
+	"TooManyDistributionsAssociatedToCachePolicy":                   newErrorTooManyDistributionsAssociatedToCachePolicy,
+	"TooManyDistributionsAssociatedToFieldLevelEncryptionConfig":    newErrorTooManyDistributionsAssociatedToFieldLevelEncryptionConfig,
+	"TooManyDistributionsAssociatedToKeyGroup":                      newErrorTooManyDistributionsAssociatedToKeyGroup,
+	"TooManyDistributionsAssociatedToOriginAccessControl":           newErrorTooManyDistributionsAssociatedToOriginAccessControl,
+	"TooManyDistributionsAssociatedToOriginRequestPolicy":           newErrorTooManyDistributionsAssociatedToOriginRequestPolicy,
+	"TooManyDistributionsAssociatedToResponseHeadersPolicy":         newErrorTooManyDistributionsAssociatedToResponseHeadersPolicy,
+	"TooManyDistributionsWithFunctionAssociations":                  newErrorTooManyDistributionsWithFunctionAssociations,
+	"TooManyDistributionsWithLambdaAssociations":                    newErrorTooManyDistributionsWithLambdaAssociations,
+	"TooManyDistributionsWithSingleFunctionARN":                     newErrorTooManyDistributionsWithSingleFunctionARN,
+	"TooManyFieldLevelEncryptionConfigs":                            newErrorTooManyFieldLevelEncryptionConfigs,
+	"TooManyFieldLevelEncryptionContentTypeProfiles":                newErrorTooManyFieldLevelEncryptionContentTypeProfiles,
+	"TooManyFieldLevelEncryptionEncryptionEntities":                 newErrorTooManyFieldLevelEncryptionEncryptionEntities,
+	"TooManyFieldLevelEncryptionFieldPatterns":                      newErrorTooManyFieldLevelEncryptionFieldPatterns,
+	"TooManyFieldLevelEncryptionProfiles":                           newErrorTooManyFieldLevelEncryptionProfiles,
+	"TooManyFieldLevelEncryptionQueryArgProfiles":                   newErrorTooManyFieldLevelEncryptionQueryArgProfiles,
+	"TooManyFunctionAssociations":                                   newErrorTooManyFunctionAssociations,
+	"TooManyFunctions":                                              newErrorTooManyFunctions,
+	"TooManyHeadersInCachePolicy":                                   newErrorTooManyHeadersInCachePolicy,
+	"TooManyHeadersInForwardedValues":                               newErrorTooManyHeadersInForwardedValues,
+	"TooManyHeadersInOriginRequestPolicy":                           newErrorTooManyHeadersInOriginRequestPolicy,
+	"TooManyInvalidationsInProgress":                                newErrorTooManyInvalidationsInProgress,
+	"TooManyKeyGroups":                                              newErrorTooManyKeyGroups,
+	"TooManyKeyGroupsAssociatedToDistribution":                      newErrorTooManyKeyGroupsAssociatedToDistribution,
+	"TooManyLambdaFunctionAssociations":                             newErrorTooManyLambdaFunctionAssociations,
+	"TooManyOriginAccessControls":                                   newErrorTooManyOriginAccessControls,
+	"TooManyOriginCustomHeaders":                                    newErrorTooManyOriginCustomHeaders,
+	"TooManyOriginGroupsPerDistribution":                            newErrorTooManyOriginGroupsPerDistribution,
+	"TooManyOriginRequestPolicies":                                  newErrorTooManyOriginRequestPolicies,
+	"TooManyOrigins":                                                newErrorTooManyOrigins,
+	"TooManyPublicKeys":                                             newErrorTooManyPublicKeys,
+	"TooManyPublicKeysInKeyGroup":                                   newErrorTooManyPublicKeysInKeyGroup,
+	"TooManyQueryStringParameters":                                  newErrorTooManyQueryStringParameters,
+	"TooManyQueryStringsInCachePolicy":                              newErrorTooManyQueryStringsInCachePolicy,
+	"TooManyQueryStringsInOriginRequestPolicy":                      newErrorTooManyQueryStringsInOriginRequestPolicy,
+	"TooManyRealtimeLogConfigs":                                     newErrorTooManyRealtimeLogConfigs,
+	"TooManyRemoveHeadersInResponseHeadersPolicy":                   newErrorTooManyRemoveHeadersInResponseHeadersPolicy,
+	"TooManyResponseHeadersPolicies":                                newErrorTooManyResponseHeadersPolicies,
+	"TooManyStreamingDistributionCNAMEs":                            newErrorTooManyStreamingDistributionCNAMEs,
+	"TooManyStreamingDistributions":                                 newErrorTooManyStreamingDistributions,
+	"TooManyTrustedSigners":                                         newErrorTooManyTrustedSigners,
+	"TrustedKeyGroupDoesNotExist":                                   newErrorTrustedKeyGroupDoesNotExist,
+	"TrustedSignerDoesNotExist":                                     newErrorTrustedSignerDoesNotExist,
+	"UnsupportedOperation":                                          newErrorUnsupportedOperation,
+}
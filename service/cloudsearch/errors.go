@@ -2,6 +2,10 @@
 
 package cloudsearch
 
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
 const (
 
 	// ErrCodeBaseException for service response error code
@@ -55,3 +59,222 @@ const (
 	// The request was rejected because it has invalid parameters.
 	ErrCodeValidationException = "ValidationException"
 )
+
+// BaseException is the modeled exception returned for the
+// "BaseException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type BaseException struct {
+	awserr.RequestFailure
+}
+
+func newErrorBaseException(err awserr.RequestFailure) error {
+	return &BaseException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *BaseException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *BaseException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DisabledOperationException is the modeled exception returned for the
+// "DisabledAction" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DisabledOperationException struct {
+	awserr.RequestFailure
+}
+
+func newErrorDisabledOperationException(err awserr.RequestFailure) error {
+	return &DisabledOperationException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DisabledOperationException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DisabledOperationException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InternalException is the modeled exception returned for the
+// "InternalException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InternalException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInternalException(err awserr.RequestFailure) error {
+	return &InternalException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InternalException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InternalException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidTypeException is the modeled exception returned for the
+// "InvalidType" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidTypeException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidTypeException(err awserr.RequestFailure) error {
+	return &InvalidTypeException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidTypeException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidTypeException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// LimitExceededException is the modeled exception returned for the
+// "LimitExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type LimitExceededException struct {
+	awserr.RequestFailure
+}
+
+func newErrorLimitExceededException(err awserr.RequestFailure) error {
+	return &LimitExceededException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *LimitExceededException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *LimitExceededException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ResourceAlreadyExistsException is the modeled exception returned for the
+// "ResourceAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ResourceAlreadyExistsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorResourceAlreadyExistsException(err awserr.RequestFailure) error {
+	return &ResourceAlreadyExistsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ResourceAlreadyExistsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ResourceAlreadyExistsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ResourceNotFoundException is the modeled exception returned for the
+// "ResourceNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ResourceNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorResourceNotFoundException(err awserr.RequestFailure) error {
+	return &ResourceNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ResourceNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ResourceNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ValidationException is the modeled exception returned for the
+// "ValidationException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ValidationException struct {
+	awserr.RequestFailure
+}
+
+func newErrorValidationException(err awserr.RequestFailure) error {
+	return &ValidationException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ValidationException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ValidationException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+var exceptionFromCode = map[string]func(awserr.RequestFailure) error{
+	"BaseException":         newErrorBaseException,
+	"DisabledAction":        newErrorDisabledOperationException,
+	"InternalException":     newErrorInternalException,
+	"InvalidType":           newErrorInvalidTypeException,
+	"LimitExceeded":         newErrorLimitExceededException,
+	"ResourceAlreadyExists": newErrorResourceAlreadyExistsException,
+	"ResourceNotFound":      newErrorResourceNotFoundException,
+	"ValidationException":   newErrorValidationException,
+}
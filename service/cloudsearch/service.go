@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/client/metadata"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/private/protocol"
 	"github.com/aws/aws-sdk-go/private/protocol/query"
 )
 
@@ -81,6 +82,7 @@ func newClient(cfg aws.Config, handlers request.Handlers, partitionID, endpoint,
 	svc.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
 	svc.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
 	svc.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
+	svc.Handlers.UnmarshalError.PushBackNamed(protocol.NewTypedRequestFailureHandler(exceptionFromCode))
 
 	// Run custom client initialization if present
 	if initClient != nil {
@@ -2,6 +2,10 @@
 
 package cloudwatch
 
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
 const (
 
 	// ErrCodeConcurrentModificationException for service response error code
@@ -82,3 +86,330 @@ const (
 	// The named resource does not exist.
 	ErrCodeResourceNotFoundException = "ResourceNotFoundException"
 )
+
+// ConcurrentModificationException is the modeled exception returned for the
+// "ConcurrentModificationException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ConcurrentModificationException struct {
+	awserr.RequestFailure
+}
+
+func newErrorConcurrentModificationException(err awserr.RequestFailure) error {
+	return &ConcurrentModificationException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ConcurrentModificationException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ConcurrentModificationException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DashboardInvalidInputError is the modeled exception returned for the
+// "InvalidParameterInput" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DashboardInvalidInputError struct {
+	awserr.RequestFailure
+}
+
+func newErrorDashboardInvalidInputError(err awserr.RequestFailure) error {
+	return &DashboardInvalidInputError{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DashboardInvalidInputError) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DashboardInvalidInputError) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DashboardNotFoundError is the modeled exception returned for the
+// "ResourceNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DashboardNotFoundError struct {
+	awserr.RequestFailure
+}
+
+func newErrorDashboardNotFoundError(err awserr.RequestFailure) error {
+	return &DashboardNotFoundError{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DashboardNotFoundError) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DashboardNotFoundError) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InternalServiceFault is the modeled exception returned for the
+// "InternalServiceError" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InternalServiceFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInternalServiceFault(err awserr.RequestFailure) error {
+	return &InternalServiceFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InternalServiceFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InternalServiceFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidFormatFault is the modeled exception returned for the
+// "InvalidFormat" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidFormatFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidFormatFault(err awserr.RequestFailure) error {
+	return &InvalidFormatFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidFormatFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidFormatFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidNextToken is the modeled exception returned for the
+// "InvalidNextToken" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidNextToken struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidNextToken(err awserr.RequestFailure) error {
+	return &InvalidNextToken{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidNextToken) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidNextToken) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidParameterCombinationException is the modeled exception returned for the
+// "InvalidParameterCombination" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidParameterCombinationException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidParameterCombinationException(err awserr.RequestFailure) error {
+	return &InvalidParameterCombinationException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidParameterCombinationException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidParameterCombinationException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidParameterValueException is the modeled exception returned for the
+// "InvalidParameterValue" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidParameterValueException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidParameterValueException(err awserr.RequestFailure) error {
+	return &InvalidParameterValueException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidParameterValueException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidParameterValueException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// LimitExceededException is the modeled exception returned for the
+// "LimitExceededException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type LimitExceededException struct {
+	awserr.RequestFailure
+}
+
+func newErrorLimitExceededException(err awserr.RequestFailure) error {
+	return &LimitExceededException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *LimitExceededException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *LimitExceededException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// LimitExceededFault is the modeled exception returned for the
+// "LimitExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type LimitExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorLimitExceededFault(err awserr.RequestFailure) error {
+	return &LimitExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *LimitExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *LimitExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// MissingRequiredParameterException is the modeled exception returned for the
+// "MissingParameter" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type MissingRequiredParameterException struct {
+	awserr.RequestFailure
+}
+
+func newErrorMissingRequiredParameterException(err awserr.RequestFailure) error {
+	return &MissingRequiredParameterException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *MissingRequiredParameterException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *MissingRequiredParameterException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ResourceNotFoundException is the modeled exception returned for the
+// "ResourceNotFoundException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ResourceNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorResourceNotFoundException(err awserr.RequestFailure) error {
+	return &ResourceNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ResourceNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ResourceNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+var exceptionFromCode = map[string]func(awserr.RequestFailure) error{
+	"ConcurrentModificationException": newErrorConcurrentModificationException,
+	"InvalidParameterInput":           newErrorDashboardInvalidInputError,
+	"ResourceNotFound":                newErrorDashboardNotFoundError,
+	"InternalServiceError":            newErrorInternalServiceFault,
+	"InvalidFormat":                   newErrorInvalidFormatFault,
+	"InvalidNextToken":                newErrorInvalidNextToken,
+	"InvalidParameterCombination":     newErrorInvalidParameterCombinationException,
+	"InvalidParameterValue":           newErrorInvalidParameterValueException,
+	"LimitExceededException":          newErrorLimitExceededException,
+	"LimitExceeded":                   newErrorLimitExceededFault,
+	"MissingParameter":                newErrorMissingRequiredParameterException,
+	"ResourceNotFoundException":       newErrorResourceNotFoundException,
+}
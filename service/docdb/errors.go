@@ -2,6 +2,10 @@
 
 package docdb
 
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
 const (
 
 	// ErrCodeAuthorizationNotFoundFault for service response error code
@@ -368,3 +372,1545 @@ const (
 	// The subscription name does not exist.
 	ErrCodeSubscriptionNotFoundFault = "SubscriptionNotFound"
 )
+
+// AuthorizationNotFoundFault is the modeled exception returned for the
+// "AuthorizationNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AuthorizationNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorAuthorizationNotFoundFault(err awserr.RequestFailure) error {
+	return &AuthorizationNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AuthorizationNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AuthorizationNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CertificateNotFoundFault is the modeled exception returned for the
+// "CertificateNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CertificateNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorCertificateNotFoundFault(err awserr.RequestFailure) error {
+	return &CertificateNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CertificateNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CertificateNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBClusterAlreadyExistsFault is the modeled exception returned for the
+// "DBClusterAlreadyExistsFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBClusterAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBClusterAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &DBClusterAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBClusterAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBClusterAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBClusterNotFoundFault is the modeled exception returned for the
+// "DBClusterNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBClusterNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBClusterNotFoundFault(err awserr.RequestFailure) error {
+	return &DBClusterNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBClusterNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBClusterNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBClusterParameterGroupNotFoundFault is the modeled exception returned for the
+// "DBClusterParameterGroupNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBClusterParameterGroupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBClusterParameterGroupNotFoundFault(err awserr.RequestFailure) error {
+	return &DBClusterParameterGroupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBClusterParameterGroupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBClusterParameterGroupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBClusterQuotaExceededFault is the modeled exception returned for the
+// "DBClusterQuotaExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBClusterQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBClusterQuotaExceededFault(err awserr.RequestFailure) error {
+	return &DBClusterQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBClusterQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBClusterQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBClusterSnapshotAlreadyExistsFault is the modeled exception returned for the
+// "DBClusterSnapshotAlreadyExistsFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBClusterSnapshotAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBClusterSnapshotAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &DBClusterSnapshotAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBClusterSnapshotAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBClusterSnapshotAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBClusterSnapshotNotFoundFault is the modeled exception returned for the
+// "DBClusterSnapshotNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBClusterSnapshotNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBClusterSnapshotNotFoundFault(err awserr.RequestFailure) error {
+	return &DBClusterSnapshotNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBClusterSnapshotNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBClusterSnapshotNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBInstanceAlreadyExistsFault is the modeled exception returned for the
+// "DBInstanceAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBInstanceAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBInstanceAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &DBInstanceAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBInstanceAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBInstanceAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBInstanceNotFoundFault is the modeled exception returned for the
+// "DBInstanceNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBInstanceNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBInstanceNotFoundFault(err awserr.RequestFailure) error {
+	return &DBInstanceNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBInstanceNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBInstanceNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBParameterGroupAlreadyExistsFault is the modeled exception returned for the
+// "DBParameterGroupAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBParameterGroupAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBParameterGroupAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &DBParameterGroupAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBParameterGroupAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBParameterGroupAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBParameterGroupNotFoundFault is the modeled exception returned for the
+// "DBParameterGroupNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBParameterGroupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBParameterGroupNotFoundFault(err awserr.RequestFailure) error {
+	return &DBParameterGroupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBParameterGroupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBParameterGroupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBParameterGroupQuotaExceededFault is the modeled exception returned for the
+// "DBParameterGroupQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBParameterGroupQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBParameterGroupQuotaExceededFault(err awserr.RequestFailure) error {
+	return &DBParameterGroupQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBParameterGroupQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBParameterGroupQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBSecurityGroupNotFoundFault is the modeled exception returned for the
+// "DBSecurityGroupNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBSecurityGroupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBSecurityGroupNotFoundFault(err awserr.RequestFailure) error {
+	return &DBSecurityGroupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBSecurityGroupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBSecurityGroupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBSnapshotAlreadyExistsFault is the modeled exception returned for the
+// "DBSnapshotAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBSnapshotAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBSnapshotAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &DBSnapshotAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBSnapshotAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBSnapshotAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBSnapshotNotFoundFault is the modeled exception returned for the
+// "DBSnapshotNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBSnapshotNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBSnapshotNotFoundFault(err awserr.RequestFailure) error {
+	return &DBSnapshotNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBSnapshotNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBSnapshotNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBSubnetGroupAlreadyExistsFault is the modeled exception returned for the
+// "DBSubnetGroupAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBSubnetGroupAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBSubnetGroupAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &DBSubnetGroupAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBSubnetGroupAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBSubnetGroupAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBSubnetGroupDoesNotCoverEnoughAZs is the modeled exception returned for the
+// "DBSubnetGroupDoesNotCoverEnoughAZs" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBSubnetGroupDoesNotCoverEnoughAZs struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBSubnetGroupDoesNotCoverEnoughAZs(err awserr.RequestFailure) error {
+	return &DBSubnetGroupDoesNotCoverEnoughAZs{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBSubnetGroupDoesNotCoverEnoughAZs) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBSubnetGroupDoesNotCoverEnoughAZs) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBSubnetGroupNotFoundFault is the modeled exception returned for the
+// "DBSubnetGroupNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBSubnetGroupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBSubnetGroupNotFoundFault(err awserr.RequestFailure) error {
+	return &DBSubnetGroupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBSubnetGroupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBSubnetGroupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBSubnetGroupQuotaExceededFault is the modeled exception returned for the
+// "DBSubnetGroupQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBSubnetGroupQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBSubnetGroupQuotaExceededFault(err awserr.RequestFailure) error {
+	return &DBSubnetGroupQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBSubnetGroupQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBSubnetGroupQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBSubnetQuotaExceededFault is the modeled exception returned for the
+// "DBSubnetQuotaExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBSubnetQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBSubnetQuotaExceededFault(err awserr.RequestFailure) error {
+	return &DBSubnetQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBSubnetQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBSubnetQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBUpgradeDependencyFailureFault is the modeled exception returned for the
+// "DBUpgradeDependencyFailure" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBUpgradeDependencyFailureFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBUpgradeDependencyFailureFault(err awserr.RequestFailure) error {
+	return &DBUpgradeDependencyFailureFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBUpgradeDependencyFailureFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBUpgradeDependencyFailureFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// EventSubscriptionQuotaExceededFault is the modeled exception returned for the
+// "EventSubscriptionQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type EventSubscriptionQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorEventSubscriptionQuotaExceededFault(err awserr.RequestFailure) error {
+	return &EventSubscriptionQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *EventSubscriptionQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *EventSubscriptionQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// GlobalClusterAlreadyExistsFault is the modeled exception returned for the
+// "GlobalClusterAlreadyExistsFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type GlobalClusterAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorGlobalClusterAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &GlobalClusterAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *GlobalClusterAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *GlobalClusterAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// GlobalClusterNotFoundFault is the modeled exception returned for the
+// "GlobalClusterNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type GlobalClusterNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorGlobalClusterNotFoundFault(err awserr.RequestFailure) error {
+	return &GlobalClusterNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *GlobalClusterNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *GlobalClusterNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// GlobalClusterQuotaExceededFault is the modeled exception returned for the
+// "GlobalClusterQuotaExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type GlobalClusterQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorGlobalClusterQuotaExceededFault(err awserr.RequestFailure) error {
+	return &GlobalClusterQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *GlobalClusterQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *GlobalClusterQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InstanceQuotaExceededFault is the modeled exception returned for the
+// "InstanceQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InstanceQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInstanceQuotaExceededFault(err awserr.RequestFailure) error {
+	return &InstanceQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InstanceQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InstanceQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InsufficientDBClusterCapacityFault is the modeled exception returned for the
+// "InsufficientDBClusterCapacityFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InsufficientDBClusterCapacityFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInsufficientDBClusterCapacityFault(err awserr.RequestFailure) error {
+	return &InsufficientDBClusterCapacityFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InsufficientDBClusterCapacityFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InsufficientDBClusterCapacityFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InsufficientDBInstanceCapacityFault is the modeled exception returned for the
+// "InsufficientDBInstanceCapacity" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InsufficientDBInstanceCapacityFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInsufficientDBInstanceCapacityFault(err awserr.RequestFailure) error {
+	return &InsufficientDBInstanceCapacityFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InsufficientDBInstanceCapacityFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InsufficientDBInstanceCapacityFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InsufficientStorageClusterCapacityFault is the modeled exception returned for the
+// "InsufficientStorageClusterCapacity" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InsufficientStorageClusterCapacityFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInsufficientStorageClusterCapacityFault(err awserr.RequestFailure) error {
+	return &InsufficientStorageClusterCapacityFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InsufficientStorageClusterCapacityFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InsufficientStorageClusterCapacityFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBClusterSnapshotStateFault is the modeled exception returned for the
+// "InvalidDBClusterSnapshotStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBClusterSnapshotStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBClusterSnapshotStateFault(err awserr.RequestFailure) error {
+	return &InvalidDBClusterSnapshotStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBClusterSnapshotStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBClusterSnapshotStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBClusterStateFault is the modeled exception returned for the
+// "InvalidDBClusterStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBClusterStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBClusterStateFault(err awserr.RequestFailure) error {
+	return &InvalidDBClusterStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBClusterStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBClusterStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBInstanceStateFault is the modeled exception returned for the
+// "InvalidDBInstanceState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBInstanceStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBInstanceStateFault(err awserr.RequestFailure) error {
+	return &InvalidDBInstanceStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBInstanceStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBInstanceStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBParameterGroupStateFault is the modeled exception returned for the
+// "InvalidDBParameterGroupState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBParameterGroupStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBParameterGroupStateFault(err awserr.RequestFailure) error {
+	return &InvalidDBParameterGroupStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBParameterGroupStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBParameterGroupStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBSecurityGroupStateFault is the modeled exception returned for the
+// "InvalidDBSecurityGroupState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBSecurityGroupStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBSecurityGroupStateFault(err awserr.RequestFailure) error {
+	return &InvalidDBSecurityGroupStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBSecurityGroupStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBSecurityGroupStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBSnapshotStateFault is the modeled exception returned for the
+// "InvalidDBSnapshotState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBSnapshotStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBSnapshotStateFault(err awserr.RequestFailure) error {
+	return &InvalidDBSnapshotStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBSnapshotStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBSnapshotStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBSubnetGroupStateFault is the modeled exception returned for the
+// "InvalidDBSubnetGroupStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBSubnetGroupStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBSubnetGroupStateFault(err awserr.RequestFailure) error {
+	return &InvalidDBSubnetGroupStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBSubnetGroupStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBSubnetGroupStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBSubnetStateFault is the modeled exception returned for the
+// "InvalidDBSubnetStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBSubnetStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBSubnetStateFault(err awserr.RequestFailure) error {
+	return &InvalidDBSubnetStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBSubnetStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBSubnetStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidEventSubscriptionStateFault is the modeled exception returned for the
+// "InvalidEventSubscriptionState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidEventSubscriptionStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidEventSubscriptionStateFault(err awserr.RequestFailure) error {
+	return &InvalidEventSubscriptionStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidEventSubscriptionStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidEventSubscriptionStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidGlobalClusterStateFault is the modeled exception returned for the
+// "InvalidGlobalClusterStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidGlobalClusterStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidGlobalClusterStateFault(err awserr.RequestFailure) error {
+	return &InvalidGlobalClusterStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidGlobalClusterStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidGlobalClusterStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidRestoreFault is the modeled exception returned for the
+// "InvalidRestoreFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidRestoreFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidRestoreFault(err awserr.RequestFailure) error {
+	return &InvalidRestoreFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidRestoreFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidRestoreFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidSubnet is the modeled exception returned for the
+// "InvalidSubnet" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidSubnet struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidSubnet(err awserr.RequestFailure) error {
+	return &InvalidSubnet{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidSubnet) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidSubnet) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidVPCNetworkStateFault is the modeled exception returned for the
+// "InvalidVPCNetworkStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidVPCNetworkStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidVPCNetworkStateFault(err awserr.RequestFailure) error {
+	return &InvalidVPCNetworkStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidVPCNetworkStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidVPCNetworkStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// KMSKeyNotAccessibleFault is the modeled exception returned for the
+// "KMSKeyNotAccessibleFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type KMSKeyNotAccessibleFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorKMSKeyNotAccessibleFault(err awserr.RequestFailure) error {
+	return &KMSKeyNotAccessibleFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *KMSKeyNotAccessibleFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *KMSKeyNotAccessibleFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ResourceNotFoundFault is the modeled exception returned for the
+// "ResourceNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ResourceNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorResourceNotFoundFault(err awserr.RequestFailure) error {
+	return &ResourceNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ResourceNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ResourceNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SNSInvalidTopicFault is the modeled exception returned for the
+// "SNSInvalidTopic" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SNSInvalidTopicFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSNSInvalidTopicFault(err awserr.RequestFailure) error {
+	return &SNSInvalidTopicFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SNSInvalidTopicFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SNSInvalidTopicFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SNSNoAuthorizationFault is the modeled exception returned for the
+// "SNSNoAuthorization" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SNSNoAuthorizationFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSNSNoAuthorizationFault(err awserr.RequestFailure) error {
+	return &SNSNoAuthorizationFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SNSNoAuthorizationFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SNSNoAuthorizationFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SNSTopicArnNotFoundFault is the modeled exception returned for the
+// "SNSTopicArnNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SNSTopicArnNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSNSTopicArnNotFoundFault(err awserr.RequestFailure) error {
+	return &SNSTopicArnNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SNSTopicArnNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SNSTopicArnNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SharedSnapshotQuotaExceededFault is the modeled exception returned for the
+// "SharedSnapshotQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SharedSnapshotQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSharedSnapshotQuotaExceededFault(err awserr.RequestFailure) error {
+	return &SharedSnapshotQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SharedSnapshotQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SharedSnapshotQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SnapshotQuotaExceededFault is the modeled exception returned for the
+// "SnapshotQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SnapshotQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSnapshotQuotaExceededFault(err awserr.RequestFailure) error {
+	return &SnapshotQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SnapshotQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SnapshotQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SourceNotFoundFault is the modeled exception returned for the
+// "SourceNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SourceNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSourceNotFoundFault(err awserr.RequestFailure) error {
+	return &SourceNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SourceNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SourceNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// StorageQuotaExceededFault is the modeled exception returned for the
+// "StorageQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type StorageQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorStorageQuotaExceededFault(err awserr.RequestFailure) error {
+	return &StorageQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *StorageQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *StorageQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// StorageTypeNotSupportedFault is the modeled exception returned for the
+// "StorageTypeNotSupported" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type StorageTypeNotSupportedFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorStorageTypeNotSupportedFault(err awserr.RequestFailure) error {
+	return &StorageTypeNotSupportedFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *StorageTypeNotSupportedFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *StorageTypeNotSupportedFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SubnetAlreadyInUse is the modeled exception returned for the
+// "SubnetAlreadyInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SubnetAlreadyInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorSubnetAlreadyInUse(err awserr.RequestFailure) error {
+	return &SubnetAlreadyInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SubnetAlreadyInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SubnetAlreadyInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SubscriptionAlreadyExistFault is the modeled exception returned for the
+// "SubscriptionAlreadyExist" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SubscriptionAlreadyExistFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSubscriptionAlreadyExistFault(err awserr.RequestFailure) error {
+	return &SubscriptionAlreadyExistFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SubscriptionAlreadyExistFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SubscriptionAlreadyExistFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SubscriptionCategoryNotFoundFault is the modeled exception returned for the
+// "SubscriptionCategoryNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SubscriptionCategoryNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSubscriptionCategoryNotFoundFault(err awserr.RequestFailure) error {
+	return &SubscriptionCategoryNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SubscriptionCategoryNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SubscriptionCategoryNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SubscriptionNotFoundFault is the modeled exception returned for the
+// "SubscriptionNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SubscriptionNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSubscriptionNotFoundFault(err awserr.RequestFailure) error {
+	return &SubscriptionNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SubscriptionNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SubscriptionNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+var exceptionFromCode = map[string]func(awserr.RequestFailure) error{
+	"AuthorizationNotFound":               newErrorAuthorizationNotFoundFault,
+	"CertificateNotFound":                 newErrorCertificateNotFoundFault,
+	"DBClusterAlreadyExistsFault":         newErrorDBClusterAlreadyExistsFault,
+	"DBClusterNotFoundFault":              newErrorDBClusterNotFoundFault,
+	"DBClusterParameterGroupNotFound":     newErrorDBClusterParameterGroupNotFoundFault,
+	"DBClusterQuotaExceededFault":         newErrorDBClusterQuotaExceededFault,
+	"DBClusterSnapshotAlreadyExistsFault": newErrorDBClusterSnapshotAlreadyExistsFault,
+	"DBClusterSnapshotNotFoundFault":      newErrorDBClusterSnapshotNotFoundFault,
+	"DBInstanceAlreadyExists":             newErrorDBInstanceAlreadyExistsFault,
+	"DBInstanceNotFound":                  newErrorDBInstanceNotFoundFault,
+	"DBParameterGroupAlreadyExists":       newErrorDBParameterGroupAlreadyExistsFault,
+	"DBParameterGroupNotFound":            newErrorDBParameterGroupNotFoundFault,
+	"DBParameterGroupQuotaExceeded":       newErrorDBParameterGroupQuotaExceededFault,
+	"DBSecurityGroupNotFound":             newErrorDBSecurityGroupNotFoundFault,
+	"DBSnapshotAlreadyExists":             newErrorDBSnapshotAlreadyExistsFault,
+	"DBSnapshotNotFound":                  newErrorDBSnapshotNotFoundFault,
+	"DBSubnetGroupAlreadyExists":          newErrorDBSubnetGroupAlreadyExistsFault,
+	"DBSubnetGroupDoesNotCoverEnoughAZs":  newErrorDBSubnetGroupDoesNotCoverEnoughAZs,
+	"DBSubnetGroupNotFoundFault":          newErrorDBSubnetGroupNotFoundFault,
+	"DBSubnetGroupQuotaExceeded":          newErrorDBSubnetGroupQuotaExceededFault,
+	"DBSubnetQuotaExceededFault":          newErrorDBSubnetQuotaExceededFault,
+	"DBUpgradeDependencyFailure":          newErrorDBUpgradeDependencyFailureFault,
+	"EventSubscriptionQuotaExceeded":      newErrorEventSubscriptionQuotaExceededFault,
+	"GlobalClusterAlreadyExistsFault":     newErrorGlobalClusterAlreadyExistsFault,
+	"GlobalClusterNotFoundFault":          newErrorGlobalClusterNotFoundFault,
+	"GlobalClusterQuotaExceededFault":     newErrorGlobalClusterQuotaExceededFault,
+	"InstanceQuotaExceeded":               newErrorInstanceQuotaExceededFault,
+	"InsufficientDBClusterCapacityFault":  newErrorInsufficientDBClusterCapacityFault,
+	"InsufficientDBInstanceCapacity":      newErrorInsufficientDBInstanceCapacityFault,
+	"InsufficientStorageClusterCapacity":  newErrorInsufficientStorageClusterCapacityFault,
+	"InvalidDBClusterSnapshotStateFault":  newErrorInvalidDBClusterSnapshotStateFault,
+	"InvalidDBClusterStateFault":          newErrorInvalidDBClusterStateFault,
+	"InvalidDBInstanceState":              newErrorInvalidDBInstanceStateFault,
+	"InvalidDBParameterGroupState":        newErrorInvalidDBParameterGroupStateFault,
+	"InvalidDBSecurityGroupState":         newErrorInvalidDBSecurityGroupStateFault,
+	"InvalidDBSnapshotState":              newErrorInvalidDBSnapshotStateFault,
+	"InvalidDBSubnetGroupStateFault":      newErrorInvalidDBSubnetGroupStateFault,
+	"InvalidDBSubnetStateFault":           newErrorInvalidDBSubnetStateFault,
+	"InvalidEventSubscriptionState":       newErrorInvalidEventSubscriptionStateFault,
+	"InvalidGlobalClusterStateFault":      newErrorInvalidGlobalClusterStateFault,
+	"InvalidRestoreFault":                 newErrorInvalidRestoreFault,
+	"InvalidSubnet":                       newErrorInvalidSubnet,
+	"InvalidVPCNetworkStateFault":         newErrorInvalidVPCNetworkStateFault,
+	"KMSKeyNotAccessibleFault":            newErrorKMSKeyNotAccessibleFault,
+	"ResourceNotFoundFault":               newErrorResourceNotFoundFault,
+	"SNSInvalidTopic":                     newErrorSNSInvalidTopicFault,
+	"SNSNoAuthorization":                  newErrorSNSNoAuthorizationFault,
+	"SNSTopicArnNotFound":                 newErrorSNSTopicArnNotFoundFault,
+	"SharedSnapshotQuotaExceeded":         newErrorSharedSnapshotQuotaExceededFault,
+	"SnapshotQuotaExceeded":               newErrorSnapshotQuotaExceededFault,
+	"SourceNotFound":                      newErrorSourceNotFoundFault,
+	"StorageQuotaExceeded":                newErrorStorageQuotaExceededFault,
+	"StorageTypeNotSupported":             newErrorStorageTypeNotSupportedFault,
+	"SubnetAlreadyInUse":                  newErrorSubnetAlreadyInUse,
+	"SubscriptionAlreadyExist":            newErrorSubscriptionAlreadyExistFault,
+	"SubscriptionCategoryNotFound":        newErrorSubscriptionCategoryNotFoundFault,
+	"SubscriptionNotFound":                newErrorSubscriptionNotFoundFault,
+}
@@ -2,6 +2,10 @@
 
 package elasticache
 
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
 const (
 
 	// ErrCodeAPICallRateForCustomerExceededFault for service response error code
@@ -500,3 +504,2058 @@ const (
 	// The quota of users has been exceeded.
 	ErrCodeUserQuotaExceededFault = "UserQuotaExceeded"
 )
+
+// APICallRateForCustomerExceededFault is the modeled exception returned for the
+// "APICallRateForCustomerExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type APICallRateForCustomerExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorAPICallRateForCustomerExceededFault(err awserr.RequestFailure) error {
+	return &APICallRateForCustomerExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *APICallRateForCustomerExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *APICallRateForCustomerExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// AuthorizationAlreadyExistsFault is the modeled exception returned for the
+// "AuthorizationAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AuthorizationAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorAuthorizationAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &AuthorizationAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AuthorizationAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AuthorizationAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// AuthorizationNotFoundFault is the modeled exception returned for the
+// "AuthorizationNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AuthorizationNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorAuthorizationNotFoundFault(err awserr.RequestFailure) error {
+	return &AuthorizationNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AuthorizationNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AuthorizationNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CacheClusterAlreadyExistsFault is the modeled exception returned for the
+// "CacheClusterAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CacheClusterAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorCacheClusterAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &CacheClusterAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CacheClusterAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CacheClusterAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CacheClusterNotFoundFault is the modeled exception returned for the
+// "CacheClusterNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CacheClusterNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorCacheClusterNotFoundFault(err awserr.RequestFailure) error {
+	return &CacheClusterNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CacheClusterNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CacheClusterNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CacheParameterGroupAlreadyExistsFault is the modeled exception returned for the
+// "CacheParameterGroupAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CacheParameterGroupAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorCacheParameterGroupAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &CacheParameterGroupAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CacheParameterGroupAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CacheParameterGroupAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CacheParameterGroupNotFoundFault is the modeled exception returned for the
+// "CacheParameterGroupNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CacheParameterGroupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorCacheParameterGroupNotFoundFault(err awserr.RequestFailure) error {
+	return &CacheParameterGroupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CacheParameterGroupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CacheParameterGroupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CacheParameterGroupQuotaExceededFault is the modeled exception returned for the
+// "CacheParameterGroupQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CacheParameterGroupQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorCacheParameterGroupQuotaExceededFault(err awserr.RequestFailure) error {
+	return &CacheParameterGroupQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CacheParameterGroupQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CacheParameterGroupQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CacheSecurityGroupAlreadyExistsFault is the modeled exception returned for the
+// "CacheSecurityGroupAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CacheSecurityGroupAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorCacheSecurityGroupAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &CacheSecurityGroupAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CacheSecurityGroupAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CacheSecurityGroupAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CacheSecurityGroupNotFoundFault is the modeled exception returned for the
+// "CacheSecurityGroupNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CacheSecurityGroupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorCacheSecurityGroupNotFoundFault(err awserr.RequestFailure) error {
+	return &CacheSecurityGroupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CacheSecurityGroupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CacheSecurityGroupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CacheSecurityGroupQuotaExceededFault is the modeled exception returned for the
+// "QuotaExceeded.CacheSecurityGroup" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CacheSecurityGroupQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorCacheSecurityGroupQuotaExceededFault(err awserr.RequestFailure) error {
+	return &CacheSecurityGroupQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CacheSecurityGroupQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CacheSecurityGroupQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CacheSubnetGroupAlreadyExistsFault is the modeled exception returned for the
+// "CacheSubnetGroupAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CacheSubnetGroupAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorCacheSubnetGroupAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &CacheSubnetGroupAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CacheSubnetGroupAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CacheSubnetGroupAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CacheSubnetGroupInUse is the modeled exception returned for the
+// "CacheSubnetGroupInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CacheSubnetGroupInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorCacheSubnetGroupInUse(err awserr.RequestFailure) error {
+	return &CacheSubnetGroupInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CacheSubnetGroupInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CacheSubnetGroupInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CacheSubnetGroupNotFoundFault is the modeled exception returned for the
+// "CacheSubnetGroupNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CacheSubnetGroupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorCacheSubnetGroupNotFoundFault(err awserr.RequestFailure) error {
+	return &CacheSubnetGroupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CacheSubnetGroupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CacheSubnetGroupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CacheSubnetGroupQuotaExceededFault is the modeled exception returned for the
+// "CacheSubnetGroupQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CacheSubnetGroupQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorCacheSubnetGroupQuotaExceededFault(err awserr.RequestFailure) error {
+	return &CacheSubnetGroupQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CacheSubnetGroupQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CacheSubnetGroupQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CacheSubnetQuotaExceededFault is the modeled exception returned for the
+// "CacheSubnetQuotaExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CacheSubnetQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorCacheSubnetQuotaExceededFault(err awserr.RequestFailure) error {
+	return &CacheSubnetQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CacheSubnetQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CacheSubnetQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ClusterQuotaForCustomerExceededFault is the modeled exception returned for the
+// "ClusterQuotaForCustomerExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ClusterQuotaForCustomerExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorClusterQuotaForCustomerExceededFault(err awserr.RequestFailure) error {
+	return &ClusterQuotaForCustomerExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ClusterQuotaForCustomerExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ClusterQuotaForCustomerExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DefaultUserAssociatedToUserGroupFault is the modeled exception returned for the
+// "DefaultUserAssociatedToUserGroup" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DefaultUserAssociatedToUserGroupFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDefaultUserAssociatedToUserGroupFault(err awserr.RequestFailure) error {
+	return &DefaultUserAssociatedToUserGroupFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DefaultUserAssociatedToUserGroupFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DefaultUserAssociatedToUserGroupFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DefaultUserRequired is the modeled exception returned for the
+// "DefaultUserRequired" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DefaultUserRequired struct {
+	awserr.RequestFailure
+}
+
+func newErrorDefaultUserRequired(err awserr.RequestFailure) error {
+	return &DefaultUserRequired{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DefaultUserRequired) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DefaultUserRequired) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DuplicateUserNameFault is the modeled exception returned for the
+// "DuplicateUserName" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DuplicateUserNameFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDuplicateUserNameFault(err awserr.RequestFailure) error {
+	return &DuplicateUserNameFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DuplicateUserNameFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DuplicateUserNameFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// GlobalReplicationGroupAlreadyExistsFault is the modeled exception returned for the
+// "GlobalReplicationGroupAlreadyExistsFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type GlobalReplicationGroupAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorGlobalReplicationGroupAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &GlobalReplicationGroupAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *GlobalReplicationGroupAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *GlobalReplicationGroupAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// GlobalReplicationGroupNotFoundFault is the modeled exception returned for the
+// "GlobalReplicationGroupNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type GlobalReplicationGroupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorGlobalReplicationGroupNotFoundFault(err awserr.RequestFailure) error {
+	return &GlobalReplicationGroupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *GlobalReplicationGroupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *GlobalReplicationGroupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InsufficientCacheClusterCapacityFault is the modeled exception returned for the
+// "InsufficientCacheClusterCapacity" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InsufficientCacheClusterCapacityFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInsufficientCacheClusterCapacityFault(err awserr.RequestFailure) error {
+	return &InsufficientCacheClusterCapacityFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InsufficientCacheClusterCapacityFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InsufficientCacheClusterCapacityFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidARNFault is the modeled exception returned for the
+// "InvalidARN" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidARNFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidARNFault(err awserr.RequestFailure) error {
+	return &InvalidARNFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidARNFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidARNFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidCacheClusterStateFault is the modeled exception returned for the
+// "InvalidCacheClusterState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidCacheClusterStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidCacheClusterStateFault(err awserr.RequestFailure) error {
+	return &InvalidCacheClusterStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidCacheClusterStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidCacheClusterStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidCacheParameterGroupStateFault is the modeled exception returned for the
+// "InvalidCacheParameterGroupState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidCacheParameterGroupStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidCacheParameterGroupStateFault(err awserr.RequestFailure) error {
+	return &InvalidCacheParameterGroupStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidCacheParameterGroupStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidCacheParameterGroupStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidCacheSecurityGroupStateFault is the modeled exception returned for the
+// "InvalidCacheSecurityGroupState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidCacheSecurityGroupStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidCacheSecurityGroupStateFault(err awserr.RequestFailure) error {
+	return &InvalidCacheSecurityGroupStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidCacheSecurityGroupStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidCacheSecurityGroupStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidCredentialsException is the modeled exception returned for the
+// "InvalidCredentialsException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidCredentialsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidCredentialsException(err awserr.RequestFailure) error {
+	return &InvalidCredentialsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidCredentialsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidCredentialsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidGlobalReplicationGroupStateFault is the modeled exception returned for the
+// "InvalidGlobalReplicationGroupState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidGlobalReplicationGroupStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidGlobalReplicationGroupStateFault(err awserr.RequestFailure) error {
+	return &InvalidGlobalReplicationGroupStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidGlobalReplicationGroupStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidGlobalReplicationGroupStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidKMSKeyFault is the modeled exception returned for the
+// "InvalidKMSKeyFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidKMSKeyFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidKMSKeyFault(err awserr.RequestFailure) error {
+	return &InvalidKMSKeyFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidKMSKeyFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidKMSKeyFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidParameterCombinationException is the modeled exception returned for the
+// "InvalidParameterCombination" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidParameterCombinationException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidParameterCombinationException(err awserr.RequestFailure) error {
+	return &InvalidParameterCombinationException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidParameterCombinationException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidParameterCombinationException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidParameterValueException is the modeled exception returned for the
+// "InvalidParameterValue" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidParameterValueException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidParameterValueException(err awserr.RequestFailure) error {
+	return &InvalidParameterValueException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidParameterValueException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidParameterValueException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidReplicationGroupStateFault is the modeled exception returned for the
+// "InvalidReplicationGroupState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidReplicationGroupStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidReplicationGroupStateFault(err awserr.RequestFailure) error {
+	return &InvalidReplicationGroupStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidReplicationGroupStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidReplicationGroupStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidServerlessCacheSnapshotStateFault is the modeled exception returned for the
+// "InvalidServerlessCacheSnapshotStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidServerlessCacheSnapshotStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidServerlessCacheSnapshotStateFault(err awserr.RequestFailure) error {
+	return &InvalidServerlessCacheSnapshotStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidServerlessCacheSnapshotStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidServerlessCacheSnapshotStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidServerlessCacheStateFault is the modeled exception returned for the
+// "InvalidServerlessCacheStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidServerlessCacheStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidServerlessCacheStateFault(err awserr.RequestFailure) error {
+	return &InvalidServerlessCacheStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidServerlessCacheStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidServerlessCacheStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidSnapshotStateFault is the modeled exception returned for the
+// "InvalidSnapshotState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidSnapshotStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidSnapshotStateFault(err awserr.RequestFailure) error {
+	return &InvalidSnapshotStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidSnapshotStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidSnapshotStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidSubnet is the modeled exception returned for the
+// "InvalidSubnet" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidSubnet struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidSubnet(err awserr.RequestFailure) error {
+	return &InvalidSubnet{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidSubnet) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidSubnet) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidUserGroupStateFault is the modeled exception returned for the
+// "InvalidUserGroupState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidUserGroupStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidUserGroupStateFault(err awserr.RequestFailure) error {
+	return &InvalidUserGroupStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidUserGroupStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidUserGroupStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidUserStateFault is the modeled exception returned for the
+// "InvalidUserState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidUserStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidUserStateFault(err awserr.RequestFailure) error {
+	return &InvalidUserStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidUserStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidUserStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidVPCNetworkStateFault is the modeled exception returned for the
+// "InvalidVPCNetworkStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidVPCNetworkStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidVPCNetworkStateFault(err awserr.RequestFailure) error {
+	return &InvalidVPCNetworkStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidVPCNetworkStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidVPCNetworkStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoOperationFault is the modeled exception returned for the
+// "NoOperationFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoOperationFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoOperationFault(err awserr.RequestFailure) error {
+	return &NoOperationFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoOperationFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoOperationFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NodeGroupNotFoundFault is the modeled exception returned for the
+// "NodeGroupNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NodeGroupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorNodeGroupNotFoundFault(err awserr.RequestFailure) error {
+	return &NodeGroupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NodeGroupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NodeGroupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NodeGroupsPerReplicationGroupQuotaExceededFault is the modeled exception returned for the
+// "NodeGroupsPerReplicationGroupQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NodeGroupsPerReplicationGroupQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorNodeGroupsPerReplicationGroupQuotaExceededFault(err awserr.RequestFailure) error {
+	return &NodeGroupsPerReplicationGroupQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NodeGroupsPerReplicationGroupQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NodeGroupsPerReplicationGroupQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NodeQuotaForClusterExceededFault is the modeled exception returned for the
+// "NodeQuotaForClusterExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NodeQuotaForClusterExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorNodeQuotaForClusterExceededFault(err awserr.RequestFailure) error {
+	return &NodeQuotaForClusterExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NodeQuotaForClusterExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NodeQuotaForClusterExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NodeQuotaForCustomerExceededFault is the modeled exception returned for the
+// "NodeQuotaForCustomerExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NodeQuotaForCustomerExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorNodeQuotaForCustomerExceededFault(err awserr.RequestFailure) error {
+	return &NodeQuotaForCustomerExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NodeQuotaForCustomerExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NodeQuotaForCustomerExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ReplicationGroupAlreadyExistsFault is the modeled exception returned for the
+// "ReplicationGroupAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ReplicationGroupAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorReplicationGroupAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &ReplicationGroupAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ReplicationGroupAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ReplicationGroupAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ReplicationGroupAlreadyUnderMigrationFault is the modeled exception returned for the
+// "ReplicationGroupAlreadyUnderMigrationFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ReplicationGroupAlreadyUnderMigrationFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorReplicationGroupAlreadyUnderMigrationFault(err awserr.RequestFailure) error {
+	return &ReplicationGroupAlreadyUnderMigrationFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ReplicationGroupAlreadyUnderMigrationFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ReplicationGroupAlreadyUnderMigrationFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ReplicationGroupNotFoundFault is the modeled exception returned for the
+// "ReplicationGroupNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ReplicationGroupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorReplicationGroupNotFoundFault(err awserr.RequestFailure) error {
+	return &ReplicationGroupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ReplicationGroupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ReplicationGroupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ReplicationGroupNotUnderMigrationFault is the modeled exception returned for the
+// "ReplicationGroupNotUnderMigrationFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ReplicationGroupNotUnderMigrationFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorReplicationGroupNotUnderMigrationFault(err awserr.RequestFailure) error {
+	return &ReplicationGroupNotUnderMigrationFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ReplicationGroupNotUnderMigrationFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ReplicationGroupNotUnderMigrationFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ReservedCacheNodeAlreadyExistsFault is the modeled exception returned for the
+// "ReservedCacheNodeAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ReservedCacheNodeAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorReservedCacheNodeAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &ReservedCacheNodeAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ReservedCacheNodeAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ReservedCacheNodeAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ReservedCacheNodeNotFoundFault is the modeled exception returned for the
+// "ReservedCacheNodeNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ReservedCacheNodeNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorReservedCacheNodeNotFoundFault(err awserr.RequestFailure) error {
+	return &ReservedCacheNodeNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ReservedCacheNodeNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ReservedCacheNodeNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ReservedCacheNodeQuotaExceededFault is the modeled exception returned for the
+// "ReservedCacheNodeQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ReservedCacheNodeQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorReservedCacheNodeQuotaExceededFault(err awserr.RequestFailure) error {
+	return &ReservedCacheNodeQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ReservedCacheNodeQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ReservedCacheNodeQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ReservedCacheNodesOfferingNotFoundFault is the modeled exception returned for the
+// "ReservedCacheNodesOfferingNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ReservedCacheNodesOfferingNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorReservedCacheNodesOfferingNotFoundFault(err awserr.RequestFailure) error {
+	return &ReservedCacheNodesOfferingNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ReservedCacheNodesOfferingNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ReservedCacheNodesOfferingNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ServerlessCacheAlreadyExistsFault is the modeled exception returned for the
+// "ServerlessCacheAlreadyExistsFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ServerlessCacheAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorServerlessCacheAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &ServerlessCacheAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ServerlessCacheAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ServerlessCacheAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ServerlessCacheNotFoundFault is the modeled exception returned for the
+// "ServerlessCacheNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ServerlessCacheNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorServerlessCacheNotFoundFault(err awserr.RequestFailure) error {
+	return &ServerlessCacheNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ServerlessCacheNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ServerlessCacheNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ServerlessCacheQuotaForCustomerExceededFault is the modeled exception returned for the
+// "ServerlessCacheQuotaForCustomerExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ServerlessCacheQuotaForCustomerExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorServerlessCacheQuotaForCustomerExceededFault(err awserr.RequestFailure) error {
+	return &ServerlessCacheQuotaForCustomerExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ServerlessCacheQuotaForCustomerExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ServerlessCacheQuotaForCustomerExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ServerlessCacheSnapshotAlreadyExistsFault is the modeled exception returned for the
+// "ServerlessCacheSnapshotAlreadyExistsFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ServerlessCacheSnapshotAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorServerlessCacheSnapshotAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &ServerlessCacheSnapshotAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ServerlessCacheSnapshotAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ServerlessCacheSnapshotAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ServerlessCacheSnapshotNotFoundFault is the modeled exception returned for the
+// "ServerlessCacheSnapshotNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ServerlessCacheSnapshotNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorServerlessCacheSnapshotNotFoundFault(err awserr.RequestFailure) error {
+	return &ServerlessCacheSnapshotNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ServerlessCacheSnapshotNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ServerlessCacheSnapshotNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ServerlessCacheSnapshotQuotaExceededFault is the modeled exception returned for the
+// "ServerlessCacheSnapshotQuotaExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ServerlessCacheSnapshotQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorServerlessCacheSnapshotQuotaExceededFault(err awserr.RequestFailure) error {
+	return &ServerlessCacheSnapshotQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ServerlessCacheSnapshotQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ServerlessCacheSnapshotQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ServiceLinkedRoleNotFoundFault is the modeled exception returned for the
+// "ServiceLinkedRoleNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ServiceLinkedRoleNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorServiceLinkedRoleNotFoundFault(err awserr.RequestFailure) error {
+	return &ServiceLinkedRoleNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ServiceLinkedRoleNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ServiceLinkedRoleNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ServiceUpdateNotFoundFault is the modeled exception returned for the
+// "ServiceUpdateNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ServiceUpdateNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorServiceUpdateNotFoundFault(err awserr.RequestFailure) error {
+	return &ServiceUpdateNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ServiceUpdateNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ServiceUpdateNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SnapshotAlreadyExistsFault is the modeled exception returned for the
+// "SnapshotAlreadyExistsFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SnapshotAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSnapshotAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &SnapshotAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SnapshotAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SnapshotAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SnapshotFeatureNotSupportedFault is the modeled exception returned for the
+// "SnapshotFeatureNotSupportedFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SnapshotFeatureNotSupportedFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSnapshotFeatureNotSupportedFault(err awserr.RequestFailure) error {
+	return &SnapshotFeatureNotSupportedFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SnapshotFeatureNotSupportedFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SnapshotFeatureNotSupportedFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SnapshotNotFoundFault is the modeled exception returned for the
+// "SnapshotNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SnapshotNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSnapshotNotFoundFault(err awserr.RequestFailure) error {
+	return &SnapshotNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SnapshotNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SnapshotNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SnapshotQuotaExceededFault is the modeled exception returned for the
+// "SnapshotQuotaExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SnapshotQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSnapshotQuotaExceededFault(err awserr.RequestFailure) error {
+	return &SnapshotQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SnapshotQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SnapshotQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SubnetInUse is the modeled exception returned for the
+// "SubnetInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SubnetInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorSubnetInUse(err awserr.RequestFailure) error {
+	return &SubnetInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SubnetInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SubnetInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SubnetNotAllowedFault is the modeled exception returned for the
+// "SubnetNotAllowedFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SubnetNotAllowedFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSubnetNotAllowedFault(err awserr.RequestFailure) error {
+	return &SubnetNotAllowedFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SubnetNotAllowedFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SubnetNotAllowedFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TagNotFoundFault is the modeled exception returned for the
+// "TagNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TagNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorTagNotFoundFault(err awserr.RequestFailure) error {
+	return &TagNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TagNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TagNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TagQuotaPerResourceExceeded is the modeled exception returned for the
+// "TagQuotaPerResourceExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TagQuotaPerResourceExceeded struct {
+	awserr.RequestFailure
+}
+
+func newErrorTagQuotaPerResourceExceeded(err awserr.RequestFailure) error {
+	return &TagQuotaPerResourceExceeded{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TagQuotaPerResourceExceeded) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TagQuotaPerResourceExceeded) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TestFailoverNotAvailableFault is the modeled exception returned for the
+// "TestFailoverNotAvailableFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TestFailoverNotAvailableFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorTestFailoverNotAvailableFault(err awserr.RequestFailure) error {
+	return &TestFailoverNotAvailableFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TestFailoverNotAvailableFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TestFailoverNotAvailableFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// UserAlreadyExistsFault is the modeled exception returned for the
+// "UserAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type UserAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorUserAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &UserAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *UserAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *UserAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// UserGroupAlreadyExistsFault is the modeled exception returned for the
+// "UserGroupAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type UserGroupAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorUserGroupAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &UserGroupAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *UserGroupAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *UserGroupAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// UserGroupNotFoundFault is the modeled exception returned for the
+// "UserGroupNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type UserGroupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorUserGroupNotFoundFault(err awserr.RequestFailure) error {
+	return &UserGroupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *UserGroupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *UserGroupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// UserGroupQuotaExceededFault is the modeled exception returned for the
+// "UserGroupQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type UserGroupQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorUserGroupQuotaExceededFault(err awserr.RequestFailure) error {
+	return &UserGroupQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *UserGroupQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *UserGroupQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// UserNotFoundFault is the modeled exception returned for the
+// "UserNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type UserNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorUserNotFoundFault(err awserr.RequestFailure) error {
+	return &UserNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *UserNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *UserNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// UserQuotaExceededFault is the modeled exception returned for the
+// "UserQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type UserQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorUserQuotaExceededFault(err awserr.RequestFailure) error {
+	return &UserQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *UserQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *UserQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+var exceptionFromCode = map[string]func(awserr.RequestFailure) error{
+	"APICallRateForCustomerExceeded":               newErrorAPICallRateForCustomerExceededFault,
+	"AuthorizationAlreadyExists":                   newErrorAuthorizationAlreadyExistsFault,
+	"AuthorizationNotFound":                        newErrorAuthorizationNotFoundFault,
+	"CacheClusterAlreadyExists":                    newErrorCacheClusterAlreadyExistsFault,
+	"CacheClusterNotFound":                         newErrorCacheClusterNotFoundFault,
+	"CacheParameterGroupAlreadyExists":             newErrorCacheParameterGroupAlreadyExistsFault,
+	"CacheParameterGroupNotFound":                  newErrorCacheParameterGroupNotFoundFault,
+	"CacheParameterGroupQuotaExceeded":             newErrorCacheParameterGroupQuotaExceededFault,
+	"CacheSecurityGroupAlreadyExists":              newErrorCacheSecurityGroupAlreadyExistsFault,
+	"CacheSecurityGroupNotFound":                   newErrorCacheSecurityGroupNotFoundFault,
+	"QuotaExceeded.CacheSecurityGroup":             newErrorCacheSecurityGroupQuotaExceededFault,
+	"CacheSubnetGroupAlreadyExists":                newErrorCacheSubnetGroupAlreadyExistsFault,
+	"CacheSubnetGroupInUse":                        newErrorCacheSubnetGroupInUse,
+	"CacheSubnetGroupNotFoundFault":                newErrorCacheSubnetGroupNotFoundFault,
+	"CacheSubnetGroupQuotaExceeded":                newErrorCacheSubnetGroupQuotaExceededFault,
+	"CacheSubnetQuotaExceededFault":                newErrorCacheSubnetQuotaExceededFault,
+	"ClusterQuotaForCustomerExceeded":              newErrorClusterQuotaForCustomerExceededFault,
+	"DefaultUserAssociatedToUserGroup":             newErrorDefaultUserAssociatedToUserGroupFault,
+	"DefaultUserRequired":                          newErrorDefaultUserRequired,
+	"DuplicateUserName":                            newErrorDuplicateUserNameFault,
+	"GlobalReplicationGroupAlreadyExistsFault":     newErrorGlobalReplicationGroupAlreadyExistsFault,
+	"GlobalReplicationGroupNotFoundFault":          newErrorGlobalReplicationGroupNotFoundFault,
+	"InsufficientCacheClusterCapacity":             newErrorInsufficientCacheClusterCapacityFault,
+	"InvalidARN":                                   newErrorInvalidARNFault,
+	"InvalidCacheClusterState":                     newErrorInvalidCacheClusterStateFault,
+	"InvalidCacheParameterGroupState":              newErrorInvalidCacheParameterGroupStateFault,
+	"InvalidCacheSecurityGroupState":               newErrorInvalidCacheSecurityGroupStateFault,
+	"InvalidCredentialsException":                  newErrorInvalidCredentialsException,
+	"InvalidGlobalReplicationGroupState":           newErrorInvalidGlobalReplicationGroupStateFault,
+	"InvalidKMSKeyFault":                           newErrorInvalidKMSKeyFault,
+	"InvalidParameterCombination":                  newErrorInvalidParameterCombinationException,
+	"InvalidParameterValue":                        newErrorInvalidParameterValueException,
+	"InvalidReplicationGroupState":                 newErrorInvalidReplicationGroupStateFault,
+	"InvalidServerlessCacheSnapshotStateFault":     newErrorInvalidServerlessCacheSnapshotStateFault,
+	"InvalidServerlessCacheStateFault":             newErrorInvalidServerlessCacheStateFault,
+	"InvalidSnapshotState":                         newErrorInvalidSnapshotStateFault,
+	"InvalidSubnet":                                newErrorInvalidSubnet,
+	"InvalidUserGroupState":                        newErrorInvalidUserGroupStateFault,
+	"InvalidUserState":                             newErrorInvalidUserStateFault,
+	"InvalidVPCNetworkStateFault":                  newErrorInvalidVPCNetworkStateFault,
+	"NoOperationFault":                             newErrorNoOperationFault,
+	"NodeGroupNotFoundFault":                       newErrorNodeGroupNotFoundFault,
+	"NodeGroupsPerReplicationGroupQuotaExceeded":   newErrorNodeGroupsPerReplicationGroupQuotaExceededFault,
+	"NodeQuotaForClusterExceeded":                  newErrorNodeQuotaForClusterExceededFault,
+	"NodeQuotaForCustomerExceeded":                 newErrorNodeQuotaForCustomerExceededFault,
+	"ReplicationGroupAlreadyExists":                newErrorReplicationGroupAlreadyExistsFault,
+	"ReplicationGroupAlreadyUnderMigrationFault":   newErrorReplicationGroupAlreadyUnderMigrationFault,
+	"ReplicationGroupNotFoundFault":                newErrorReplicationGroupNotFoundFault,
+	"ReplicationGroupNotUnderMigrationFault":       newErrorReplicationGroupNotUnderMigrationFault,
+	"ReservedCacheNodeAlreadyExists":               newErrorReservedCacheNodeAlreadyExistsFault,
+	"ReservedCacheNodeNotFound":                    newErrorReservedCacheNodeNotFoundFault,
+	"ReservedCacheNodeQuotaExceeded":               newErrorReservedCacheNodeQuotaExceededFault,
+	"ReservedCacheNodesOfferingNotFound":           newErrorReservedCacheNodesOfferingNotFoundFault,
+	"ServerlessCacheAlreadyExistsFault":            newErrorServerlessCacheAlreadyExistsFault,
+	"ServerlessCacheNotFoundFault":                 newErrorServerlessCacheNotFoundFault,
+	"ServerlessCacheQuotaForCustomerExceededFault": newErrorServerlessCacheQuotaForCustomerExceededFault,
+	"ServerlessCacheSnapshotAlreadyExistsFault":    newErrorServerlessCacheSnapshotAlreadyExistsFault,
+	"ServerlessCacheSnapshotNotFoundFault":         newErrorServerlessCacheSnapshotNotFoundFault,
+	"ServerlessCacheSnapshotQuotaExceededFault":    newErrorServerlessCacheSnapshotQuotaExceededFault,
+	"ServiceLinkedRoleNotFoundFault":               newErrorServiceLinkedRoleNotFoundFault,
+	"ServiceUpdateNotFoundFault":                   newErrorServiceUpdateNotFoundFault,
+	"SnapshotAlreadyExistsFault":                   newErrorSnapshotAlreadyExistsFault,
+	"SnapshotFeatureNotSupportedFault":             newErrorSnapshotFeatureNotSupportedFault,
+	"SnapshotNotFoundFault":                        newErrorSnapshotNotFoundFault,
+	"SnapshotQuotaExceededFault":                   newErrorSnapshotQuotaExceededFault,
+	"SubnetInUse":                                  newErrorSubnetInUse,
+	"SubnetNotAllowedFault":                        newErrorSubnetNotAllowedFault,
+	"TagNotFound":                                  newErrorTagNotFoundFault,
+	"TagQuotaPerResourceExceeded":                  newErrorTagQuotaPerResourceExceeded,
+	"TestFailoverNotAvailableFault":                newErrorTestFailoverNotAvailableFault,
+	"UserAlreadyExists":                            newErrorUserAlreadyExistsFault,
+	"UserGroupAlreadyExists":                       newErrorUserGroupAlreadyExistsFault,
+	"UserGroupNotFound":                            newErrorUserGroupNotFoundFault,
+	"UserGroupQuotaExceeded":                       newErrorUserGroupQuotaExceededFault,
+	"UserNotFound":                                 newErrorUserNotFoundFault,
+	"UserQuotaExceeded":                            newErrorUserQuotaExceededFault,
+}
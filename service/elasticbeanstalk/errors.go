@@ -2,6 +2,10 @@
 
 package elasticbeanstalk
 
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
 const (
 
 	// ErrCodeCodeBuildNotInServiceRegionException for service response error code
@@ -136,3 +140,519 @@ const (
 	// already has and the tags this operation would add if it succeeded.
 	ErrCodeTooManyTagsException = "TooManyTagsException"
 )
+
+// CodeBuildNotInServiceRegionException is the modeled exception returned for the
+// "CodeBuildNotInServiceRegionException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CodeBuildNotInServiceRegionException struct {
+	awserr.RequestFailure
+}
+
+func newErrorCodeBuildNotInServiceRegionException(err awserr.RequestFailure) error {
+	return &CodeBuildNotInServiceRegionException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CodeBuildNotInServiceRegionException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CodeBuildNotInServiceRegionException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InsufficientPrivilegesException is the modeled exception returned for the
+// "InsufficientPrivilegesException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InsufficientPrivilegesException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInsufficientPrivilegesException(err awserr.RequestFailure) error {
+	return &InsufficientPrivilegesException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InsufficientPrivilegesException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InsufficientPrivilegesException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidRequestException is the modeled exception returned for the
+// "InvalidRequestException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidRequestException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidRequestException(err awserr.RequestFailure) error {
+	return &InvalidRequestException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidRequestException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidRequestException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ManagedActionInvalidStateException is the modeled exception returned for the
+// "ManagedActionInvalidStateException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ManagedActionInvalidStateException struct {
+	awserr.RequestFailure
+}
+
+func newErrorManagedActionInvalidStateException(err awserr.RequestFailure) error {
+	return &ManagedActionInvalidStateException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ManagedActionInvalidStateException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ManagedActionInvalidStateException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// OperationInProgressException is the modeled exception returned for the
+// "OperationInProgressFailure" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type OperationInProgressException struct {
+	awserr.RequestFailure
+}
+
+func newErrorOperationInProgressException(err awserr.RequestFailure) error {
+	return &OperationInProgressException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *OperationInProgressException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *OperationInProgressException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// PlatformVersionStillReferencedException is the modeled exception returned for the
+// "PlatformVersionStillReferencedException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type PlatformVersionStillReferencedException struct {
+	awserr.RequestFailure
+}
+
+func newErrorPlatformVersionStillReferencedException(err awserr.RequestFailure) error {
+	return &PlatformVersionStillReferencedException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *PlatformVersionStillReferencedException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *PlatformVersionStillReferencedException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ResourceNotFoundException is the modeled exception returned for the
+// "ResourceNotFoundException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ResourceNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorResourceNotFoundException(err awserr.RequestFailure) error {
+	return &ResourceNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ResourceNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ResourceNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ResourceTypeNotSupportedException is the modeled exception returned for the
+// "ResourceTypeNotSupportedException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ResourceTypeNotSupportedException struct {
+	awserr.RequestFailure
+}
+
+func newErrorResourceTypeNotSupportedException(err awserr.RequestFailure) error {
+	return &ResourceTypeNotSupportedException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ResourceTypeNotSupportedException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ResourceTypeNotSupportedException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// S3LocationNotInServiceRegionException is the modeled exception returned for the
+// "S3LocationNotInServiceRegionException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type S3LocationNotInServiceRegionException struct {
+	awserr.RequestFailure
+}
+
+func newErrorS3LocationNotInServiceRegionException(err awserr.RequestFailure) error {
+	return &S3LocationNotInServiceRegionException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *S3LocationNotInServiceRegionException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *S3LocationNotInServiceRegionException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// S3SubscriptionRequiredException is the modeled exception returned for the
+// "S3SubscriptionRequiredException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type S3SubscriptionRequiredException struct {
+	awserr.RequestFailure
+}
+
+func newErrorS3SubscriptionRequiredException(err awserr.RequestFailure) error {
+	return &S3SubscriptionRequiredException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *S3SubscriptionRequiredException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *S3SubscriptionRequiredException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ServiceException is the modeled exception returned for the
+// "ElasticBeanstalkServiceException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ServiceException struct {
+	awserr.RequestFailure
+}
+
+func newErrorServiceException(err awserr.RequestFailure) error {
+	return &ServiceException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ServiceException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ServiceException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SourceBundleDeletionException is the modeled exception returned for the
+// "SourceBundleDeletionFailure" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SourceBundleDeletionException struct {
+	awserr.RequestFailure
+}
+
+func newErrorSourceBundleDeletionException(err awserr.RequestFailure) error {
+	return &SourceBundleDeletionException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SourceBundleDeletionException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SourceBundleDeletionException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyApplicationVersionsException is the modeled exception returned for the
+// "TooManyApplicationVersionsException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyApplicationVersionsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyApplicationVersionsException(err awserr.RequestFailure) error {
+	return &TooManyApplicationVersionsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyApplicationVersionsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyApplicationVersionsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyApplicationsException is the modeled exception returned for the
+// "TooManyApplicationsException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyApplicationsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyApplicationsException(err awserr.RequestFailure) error {
+	return &TooManyApplicationsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyApplicationsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyApplicationsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyBucketsException is the modeled exception returned for the
+// "TooManyBucketsException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyBucketsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyBucketsException(err awserr.RequestFailure) error {
+	return &TooManyBucketsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyBucketsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyBucketsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyConfigurationTemplatesException is the modeled exception returned for the
+// "TooManyConfigurationTemplatesException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyConfigurationTemplatesException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyConfigurationTemplatesException(err awserr.RequestFailure) error {
+	return &TooManyConfigurationTemplatesException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyConfigurationTemplatesException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyConfigurationTemplatesException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyEnvironmentsException is the modeled exception returned for the
+// "TooManyEnvironmentsException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyEnvironmentsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyEnvironmentsException(err awserr.RequestFailure) error {
+	return &TooManyEnvironmentsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyEnvironmentsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyEnvironmentsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyPlatformsException is the modeled exception returned for the
+// "TooManyPlatformsException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyPlatformsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyPlatformsException(err awserr.RequestFailure) error {
+	return &TooManyPlatformsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyPlatformsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyPlatformsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyTagsException is the modeled exception returned for the
+// "TooManyTagsException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyTagsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyTagsException(err awserr.RequestFailure) error {
+	return &TooManyTagsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyTagsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyTagsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+var exceptionFromCode = map[string]func(awserr.RequestFailure) error{
+	"CodeBuildNotInServiceRegionException":    newErrorCodeBuildNotInServiceRegionException,
+	"InsufficientPrivilegesException":         newErrorInsufficientPrivilegesException,
+	"InvalidRequestException":                 newErrorInvalidRequestException,
+	"ManagedActionInvalidStateException":      newErrorManagedActionInvalidStateException,
+	"OperationInProgressFailure":              newErrorOperationInProgressException,
+	"PlatformVersionStillReferencedException": newErrorPlatformVersionStillReferencedException,
+	"ResourceNotFoundException":               newErrorResourceNotFoundException,
+	"ResourceTypeNotSupportedException":       newErrorResourceTypeNotSupportedException,
+	"S3LocationNotInServiceRegionException":   newErrorS3LocationNotInServiceRegionException,
+	"S3SubscriptionRequiredException":         newErrorS3SubscriptionRequiredException,
+	"ElasticBeanstalkServiceException":        newErrorServiceException,
+	"SourceBundleDeletionFailure":             newErrorSourceBundleDeletionException,
+	"TooManyApplicationVersionsException":     newErrorTooManyApplicationVersionsException,
+	"TooManyApplicationsException":            newErrorTooManyApplicationsException,
+	"TooManyBucketsException":                 newErrorTooManyBucketsException,
+	"TooManyConfigurationTemplatesException":  newErrorTooManyConfigurationTemplatesException,
+	"TooManyEnvironmentsException":            newErrorTooManyEnvironmentsException,
+	"TooManyPlatformsException":               newErrorTooManyPlatformsException,
+	"TooManyTagsException":                    newErrorTooManyTagsException,
+}
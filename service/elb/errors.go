@@ -2,6 +2,10 @@
 
 package elb
 
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
 const (
 
 	// ErrCodeAccessPointNotFoundException for service response error code
@@ -143,3 +147,600 @@ const (
 	// The specified protocol or signature version is not supported.
 	ErrCodeUnsupportedProtocolException = "UnsupportedProtocol"
 )
+
+// AccessPointNotFoundException is the modeled exception returned for the
+// "LoadBalancerNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AccessPointNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorAccessPointNotFoundException(err awserr.RequestFailure) error {
+	return &AccessPointNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AccessPointNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AccessPointNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CertificateNotFoundException is the modeled exception returned for the
+// "CertificateNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CertificateNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorCertificateNotFoundException(err awserr.RequestFailure) error {
+	return &CertificateNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CertificateNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CertificateNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DependencyThrottleException is the modeled exception returned for the
+// "DependencyThrottle" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DependencyThrottleException struct {
+	awserr.RequestFailure
+}
+
+func newErrorDependencyThrottleException(err awserr.RequestFailure) error {
+	return &DependencyThrottleException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DependencyThrottleException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DependencyThrottleException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DuplicateAccessPointNameException is the modeled exception returned for the
+// "DuplicateLoadBalancerName" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DuplicateAccessPointNameException struct {
+	awserr.RequestFailure
+}
+
+func newErrorDuplicateAccessPointNameException(err awserr.RequestFailure) error {
+	return &DuplicateAccessPointNameException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DuplicateAccessPointNameException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DuplicateAccessPointNameException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DuplicateListenerException is the modeled exception returned for the
+// "DuplicateListener" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DuplicateListenerException struct {
+	awserr.RequestFailure
+}
+
+func newErrorDuplicateListenerException(err awserr.RequestFailure) error {
+	return &DuplicateListenerException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DuplicateListenerException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DuplicateListenerException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DuplicatePolicyNameException is the modeled exception returned for the
+// "DuplicatePolicyName" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DuplicatePolicyNameException struct {
+	awserr.RequestFailure
+}
+
+func newErrorDuplicatePolicyNameException(err awserr.RequestFailure) error {
+	return &DuplicatePolicyNameException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DuplicatePolicyNameException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DuplicatePolicyNameException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DuplicateTagKeysException is the modeled exception returned for the
+// "DuplicateTagKeys" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DuplicateTagKeysException struct {
+	awserr.RequestFailure
+}
+
+func newErrorDuplicateTagKeysException(err awserr.RequestFailure) error {
+	return &DuplicateTagKeysException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DuplicateTagKeysException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DuplicateTagKeysException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidConfigurationRequestException is the modeled exception returned for the
+// "InvalidConfigurationRequest" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidConfigurationRequestException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidConfigurationRequestException(err awserr.RequestFailure) error {
+	return &InvalidConfigurationRequestException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidConfigurationRequestException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidConfigurationRequestException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidEndPointException is the modeled exception returned for the
+// "InvalidInstance" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidEndPointException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidEndPointException(err awserr.RequestFailure) error {
+	return &InvalidEndPointException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidEndPointException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidEndPointException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidSchemeException is the modeled exception returned for the
+// "InvalidScheme" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidSchemeException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidSchemeException(err awserr.RequestFailure) error {
+	return &InvalidSchemeException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidSchemeException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidSchemeException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidSecurityGroupException is the modeled exception returned for the
+// "InvalidSecurityGroup" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidSecurityGroupException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidSecurityGroupException(err awserr.RequestFailure) error {
+	return &InvalidSecurityGroupException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidSecurityGroupException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidSecurityGroupException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidSubnetException is the modeled exception returned for the
+// "InvalidSubnet" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidSubnetException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidSubnetException(err awserr.RequestFailure) error {
+	return &InvalidSubnetException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidSubnetException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidSubnetException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ListenerNotFoundException is the modeled exception returned for the
+// "ListenerNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ListenerNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorListenerNotFoundException(err awserr.RequestFailure) error {
+	return &ListenerNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ListenerNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ListenerNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// LoadBalancerAttributeNotFoundException is the modeled exception returned for the
+// "LoadBalancerAttributeNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type LoadBalancerAttributeNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorLoadBalancerAttributeNotFoundException(err awserr.RequestFailure) error {
+	return &LoadBalancerAttributeNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *LoadBalancerAttributeNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *LoadBalancerAttributeNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// OperationNotPermittedException is the modeled exception returned for the
+// "OperationNotPermitted" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type OperationNotPermittedException struct {
+	awserr.RequestFailure
+}
+
+func newErrorOperationNotPermittedException(err awserr.RequestFailure) error {
+	return &OperationNotPermittedException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *OperationNotPermittedException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *OperationNotPermittedException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// PolicyNotFoundException is the modeled exception returned for the
+// "PolicyNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type PolicyNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorPolicyNotFoundException(err awserr.RequestFailure) error {
+	return &PolicyNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *PolicyNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *PolicyNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// PolicyTypeNotFoundException is the modeled exception returned for the
+// "PolicyTypeNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type PolicyTypeNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorPolicyTypeNotFoundException(err awserr.RequestFailure) error {
+	return &PolicyTypeNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *PolicyTypeNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *PolicyTypeNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SubnetNotFoundException is the modeled exception returned for the
+// "SubnetNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SubnetNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorSubnetNotFoundException(err awserr.RequestFailure) error {
+	return &SubnetNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SubnetNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SubnetNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyAccessPointsException is the modeled exception returned for the
+// "TooManyLoadBalancers" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyAccessPointsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyAccessPointsException(err awserr.RequestFailure) error {
+	return &TooManyAccessPointsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyAccessPointsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyAccessPointsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyPoliciesException is the modeled exception returned for the
+// "TooManyPolicies" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyPoliciesException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyPoliciesException(err awserr.RequestFailure) error {
+	return &TooManyPoliciesException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyPoliciesException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyPoliciesException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyTagsException is the modeled exception returned for the
+// "TooManyTags" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyTagsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyTagsException(err awserr.RequestFailure) error {
+	return &TooManyTagsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyTagsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyTagsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// UnsupportedProtocolException is the modeled exception returned for the
+// "UnsupportedProtocol" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type UnsupportedProtocolException struct {
+	awserr.RequestFailure
+}
+
+func newErrorUnsupportedProtocolException(err awserr.RequestFailure) error {
+	return &UnsupportedProtocolException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *UnsupportedProtocolException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *UnsupportedProtocolException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+var exceptionFromCode = map[string]func(awserr.RequestFailure) error{
+	"LoadBalancerNotFound":          newErrorAccessPointNotFoundException,
+	"CertificateNotFound":           newErrorCertificateNotFoundException,
+	"DependencyThrottle":            newErrorDependencyThrottleException,
+	"DuplicateLoadBalancerName":     newErrorDuplicateAccessPointNameException,
+	"DuplicateListener":             newErrorDuplicateListenerException,
+	"DuplicatePolicyName":           newErrorDuplicatePolicyNameException,
+	"DuplicateTagKeys":              newErrorDuplicateTagKeysException,
+	"InvalidConfigurationRequest":   newErrorInvalidConfigurationRequestException,
+	"InvalidInstance":               newErrorInvalidEndPointException,
+	"InvalidScheme":                 newErrorInvalidSchemeException,
+	"InvalidSecurityGroup":          newErrorInvalidSecurityGroupException,
+	"InvalidSubnet":                 newErrorInvalidSubnetException,
+	"ListenerNotFound":              newErrorListenerNotFoundException,
+	"LoadBalancerAttributeNotFound": newErrorLoadBalancerAttributeNotFoundException,
+	"OperationNotPermitted":         newErrorOperationNotPermittedException,
+	"PolicyNotFound":                newErrorPolicyNotFoundException,
+	"PolicyTypeNotFound":            newErrorPolicyTypeNotFoundException,
+	"SubnetNotFound":                newErrorSubnetNotFoundException,
+	"TooManyLoadBalancers":          newErrorTooManyAccessPointsException,
+	"TooManyPolicies":               newErrorTooManyPoliciesException,
+	"TooManyTags":                   newErrorTooManyTagsException,
+	"UnsupportedProtocol":           newErrorUnsupportedProtocolException,
+}
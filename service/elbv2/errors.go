@@ -2,6 +2,10 @@
 
 package elbv2
 
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
 const (
 
 	// ErrCodeALPNPolicyNotSupportedException for service response error code
@@ -318,3 +322,1383 @@ const (
 	// The specified protocol is not supported.
 	ErrCodeUnsupportedProtocolException = "UnsupportedProtocol"
 )
+
+// ALPNPolicyNotSupportedException is the modeled exception returned for the
+// "ALPNPolicyNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ALPNPolicyNotSupportedException struct {
+	awserr.RequestFailure
+}
+
+func newErrorALPNPolicyNotSupportedException(err awserr.RequestFailure) error {
+	return &ALPNPolicyNotSupportedException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ALPNPolicyNotSupportedException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ALPNPolicyNotSupportedException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// AllocationIdNotFoundException is the modeled exception returned for the
+// "AllocationIdNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AllocationIdNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorAllocationIdNotFoundException(err awserr.RequestFailure) error {
+	return &AllocationIdNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AllocationIdNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AllocationIdNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// AvailabilityZoneNotSupportedException is the modeled exception returned for the
+// "AvailabilityZoneNotSupported" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AvailabilityZoneNotSupportedException struct {
+	awserr.RequestFailure
+}
+
+func newErrorAvailabilityZoneNotSupportedException(err awserr.RequestFailure) error {
+	return &AvailabilityZoneNotSupportedException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AvailabilityZoneNotSupportedException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AvailabilityZoneNotSupportedException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CaCertificatesBundleNotFoundException is the modeled exception returned for the
+// "CaCertificatesBundleNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CaCertificatesBundleNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorCaCertificatesBundleNotFoundException(err awserr.RequestFailure) error {
+	return &CaCertificatesBundleNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CaCertificatesBundleNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CaCertificatesBundleNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CertificateNotFoundException is the modeled exception returned for the
+// "CertificateNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CertificateNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorCertificateNotFoundException(err awserr.RequestFailure) error {
+	return &CertificateNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CertificateNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CertificateNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DeleteAssociationSameAccountException is the modeled exception returned for the
+// "DeleteAssociationSameAccount" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DeleteAssociationSameAccountException struct {
+	awserr.RequestFailure
+}
+
+func newErrorDeleteAssociationSameAccountException(err awserr.RequestFailure) error {
+	return &DeleteAssociationSameAccountException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DeleteAssociationSameAccountException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DeleteAssociationSameAccountException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DuplicateListenerException is the modeled exception returned for the
+// "DuplicateListener" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DuplicateListenerException struct {
+	awserr.RequestFailure
+}
+
+func newErrorDuplicateListenerException(err awserr.RequestFailure) error {
+	return &DuplicateListenerException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DuplicateListenerException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DuplicateListenerException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DuplicateLoadBalancerNameException is the modeled exception returned for the
+// "DuplicateLoadBalancerName" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DuplicateLoadBalancerNameException struct {
+	awserr.RequestFailure
+}
+
+func newErrorDuplicateLoadBalancerNameException(err awserr.RequestFailure) error {
+	return &DuplicateLoadBalancerNameException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DuplicateLoadBalancerNameException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DuplicateLoadBalancerNameException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DuplicateTagKeysException is the modeled exception returned for the
+// "DuplicateTagKeys" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DuplicateTagKeysException struct {
+	awserr.RequestFailure
+}
+
+func newErrorDuplicateTagKeysException(err awserr.RequestFailure) error {
+	return &DuplicateTagKeysException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DuplicateTagKeysException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DuplicateTagKeysException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DuplicateTargetGroupNameException is the modeled exception returned for the
+// "DuplicateTargetGroupName" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DuplicateTargetGroupNameException struct {
+	awserr.RequestFailure
+}
+
+func newErrorDuplicateTargetGroupNameException(err awserr.RequestFailure) error {
+	return &DuplicateTargetGroupNameException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DuplicateTargetGroupNameException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DuplicateTargetGroupNameException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DuplicateTrustStoreNameException is the modeled exception returned for the
+// "DuplicateTrustStoreName" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DuplicateTrustStoreNameException struct {
+	awserr.RequestFailure
+}
+
+func newErrorDuplicateTrustStoreNameException(err awserr.RequestFailure) error {
+	return &DuplicateTrustStoreNameException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DuplicateTrustStoreNameException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DuplicateTrustStoreNameException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// HealthUnavailableException is the modeled exception returned for the
+// "HealthUnavailable" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type HealthUnavailableException struct {
+	awserr.RequestFailure
+}
+
+func newErrorHealthUnavailableException(err awserr.RequestFailure) error {
+	return &HealthUnavailableException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *HealthUnavailableException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *HealthUnavailableException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// IncompatibleProtocolsException is the modeled exception returned for the
+// "IncompatibleProtocols" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type IncompatibleProtocolsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorIncompatibleProtocolsException(err awserr.RequestFailure) error {
+	return &IncompatibleProtocolsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *IncompatibleProtocolsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *IncompatibleProtocolsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidCaCertificatesBundleException is the modeled exception returned for the
+// "InvalidCaCertificatesBundle" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidCaCertificatesBundleException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidCaCertificatesBundleException(err awserr.RequestFailure) error {
+	return &InvalidCaCertificatesBundleException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidCaCertificatesBundleException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidCaCertificatesBundleException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidConfigurationRequestException is the modeled exception returned for the
+// "InvalidConfigurationRequest" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidConfigurationRequestException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidConfigurationRequestException(err awserr.RequestFailure) error {
+	return &InvalidConfigurationRequestException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidConfigurationRequestException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidConfigurationRequestException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidLoadBalancerActionException is the modeled exception returned for the
+// "InvalidLoadBalancerAction" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidLoadBalancerActionException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidLoadBalancerActionException(err awserr.RequestFailure) error {
+	return &InvalidLoadBalancerActionException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidLoadBalancerActionException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidLoadBalancerActionException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidRevocationContentException is the modeled exception returned for the
+// "InvalidRevocationContent" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidRevocationContentException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidRevocationContentException(err awserr.RequestFailure) error {
+	return &InvalidRevocationContentException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidRevocationContentException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidRevocationContentException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidSchemeException is the modeled exception returned for the
+// "InvalidScheme" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidSchemeException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidSchemeException(err awserr.RequestFailure) error {
+	return &InvalidSchemeException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidSchemeException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidSchemeException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidSecurityGroupException is the modeled exception returned for the
+// "InvalidSecurityGroup" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidSecurityGroupException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidSecurityGroupException(err awserr.RequestFailure) error {
+	return &InvalidSecurityGroupException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidSecurityGroupException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidSecurityGroupException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidSubnetException is the modeled exception returned for the
+// "InvalidSubnet" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidSubnetException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidSubnetException(err awserr.RequestFailure) error {
+	return &InvalidSubnetException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidSubnetException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidSubnetException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidTargetException is the modeled exception returned for the
+// "InvalidTarget" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidTargetException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidTargetException(err awserr.RequestFailure) error {
+	return &InvalidTargetException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidTargetException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidTargetException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ListenerNotFoundException is the modeled exception returned for the
+// "ListenerNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ListenerNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorListenerNotFoundException(err awserr.RequestFailure) error {
+	return &ListenerNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ListenerNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ListenerNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// LoadBalancerNotFoundException is the modeled exception returned for the
+// "LoadBalancerNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type LoadBalancerNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorLoadBalancerNotFoundException(err awserr.RequestFailure) error {
+	return &LoadBalancerNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *LoadBalancerNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *LoadBalancerNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// OperationNotPermittedException is the modeled exception returned for the
+// "OperationNotPermitted" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type OperationNotPermittedException struct {
+	awserr.RequestFailure
+}
+
+func newErrorOperationNotPermittedException(err awserr.RequestFailure) error {
+	return &OperationNotPermittedException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *OperationNotPermittedException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *OperationNotPermittedException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// PriorityInUseException is the modeled exception returned for the
+// "PriorityInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type PriorityInUseException struct {
+	awserr.RequestFailure
+}
+
+func newErrorPriorityInUseException(err awserr.RequestFailure) error {
+	return &PriorityInUseException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *PriorityInUseException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *PriorityInUseException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ResourceInUseException is the modeled exception returned for the
+// "ResourceInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ResourceInUseException struct {
+	awserr.RequestFailure
+}
+
+func newErrorResourceInUseException(err awserr.RequestFailure) error {
+	return &ResourceInUseException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ResourceInUseException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ResourceInUseException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ResourceNotFoundException is the modeled exception returned for the
+// "ResourceNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ResourceNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorResourceNotFoundException(err awserr.RequestFailure) error {
+	return &ResourceNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ResourceNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ResourceNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// RevocationContentNotFoundException is the modeled exception returned for the
+// "RevocationContentNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type RevocationContentNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorRevocationContentNotFoundException(err awserr.RequestFailure) error {
+	return &RevocationContentNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *RevocationContentNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *RevocationContentNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// RevocationIdNotFoundException is the modeled exception returned for the
+// "RevocationIdNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type RevocationIdNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorRevocationIdNotFoundException(err awserr.RequestFailure) error {
+	return &RevocationIdNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *RevocationIdNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *RevocationIdNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// RuleNotFoundException is the modeled exception returned for the
+// "RuleNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type RuleNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorRuleNotFoundException(err awserr.RequestFailure) error {
+	return &RuleNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *RuleNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *RuleNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SSLPolicyNotFoundException is the modeled exception returned for the
+// "SSLPolicyNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SSLPolicyNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorSSLPolicyNotFoundException(err awserr.RequestFailure) error {
+	return &SSLPolicyNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SSLPolicyNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SSLPolicyNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SubnetNotFoundException is the modeled exception returned for the
+// "SubnetNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SubnetNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorSubnetNotFoundException(err awserr.RequestFailure) error {
+	return &SubnetNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SubnetNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SubnetNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TargetGroupAssociationLimitException is the modeled exception returned for the
+// "TargetGroupAssociationLimit" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TargetGroupAssociationLimitException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTargetGroupAssociationLimitException(err awserr.RequestFailure) error {
+	return &TargetGroupAssociationLimitException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TargetGroupAssociationLimitException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TargetGroupAssociationLimitException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TargetGroupNotFoundException is the modeled exception returned for the
+// "TargetGroupNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TargetGroupNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTargetGroupNotFoundException(err awserr.RequestFailure) error {
+	return &TargetGroupNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TargetGroupNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TargetGroupNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyActionsException is the modeled exception returned for the
+// "TooManyActions" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyActionsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyActionsException(err awserr.RequestFailure) error {
+	return &TooManyActionsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyActionsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyActionsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyCertificatesException is the modeled exception returned for the
+// "TooManyCertificates" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyCertificatesException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyCertificatesException(err awserr.RequestFailure) error {
+	return &TooManyCertificatesException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyCertificatesException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyCertificatesException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyListenersException is the modeled exception returned for the
+// "TooManyListeners" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyListenersException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyListenersException(err awserr.RequestFailure) error {
+	return &TooManyListenersException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyListenersException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyListenersException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyLoadBalancersException is the modeled exception returned for the
+// "TooManyLoadBalancers" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyLoadBalancersException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyLoadBalancersException(err awserr.RequestFailure) error {
+	return &TooManyLoadBalancersException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyLoadBalancersException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyLoadBalancersException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyRegistrationsForTargetIdException is the modeled exception returned for the
+// "TooManyRegistrationsForTargetId" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyRegistrationsForTargetIdException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyRegistrationsForTargetIdException(err awserr.RequestFailure) error {
+	return &TooManyRegistrationsForTargetIdException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyRegistrationsForTargetIdException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyRegistrationsForTargetIdException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyRulesException is the modeled exception returned for the
+// "TooManyRules" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyRulesException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyRulesException(err awserr.RequestFailure) error {
+	return &TooManyRulesException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyRulesException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyRulesException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyTagsException is the modeled exception returned for the
+// "TooManyTags" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyTagsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyTagsException(err awserr.RequestFailure) error {
+	return &TooManyTagsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyTagsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyTagsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyTargetGroupsException is the modeled exception returned for the
+// "TooManyTargetGroups" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyTargetGroupsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyTargetGroupsException(err awserr.RequestFailure) error {
+	return &TooManyTargetGroupsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyTargetGroupsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyTargetGroupsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyTargetsException is the modeled exception returned for the
+// "TooManyTargets" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyTargetsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyTargetsException(err awserr.RequestFailure) error {
+	return &TooManyTargetsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyTargetsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyTargetsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyTrustStoreRevocationEntriesException is the modeled exception returned for the
+// "TooManyTrustStoreRevocationEntries" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyTrustStoreRevocationEntriesException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyTrustStoreRevocationEntriesException(err awserr.RequestFailure) error {
+	return &TooManyTrustStoreRevocationEntriesException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyTrustStoreRevocationEntriesException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyTrustStoreRevocationEntriesException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyTrustStoresException is the modeled exception returned for the
+// "TooManyTrustStores" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyTrustStoresException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyTrustStoresException(err awserr.RequestFailure) error {
+	return &TooManyTrustStoresException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyTrustStoresException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyTrustStoresException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyUniqueTargetGroupsPerLoadBalancerException is the modeled exception returned for the
+// "TooManyUniqueTargetGroupsPerLoadBalancer" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyUniqueTargetGroupsPerLoadBalancerException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyUniqueTargetGroupsPerLoadBalancerException(err awserr.RequestFailure) error {
+	return &TooManyUniqueTargetGroupsPerLoadBalancerException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyUniqueTargetGroupsPerLoadBalancerException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyUniqueTargetGroupsPerLoadBalancerException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TrustStoreAssociationNotFoundException is the modeled exception returned for the
+// "AssociationNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TrustStoreAssociationNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTrustStoreAssociationNotFoundException(err awserr.RequestFailure) error {
+	return &TrustStoreAssociationNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TrustStoreAssociationNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TrustStoreAssociationNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TrustStoreInUseException is the modeled exception returned for the
+// "TrustStoreInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TrustStoreInUseException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTrustStoreInUseException(err awserr.RequestFailure) error {
+	return &TrustStoreInUseException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TrustStoreInUseException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TrustStoreInUseException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TrustStoreNotFoundException is the modeled exception returned for the
+// "TrustStoreNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TrustStoreNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTrustStoreNotFoundException(err awserr.RequestFailure) error {
+	return &TrustStoreNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TrustStoreNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TrustStoreNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TrustStoreNotReadyException is the modeled exception returned for the
+// "TrustStoreNotReady" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TrustStoreNotReadyException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTrustStoreNotReadyException(err awserr.RequestFailure) error {
+	return &TrustStoreNotReadyException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TrustStoreNotReadyException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TrustStoreNotReadyException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// UnsupportedProtocolException is the modeled exception returned for the
+// "UnsupportedProtocol" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type UnsupportedProtocolException struct {
+	awserr.RequestFailure
+}
+
+func newErrorUnsupportedProtocolException(err awserr.RequestFailure) error {
+	return &UnsupportedProtocolException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *UnsupportedProtocolException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *UnsupportedProtocolException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+var exceptionFromCode = map[string]func(awserr.RequestFailure) error{
+	"ALPNPolicyNotFound":                       newErrorALPNPolicyNotSupportedException,
+	"AllocationIdNotFound":                     newErrorAllocationIdNotFoundException,
+	"AvailabilityZoneNotSupported":             newErrorAvailabilityZoneNotSupportedException,
+	"CaCertificatesBundleNotFound":             newErrorCaCertificatesBundleNotFoundException,
+	"CertificateNotFound":                      newErrorCertificateNotFoundException,
+	"DeleteAssociationSameAccount":             newErrorDeleteAssociationSameAccountException,
+	"DuplicateListener":                        newErrorDuplicateListenerException,
+	"DuplicateLoadBalancerName":                newErrorDuplicateLoadBalancerNameException,
+	"DuplicateTagKeys":                         newErrorDuplicateTagKeysException,
+	"DuplicateTargetGroupName":                 newErrorDuplicateTargetGroupNameException,
+	"DuplicateTrustStoreName":                  newErrorDuplicateTrustStoreNameException,
+	"HealthUnavailable":                        newErrorHealthUnavailableException,
+	"IncompatibleProtocols":                    newErrorIncompatibleProtocolsException,
+	"InvalidCaCertificatesBundle":              newErrorInvalidCaCertificatesBundleException,
+	"InvalidConfigurationRequest":              newErrorInvalidConfigurationRequestException,
+	"InvalidLoadBalancerAction":                newErrorInvalidLoadBalancerActionException,
+	"InvalidRevocationContent":                 newErrorInvalidRevocationContentException,
+	"InvalidScheme":                            newErrorInvalidSchemeException,
+	"InvalidSecurityGroup":                     newErrorInvalidSecurityGroupException,
+	"InvalidSubnet":                            newErrorInvalidSubnetException,
+	"InvalidTarget":                            newErrorInvalidTargetException,
+	"ListenerNotFound":                         newErrorListenerNotFoundException,
+	"LoadBalancerNotFound":                     newErrorLoadBalancerNotFoundException,
+	"OperationNotPermitted":                    newErrorOperationNotPermittedException,
+	"PriorityInUse":                            newErrorPriorityInUseException,
+	"ResourceInUse":                            newErrorResourceInUseException,
+	"ResourceNotFound":                         newErrorResourceNotFoundException,
+	"RevocationContentNotFound":                newErrorRevocationContentNotFoundException,
+	"RevocationIdNotFound":                     newErrorRevocationIdNotFoundException,
+	"RuleNotFound":                             newErrorRuleNotFoundException,
+	"SSLPolicyNotFound":                        newErrorSSLPolicyNotFoundException,
+	"SubnetNotFound":                           newErrorSubnetNotFoundException,
+	"TargetGroupAssociationLimit":              newErrorTargetGroupAssociationLimitException,
+	"TargetGroupNotFound":                      newErrorTargetGroupNotFoundException,
+	"TooManyActions":                           newErrorTooManyActionsException,
+	"TooManyCertificates":                      newErrorTooManyCertificatesException,
+	"TooManyListeners":                         newErrorTooManyListenersException,
+	"TooManyLoadBalancers":                     newErrorTooManyLoadBalancersException,
+	"TooManyRegistrationsForTargetId":          newErrorTooManyRegistrationsForTargetIdException,
+	"TooManyRules":                             newErrorTooManyRulesException,
+	"TooManyTags":                              newErrorTooManyTagsException,
+	"TooManyTargetGroups":                      newErrorTooManyTargetGroupsException,
+	"TooManyTargets":                           newErrorTooManyTargetsException,
+	"TooManyTrustStoreRevocationEntries":       newErrorTooManyTrustStoreRevocationEntriesException,
+	"TooManyTrustStores":                       newErrorTooManyTrustStoresException,
+	"TooManyUniqueTargetGroupsPerLoadBalancer": newErrorTooManyUniqueTargetGroupsPerLoadBalancerException,
+	"AssociationNotFound":                      newErrorTrustStoreAssociationNotFoundException,
+	"TrustStoreInUse":                          newErrorTrustStoreInUseException,
+	"TrustStoreNotFound":                       newErrorTrustStoreNotFoundException,
+	"TrustStoreNotReady":                       newErrorTrustStoreNotReadyException,
+	"UnsupportedProtocol":                      newErrorUnsupportedProtocolException,
+}
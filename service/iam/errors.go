@@ -2,6 +2,10 @@
 
 package iam
 
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
 const (
 
 	// ErrCodeConcurrentModificationException for service response error code
@@ -207,3 +211,762 @@ const (
 	// or unrecognized.
 	ErrCodeUnrecognizedPublicKeyEncodingException = "UnrecognizedPublicKeyEncoding"
 )
+
+// ConcurrentModificationException is the modeled exception returned for the
+// "ConcurrentModification" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ConcurrentModificationException struct {
+	awserr.RequestFailure
+}
+
+func newErrorConcurrentModificationException(err awserr.RequestFailure) error {
+	return &ConcurrentModificationException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ConcurrentModificationException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ConcurrentModificationException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CredentialReportExpiredException is the modeled exception returned for the
+// "ReportExpired" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CredentialReportExpiredException struct {
+	awserr.RequestFailure
+}
+
+func newErrorCredentialReportExpiredException(err awserr.RequestFailure) error {
+	return &CredentialReportExpiredException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CredentialReportExpiredException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CredentialReportExpiredException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CredentialReportNotPresentException is the modeled exception returned for the
+// "ReportNotPresent" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CredentialReportNotPresentException struct {
+	awserr.RequestFailure
+}
+
+func newErrorCredentialReportNotPresentException(err awserr.RequestFailure) error {
+	return &CredentialReportNotPresentException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CredentialReportNotPresentException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CredentialReportNotPresentException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CredentialReportNotReadyException is the modeled exception returned for the
+// "ReportInProgress" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CredentialReportNotReadyException struct {
+	awserr.RequestFailure
+}
+
+func newErrorCredentialReportNotReadyException(err awserr.RequestFailure) error {
+	return &CredentialReportNotReadyException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CredentialReportNotReadyException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CredentialReportNotReadyException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DeleteConflictException is the modeled exception returned for the
+// "DeleteConflict" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DeleteConflictException struct {
+	awserr.RequestFailure
+}
+
+func newErrorDeleteConflictException(err awserr.RequestFailure) error {
+	return &DeleteConflictException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DeleteConflictException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DeleteConflictException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DuplicateCertificateException is the modeled exception returned for the
+// "DuplicateCertificate" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DuplicateCertificateException struct {
+	awserr.RequestFailure
+}
+
+func newErrorDuplicateCertificateException(err awserr.RequestFailure) error {
+	return &DuplicateCertificateException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DuplicateCertificateException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DuplicateCertificateException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DuplicateSSHPublicKeyException is the modeled exception returned for the
+// "DuplicateSSHPublicKey" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DuplicateSSHPublicKeyException struct {
+	awserr.RequestFailure
+}
+
+func newErrorDuplicateSSHPublicKeyException(err awserr.RequestFailure) error {
+	return &DuplicateSSHPublicKeyException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DuplicateSSHPublicKeyException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DuplicateSSHPublicKeyException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// EntityAlreadyExistsException is the modeled exception returned for the
+// "EntityAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type EntityAlreadyExistsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorEntityAlreadyExistsException(err awserr.RequestFailure) error {
+	return &EntityAlreadyExistsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *EntityAlreadyExistsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *EntityAlreadyExistsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// EntityTemporarilyUnmodifiableException is the modeled exception returned for the
+// "EntityTemporarilyUnmodifiable" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type EntityTemporarilyUnmodifiableException struct {
+	awserr.RequestFailure
+}
+
+func newErrorEntityTemporarilyUnmodifiableException(err awserr.RequestFailure) error {
+	return &EntityTemporarilyUnmodifiableException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *EntityTemporarilyUnmodifiableException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *EntityTemporarilyUnmodifiableException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidAuthenticationCodeException is the modeled exception returned for the
+// "InvalidAuthenticationCode" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidAuthenticationCodeException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidAuthenticationCodeException(err awserr.RequestFailure) error {
+	return &InvalidAuthenticationCodeException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidAuthenticationCodeException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidAuthenticationCodeException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidCertificateException is the modeled exception returned for the
+// "InvalidCertificate" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidCertificateException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidCertificateException(err awserr.RequestFailure) error {
+	return &InvalidCertificateException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidCertificateException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidCertificateException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidInputException is the modeled exception returned for the
+// "InvalidInput" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidInputException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidInputException(err awserr.RequestFailure) error {
+	return &InvalidInputException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidInputException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidInputException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidPublicKeyException is the modeled exception returned for the
+// "InvalidPublicKey" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidPublicKeyException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidPublicKeyException(err awserr.RequestFailure) error {
+	return &InvalidPublicKeyException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidPublicKeyException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidPublicKeyException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidUserTypeException is the modeled exception returned for the
+// "InvalidUserType" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidUserTypeException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidUserTypeException(err awserr.RequestFailure) error {
+	return &InvalidUserTypeException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidUserTypeException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidUserTypeException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// KeyPairMismatchException is the modeled exception returned for the
+// "KeyPairMismatch" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type KeyPairMismatchException struct {
+	awserr.RequestFailure
+}
+
+func newErrorKeyPairMismatchException(err awserr.RequestFailure) error {
+	return &KeyPairMismatchException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *KeyPairMismatchException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *KeyPairMismatchException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// LimitExceededException is the modeled exception returned for the
+// "LimitExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type LimitExceededException struct {
+	awserr.RequestFailure
+}
+
+func newErrorLimitExceededException(err awserr.RequestFailure) error {
+	return &LimitExceededException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *LimitExceededException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *LimitExceededException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// MalformedCertificateException is the modeled exception returned for the
+// "MalformedCertificate" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type MalformedCertificateException struct {
+	awserr.RequestFailure
+}
+
+func newErrorMalformedCertificateException(err awserr.RequestFailure) error {
+	return &MalformedCertificateException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *MalformedCertificateException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *MalformedCertificateException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// MalformedPolicyDocumentException is the modeled exception returned for the
+// "MalformedPolicyDocument" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type MalformedPolicyDocumentException struct {
+	awserr.RequestFailure
+}
+
+func newErrorMalformedPolicyDocumentException(err awserr.RequestFailure) error {
+	return &MalformedPolicyDocumentException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *MalformedPolicyDocumentException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *MalformedPolicyDocumentException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchEntityException is the modeled exception returned for the
+// "NoSuchEntity" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchEntityException struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchEntityException(err awserr.RequestFailure) error {
+	return &NoSuchEntityException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchEntityException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchEntityException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// OpenIdIdpCommunicationErrorException is the modeled exception returned for the
+// "OpenIdIdpCommunicationError" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type OpenIdIdpCommunicationErrorException struct {
+	awserr.RequestFailure
+}
+
+func newErrorOpenIdIdpCommunicationErrorException(err awserr.RequestFailure) error {
+	return &OpenIdIdpCommunicationErrorException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *OpenIdIdpCommunicationErrorException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *OpenIdIdpCommunicationErrorException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// PasswordPolicyViolationException is the modeled exception returned for the
+// "PasswordPolicyViolation" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type PasswordPolicyViolationException struct {
+	awserr.RequestFailure
+}
+
+func newErrorPasswordPolicyViolationException(err awserr.RequestFailure) error {
+	return &PasswordPolicyViolationException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *PasswordPolicyViolationException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *PasswordPolicyViolationException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// PolicyEvaluationException is the modeled exception returned for the
+// "PolicyEvaluation" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type PolicyEvaluationException struct {
+	awserr.RequestFailure
+}
+
+func newErrorPolicyEvaluationException(err awserr.RequestFailure) error {
+	return &PolicyEvaluationException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *PolicyEvaluationException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *PolicyEvaluationException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// PolicyNotAttachableException is the modeled exception returned for the
+// "PolicyNotAttachable" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type PolicyNotAttachableException struct {
+	awserr.RequestFailure
+}
+
+func newErrorPolicyNotAttachableException(err awserr.RequestFailure) error {
+	return &PolicyNotAttachableException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *PolicyNotAttachableException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *PolicyNotAttachableException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ReportGenerationLimitExceededException is the modeled exception returned for the
+// "ReportGenerationLimitExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ReportGenerationLimitExceededException struct {
+	awserr.RequestFailure
+}
+
+func newErrorReportGenerationLimitExceededException(err awserr.RequestFailure) error {
+	return &ReportGenerationLimitExceededException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ReportGenerationLimitExceededException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ReportGenerationLimitExceededException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ServiceFailureException is the modeled exception returned for the
+// "ServiceFailure" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ServiceFailureException struct {
+	awserr.RequestFailure
+}
+
+func newErrorServiceFailureException(err awserr.RequestFailure) error {
+	return &ServiceFailureException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ServiceFailureException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ServiceFailureException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ServiceNotSupportedException is the modeled exception returned for the
+// "NotSupportedService" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ServiceNotSupportedException struct {
+	awserr.RequestFailure
+}
+
+func newErrorServiceNotSupportedException(err awserr.RequestFailure) error {
+	return &ServiceNotSupportedException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ServiceNotSupportedException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ServiceNotSupportedException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// UnmodifiableEntityException is the modeled exception returned for the
+// "UnmodifiableEntity" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type UnmodifiableEntityException struct {
+	awserr.RequestFailure
+}
+
+func newErrorUnmodifiableEntityException(err awserr.RequestFailure) error {
+	return &UnmodifiableEntityException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *UnmodifiableEntityException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *UnmodifiableEntityException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// UnrecognizedPublicKeyEncodingException is the modeled exception returned for the
+// "UnrecognizedPublicKeyEncoding" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type UnrecognizedPublicKeyEncodingException struct {
+	awserr.RequestFailure
+}
+
+func newErrorUnrecognizedPublicKeyEncodingException(err awserr.RequestFailure) error {
+	return &UnrecognizedPublicKeyEncodingException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *UnrecognizedPublicKeyEncodingException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *UnrecognizedPublicKeyEncodingException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+var exceptionFromCode = map[string]func(awserr.RequestFailure) error{
+	"ConcurrentModification":        newErrorConcurrentModificationException,
+	"ReportExpired":                 newErrorCredentialReportExpiredException,
+	"ReportNotPresent":              newErrorCredentialReportNotPresentException,
+	"ReportInProgress":              newErrorCredentialReportNotReadyException,
+	"DeleteConflict":                newErrorDeleteConflictException,
+	"DuplicateCertificate":          newErrorDuplicateCertificateException,
+	"DuplicateSSHPublicKey":         newErrorDuplicateSSHPublicKeyException,
+	"EntityAlreadyExists":           newErrorEntityAlreadyExistsException,
+	"EntityTemporarilyUnmodifiable": newErrorEntityTemporarilyUnmodifiableException,
+	"InvalidAuthenticationCode":     newErrorInvalidAuthenticationCodeException,
+	"InvalidCertificate":            newErrorInvalidCertificateException,
+	"InvalidInput":                  newErrorInvalidInputException,
+	"InvalidPublicKey":              newErrorInvalidPublicKeyException,
+	"InvalidUserType":               newErrorInvalidUserTypeException,
+	"KeyPairMismatch":               newErrorKeyPairMismatchException,
+	"LimitExceeded":                 newErrorLimitExceededException,
+	"MalformedCertificate":          newErrorMalformedCertificateException,
+	"MalformedPolicyDocument":       newErrorMalformedPolicyDocumentException,
+	"NoSuchEntity":                  newErrorNoSuchEntityException,
+	"OpenIdIdpCommunicationError":   newErrorOpenIdIdpCommunicationErrorException,
+	"PasswordPolicyViolation":       newErrorPasswordPolicyViolationException,
+	"PolicyEvaluation":              newErrorPolicyEvaluationException,
+	"PolicyNotAttachable":           newErrorPolicyNotAttachableException,
+	"ReportGenerationLimitExceeded": newErrorReportGenerationLimitExceededException,
+	"ServiceFailure":                newErrorServiceFailureException,
+	"NotSupportedService":           newErrorServiceNotSupportedException,
+	"UnmodifiableEntity":            newErrorUnmodifiableEntityException,
+	"UnrecognizedPublicKeyEncoding": newErrorUnrecognizedPublicKeyEncodingException,
+}
@@ -2,6 +2,10 @@
 
 package rds
 
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
 const (
 
 	// ErrCodeAuthorizationAlreadyExistsFault for service response error code
@@ -944,3 +948,3921 @@ const (
 	// The specified DB engine version isn't supported for Aurora Limitless Database.
 	ErrCodeUnsupportedDBEngineVersionFault = "UnsupportedDBEngineVersion"
 )
+
+// AuthorizationAlreadyExistsFault is the modeled exception returned for the
+// "AuthorizationAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AuthorizationAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorAuthorizationAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &AuthorizationAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AuthorizationAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AuthorizationAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// AuthorizationNotFoundFault is the modeled exception returned for the
+// "AuthorizationNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AuthorizationNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorAuthorizationNotFoundFault(err awserr.RequestFailure) error {
+	return &AuthorizationNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AuthorizationNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AuthorizationNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// AuthorizationQuotaExceededFault is the modeled exception returned for the
+// "AuthorizationQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AuthorizationQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorAuthorizationQuotaExceededFault(err awserr.RequestFailure) error {
+	return &AuthorizationQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AuthorizationQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AuthorizationQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// BackupPolicyNotFoundFault is the modeled exception returned for the
+// "BackupPolicyNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type BackupPolicyNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorBackupPolicyNotFoundFault(err awserr.RequestFailure) error {
+	return &BackupPolicyNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *BackupPolicyNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *BackupPolicyNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// BlueGreenDeploymentAlreadyExistsFault is the modeled exception returned for the
+// "BlueGreenDeploymentAlreadyExistsFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type BlueGreenDeploymentAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorBlueGreenDeploymentAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &BlueGreenDeploymentAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *BlueGreenDeploymentAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *BlueGreenDeploymentAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// BlueGreenDeploymentNotFoundFault is the modeled exception returned for the
+// "BlueGreenDeploymentNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type BlueGreenDeploymentNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorBlueGreenDeploymentNotFoundFault(err awserr.RequestFailure) error {
+	return &BlueGreenDeploymentNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *BlueGreenDeploymentNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *BlueGreenDeploymentNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CertificateNotFoundFault is the modeled exception returned for the
+// "CertificateNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CertificateNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorCertificateNotFoundFault(err awserr.RequestFailure) error {
+	return &CertificateNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CertificateNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CertificateNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CreateCustomDBEngineVersionFault is the modeled exception returned for the
+// "CreateCustomDBEngineVersionFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CreateCustomDBEngineVersionFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorCreateCustomDBEngineVersionFault(err awserr.RequestFailure) error {
+	return &CreateCustomDBEngineVersionFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CreateCustomDBEngineVersionFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CreateCustomDBEngineVersionFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CustomAvailabilityZoneNotFoundFault is the modeled exception returned for the
+// "CustomAvailabilityZoneNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CustomAvailabilityZoneNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorCustomAvailabilityZoneNotFoundFault(err awserr.RequestFailure) error {
+	return &CustomAvailabilityZoneNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CustomAvailabilityZoneNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CustomAvailabilityZoneNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CustomDBEngineVersionAlreadyExistsFault is the modeled exception returned for the
+// "CustomDBEngineVersionAlreadyExistsFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CustomDBEngineVersionAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorCustomDBEngineVersionAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &CustomDBEngineVersionAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CustomDBEngineVersionAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CustomDBEngineVersionAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CustomDBEngineVersionNotFoundFault is the modeled exception returned for the
+// "CustomDBEngineVersionNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CustomDBEngineVersionNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorCustomDBEngineVersionNotFoundFault(err awserr.RequestFailure) error {
+	return &CustomDBEngineVersionNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CustomDBEngineVersionNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CustomDBEngineVersionNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CustomDBEngineVersionQuotaExceededFault is the modeled exception returned for the
+// "CustomDBEngineVersionQuotaExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CustomDBEngineVersionQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorCustomDBEngineVersionQuotaExceededFault(err awserr.RequestFailure) error {
+	return &CustomDBEngineVersionQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CustomDBEngineVersionQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CustomDBEngineVersionQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBClusterAlreadyExistsFault is the modeled exception returned for the
+// "DBClusterAlreadyExistsFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBClusterAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBClusterAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &DBClusterAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBClusterAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBClusterAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBClusterAutomatedBackupNotFoundFault is the modeled exception returned for the
+// "DBClusterAutomatedBackupNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBClusterAutomatedBackupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBClusterAutomatedBackupNotFoundFault(err awserr.RequestFailure) error {
+	return &DBClusterAutomatedBackupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBClusterAutomatedBackupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBClusterAutomatedBackupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBClusterAutomatedBackupQuotaExceededFault is the modeled exception returned for the
+// "DBClusterAutomatedBackupQuotaExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBClusterAutomatedBackupQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBClusterAutomatedBackupQuotaExceededFault(err awserr.RequestFailure) error {
+	return &DBClusterAutomatedBackupQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBClusterAutomatedBackupQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBClusterAutomatedBackupQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBClusterBacktrackNotFoundFault is the modeled exception returned for the
+// "DBClusterBacktrackNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBClusterBacktrackNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBClusterBacktrackNotFoundFault(err awserr.RequestFailure) error {
+	return &DBClusterBacktrackNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBClusterBacktrackNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBClusterBacktrackNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBClusterEndpointAlreadyExistsFault is the modeled exception returned for the
+// "DBClusterEndpointAlreadyExistsFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBClusterEndpointAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBClusterEndpointAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &DBClusterEndpointAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBClusterEndpointAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBClusterEndpointAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBClusterEndpointNotFoundFault is the modeled exception returned for the
+// "DBClusterEndpointNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBClusterEndpointNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBClusterEndpointNotFoundFault(err awserr.RequestFailure) error {
+	return &DBClusterEndpointNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBClusterEndpointNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBClusterEndpointNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBClusterEndpointQuotaExceededFault is the modeled exception returned for the
+// "DBClusterEndpointQuotaExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBClusterEndpointQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBClusterEndpointQuotaExceededFault(err awserr.RequestFailure) error {
+	return &DBClusterEndpointQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBClusterEndpointQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBClusterEndpointQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBClusterNotFoundFault is the modeled exception returned for the
+// "DBClusterNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBClusterNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBClusterNotFoundFault(err awserr.RequestFailure) error {
+	return &DBClusterNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBClusterNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBClusterNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBClusterParameterGroupNotFoundFault is the modeled exception returned for the
+// "DBClusterParameterGroupNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBClusterParameterGroupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBClusterParameterGroupNotFoundFault(err awserr.RequestFailure) error {
+	return &DBClusterParameterGroupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBClusterParameterGroupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBClusterParameterGroupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBClusterQuotaExceededFault is the modeled exception returned for the
+// "DBClusterQuotaExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBClusterQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBClusterQuotaExceededFault(err awserr.RequestFailure) error {
+	return &DBClusterQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBClusterQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBClusterQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBClusterRoleAlreadyExistsFault is the modeled exception returned for the
+// "DBClusterRoleAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBClusterRoleAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBClusterRoleAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &DBClusterRoleAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBClusterRoleAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBClusterRoleAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBClusterRoleNotFoundFault is the modeled exception returned for the
+// "DBClusterRoleNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBClusterRoleNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBClusterRoleNotFoundFault(err awserr.RequestFailure) error {
+	return &DBClusterRoleNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBClusterRoleNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBClusterRoleNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBClusterRoleQuotaExceededFault is the modeled exception returned for the
+// "DBClusterRoleQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBClusterRoleQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBClusterRoleQuotaExceededFault(err awserr.RequestFailure) error {
+	return &DBClusterRoleQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBClusterRoleQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBClusterRoleQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBClusterSnapshotAlreadyExistsFault is the modeled exception returned for the
+// "DBClusterSnapshotAlreadyExistsFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBClusterSnapshotAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBClusterSnapshotAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &DBClusterSnapshotAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBClusterSnapshotAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBClusterSnapshotAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBClusterSnapshotNotFoundFault is the modeled exception returned for the
+// "DBClusterSnapshotNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBClusterSnapshotNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBClusterSnapshotNotFoundFault(err awserr.RequestFailure) error {
+	return &DBClusterSnapshotNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBClusterSnapshotNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBClusterSnapshotNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBInstanceAlreadyExistsFault is the modeled exception returned for the
+// "DBInstanceAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBInstanceAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBInstanceAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &DBInstanceAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBInstanceAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBInstanceAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBInstanceAutomatedBackupNotFoundFault is the modeled exception returned for the
+// "DBInstanceAutomatedBackupNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBInstanceAutomatedBackupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBInstanceAutomatedBackupNotFoundFault(err awserr.RequestFailure) error {
+	return &DBInstanceAutomatedBackupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBInstanceAutomatedBackupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBInstanceAutomatedBackupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBInstanceAutomatedBackupQuotaExceededFault is the modeled exception returned for the
+// "DBInstanceAutomatedBackupQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBInstanceAutomatedBackupQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBInstanceAutomatedBackupQuotaExceededFault(err awserr.RequestFailure) error {
+	return &DBInstanceAutomatedBackupQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBInstanceAutomatedBackupQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBInstanceAutomatedBackupQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBInstanceNotFoundFault is the modeled exception returned for the
+// "DBInstanceNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBInstanceNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBInstanceNotFoundFault(err awserr.RequestFailure) error {
+	return &DBInstanceNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBInstanceNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBInstanceNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBInstanceRoleAlreadyExistsFault is the modeled exception returned for the
+// "DBInstanceRoleAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBInstanceRoleAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBInstanceRoleAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &DBInstanceRoleAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBInstanceRoleAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBInstanceRoleAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBInstanceRoleNotFoundFault is the modeled exception returned for the
+// "DBInstanceRoleNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBInstanceRoleNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBInstanceRoleNotFoundFault(err awserr.RequestFailure) error {
+	return &DBInstanceRoleNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBInstanceRoleNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBInstanceRoleNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBInstanceRoleQuotaExceededFault is the modeled exception returned for the
+// "DBInstanceRoleQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBInstanceRoleQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBInstanceRoleQuotaExceededFault(err awserr.RequestFailure) error {
+	return &DBInstanceRoleQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBInstanceRoleQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBInstanceRoleQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBLogFileNotFoundFault is the modeled exception returned for the
+// "DBLogFileNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBLogFileNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBLogFileNotFoundFault(err awserr.RequestFailure) error {
+	return &DBLogFileNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBLogFileNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBLogFileNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBParameterGroupAlreadyExistsFault is the modeled exception returned for the
+// "DBParameterGroupAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBParameterGroupAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBParameterGroupAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &DBParameterGroupAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBParameterGroupAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBParameterGroupAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBParameterGroupNotFoundFault is the modeled exception returned for the
+// "DBParameterGroupNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBParameterGroupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBParameterGroupNotFoundFault(err awserr.RequestFailure) error {
+	return &DBParameterGroupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBParameterGroupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBParameterGroupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBParameterGroupQuotaExceededFault is the modeled exception returned for the
+// "DBParameterGroupQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBParameterGroupQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBParameterGroupQuotaExceededFault(err awserr.RequestFailure) error {
+	return &DBParameterGroupQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBParameterGroupQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBParameterGroupQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBProxyAlreadyExistsFault is the modeled exception returned for the
+// "DBProxyAlreadyExistsFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBProxyAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBProxyAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &DBProxyAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBProxyAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBProxyAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBProxyEndpointAlreadyExistsFault is the modeled exception returned for the
+// "DBProxyEndpointAlreadyExistsFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBProxyEndpointAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBProxyEndpointAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &DBProxyEndpointAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBProxyEndpointAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBProxyEndpointAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBProxyEndpointNotFoundFault is the modeled exception returned for the
+// "DBProxyEndpointNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBProxyEndpointNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBProxyEndpointNotFoundFault(err awserr.RequestFailure) error {
+	return &DBProxyEndpointNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBProxyEndpointNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBProxyEndpointNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBProxyEndpointQuotaExceededFault is the modeled exception returned for the
+// "DBProxyEndpointQuotaExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBProxyEndpointQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBProxyEndpointQuotaExceededFault(err awserr.RequestFailure) error {
+	return &DBProxyEndpointQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBProxyEndpointQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBProxyEndpointQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBProxyNotFoundFault is the modeled exception returned for the
+// "DBProxyNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBProxyNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBProxyNotFoundFault(err awserr.RequestFailure) error {
+	return &DBProxyNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBProxyNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBProxyNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBProxyQuotaExceededFault is the modeled exception returned for the
+// "DBProxyQuotaExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBProxyQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBProxyQuotaExceededFault(err awserr.RequestFailure) error {
+	return &DBProxyQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBProxyQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBProxyQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBProxyTargetAlreadyRegisteredFault is the modeled exception returned for the
+// "DBProxyTargetAlreadyRegisteredFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBProxyTargetAlreadyRegisteredFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBProxyTargetAlreadyRegisteredFault(err awserr.RequestFailure) error {
+	return &DBProxyTargetAlreadyRegisteredFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBProxyTargetAlreadyRegisteredFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBProxyTargetAlreadyRegisteredFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBProxyTargetGroupNotFoundFault is the modeled exception returned for the
+// "DBProxyTargetGroupNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBProxyTargetGroupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBProxyTargetGroupNotFoundFault(err awserr.RequestFailure) error {
+	return &DBProxyTargetGroupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBProxyTargetGroupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBProxyTargetGroupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBProxyTargetNotFoundFault is the modeled exception returned for the
+// "DBProxyTargetNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBProxyTargetNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBProxyTargetNotFoundFault(err awserr.RequestFailure) error {
+	return &DBProxyTargetNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBProxyTargetNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBProxyTargetNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBSecurityGroupAlreadyExistsFault is the modeled exception returned for the
+// "DBSecurityGroupAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBSecurityGroupAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBSecurityGroupAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &DBSecurityGroupAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBSecurityGroupAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBSecurityGroupAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBSecurityGroupNotFoundFault is the modeled exception returned for the
+// "DBSecurityGroupNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBSecurityGroupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBSecurityGroupNotFoundFault(err awserr.RequestFailure) error {
+	return &DBSecurityGroupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBSecurityGroupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBSecurityGroupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBSecurityGroupNotSupportedFault is the modeled exception returned for the
+// "DBSecurityGroupNotSupported" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBSecurityGroupNotSupportedFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBSecurityGroupNotSupportedFault(err awserr.RequestFailure) error {
+	return &DBSecurityGroupNotSupportedFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBSecurityGroupNotSupportedFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBSecurityGroupNotSupportedFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBSecurityGroupQuotaExceededFault is the modeled exception returned for the
+// "QuotaExceeded.DBSecurityGroup" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBSecurityGroupQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBSecurityGroupQuotaExceededFault(err awserr.RequestFailure) error {
+	return &DBSecurityGroupQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBSecurityGroupQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBSecurityGroupQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBShardGroupAlreadyExistsFault is the modeled exception returned for the
+// "DBShardGroupAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBShardGroupAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBShardGroupAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &DBShardGroupAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBShardGroupAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBShardGroupAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBShardGroupNotFoundFault is the modeled exception returned for the
+// "DBShardGroupNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBShardGroupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBShardGroupNotFoundFault(err awserr.RequestFailure) error {
+	return &DBShardGroupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBShardGroupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBShardGroupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBSnapshotAlreadyExistsFault is the modeled exception returned for the
+// "DBSnapshotAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBSnapshotAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBSnapshotAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &DBSnapshotAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBSnapshotAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBSnapshotAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBSnapshotNotFoundFault is the modeled exception returned for the
+// "DBSnapshotNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBSnapshotNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBSnapshotNotFoundFault(err awserr.RequestFailure) error {
+	return &DBSnapshotNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBSnapshotNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBSnapshotNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBSnapshotTenantDatabaseNotFoundFault is the modeled exception returned for the
+// "DBSnapshotTenantDatabaseNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBSnapshotTenantDatabaseNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBSnapshotTenantDatabaseNotFoundFault(err awserr.RequestFailure) error {
+	return &DBSnapshotTenantDatabaseNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBSnapshotTenantDatabaseNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBSnapshotTenantDatabaseNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBSubnetGroupAlreadyExistsFault is the modeled exception returned for the
+// "DBSubnetGroupAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBSubnetGroupAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBSubnetGroupAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &DBSubnetGroupAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBSubnetGroupAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBSubnetGroupAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBSubnetGroupDoesNotCoverEnoughAZs is the modeled exception returned for the
+// "DBSubnetGroupDoesNotCoverEnoughAZs" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBSubnetGroupDoesNotCoverEnoughAZs struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBSubnetGroupDoesNotCoverEnoughAZs(err awserr.RequestFailure) error {
+	return &DBSubnetGroupDoesNotCoverEnoughAZs{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBSubnetGroupDoesNotCoverEnoughAZs) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBSubnetGroupDoesNotCoverEnoughAZs) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBSubnetGroupNotAllowedFault is the modeled exception returned for the
+// "DBSubnetGroupNotAllowedFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBSubnetGroupNotAllowedFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBSubnetGroupNotAllowedFault(err awserr.RequestFailure) error {
+	return &DBSubnetGroupNotAllowedFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBSubnetGroupNotAllowedFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBSubnetGroupNotAllowedFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBSubnetGroupNotFoundFault is the modeled exception returned for the
+// "DBSubnetGroupNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBSubnetGroupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBSubnetGroupNotFoundFault(err awserr.RequestFailure) error {
+	return &DBSubnetGroupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBSubnetGroupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBSubnetGroupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBSubnetGroupQuotaExceededFault is the modeled exception returned for the
+// "DBSubnetGroupQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBSubnetGroupQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBSubnetGroupQuotaExceededFault(err awserr.RequestFailure) error {
+	return &DBSubnetGroupQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBSubnetGroupQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBSubnetGroupQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBSubnetQuotaExceededFault is the modeled exception returned for the
+// "DBSubnetQuotaExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBSubnetQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBSubnetQuotaExceededFault(err awserr.RequestFailure) error {
+	return &DBSubnetQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBSubnetQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBSubnetQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DBUpgradeDependencyFailureFault is the modeled exception returned for the
+// "DBUpgradeDependencyFailure" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DBUpgradeDependencyFailureFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDBUpgradeDependencyFailureFault(err awserr.RequestFailure) error {
+	return &DBUpgradeDependencyFailureFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DBUpgradeDependencyFailureFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DBUpgradeDependencyFailureFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DomainNotFoundFault is the modeled exception returned for the
+// "DomainNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DomainNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDomainNotFoundFault(err awserr.RequestFailure) error {
+	return &DomainNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DomainNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DomainNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// Ec2ImagePropertiesNotSupportedFault is the modeled exception returned for the
+// "Ec2ImagePropertiesNotSupportedFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type Ec2ImagePropertiesNotSupportedFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorEc2ImagePropertiesNotSupportedFault(err awserr.RequestFailure) error {
+	return &Ec2ImagePropertiesNotSupportedFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *Ec2ImagePropertiesNotSupportedFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *Ec2ImagePropertiesNotSupportedFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// EventSubscriptionQuotaExceededFault is the modeled exception returned for the
+// "EventSubscriptionQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type EventSubscriptionQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorEventSubscriptionQuotaExceededFault(err awserr.RequestFailure) error {
+	return &EventSubscriptionQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *EventSubscriptionQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *EventSubscriptionQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ExportTaskAlreadyExistsFault is the modeled exception returned for the
+// "ExportTaskAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ExportTaskAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorExportTaskAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &ExportTaskAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ExportTaskAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ExportTaskAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ExportTaskNotFoundFault is the modeled exception returned for the
+// "ExportTaskNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ExportTaskNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorExportTaskNotFoundFault(err awserr.RequestFailure) error {
+	return &ExportTaskNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ExportTaskNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ExportTaskNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// GlobalClusterAlreadyExistsFault is the modeled exception returned for the
+// "GlobalClusterAlreadyExistsFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type GlobalClusterAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorGlobalClusterAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &GlobalClusterAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *GlobalClusterAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *GlobalClusterAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// GlobalClusterNotFoundFault is the modeled exception returned for the
+// "GlobalClusterNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type GlobalClusterNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorGlobalClusterNotFoundFault(err awserr.RequestFailure) error {
+	return &GlobalClusterNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *GlobalClusterNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *GlobalClusterNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// GlobalClusterQuotaExceededFault is the modeled exception returned for the
+// "GlobalClusterQuotaExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type GlobalClusterQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorGlobalClusterQuotaExceededFault(err awserr.RequestFailure) error {
+	return &GlobalClusterQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *GlobalClusterQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *GlobalClusterQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// IamRoleMissingPermissionsFault is the modeled exception returned for the
+// "IamRoleMissingPermissions" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type IamRoleMissingPermissionsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorIamRoleMissingPermissionsFault(err awserr.RequestFailure) error {
+	return &IamRoleMissingPermissionsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *IamRoleMissingPermissionsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *IamRoleMissingPermissionsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// IamRoleNotFoundFault is the modeled exception returned for the
+// "IamRoleNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type IamRoleNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorIamRoleNotFoundFault(err awserr.RequestFailure) error {
+	return &IamRoleNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *IamRoleNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *IamRoleNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InstanceQuotaExceededFault is the modeled exception returned for the
+// "InstanceQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InstanceQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInstanceQuotaExceededFault(err awserr.RequestFailure) error {
+	return &InstanceQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InstanceQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InstanceQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InsufficientAvailableIPsInSubnetFault is the modeled exception returned for the
+// "InsufficientAvailableIPsInSubnetFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InsufficientAvailableIPsInSubnetFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInsufficientAvailableIPsInSubnetFault(err awserr.RequestFailure) error {
+	return &InsufficientAvailableIPsInSubnetFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InsufficientAvailableIPsInSubnetFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InsufficientAvailableIPsInSubnetFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InsufficientDBClusterCapacityFault is the modeled exception returned for the
+// "InsufficientDBClusterCapacityFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InsufficientDBClusterCapacityFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInsufficientDBClusterCapacityFault(err awserr.RequestFailure) error {
+	return &InsufficientDBClusterCapacityFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InsufficientDBClusterCapacityFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InsufficientDBClusterCapacityFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InsufficientDBInstanceCapacityFault is the modeled exception returned for the
+// "InsufficientDBInstanceCapacity" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InsufficientDBInstanceCapacityFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInsufficientDBInstanceCapacityFault(err awserr.RequestFailure) error {
+	return &InsufficientDBInstanceCapacityFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InsufficientDBInstanceCapacityFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InsufficientDBInstanceCapacityFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InsufficientStorageClusterCapacityFault is the modeled exception returned for the
+// "InsufficientStorageClusterCapacity" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InsufficientStorageClusterCapacityFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInsufficientStorageClusterCapacityFault(err awserr.RequestFailure) error {
+	return &InsufficientStorageClusterCapacityFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InsufficientStorageClusterCapacityFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InsufficientStorageClusterCapacityFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// IntegrationAlreadyExistsFault is the modeled exception returned for the
+// "IntegrationAlreadyExistsFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type IntegrationAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorIntegrationAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &IntegrationAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *IntegrationAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *IntegrationAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// IntegrationConflictOperationFault is the modeled exception returned for the
+// "IntegrationConflictOperationFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type IntegrationConflictOperationFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorIntegrationConflictOperationFault(err awserr.RequestFailure) error {
+	return &IntegrationConflictOperationFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *IntegrationConflictOperationFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *IntegrationConflictOperationFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// IntegrationNotFoundFault is the modeled exception returned for the
+// "IntegrationNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type IntegrationNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorIntegrationNotFoundFault(err awserr.RequestFailure) error {
+	return &IntegrationNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *IntegrationNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *IntegrationNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// IntegrationQuotaExceededFault is the modeled exception returned for the
+// "IntegrationQuotaExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type IntegrationQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorIntegrationQuotaExceededFault(err awserr.RequestFailure) error {
+	return &IntegrationQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *IntegrationQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *IntegrationQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidBlueGreenDeploymentStateFault is the modeled exception returned for the
+// "InvalidBlueGreenDeploymentStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidBlueGreenDeploymentStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidBlueGreenDeploymentStateFault(err awserr.RequestFailure) error {
+	return &InvalidBlueGreenDeploymentStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidBlueGreenDeploymentStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidBlueGreenDeploymentStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidCustomDBEngineVersionStateFault is the modeled exception returned for the
+// "InvalidCustomDBEngineVersionStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidCustomDBEngineVersionStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidCustomDBEngineVersionStateFault(err awserr.RequestFailure) error {
+	return &InvalidCustomDBEngineVersionStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidCustomDBEngineVersionStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidCustomDBEngineVersionStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBClusterAutomatedBackupStateFault is the modeled exception returned for the
+// "InvalidDBClusterAutomatedBackupStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBClusterAutomatedBackupStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBClusterAutomatedBackupStateFault(err awserr.RequestFailure) error {
+	return &InvalidDBClusterAutomatedBackupStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBClusterAutomatedBackupStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBClusterAutomatedBackupStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBClusterCapacityFault is the modeled exception returned for the
+// "InvalidDBClusterCapacityFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBClusterCapacityFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBClusterCapacityFault(err awserr.RequestFailure) error {
+	return &InvalidDBClusterCapacityFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBClusterCapacityFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBClusterCapacityFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBClusterEndpointStateFault is the modeled exception returned for the
+// "InvalidDBClusterEndpointStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBClusterEndpointStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBClusterEndpointStateFault(err awserr.RequestFailure) error {
+	return &InvalidDBClusterEndpointStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBClusterEndpointStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBClusterEndpointStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBClusterSnapshotStateFault is the modeled exception returned for the
+// "InvalidDBClusterSnapshotStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBClusterSnapshotStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBClusterSnapshotStateFault(err awserr.RequestFailure) error {
+	return &InvalidDBClusterSnapshotStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBClusterSnapshotStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBClusterSnapshotStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBClusterStateFault is the modeled exception returned for the
+// "InvalidDBClusterStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBClusterStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBClusterStateFault(err awserr.RequestFailure) error {
+	return &InvalidDBClusterStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBClusterStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBClusterStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBInstanceAutomatedBackupStateFault is the modeled exception returned for the
+// "InvalidDBInstanceAutomatedBackupState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBInstanceAutomatedBackupStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBInstanceAutomatedBackupStateFault(err awserr.RequestFailure) error {
+	return &InvalidDBInstanceAutomatedBackupStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBInstanceAutomatedBackupStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBInstanceAutomatedBackupStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBInstanceStateFault is the modeled exception returned for the
+// "InvalidDBInstanceState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBInstanceStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBInstanceStateFault(err awserr.RequestFailure) error {
+	return &InvalidDBInstanceStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBInstanceStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBInstanceStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBParameterGroupStateFault is the modeled exception returned for the
+// "InvalidDBParameterGroupState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBParameterGroupStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBParameterGroupStateFault(err awserr.RequestFailure) error {
+	return &InvalidDBParameterGroupStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBParameterGroupStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBParameterGroupStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBProxyEndpointStateFault is the modeled exception returned for the
+// "InvalidDBProxyEndpointStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBProxyEndpointStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBProxyEndpointStateFault(err awserr.RequestFailure) error {
+	return &InvalidDBProxyEndpointStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBProxyEndpointStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBProxyEndpointStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBProxyStateFault is the modeled exception returned for the
+// "InvalidDBProxyStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBProxyStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBProxyStateFault(err awserr.RequestFailure) error {
+	return &InvalidDBProxyStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBProxyStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBProxyStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBSecurityGroupStateFault is the modeled exception returned for the
+// "InvalidDBSecurityGroupState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBSecurityGroupStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBSecurityGroupStateFault(err awserr.RequestFailure) error {
+	return &InvalidDBSecurityGroupStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBSecurityGroupStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBSecurityGroupStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBShardGroupStateFault is the modeled exception returned for the
+// "InvalidDBShardGroupState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBShardGroupStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBShardGroupStateFault(err awserr.RequestFailure) error {
+	return &InvalidDBShardGroupStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBShardGroupStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBShardGroupStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBSnapshotStateFault is the modeled exception returned for the
+// "InvalidDBSnapshotState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBSnapshotStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBSnapshotStateFault(err awserr.RequestFailure) error {
+	return &InvalidDBSnapshotStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBSnapshotStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBSnapshotStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBSubnetGroupFault is the modeled exception returned for the
+// "InvalidDBSubnetGroupFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBSubnetGroupFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBSubnetGroupFault(err awserr.RequestFailure) error {
+	return &InvalidDBSubnetGroupFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBSubnetGroupFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBSubnetGroupFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBSubnetGroupStateFault is the modeled exception returned for the
+// "InvalidDBSubnetGroupStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBSubnetGroupStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBSubnetGroupStateFault(err awserr.RequestFailure) error {
+	return &InvalidDBSubnetGroupStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBSubnetGroupStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBSubnetGroupStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDBSubnetStateFault is the modeled exception returned for the
+// "InvalidDBSubnetStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDBSubnetStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDBSubnetStateFault(err awserr.RequestFailure) error {
+	return &InvalidDBSubnetStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDBSubnetStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDBSubnetStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidEventSubscriptionStateFault is the modeled exception returned for the
+// "InvalidEventSubscriptionState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidEventSubscriptionStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidEventSubscriptionStateFault(err awserr.RequestFailure) error {
+	return &InvalidEventSubscriptionStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidEventSubscriptionStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidEventSubscriptionStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidExportOnlyFault is the modeled exception returned for the
+// "InvalidExportOnly" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidExportOnlyFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidExportOnlyFault(err awserr.RequestFailure) error {
+	return &InvalidExportOnlyFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidExportOnlyFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidExportOnlyFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidExportSourceStateFault is the modeled exception returned for the
+// "InvalidExportSourceState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidExportSourceStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidExportSourceStateFault(err awserr.RequestFailure) error {
+	return &InvalidExportSourceStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidExportSourceStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidExportSourceStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidExportTaskStateFault is the modeled exception returned for the
+// "InvalidExportTaskStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidExportTaskStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidExportTaskStateFault(err awserr.RequestFailure) error {
+	return &InvalidExportTaskStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidExportTaskStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidExportTaskStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidGlobalClusterStateFault is the modeled exception returned for the
+// "InvalidGlobalClusterStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidGlobalClusterStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidGlobalClusterStateFault(err awserr.RequestFailure) error {
+	return &InvalidGlobalClusterStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidGlobalClusterStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidGlobalClusterStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidIntegrationStateFault is the modeled exception returned for the
+// "InvalidIntegrationStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidIntegrationStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidIntegrationStateFault(err awserr.RequestFailure) error {
+	return &InvalidIntegrationStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidIntegrationStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidIntegrationStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidMaxAcuFault is the modeled exception returned for the
+// "InvalidMaxAcu" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidMaxAcuFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidMaxAcuFault(err awserr.RequestFailure) error {
+	return &InvalidMaxAcuFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidMaxAcuFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidMaxAcuFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidOptionGroupStateFault is the modeled exception returned for the
+// "InvalidOptionGroupStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidOptionGroupStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidOptionGroupStateFault(err awserr.RequestFailure) error {
+	return &InvalidOptionGroupStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidOptionGroupStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidOptionGroupStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidResourceStateFault is the modeled exception returned for the
+// "InvalidResourceStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidResourceStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidResourceStateFault(err awserr.RequestFailure) error {
+	return &InvalidResourceStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidResourceStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidResourceStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidRestoreFault is the modeled exception returned for the
+// "InvalidRestoreFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidRestoreFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidRestoreFault(err awserr.RequestFailure) error {
+	return &InvalidRestoreFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidRestoreFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidRestoreFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidS3BucketFault is the modeled exception returned for the
+// "InvalidS3BucketFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidS3BucketFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidS3BucketFault(err awserr.RequestFailure) error {
+	return &InvalidS3BucketFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidS3BucketFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidS3BucketFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidSubnet is the modeled exception returned for the
+// "InvalidSubnet" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidSubnet struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidSubnet(err awserr.RequestFailure) error {
+	return &InvalidSubnet{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidSubnet) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidSubnet) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidVPCNetworkStateFault is the modeled exception returned for the
+// "InvalidVPCNetworkStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidVPCNetworkStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidVPCNetworkStateFault(err awserr.RequestFailure) error {
+	return &InvalidVPCNetworkStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidVPCNetworkStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidVPCNetworkStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// KMSKeyNotAccessibleFault is the modeled exception returned for the
+// "KMSKeyNotAccessibleFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type KMSKeyNotAccessibleFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorKMSKeyNotAccessibleFault(err awserr.RequestFailure) error {
+	return &KMSKeyNotAccessibleFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *KMSKeyNotAccessibleFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *KMSKeyNotAccessibleFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// MaxDBShardGroupLimitReached is the modeled exception returned for the
+// "MaxDBShardGroupLimitReached" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type MaxDBShardGroupLimitReached struct {
+	awserr.RequestFailure
+}
+
+func newErrorMaxDBShardGroupLimitReached(err awserr.RequestFailure) error {
+	return &MaxDBShardGroupLimitReached{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *MaxDBShardGroupLimitReached) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *MaxDBShardGroupLimitReached) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NetworkTypeNotSupported is the modeled exception returned for the
+// "NetworkTypeNotSupported" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NetworkTypeNotSupported struct {
+	awserr.RequestFailure
+}
+
+func newErrorNetworkTypeNotSupported(err awserr.RequestFailure) error {
+	return &NetworkTypeNotSupported{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NetworkTypeNotSupported) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NetworkTypeNotSupported) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// OptionGroupAlreadyExistsFault is the modeled exception returned for the
+// "OptionGroupAlreadyExistsFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type OptionGroupAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorOptionGroupAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &OptionGroupAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *OptionGroupAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *OptionGroupAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// OptionGroupNotFoundFault is the modeled exception returned for the
+// "OptionGroupNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type OptionGroupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorOptionGroupNotFoundFault(err awserr.RequestFailure) error {
+	return &OptionGroupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *OptionGroupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *OptionGroupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// OptionGroupQuotaExceededFault is the modeled exception returned for the
+// "OptionGroupQuotaExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type OptionGroupQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorOptionGroupQuotaExceededFault(err awserr.RequestFailure) error {
+	return &OptionGroupQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *OptionGroupQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *OptionGroupQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// PointInTimeRestoreNotEnabledFault is the modeled exception returned for the
+// "PointInTimeRestoreNotEnabled" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type PointInTimeRestoreNotEnabledFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorPointInTimeRestoreNotEnabledFault(err awserr.RequestFailure) error {
+	return &PointInTimeRestoreNotEnabledFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *PointInTimeRestoreNotEnabledFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *PointInTimeRestoreNotEnabledFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ProvisionedIopsNotAvailableInAZFault is the modeled exception returned for the
+// "ProvisionedIopsNotAvailableInAZFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ProvisionedIopsNotAvailableInAZFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorProvisionedIopsNotAvailableInAZFault(err awserr.RequestFailure) error {
+	return &ProvisionedIopsNotAvailableInAZFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ProvisionedIopsNotAvailableInAZFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ProvisionedIopsNotAvailableInAZFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ReservedDBInstanceAlreadyExistsFault is the modeled exception returned for the
+// "ReservedDBInstanceAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ReservedDBInstanceAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorReservedDBInstanceAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &ReservedDBInstanceAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ReservedDBInstanceAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ReservedDBInstanceAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ReservedDBInstanceNotFoundFault is the modeled exception returned for the
+// "ReservedDBInstanceNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ReservedDBInstanceNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorReservedDBInstanceNotFoundFault(err awserr.RequestFailure) error {
+	return &ReservedDBInstanceNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ReservedDBInstanceNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ReservedDBInstanceNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ReservedDBInstanceQuotaExceededFault is the modeled exception returned for the
+// "ReservedDBInstanceQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ReservedDBInstanceQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorReservedDBInstanceQuotaExceededFault(err awserr.RequestFailure) error {
+	return &ReservedDBInstanceQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ReservedDBInstanceQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ReservedDBInstanceQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ReservedDBInstancesOfferingNotFoundFault is the modeled exception returned for the
+// "ReservedDBInstancesOfferingNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ReservedDBInstancesOfferingNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorReservedDBInstancesOfferingNotFoundFault(err awserr.RequestFailure) error {
+	return &ReservedDBInstancesOfferingNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ReservedDBInstancesOfferingNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ReservedDBInstancesOfferingNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ResourceNotFoundFault is the modeled exception returned for the
+// "ResourceNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ResourceNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorResourceNotFoundFault(err awserr.RequestFailure) error {
+	return &ResourceNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ResourceNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ResourceNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SNSInvalidTopicFault is the modeled exception returned for the
+// "SNSInvalidTopic" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SNSInvalidTopicFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSNSInvalidTopicFault(err awserr.RequestFailure) error {
+	return &SNSInvalidTopicFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SNSInvalidTopicFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SNSInvalidTopicFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SNSNoAuthorizationFault is the modeled exception returned for the
+// "SNSNoAuthorization" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SNSNoAuthorizationFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSNSNoAuthorizationFault(err awserr.RequestFailure) error {
+	return &SNSNoAuthorizationFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SNSNoAuthorizationFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SNSNoAuthorizationFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SNSTopicArnNotFoundFault is the modeled exception returned for the
+// "SNSTopicArnNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SNSTopicArnNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSNSTopicArnNotFoundFault(err awserr.RequestFailure) error {
+	return &SNSTopicArnNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SNSTopicArnNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SNSTopicArnNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SharedSnapshotQuotaExceededFault is the modeled exception returned for the
+// "SharedSnapshotQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SharedSnapshotQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSharedSnapshotQuotaExceededFault(err awserr.RequestFailure) error {
+	return &SharedSnapshotQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SharedSnapshotQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SharedSnapshotQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SnapshotQuotaExceededFault is the modeled exception returned for the
+// "SnapshotQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SnapshotQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSnapshotQuotaExceededFault(err awserr.RequestFailure) error {
+	return &SnapshotQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SnapshotQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SnapshotQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SourceClusterNotSupportedFault is the modeled exception returned for the
+// "SourceClusterNotSupportedFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SourceClusterNotSupportedFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSourceClusterNotSupportedFault(err awserr.RequestFailure) error {
+	return &SourceClusterNotSupportedFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SourceClusterNotSupportedFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SourceClusterNotSupportedFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SourceDatabaseNotSupportedFault is the modeled exception returned for the
+// "SourceDatabaseNotSupportedFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SourceDatabaseNotSupportedFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSourceDatabaseNotSupportedFault(err awserr.RequestFailure) error {
+	return &SourceDatabaseNotSupportedFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SourceDatabaseNotSupportedFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SourceDatabaseNotSupportedFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SourceNotFoundFault is the modeled exception returned for the
+// "SourceNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SourceNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSourceNotFoundFault(err awserr.RequestFailure) error {
+	return &SourceNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SourceNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SourceNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// StorageQuotaExceededFault is the modeled exception returned for the
+// "StorageQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type StorageQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorStorageQuotaExceededFault(err awserr.RequestFailure) error {
+	return &StorageQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *StorageQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *StorageQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// StorageTypeNotAvailableFault is the modeled exception returned for the
+// "StorageTypeNotAvailableFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type StorageTypeNotAvailableFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorStorageTypeNotAvailableFault(err awserr.RequestFailure) error {
+	return &StorageTypeNotAvailableFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *StorageTypeNotAvailableFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *StorageTypeNotAvailableFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// StorageTypeNotSupportedFault is the modeled exception returned for the
+// "StorageTypeNotSupported" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type StorageTypeNotSupportedFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorStorageTypeNotSupportedFault(err awserr.RequestFailure) error {
+	return &StorageTypeNotSupportedFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *StorageTypeNotSupportedFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *StorageTypeNotSupportedFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SubnetAlreadyInUse is the modeled exception returned for the
+// "SubnetAlreadyInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SubnetAlreadyInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorSubnetAlreadyInUse(err awserr.RequestFailure) error {
+	return &SubnetAlreadyInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SubnetAlreadyInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SubnetAlreadyInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SubscriptionAlreadyExistFault is the modeled exception returned for the
+// "SubscriptionAlreadyExist" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SubscriptionAlreadyExistFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSubscriptionAlreadyExistFault(err awserr.RequestFailure) error {
+	return &SubscriptionAlreadyExistFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SubscriptionAlreadyExistFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SubscriptionAlreadyExistFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SubscriptionCategoryNotFoundFault is the modeled exception returned for the
+// "SubscriptionCategoryNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SubscriptionCategoryNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSubscriptionCategoryNotFoundFault(err awserr.RequestFailure) error {
+	return &SubscriptionCategoryNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SubscriptionCategoryNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SubscriptionCategoryNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SubscriptionNotFoundFault is the modeled exception returned for the
+// "SubscriptionNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SubscriptionNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSubscriptionNotFoundFault(err awserr.RequestFailure) error {
+	return &SubscriptionNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SubscriptionNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SubscriptionNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TenantDatabaseAlreadyExistsFault is the modeled exception returned for the
+// "TenantDatabaseAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TenantDatabaseAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorTenantDatabaseAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &TenantDatabaseAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TenantDatabaseAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TenantDatabaseAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TenantDatabaseNotFoundFault is the modeled exception returned for the
+// "TenantDatabaseNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TenantDatabaseNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorTenantDatabaseNotFoundFault(err awserr.RequestFailure) error {
+	return &TenantDatabaseNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TenantDatabaseNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TenantDatabaseNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TenantDatabaseQuotaExceededFault is the modeled exception returned for the
+// "TenantDatabaseQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TenantDatabaseQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorTenantDatabaseQuotaExceededFault(err awserr.RequestFailure) error {
+	return &TenantDatabaseQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TenantDatabaseQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TenantDatabaseQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// UnsupportedDBEngineVersionFault is the modeled exception returned for the
+// "UnsupportedDBEngineVersion" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type UnsupportedDBEngineVersionFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorUnsupportedDBEngineVersionFault(err awserr.RequestFailure) error {
+	return &UnsupportedDBEngineVersionFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *UnsupportedDBEngineVersionFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *UnsupportedDBEngineVersionFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+var exceptionFromCode = map[string]func(awserr.RequestFailure) error{
+	"AuthorizationAlreadyExists":                 newErrorAuthorizationAlreadyExistsFault,
+	"AuthorizationNotFound":                      newErrorAuthorizationNotFoundFault,
+	"AuthorizationQuotaExceeded":                 newErrorAuthorizationQuotaExceededFault,
+	"BackupPolicyNotFoundFault":                  newErrorBackupPolicyNotFoundFault,
+	"BlueGreenDeploymentAlreadyExistsFault":      newErrorBlueGreenDeploymentAlreadyExistsFault,
+	"BlueGreenDeploymentNotFoundFault":           newErrorBlueGreenDeploymentNotFoundFault,
+	"CertificateNotFound":                        newErrorCertificateNotFoundFault,
+	"CreateCustomDBEngineVersionFault":           newErrorCreateCustomDBEngineVersionFault,
+	"CustomAvailabilityZoneNotFound":             newErrorCustomAvailabilityZoneNotFoundFault,
+	"CustomDBEngineVersionAlreadyExistsFault":    newErrorCustomDBEngineVersionAlreadyExistsFault,
+	"CustomDBEngineVersionNotFoundFault":         newErrorCustomDBEngineVersionNotFoundFault,
+	"CustomDBEngineVersionQuotaExceededFault":    newErrorCustomDBEngineVersionQuotaExceededFault,
+	"DBClusterAlreadyExistsFault":                newErrorDBClusterAlreadyExistsFault,
+	"DBClusterAutomatedBackupNotFoundFault":      newErrorDBClusterAutomatedBackupNotFoundFault,
+	"DBClusterAutomatedBackupQuotaExceededFault": newErrorDBClusterAutomatedBackupQuotaExceededFault,
+	"DBClusterBacktrackNotFoundFault":            newErrorDBClusterBacktrackNotFoundFault,
+	"DBClusterEndpointAlreadyExistsFault":        newErrorDBClusterEndpointAlreadyExistsFault,
+	"DBClusterEndpointNotFoundFault":             newErrorDBClusterEndpointNotFoundFault,
+	"DBClusterEndpointQuotaExceededFault":        newErrorDBClusterEndpointQuotaExceededFault,
+	"DBClusterNotFoundFault":                     newErrorDBClusterNotFoundFault,
+	"DBClusterParameterGroupNotFound":            newErrorDBClusterParameterGroupNotFoundFault,
+	"DBClusterQuotaExceededFault":                newErrorDBClusterQuotaExceededFault,
+	"DBClusterRoleAlreadyExists":                 newErrorDBClusterRoleAlreadyExistsFault,
+	"DBClusterRoleNotFound":                      newErrorDBClusterRoleNotFoundFault,
+	"DBClusterRoleQuotaExceeded":                 newErrorDBClusterRoleQuotaExceededFault,
+	"DBClusterSnapshotAlreadyExistsFault":        newErrorDBClusterSnapshotAlreadyExistsFault,
+	"DBClusterSnapshotNotFoundFault":             newErrorDBClusterSnapshotNotFoundFault,
+	"DBInstanceAlreadyExists":                    newErrorDBInstanceAlreadyExistsFault,
+	"DBInstanceAutomatedBackupNotFound":          newErrorDBInstanceAutomatedBackupNotFoundFault,
+	"DBInstanceAutomatedBackupQuotaExceeded":     newErrorDBInstanceAutomatedBackupQuotaExceededFault,
+	"DBInstanceNotFound":                         newErrorDBInstanceNotFoundFault,
+	"DBInstanceRoleAlreadyExists":                newErrorDBInstanceRoleAlreadyExistsFault,
+	"DBInstanceRoleNotFound":                     newErrorDBInstanceRoleNotFoundFault,
+	"DBInstanceRoleQuotaExceeded":                newErrorDBInstanceRoleQuotaExceededFault,
+	"DBLogFileNotFoundFault":                     newErrorDBLogFileNotFoundFault,
+	"DBParameterGroupAlreadyExists":              newErrorDBParameterGroupAlreadyExistsFault,
+	"DBParameterGroupNotFound":                   newErrorDBParameterGroupNotFoundFault,
+	"DBParameterGroupQuotaExceeded":              newErrorDBParameterGroupQuotaExceededFault,
+	"DBProxyAlreadyExistsFault":                  newErrorDBProxyAlreadyExistsFault,
+	"DBProxyEndpointAlreadyExistsFault":          newErrorDBProxyEndpointAlreadyExistsFault,
+	"DBProxyEndpointNotFoundFault":               newErrorDBProxyEndpointNotFoundFault,
+	"DBProxyEndpointQuotaExceededFault":          newErrorDBProxyEndpointQuotaExceededFault,
+	"DBProxyNotFoundFault":                       newErrorDBProxyNotFoundFault,
+	"DBProxyQuotaExceededFault":                  newErrorDBProxyQuotaExceededFault,
+	"DBProxyTargetAlreadyRegisteredFault":        newErrorDBProxyTargetAlreadyRegisteredFault,
+	"DBProxyTargetGroupNotFoundFault":            newErrorDBProxyTargetGroupNotFoundFault,
+	"DBProxyTargetNotFoundFault":                 newErrorDBProxyTargetNotFoundFault,
+	"DBSecurityGroupAlreadyExists":               newErrorDBSecurityGroupAlreadyExistsFault,
+	"DBSecurityGroupNotFound":                    newErrorDBSecurityGroupNotFoundFault,
+	"DBSecurityGroupNotSupported":                newErrorDBSecurityGroupNotSupportedFault,
+	"QuotaExceeded.DBSecurityGroup":              newErrorDBSecurityGroupQuotaExceededFault,
+	"DBShardGroupAlreadyExists":                  newErrorDBShardGroupAlreadyExistsFault,
+	"DBShardGroupNotFound":                       newErrorDBShardGroupNotFoundFault,
+	"DBSnapshotAlreadyExists":                    newErrorDBSnapshotAlreadyExistsFault,
+	"DBSnapshotNotFound":                         newErrorDBSnapshotNotFoundFault,
+	"DBSnapshotTenantDatabaseNotFoundFault":      newErrorDBSnapshotTenantDatabaseNotFoundFault,
+	"DBSubnetGroupAlreadyExists":                 newErrorDBSubnetGroupAlreadyExistsFault,
+	"DBSubnetGroupDoesNotCoverEnoughAZs":         newErrorDBSubnetGroupDoesNotCoverEnoughAZs,
+	"DBSubnetGroupNotAllowedFault":               newErrorDBSubnetGroupNotAllowedFault,
+	"DBSubnetGroupNotFoundFault":                 newErrorDBSubnetGroupNotFoundFault,
+	"DBSubnetGroupQuotaExceeded":                 newErrorDBSubnetGroupQuotaExceededFault,
+	"DBSubnetQuotaExceededFault":                 newErrorDBSubnetQuotaExceededFault,
+	"DBUpgradeDependencyFailure":                 newErrorDBUpgradeDependencyFailureFault,
+	"DomainNotFoundFault":                        newErrorDomainNotFoundFault,
+	"Ec2ImagePropertiesNotSupportedFault":        newErrorEc2ImagePropertiesNotSupportedFault,
+	"EventSubscriptionQuotaExceeded":             newErrorEventSubscriptionQuotaExceededFault,
+	"ExportTaskAlreadyExists":                    newErrorExportTaskAlreadyExistsFault,
+	"ExportTaskNotFound":                         newErrorExportTaskNotFoundFault,
+	"GlobalClusterAlreadyExistsFault":            newErrorGlobalClusterAlreadyExistsFault,
+	"GlobalClusterNotFoundFault":                 newErrorGlobalClusterNotFoundFault,
+	"GlobalClusterQuotaExceededFault":            newErrorGlobalClusterQuotaExceededFault,
+	"IamRoleMissingPermissions":                  newErrorIamRoleMissingPermissionsFault,
+	"IamRoleNotFound":                            newErrorIamRoleNotFoundFault,
+	"InstanceQuotaExceeded":                      newErrorInstanceQuotaExceededFault,
+	"InsufficientAvailableIPsInSubnetFault":      newErrorInsufficientAvailableIPsInSubnetFault,
+	"InsufficientDBClusterCapacityFault":         newErrorInsufficientDBClusterCapacityFault,
+	"InsufficientDBInstanceCapacity":             newErrorInsufficientDBInstanceCapacityFault,
+	"InsufficientStorageClusterCapacity":         newErrorInsufficientStorageClusterCapacityFault,
+	"IntegrationAlreadyExistsFault":              newErrorIntegrationAlreadyExistsFault,
+	"IntegrationConflictOperationFault":          newErrorIntegrationConflictOperationFault,
+	"IntegrationNotFoundFault":                   newErrorIntegrationNotFoundFault,
+	"IntegrationQuotaExceededFault":              newErrorIntegrationQuotaExceededFault,
+	"InvalidBlueGreenDeploymentStateFault":       newErrorInvalidBlueGreenDeploymentStateFault,
+	"InvalidCustomDBEngineVersionStateFault":     newErrorInvalidCustomDBEngineVersionStateFault,
+	"InvalidDBClusterAutomatedBackupStateFault":  newErrorInvalidDBClusterAutomatedBackupStateFault,
+	"InvalidDBClusterCapacityFault":              newErrorInvalidDBClusterCapacityFault,
+	"InvalidDBClusterEndpointStateFault":         newErrorInvalidDBClusterEndpointStateFault,
+	"InvalidDBClusterSnapshotStateFault":         newErrorInvalidDBClusterSnapshotStateFault,
+	"InvalidDBClusterStateFault":                 newErrorInvalidDBClusterStateFault,
+	"InvalidDBInstanceAutomatedBackupState":      newErrorInvalidDBInstanceAutomatedBackupStateFault,
+	"InvalidDBInstanceState":                     newErrorInvalidDBInstanceStateFault,
+	"InvalidDBParameterGroupState":               newErrorInvalidDBParameterGroupStateFault,
+	"InvalidDBProxyEndpointStateFault":           newErrorInvalidDBProxyEndpointStateFault,
+	"InvalidDBProxyStateFault":                   newErrorInvalidDBProxyStateFault,
+	"InvalidDBSecurityGroupState":                newErrorInvalidDBSecurityGroupStateFault,
+	"InvalidDBShardGroupState":                   newErrorInvalidDBShardGroupStateFault,
+	"InvalidDBSnapshotState":                     newErrorInvalidDBSnapshotStateFault,
+	"InvalidDBSubnetGroupFault":                  newErrorInvalidDBSubnetGroupFault,
+	"InvalidDBSubnetGroupStateFault":             newErrorInvalidDBSubnetGroupStateFault,
+	"InvalidDBSubnetStateFault":                  newErrorInvalidDBSubnetStateFault,
+	"InvalidEventSubscriptionState":              newErrorInvalidEventSubscriptionStateFault,
+	"InvalidExportOnly":                          newErrorInvalidExportOnlyFault,
+	"InvalidExportSourceState":                   newErrorInvalidExportSourceStateFault,
+	"InvalidExportTaskStateFault":                newErrorInvalidExportTaskStateFault,
+	"InvalidGlobalClusterStateFault":             newErrorInvalidGlobalClusterStateFault,
+	"InvalidIntegrationStateFault":               newErrorInvalidIntegrationStateFault,
+	"InvalidMaxAcu":                              newErrorInvalidMaxAcuFault,
+	"InvalidOptionGroupStateFault":               newErrorInvalidOptionGroupStateFault,
+	"InvalidResourceStateFault":                  newErrorInvalidResourceStateFault,
+	"InvalidRestoreFault":                        newErrorInvalidRestoreFault,
+	"InvalidS3BucketFault":                       newErrorInvalidS3BucketFault,
+	"InvalidSubnet":                              newErrorInvalidSubnet,
+	"InvalidVPCNetworkStateFault":                newErrorInvalidVPCNetworkStateFault,
+	"KMSKeyNotAccessibleFault":                   newErrorKMSKeyNotAccessibleFault,
+	"MaxDBShardGroupLimitReached":                newErrorMaxDBShardGroupLimitReached,
+	"NetworkTypeNotSupported":                    newErrorNetworkTypeNotSupported,
+	"OptionGroupAlreadyExistsFault":              newErrorOptionGroupAlreadyExistsFault,
+	"OptionGroupNotFoundFault":                   newErrorOptionGroupNotFoundFault,
+	"OptionGroupQuotaExceededFault":              newErrorOptionGroupQuotaExceededFault,
+	"PointInTimeRestoreNotEnabled":               newErrorPointInTimeRestoreNotEnabledFault,
+	"ProvisionedIopsNotAvailableInAZFault":       newErrorProvisionedIopsNotAvailableInAZFault,
+	"ReservedDBInstanceAlreadyExists":            newErrorReservedDBInstanceAlreadyExistsFault,
+	"ReservedDBInstanceNotFound":                 newErrorReservedDBInstanceNotFoundFault,
+	"ReservedDBInstanceQuotaExceeded":            newErrorReservedDBInstanceQuotaExceededFault,
+	"ReservedDBInstancesOfferingNotFound":        newErrorReservedDBInstancesOfferingNotFoundFault,
+	"ResourceNotFoundFault":                      newErrorResourceNotFoundFault,
+	"SNSInvalidTopic":                            newErrorSNSInvalidTopicFault,
+	"SNSNoAuthorization":                         newErrorSNSNoAuthorizationFault,
+	"SNSTopicArnNotFound":                        newErrorSNSTopicArnNotFoundFault,
+	"SharedSnapshotQuotaExceeded":                newErrorSharedSnapshotQuotaExceededFault,
+	"SnapshotQuotaExceeded":                      newErrorSnapshotQuotaExceededFault,
+	"SourceClusterNotSupportedFault":             newErrorSourceClusterNotSupportedFault,
+	"SourceDatabaseNotSupportedFault":            newErrorSourceDatabaseNotSupportedFault,
+	"SourceNotFound":                             newErrorSourceNotFoundFault,
+	"StorageQuotaExceeded":                       newErrorStorageQuotaExceededFault,
+	"StorageTypeNotAvailableFault":               newErrorStorageTypeNotAvailableFault,
+	"StorageTypeNotSupported":                    newErrorStorageTypeNotSupportedFault,
+	"SubnetAlreadyInUse":                         newErrorSubnetAlreadyInUse,
+	"SubscriptionAlreadyExist":                   newErrorSubscriptionAlreadyExistFault,
+	"SubscriptionCategoryNotFound":               newErrorSubscriptionCategoryNotFoundFault,
+	"SubscriptionNotFound":                       newErrorSubscriptionNotFoundFault,
+	"TenantDatabaseAlreadyExists":                newErrorTenantDatabaseAlreadyExistsFault,
+	"TenantDatabaseNotFound":                     newErrorTenantDatabaseNotFoundFault,
+	"TenantDatabaseQuotaExceeded":                newErrorTenantDatabaseQuotaExceededFault,
+	"UnsupportedDBEngineVersion":                 newErrorUnsupportedDBEngineVersionFault,
+}
@@ -2,6 +2,10 @@
 
 package redshift
 
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
 const (
 
 	// ErrCodeAccessToClusterDeniedFault for service response error code
@@ -896,3 +900,3678 @@ const (
 	// The usage limit identifier can't be found.
 	ErrCodeUsageLimitNotFoundFault = "UsageLimitNotFound"
 )
+
+// AccessToClusterDeniedFault is the modeled exception returned for the
+// "AccessToClusterDenied" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AccessToClusterDeniedFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorAccessToClusterDeniedFault(err awserr.RequestFailure) error {
+	return &AccessToClusterDeniedFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AccessToClusterDeniedFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AccessToClusterDeniedFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// AccessToSnapshotDeniedFault is the modeled exception returned for the
+// "AccessToSnapshotDenied" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AccessToSnapshotDeniedFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorAccessToSnapshotDeniedFault(err awserr.RequestFailure) error {
+	return &AccessToSnapshotDeniedFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AccessToSnapshotDeniedFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AccessToSnapshotDeniedFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// AuthenticationProfileAlreadyExistsFault is the modeled exception returned for the
+// "AuthenticationProfileAlreadyExistsFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AuthenticationProfileAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorAuthenticationProfileAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &AuthenticationProfileAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AuthenticationProfileAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AuthenticationProfileAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// AuthenticationProfileNotFoundFault is the modeled exception returned for the
+// "AuthenticationProfileNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AuthenticationProfileNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorAuthenticationProfileNotFoundFault(err awserr.RequestFailure) error {
+	return &AuthenticationProfileNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AuthenticationProfileNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AuthenticationProfileNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// AuthenticationProfileQuotaExceededFault is the modeled exception returned for the
+// "AuthenticationProfileQuotaExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AuthenticationProfileQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorAuthenticationProfileQuotaExceededFault(err awserr.RequestFailure) error {
+	return &AuthenticationProfileQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AuthenticationProfileQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AuthenticationProfileQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// AuthorizationAlreadyExistsFault is the modeled exception returned for the
+// "AuthorizationAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AuthorizationAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorAuthorizationAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &AuthorizationAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AuthorizationAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AuthorizationAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// AuthorizationNotFoundFault is the modeled exception returned for the
+// "AuthorizationNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AuthorizationNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorAuthorizationNotFoundFault(err awserr.RequestFailure) error {
+	return &AuthorizationNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AuthorizationNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AuthorizationNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// AuthorizationQuotaExceededFault is the modeled exception returned for the
+// "AuthorizationQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AuthorizationQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorAuthorizationQuotaExceededFault(err awserr.RequestFailure) error {
+	return &AuthorizationQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AuthorizationQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AuthorizationQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// BatchDeleteRequestSizeExceededFault is the modeled exception returned for the
+// "BatchDeleteRequestSizeExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type BatchDeleteRequestSizeExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorBatchDeleteRequestSizeExceededFault(err awserr.RequestFailure) error {
+	return &BatchDeleteRequestSizeExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *BatchDeleteRequestSizeExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *BatchDeleteRequestSizeExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// BatchModifyClusterSnapshotsLimitExceededFault is the modeled exception returned for the
+// "BatchModifyClusterSnapshotsLimitExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type BatchModifyClusterSnapshotsLimitExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorBatchModifyClusterSnapshotsLimitExceededFault(err awserr.RequestFailure) error {
+	return &BatchModifyClusterSnapshotsLimitExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *BatchModifyClusterSnapshotsLimitExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *BatchModifyClusterSnapshotsLimitExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// BucketNotFoundFault is the modeled exception returned for the
+// "BucketNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type BucketNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorBucketNotFoundFault(err awserr.RequestFailure) error {
+	return &BucketNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *BucketNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *BucketNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ClusterAlreadyExistsFault is the modeled exception returned for the
+// "ClusterAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ClusterAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorClusterAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &ClusterAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ClusterAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ClusterAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ClusterNotFoundFault is the modeled exception returned for the
+// "ClusterNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ClusterNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorClusterNotFoundFault(err awserr.RequestFailure) error {
+	return &ClusterNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ClusterNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ClusterNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ClusterOnLatestRevisionFault is the modeled exception returned for the
+// "ClusterOnLatestRevision" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ClusterOnLatestRevisionFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorClusterOnLatestRevisionFault(err awserr.RequestFailure) error {
+	return &ClusterOnLatestRevisionFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ClusterOnLatestRevisionFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ClusterOnLatestRevisionFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ClusterParameterGroupAlreadyExistsFault is the modeled exception returned for the
+// "ClusterParameterGroupAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ClusterParameterGroupAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorClusterParameterGroupAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &ClusterParameterGroupAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ClusterParameterGroupAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ClusterParameterGroupAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ClusterParameterGroupNotFoundFault is the modeled exception returned for the
+// "ClusterParameterGroupNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ClusterParameterGroupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorClusterParameterGroupNotFoundFault(err awserr.RequestFailure) error {
+	return &ClusterParameterGroupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ClusterParameterGroupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ClusterParameterGroupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ClusterParameterGroupQuotaExceededFault is the modeled exception returned for the
+// "ClusterParameterGroupQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ClusterParameterGroupQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorClusterParameterGroupQuotaExceededFault(err awserr.RequestFailure) error {
+	return &ClusterParameterGroupQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ClusterParameterGroupQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ClusterParameterGroupQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ClusterQuotaExceededFault is the modeled exception returned for the
+// "ClusterQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ClusterQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorClusterQuotaExceededFault(err awserr.RequestFailure) error {
+	return &ClusterQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ClusterQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ClusterQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ClusterSecurityGroupAlreadyExistsFault is the modeled exception returned for the
+// "ClusterSecurityGroupAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ClusterSecurityGroupAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorClusterSecurityGroupAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &ClusterSecurityGroupAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ClusterSecurityGroupAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ClusterSecurityGroupAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ClusterSecurityGroupNotFoundFault is the modeled exception returned for the
+// "ClusterSecurityGroupNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ClusterSecurityGroupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorClusterSecurityGroupNotFoundFault(err awserr.RequestFailure) error {
+	return &ClusterSecurityGroupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ClusterSecurityGroupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ClusterSecurityGroupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ClusterSecurityGroupQuotaExceededFault is the modeled exception returned for the
+// "QuotaExceeded.ClusterSecurityGroup" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ClusterSecurityGroupQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorClusterSecurityGroupQuotaExceededFault(err awserr.RequestFailure) error {
+	return &ClusterSecurityGroupQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ClusterSecurityGroupQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ClusterSecurityGroupQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ClusterSnapshotAlreadyExistsFault is the modeled exception returned for the
+// "ClusterSnapshotAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ClusterSnapshotAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorClusterSnapshotAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &ClusterSnapshotAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ClusterSnapshotAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ClusterSnapshotAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ClusterSnapshotNotFoundFault is the modeled exception returned for the
+// "ClusterSnapshotNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ClusterSnapshotNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorClusterSnapshotNotFoundFault(err awserr.RequestFailure) error {
+	return &ClusterSnapshotNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ClusterSnapshotNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ClusterSnapshotNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ClusterSnapshotQuotaExceededFault is the modeled exception returned for the
+// "ClusterSnapshotQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ClusterSnapshotQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorClusterSnapshotQuotaExceededFault(err awserr.RequestFailure) error {
+	return &ClusterSnapshotQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ClusterSnapshotQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ClusterSnapshotQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ClusterSubnetGroupAlreadyExistsFault is the modeled exception returned for the
+// "ClusterSubnetGroupAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ClusterSubnetGroupAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorClusterSubnetGroupAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &ClusterSubnetGroupAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ClusterSubnetGroupAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ClusterSubnetGroupAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ClusterSubnetGroupNotFoundFault is the modeled exception returned for the
+// "ClusterSubnetGroupNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ClusterSubnetGroupNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorClusterSubnetGroupNotFoundFault(err awserr.RequestFailure) error {
+	return &ClusterSubnetGroupNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ClusterSubnetGroupNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ClusterSubnetGroupNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ClusterSubnetGroupQuotaExceededFault is the modeled exception returned for the
+// "ClusterSubnetGroupQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ClusterSubnetGroupQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorClusterSubnetGroupQuotaExceededFault(err awserr.RequestFailure) error {
+	return &ClusterSubnetGroupQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ClusterSubnetGroupQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ClusterSubnetGroupQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ClusterSubnetQuotaExceededFault is the modeled exception returned for the
+// "ClusterSubnetQuotaExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ClusterSubnetQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorClusterSubnetQuotaExceededFault(err awserr.RequestFailure) error {
+	return &ClusterSubnetQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ClusterSubnetQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ClusterSubnetQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ConflictPolicyUpdateFault is the modeled exception returned for the
+// "ConflictPolicyUpdateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ConflictPolicyUpdateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorConflictPolicyUpdateFault(err awserr.RequestFailure) error {
+	return &ConflictPolicyUpdateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ConflictPolicyUpdateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ConflictPolicyUpdateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CopyToRegionDisabledFault is the modeled exception returned for the
+// "CopyToRegionDisabledFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CopyToRegionDisabledFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorCopyToRegionDisabledFault(err awserr.RequestFailure) error {
+	return &CopyToRegionDisabledFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CopyToRegionDisabledFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CopyToRegionDisabledFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CustomCnameAssociationFault is the modeled exception returned for the
+// "CustomCnameAssociationFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CustomCnameAssociationFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorCustomCnameAssociationFault(err awserr.RequestFailure) error {
+	return &CustomCnameAssociationFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CustomCnameAssociationFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CustomCnameAssociationFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CustomDomainAssociationNotFoundFault is the modeled exception returned for the
+// "CustomDomainAssociationNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CustomDomainAssociationNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorCustomDomainAssociationNotFoundFault(err awserr.RequestFailure) error {
+	return &CustomDomainAssociationNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CustomDomainAssociationNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CustomDomainAssociationNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DependentServiceAccessDeniedFault is the modeled exception returned for the
+// "DependentServiceAccessDenied" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DependentServiceAccessDeniedFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDependentServiceAccessDeniedFault(err awserr.RequestFailure) error {
+	return &DependentServiceAccessDeniedFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DependentServiceAccessDeniedFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DependentServiceAccessDeniedFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DependentServiceRequestThrottlingFault is the modeled exception returned for the
+// "DependentServiceRequestThrottlingFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DependentServiceRequestThrottlingFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDependentServiceRequestThrottlingFault(err awserr.RequestFailure) error {
+	return &DependentServiceRequestThrottlingFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DependentServiceRequestThrottlingFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DependentServiceRequestThrottlingFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DependentServiceUnavailableFault is the modeled exception returned for the
+// "DependentServiceUnavailableFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DependentServiceUnavailableFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorDependentServiceUnavailableFault(err awserr.RequestFailure) error {
+	return &DependentServiceUnavailableFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DependentServiceUnavailableFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DependentServiceUnavailableFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// EndpointAlreadyExistsFault is the modeled exception returned for the
+// "EndpointAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type EndpointAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorEndpointAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &EndpointAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *EndpointAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *EndpointAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// EndpointAuthorizationAlreadyExistsFault is the modeled exception returned for the
+// "EndpointAuthorizationAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type EndpointAuthorizationAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorEndpointAuthorizationAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &EndpointAuthorizationAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *EndpointAuthorizationAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *EndpointAuthorizationAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// EndpointAuthorizationNotFoundFault is the modeled exception returned for the
+// "EndpointAuthorizationNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type EndpointAuthorizationNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorEndpointAuthorizationNotFoundFault(err awserr.RequestFailure) error {
+	return &EndpointAuthorizationNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *EndpointAuthorizationNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *EndpointAuthorizationNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// EndpointAuthorizationsPerClusterLimitExceededFault is the modeled exception returned for the
+// "EndpointAuthorizationsPerClusterLimitExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type EndpointAuthorizationsPerClusterLimitExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorEndpointAuthorizationsPerClusterLimitExceededFault(err awserr.RequestFailure) error {
+	return &EndpointAuthorizationsPerClusterLimitExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *EndpointAuthorizationsPerClusterLimitExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *EndpointAuthorizationsPerClusterLimitExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// EndpointNotFoundFault is the modeled exception returned for the
+// "EndpointNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type EndpointNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorEndpointNotFoundFault(err awserr.RequestFailure) error {
+	return &EndpointNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *EndpointNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *EndpointNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// EndpointsPerAuthorizationLimitExceededFault is the modeled exception returned for the
+// "EndpointsPerAuthorizationLimitExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type EndpointsPerAuthorizationLimitExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorEndpointsPerAuthorizationLimitExceededFault(err awserr.RequestFailure) error {
+	return &EndpointsPerAuthorizationLimitExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *EndpointsPerAuthorizationLimitExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *EndpointsPerAuthorizationLimitExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// EndpointsPerClusterLimitExceededFault is the modeled exception returned for the
+// "EndpointsPerClusterLimitExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type EndpointsPerClusterLimitExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorEndpointsPerClusterLimitExceededFault(err awserr.RequestFailure) error {
+	return &EndpointsPerClusterLimitExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *EndpointsPerClusterLimitExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *EndpointsPerClusterLimitExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// EventSubscriptionQuotaExceededFault is the modeled exception returned for the
+// "EventSubscriptionQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type EventSubscriptionQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorEventSubscriptionQuotaExceededFault(err awserr.RequestFailure) error {
+	return &EventSubscriptionQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *EventSubscriptionQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *EventSubscriptionQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// HsmClientCertificateAlreadyExistsFault is the modeled exception returned for the
+// "HsmClientCertificateAlreadyExistsFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type HsmClientCertificateAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorHsmClientCertificateAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &HsmClientCertificateAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *HsmClientCertificateAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *HsmClientCertificateAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// HsmClientCertificateNotFoundFault is the modeled exception returned for the
+// "HsmClientCertificateNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type HsmClientCertificateNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorHsmClientCertificateNotFoundFault(err awserr.RequestFailure) error {
+	return &HsmClientCertificateNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *HsmClientCertificateNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *HsmClientCertificateNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// HsmClientCertificateQuotaExceededFault is the modeled exception returned for the
+// "HsmClientCertificateQuotaExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type HsmClientCertificateQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorHsmClientCertificateQuotaExceededFault(err awserr.RequestFailure) error {
+	return &HsmClientCertificateQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *HsmClientCertificateQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *HsmClientCertificateQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// HsmConfigurationAlreadyExistsFault is the modeled exception returned for the
+// "HsmConfigurationAlreadyExistsFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type HsmConfigurationAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorHsmConfigurationAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &HsmConfigurationAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *HsmConfigurationAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *HsmConfigurationAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// HsmConfigurationNotFoundFault is the modeled exception returned for the
+// "HsmConfigurationNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type HsmConfigurationNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorHsmConfigurationNotFoundFault(err awserr.RequestFailure) error {
+	return &HsmConfigurationNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *HsmConfigurationNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *HsmConfigurationNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// HsmConfigurationQuotaExceededFault is the modeled exception returned for the
+// "HsmConfigurationQuotaExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type HsmConfigurationQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorHsmConfigurationQuotaExceededFault(err awserr.RequestFailure) error {
+	return &HsmConfigurationQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *HsmConfigurationQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *HsmConfigurationQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InProgressTableRestoreQuotaExceededFault is the modeled exception returned for the
+// "InProgressTableRestoreQuotaExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InProgressTableRestoreQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInProgressTableRestoreQuotaExceededFault(err awserr.RequestFailure) error {
+	return &InProgressTableRestoreQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InProgressTableRestoreQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InProgressTableRestoreQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// IncompatibleOrderableOptions is the modeled exception returned for the
+// "IncompatibleOrderableOptions" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type IncompatibleOrderableOptions struct {
+	awserr.RequestFailure
+}
+
+func newErrorIncompatibleOrderableOptions(err awserr.RequestFailure) error {
+	return &IncompatibleOrderableOptions{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *IncompatibleOrderableOptions) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *IncompatibleOrderableOptions) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InsufficientClusterCapacityFault is the modeled exception returned for the
+// "InsufficientClusterCapacity" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InsufficientClusterCapacityFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInsufficientClusterCapacityFault(err awserr.RequestFailure) error {
+	return &InsufficientClusterCapacityFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InsufficientClusterCapacityFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InsufficientClusterCapacityFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InsufficientS3BucketPolicyFault is the modeled exception returned for the
+// "InsufficientS3BucketPolicyFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InsufficientS3BucketPolicyFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInsufficientS3BucketPolicyFault(err awserr.RequestFailure) error {
+	return &InsufficientS3BucketPolicyFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InsufficientS3BucketPolicyFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InsufficientS3BucketPolicyFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// IntegrationNotFoundFault is the modeled exception returned for the
+// "IntegrationNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type IntegrationNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorIntegrationNotFoundFault(err awserr.RequestFailure) error {
+	return &IntegrationNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *IntegrationNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *IntegrationNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidAuthenticationProfileRequestFault is the modeled exception returned for the
+// "InvalidAuthenticationProfileRequestFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidAuthenticationProfileRequestFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidAuthenticationProfileRequestFault(err awserr.RequestFailure) error {
+	return &InvalidAuthenticationProfileRequestFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidAuthenticationProfileRequestFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidAuthenticationProfileRequestFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidAuthorizationStateFault is the modeled exception returned for the
+// "InvalidAuthorizationState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidAuthorizationStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidAuthorizationStateFault(err awserr.RequestFailure) error {
+	return &InvalidAuthorizationStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidAuthorizationStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidAuthorizationStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidClusterParameterGroupStateFault is the modeled exception returned for the
+// "InvalidClusterParameterGroupState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidClusterParameterGroupStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidClusterParameterGroupStateFault(err awserr.RequestFailure) error {
+	return &InvalidClusterParameterGroupStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidClusterParameterGroupStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidClusterParameterGroupStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidClusterSecurityGroupStateFault is the modeled exception returned for the
+// "InvalidClusterSecurityGroupState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidClusterSecurityGroupStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidClusterSecurityGroupStateFault(err awserr.RequestFailure) error {
+	return &InvalidClusterSecurityGroupStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidClusterSecurityGroupStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidClusterSecurityGroupStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidClusterSnapshotScheduleStateFault is the modeled exception returned for the
+// "InvalidClusterSnapshotScheduleState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidClusterSnapshotScheduleStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidClusterSnapshotScheduleStateFault(err awserr.RequestFailure) error {
+	return &InvalidClusterSnapshotScheduleStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidClusterSnapshotScheduleStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidClusterSnapshotScheduleStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidClusterSnapshotStateFault is the modeled exception returned for the
+// "InvalidClusterSnapshotState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidClusterSnapshotStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidClusterSnapshotStateFault(err awserr.RequestFailure) error {
+	return &InvalidClusterSnapshotStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidClusterSnapshotStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidClusterSnapshotStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidClusterStateFault is the modeled exception returned for the
+// "InvalidClusterState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidClusterStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidClusterStateFault(err awserr.RequestFailure) error {
+	return &InvalidClusterStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidClusterStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidClusterStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidClusterSubnetGroupStateFault is the modeled exception returned for the
+// "InvalidClusterSubnetGroupStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidClusterSubnetGroupStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidClusterSubnetGroupStateFault(err awserr.RequestFailure) error {
+	return &InvalidClusterSubnetGroupStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidClusterSubnetGroupStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidClusterSubnetGroupStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidClusterSubnetStateFault is the modeled exception returned for the
+// "InvalidClusterSubnetStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidClusterSubnetStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidClusterSubnetStateFault(err awserr.RequestFailure) error {
+	return &InvalidClusterSubnetStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidClusterSubnetStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidClusterSubnetStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidClusterTrackFault is the modeled exception returned for the
+// "InvalidClusterTrack" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidClusterTrackFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidClusterTrackFault(err awserr.RequestFailure) error {
+	return &InvalidClusterTrackFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidClusterTrackFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidClusterTrackFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDataShareFault is the modeled exception returned for the
+// "InvalidDataShareFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDataShareFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDataShareFault(err awserr.RequestFailure) error {
+	return &InvalidDataShareFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDataShareFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDataShareFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidElasticIpFault is the modeled exception returned for the
+// "InvalidElasticIpFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidElasticIpFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidElasticIpFault(err awserr.RequestFailure) error {
+	return &InvalidElasticIpFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidElasticIpFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidElasticIpFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidEndpointStateFault is the modeled exception returned for the
+// "InvalidEndpointState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidEndpointStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidEndpointStateFault(err awserr.RequestFailure) error {
+	return &InvalidEndpointStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidEndpointStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidEndpointStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidHsmClientCertificateStateFault is the modeled exception returned for the
+// "InvalidHsmClientCertificateStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidHsmClientCertificateStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidHsmClientCertificateStateFault(err awserr.RequestFailure) error {
+	return &InvalidHsmClientCertificateStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidHsmClientCertificateStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidHsmClientCertificateStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidHsmConfigurationStateFault is the modeled exception returned for the
+// "InvalidHsmConfigurationStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidHsmConfigurationStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidHsmConfigurationStateFault(err awserr.RequestFailure) error {
+	return &InvalidHsmConfigurationStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidHsmConfigurationStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidHsmConfigurationStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidNamespaceFault is the modeled exception returned for the
+// "InvalidNamespaceFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidNamespaceFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidNamespaceFault(err awserr.RequestFailure) error {
+	return &InvalidNamespaceFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidNamespaceFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidNamespaceFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidPolicyFault is the modeled exception returned for the
+// "InvalidPolicyFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidPolicyFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidPolicyFault(err awserr.RequestFailure) error {
+	return &InvalidPolicyFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidPolicyFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidPolicyFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidReservedNodeStateFault is the modeled exception returned for the
+// "InvalidReservedNodeState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidReservedNodeStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidReservedNodeStateFault(err awserr.RequestFailure) error {
+	return &InvalidReservedNodeStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidReservedNodeStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidReservedNodeStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidRestoreFault is the modeled exception returned for the
+// "InvalidRestore" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidRestoreFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidRestoreFault(err awserr.RequestFailure) error {
+	return &InvalidRestoreFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidRestoreFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidRestoreFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidRetentionPeriodFault is the modeled exception returned for the
+// "InvalidRetentionPeriodFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidRetentionPeriodFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidRetentionPeriodFault(err awserr.RequestFailure) error {
+	return &InvalidRetentionPeriodFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidRetentionPeriodFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidRetentionPeriodFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidS3BucketNameFault is the modeled exception returned for the
+// "InvalidS3BucketNameFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidS3BucketNameFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidS3BucketNameFault(err awserr.RequestFailure) error {
+	return &InvalidS3BucketNameFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidS3BucketNameFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidS3BucketNameFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidS3KeyPrefixFault is the modeled exception returned for the
+// "InvalidS3KeyPrefixFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidS3KeyPrefixFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidS3KeyPrefixFault(err awserr.RequestFailure) error {
+	return &InvalidS3KeyPrefixFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidS3KeyPrefixFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidS3KeyPrefixFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidScheduleFault is the modeled exception returned for the
+// "InvalidSchedule" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidScheduleFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidScheduleFault(err awserr.RequestFailure) error {
+	return &InvalidScheduleFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidScheduleFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidScheduleFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidScheduledActionFault is the modeled exception returned for the
+// "InvalidScheduledAction" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidScheduledActionFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidScheduledActionFault(err awserr.RequestFailure) error {
+	return &InvalidScheduledActionFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidScheduledActionFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidScheduledActionFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidSnapshotCopyGrantStateFault is the modeled exception returned for the
+// "InvalidSnapshotCopyGrantStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidSnapshotCopyGrantStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidSnapshotCopyGrantStateFault(err awserr.RequestFailure) error {
+	return &InvalidSnapshotCopyGrantStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidSnapshotCopyGrantStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidSnapshotCopyGrantStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidSubnet is the modeled exception returned for the
+// "InvalidSubnet" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidSubnet struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidSubnet(err awserr.RequestFailure) error {
+	return &InvalidSubnet{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidSubnet) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidSubnet) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidSubscriptionStateFault is the modeled exception returned for the
+// "InvalidSubscriptionStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidSubscriptionStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidSubscriptionStateFault(err awserr.RequestFailure) error {
+	return &InvalidSubscriptionStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidSubscriptionStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidSubscriptionStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidTableRestoreArgumentFault is the modeled exception returned for the
+// "InvalidTableRestoreArgument" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidTableRestoreArgumentFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidTableRestoreArgumentFault(err awserr.RequestFailure) error {
+	return &InvalidTableRestoreArgumentFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidTableRestoreArgumentFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidTableRestoreArgumentFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidTagFault is the modeled exception returned for the
+// "InvalidTagFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidTagFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidTagFault(err awserr.RequestFailure) error {
+	return &InvalidTagFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidTagFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidTagFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidUsageLimitFault is the modeled exception returned for the
+// "InvalidUsageLimit" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidUsageLimitFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidUsageLimitFault(err awserr.RequestFailure) error {
+	return &InvalidUsageLimitFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidUsageLimitFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidUsageLimitFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidVPCNetworkStateFault is the modeled exception returned for the
+// "InvalidVPCNetworkStateFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidVPCNetworkStateFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidVPCNetworkStateFault(err awserr.RequestFailure) error {
+	return &InvalidVPCNetworkStateFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidVPCNetworkStateFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidVPCNetworkStateFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// Ipv6CidrBlockNotFoundFault is the modeled exception returned for the
+// "Ipv6CidrBlockNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type Ipv6CidrBlockNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorIpv6CidrBlockNotFoundFault(err awserr.RequestFailure) error {
+	return &Ipv6CidrBlockNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *Ipv6CidrBlockNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *Ipv6CidrBlockNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// LimitExceededFault is the modeled exception returned for the
+// "LimitExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type LimitExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorLimitExceededFault(err awserr.RequestFailure) error {
+	return &LimitExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *LimitExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *LimitExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NumberOfNodesPerClusterLimitExceededFault is the modeled exception returned for the
+// "NumberOfNodesPerClusterLimitExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NumberOfNodesPerClusterLimitExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorNumberOfNodesPerClusterLimitExceededFault(err awserr.RequestFailure) error {
+	return &NumberOfNodesPerClusterLimitExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NumberOfNodesPerClusterLimitExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NumberOfNodesPerClusterLimitExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NumberOfNodesQuotaExceededFault is the modeled exception returned for the
+// "NumberOfNodesQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NumberOfNodesQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorNumberOfNodesQuotaExceededFault(err awserr.RequestFailure) error {
+	return &NumberOfNodesQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NumberOfNodesQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NumberOfNodesQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// PartnerNotFoundFault is the modeled exception returned for the
+// "PartnerNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type PartnerNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorPartnerNotFoundFault(err awserr.RequestFailure) error {
+	return &PartnerNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *PartnerNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *PartnerNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// RedshiftIdcApplicationAlreadyExistsFault is the modeled exception returned for the
+// "RedshiftIdcApplicationAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type RedshiftIdcApplicationAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorRedshiftIdcApplicationAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &RedshiftIdcApplicationAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *RedshiftIdcApplicationAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *RedshiftIdcApplicationAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// RedshiftIdcApplicationNotExistsFault is the modeled exception returned for the
+// "RedshiftIdcApplicationNotExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type RedshiftIdcApplicationNotExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorRedshiftIdcApplicationNotExistsFault(err awserr.RequestFailure) error {
+	return &RedshiftIdcApplicationNotExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *RedshiftIdcApplicationNotExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *RedshiftIdcApplicationNotExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// RedshiftIdcApplicationQuotaExceededFault is the modeled exception returned for the
+// "RedshiftIdcApplicationQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type RedshiftIdcApplicationQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorRedshiftIdcApplicationQuotaExceededFault(err awserr.RequestFailure) error {
+	return &RedshiftIdcApplicationQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *RedshiftIdcApplicationQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *RedshiftIdcApplicationQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ReservedNodeAlreadyExistsFault is the modeled exception returned for the
+// "ReservedNodeAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ReservedNodeAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorReservedNodeAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &ReservedNodeAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ReservedNodeAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ReservedNodeAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ReservedNodeAlreadyMigratedFault is the modeled exception returned for the
+// "ReservedNodeAlreadyMigrated" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ReservedNodeAlreadyMigratedFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorReservedNodeAlreadyMigratedFault(err awserr.RequestFailure) error {
+	return &ReservedNodeAlreadyMigratedFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ReservedNodeAlreadyMigratedFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ReservedNodeAlreadyMigratedFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ReservedNodeExchangeNotFoundFault is the modeled exception returned for the
+// "ReservedNodeExchangeNotFond" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ReservedNodeExchangeNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorReservedNodeExchangeNotFoundFault(err awserr.RequestFailure) error {
+	return &ReservedNodeExchangeNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ReservedNodeExchangeNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ReservedNodeExchangeNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ReservedNodeNotFoundFault is the modeled exception returned for the
+// "ReservedNodeNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ReservedNodeNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorReservedNodeNotFoundFault(err awserr.RequestFailure) error {
+	return &ReservedNodeNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ReservedNodeNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ReservedNodeNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ReservedNodeOfferingNotFoundFault is the modeled exception returned for the
+// "ReservedNodeOfferingNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ReservedNodeOfferingNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorReservedNodeOfferingNotFoundFault(err awserr.RequestFailure) error {
+	return &ReservedNodeOfferingNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ReservedNodeOfferingNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ReservedNodeOfferingNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ReservedNodeQuotaExceededFault is the modeled exception returned for the
+// "ReservedNodeQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ReservedNodeQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorReservedNodeQuotaExceededFault(err awserr.RequestFailure) error {
+	return &ReservedNodeQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ReservedNodeQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ReservedNodeQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ResizeNotFoundFault is the modeled exception returned for the
+// "ResizeNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ResizeNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorResizeNotFoundFault(err awserr.RequestFailure) error {
+	return &ResizeNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ResizeNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ResizeNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ResourceNotFoundFault is the modeled exception returned for the
+// "ResourceNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ResourceNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorResourceNotFoundFault(err awserr.RequestFailure) error {
+	return &ResourceNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ResourceNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ResourceNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SNSInvalidTopicFault is the modeled exception returned for the
+// "SNSInvalidTopic" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SNSInvalidTopicFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSNSInvalidTopicFault(err awserr.RequestFailure) error {
+	return &SNSInvalidTopicFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SNSInvalidTopicFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SNSInvalidTopicFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SNSNoAuthorizationFault is the modeled exception returned for the
+// "SNSNoAuthorization" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SNSNoAuthorizationFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSNSNoAuthorizationFault(err awserr.RequestFailure) error {
+	return &SNSNoAuthorizationFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SNSNoAuthorizationFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SNSNoAuthorizationFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SNSTopicArnNotFoundFault is the modeled exception returned for the
+// "SNSTopicArnNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SNSTopicArnNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSNSTopicArnNotFoundFault(err awserr.RequestFailure) error {
+	return &SNSTopicArnNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SNSTopicArnNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SNSTopicArnNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ScheduleDefinitionTypeUnsupportedFault is the modeled exception returned for the
+// "ScheduleDefinitionTypeUnsupported" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ScheduleDefinitionTypeUnsupportedFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorScheduleDefinitionTypeUnsupportedFault(err awserr.RequestFailure) error {
+	return &ScheduleDefinitionTypeUnsupportedFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ScheduleDefinitionTypeUnsupportedFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ScheduleDefinitionTypeUnsupportedFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ScheduledActionAlreadyExistsFault is the modeled exception returned for the
+// "ScheduledActionAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ScheduledActionAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorScheduledActionAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &ScheduledActionAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ScheduledActionAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ScheduledActionAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ScheduledActionNotFoundFault is the modeled exception returned for the
+// "ScheduledActionNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ScheduledActionNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorScheduledActionNotFoundFault(err awserr.RequestFailure) error {
+	return &ScheduledActionNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ScheduledActionNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ScheduledActionNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ScheduledActionQuotaExceededFault is the modeled exception returned for the
+// "ScheduledActionQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ScheduledActionQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorScheduledActionQuotaExceededFault(err awserr.RequestFailure) error {
+	return &ScheduledActionQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ScheduledActionQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ScheduledActionQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ScheduledActionTypeUnsupportedFault is the modeled exception returned for the
+// "ScheduledActionTypeUnsupported" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ScheduledActionTypeUnsupportedFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorScheduledActionTypeUnsupportedFault(err awserr.RequestFailure) error {
+	return &ScheduledActionTypeUnsupportedFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ScheduledActionTypeUnsupportedFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ScheduledActionTypeUnsupportedFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SnapshotCopyAlreadyDisabledFault is the modeled exception returned for the
+// "SnapshotCopyAlreadyDisabledFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SnapshotCopyAlreadyDisabledFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSnapshotCopyAlreadyDisabledFault(err awserr.RequestFailure) error {
+	return &SnapshotCopyAlreadyDisabledFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SnapshotCopyAlreadyDisabledFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SnapshotCopyAlreadyDisabledFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SnapshotCopyAlreadyEnabledFault is the modeled exception returned for the
+// "SnapshotCopyAlreadyEnabledFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SnapshotCopyAlreadyEnabledFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSnapshotCopyAlreadyEnabledFault(err awserr.RequestFailure) error {
+	return &SnapshotCopyAlreadyEnabledFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SnapshotCopyAlreadyEnabledFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SnapshotCopyAlreadyEnabledFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SnapshotCopyDisabledFault is the modeled exception returned for the
+// "SnapshotCopyDisabledFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SnapshotCopyDisabledFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSnapshotCopyDisabledFault(err awserr.RequestFailure) error {
+	return &SnapshotCopyDisabledFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SnapshotCopyDisabledFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SnapshotCopyDisabledFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SnapshotCopyGrantAlreadyExistsFault is the modeled exception returned for the
+// "SnapshotCopyGrantAlreadyExistsFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SnapshotCopyGrantAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSnapshotCopyGrantAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &SnapshotCopyGrantAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SnapshotCopyGrantAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SnapshotCopyGrantAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SnapshotCopyGrantNotFoundFault is the modeled exception returned for the
+// "SnapshotCopyGrantNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SnapshotCopyGrantNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSnapshotCopyGrantNotFoundFault(err awserr.RequestFailure) error {
+	return &SnapshotCopyGrantNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SnapshotCopyGrantNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SnapshotCopyGrantNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SnapshotCopyGrantQuotaExceededFault is the modeled exception returned for the
+// "SnapshotCopyGrantQuotaExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SnapshotCopyGrantQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSnapshotCopyGrantQuotaExceededFault(err awserr.RequestFailure) error {
+	return &SnapshotCopyGrantQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SnapshotCopyGrantQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SnapshotCopyGrantQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SnapshotScheduleAlreadyExistsFault is the modeled exception returned for the
+// "SnapshotScheduleAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SnapshotScheduleAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSnapshotScheduleAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &SnapshotScheduleAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SnapshotScheduleAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SnapshotScheduleAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SnapshotScheduleNotFoundFault is the modeled exception returned for the
+// "SnapshotScheduleNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SnapshotScheduleNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSnapshotScheduleNotFoundFault(err awserr.RequestFailure) error {
+	return &SnapshotScheduleNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SnapshotScheduleNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SnapshotScheduleNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SnapshotScheduleQuotaExceededFault is the modeled exception returned for the
+// "SnapshotScheduleQuotaExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SnapshotScheduleQuotaExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSnapshotScheduleQuotaExceededFault(err awserr.RequestFailure) error {
+	return &SnapshotScheduleQuotaExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SnapshotScheduleQuotaExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SnapshotScheduleQuotaExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SnapshotScheduleUpdateInProgressFault is the modeled exception returned for the
+// "SnapshotScheduleUpdateInProgress" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SnapshotScheduleUpdateInProgressFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSnapshotScheduleUpdateInProgressFault(err awserr.RequestFailure) error {
+	return &SnapshotScheduleUpdateInProgressFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SnapshotScheduleUpdateInProgressFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SnapshotScheduleUpdateInProgressFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SourceNotFoundFault is the modeled exception returned for the
+// "SourceNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SourceNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSourceNotFoundFault(err awserr.RequestFailure) error {
+	return &SourceNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SourceNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SourceNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SubnetAlreadyInUse is the modeled exception returned for the
+// "SubnetAlreadyInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SubnetAlreadyInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorSubnetAlreadyInUse(err awserr.RequestFailure) error {
+	return &SubnetAlreadyInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SubnetAlreadyInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SubnetAlreadyInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SubscriptionAlreadyExistFault is the modeled exception returned for the
+// "SubscriptionAlreadyExist" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SubscriptionAlreadyExistFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSubscriptionAlreadyExistFault(err awserr.RequestFailure) error {
+	return &SubscriptionAlreadyExistFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SubscriptionAlreadyExistFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SubscriptionAlreadyExistFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SubscriptionCategoryNotFoundFault is the modeled exception returned for the
+// "SubscriptionCategoryNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SubscriptionCategoryNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSubscriptionCategoryNotFoundFault(err awserr.RequestFailure) error {
+	return &SubscriptionCategoryNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SubscriptionCategoryNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SubscriptionCategoryNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SubscriptionEventIdNotFoundFault is the modeled exception returned for the
+// "SubscriptionEventIdNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SubscriptionEventIdNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSubscriptionEventIdNotFoundFault(err awserr.RequestFailure) error {
+	return &SubscriptionEventIdNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SubscriptionEventIdNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SubscriptionEventIdNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SubscriptionNotFoundFault is the modeled exception returned for the
+// "SubscriptionNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SubscriptionNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSubscriptionNotFoundFault(err awserr.RequestFailure) error {
+	return &SubscriptionNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SubscriptionNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SubscriptionNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SubscriptionSeverityNotFoundFault is the modeled exception returned for the
+// "SubscriptionSeverityNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SubscriptionSeverityNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorSubscriptionSeverityNotFoundFault(err awserr.RequestFailure) error {
+	return &SubscriptionSeverityNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SubscriptionSeverityNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SubscriptionSeverityNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TableLimitExceededFault is the modeled exception returned for the
+// "TableLimitExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TableLimitExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorTableLimitExceededFault(err awserr.RequestFailure) error {
+	return &TableLimitExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TableLimitExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TableLimitExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TableRestoreNotFoundFault is the modeled exception returned for the
+// "TableRestoreNotFoundFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TableRestoreNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorTableRestoreNotFoundFault(err awserr.RequestFailure) error {
+	return &TableRestoreNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TableRestoreNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TableRestoreNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TagLimitExceededFault is the modeled exception returned for the
+// "TagLimitExceededFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TagLimitExceededFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorTagLimitExceededFault(err awserr.RequestFailure) error {
+	return &TagLimitExceededFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TagLimitExceededFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TagLimitExceededFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// UnauthorizedOperation is the modeled exception returned for the
+// "UnauthorizedOperation" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type UnauthorizedOperation struct {
+	awserr.RequestFailure
+}
+
+func newErrorUnauthorizedOperation(err awserr.RequestFailure) error {
+	return &UnauthorizedOperation{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *UnauthorizedOperation) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *UnauthorizedOperation) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// UnauthorizedPartnerIntegrationFault is the modeled exception returned for the
+// "UnauthorizedPartnerIntegration" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type UnauthorizedPartnerIntegrationFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorUnauthorizedPartnerIntegrationFault(err awserr.RequestFailure) error {
+	return &UnauthorizedPartnerIntegrationFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *UnauthorizedPartnerIntegrationFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *UnauthorizedPartnerIntegrationFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// UnknownSnapshotCopyRegionFault is the modeled exception returned for the
+// "UnknownSnapshotCopyRegionFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type UnknownSnapshotCopyRegionFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorUnknownSnapshotCopyRegionFault(err awserr.RequestFailure) error {
+	return &UnknownSnapshotCopyRegionFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *UnknownSnapshotCopyRegionFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *UnknownSnapshotCopyRegionFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// UnsupportedOperationFault is the modeled exception returned for the
+// "UnsupportedOperation" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type UnsupportedOperationFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorUnsupportedOperationFault(err awserr.RequestFailure) error {
+	return &UnsupportedOperationFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *UnsupportedOperationFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *UnsupportedOperationFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// UnsupportedOptionFault is the modeled exception returned for the
+// "UnsupportedOptionFault" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type UnsupportedOptionFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorUnsupportedOptionFault(err awserr.RequestFailure) error {
+	return &UnsupportedOptionFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *UnsupportedOptionFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *UnsupportedOptionFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// UsageLimitAlreadyExistsFault is the modeled exception returned for the
+// "UsageLimitAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type UsageLimitAlreadyExistsFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorUsageLimitAlreadyExistsFault(err awserr.RequestFailure) error {
+	return &UsageLimitAlreadyExistsFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *UsageLimitAlreadyExistsFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *UsageLimitAlreadyExistsFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// UsageLimitNotFoundFault is the modeled exception returned for the
+// "UsageLimitNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type UsageLimitNotFoundFault struct {
+	awserr.RequestFailure
+}
+
+func newErrorUsageLimitNotFoundFault(err awserr.RequestFailure) error {
+	return &UsageLimitNotFoundFault{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *UsageLimitNotFoundFault) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *UsageLimitNotFoundFault) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+var exceptionFromCode = map[string]func(awserr.RequestFailure) error{
+	"AccessToClusterDenied":                         newErrorAccessToClusterDeniedFault,
+	"AccessToSnapshotDenied":                        newErrorAccessToSnapshotDeniedFault,
+	"AuthenticationProfileAlreadyExistsFault":       newErrorAuthenticationProfileAlreadyExistsFault,
+	"AuthenticationProfileNotFoundFault":            newErrorAuthenticationProfileNotFoundFault,
+	"AuthenticationProfileQuotaExceededFault":       newErrorAuthenticationProfileQuotaExceededFault,
+	"AuthorizationAlreadyExists":                    newErrorAuthorizationAlreadyExistsFault,
+	"AuthorizationNotFound":                         newErrorAuthorizationNotFoundFault,
+	"AuthorizationQuotaExceeded":                    newErrorAuthorizationQuotaExceededFault,
+	"BatchDeleteRequestSizeExceeded":                newErrorBatchDeleteRequestSizeExceededFault,
+	"BatchModifyClusterSnapshotsLimitExceededFault": newErrorBatchModifyClusterSnapshotsLimitExceededFault,
+	"BucketNotFoundFault":                           newErrorBucketNotFoundFault,
+	"ClusterAlreadyExists":                          newErrorClusterAlreadyExistsFault,
+	"ClusterNotFound":                               newErrorClusterNotFoundFault,
+	"ClusterOnLatestRevision":                       newErrorClusterOnLatestRevisionFault,
+	"ClusterParameterGroupAlreadyExists":            newErrorClusterParameterGroupAlreadyExistsFault,
+	"ClusterParameterGroupNotFound":                 newErrorClusterParameterGroupNotFoundFault,
+	"ClusterParameterGroupQuotaExceeded":            newErrorClusterParameterGroupQuotaExceededFault,
+	"ClusterQuotaExceeded":                          newErrorClusterQuotaExceededFault,
+	"ClusterSecurityGroupAlreadyExists":             newErrorClusterSecurityGroupAlreadyExistsFault,
+	"ClusterSecurityGroupNotFound":                  newErrorClusterSecurityGroupNotFoundFault,
+	"QuotaExceeded.ClusterSecurityGroup":            newErrorClusterSecurityGroupQuotaExceededFault,
+	"ClusterSnapshotAlreadyExists":                  newErrorClusterSnapshotAlreadyExistsFault,
+	"ClusterSnapshotNotFound":                       newErrorClusterSnapshotNotFoundFault,
+	"ClusterSnapshotQuotaExceeded":                  newErrorClusterSnapshotQuotaExceededFault,
+	"ClusterSubnetGroupAlreadyExists":               newErrorClusterSubnetGroupAlreadyExistsFault,
+	"ClusterSubnetGroupNotFoundFault":               newErrorClusterSubnetGroupNotFoundFault,
+	"ClusterSubnetGroupQuotaExceeded":               newErrorClusterSubnetGroupQuotaExceededFault,
+	"ClusterSubnetQuotaExceededFault":               newErrorClusterSubnetQuotaExceededFault,
+	"ConflictPolicyUpdateFault":                     newErrorConflictPolicyUpdateFault,
+	"CopyToRegionDisabledFault":                     newErrorCopyToRegionDisabledFault,
+	"CustomCnameAssociationFault":                   newErrorCustomCnameAssociationFault,
+	"CustomDomainAssociationNotFoundFault":          newErrorCustomDomainAssociationNotFoundFault,
+	"DependentServiceAccessDenied":                  newErrorDependentServiceAccessDeniedFault,
+	"DependentServiceRequestThrottlingFault":        newErrorDependentServiceRequestThrottlingFault,
+	"DependentServiceUnavailableFault":              newErrorDependentServiceUnavailableFault,
+	"EndpointAlreadyExists":                         newErrorEndpointAlreadyExistsFault,
+	"EndpointAuthorizationAlreadyExists":            newErrorEndpointAuthorizationAlreadyExistsFault,
+	"EndpointAuthorizationNotFound":                 newErrorEndpointAuthorizationNotFoundFault,
+	"EndpointAuthorizationsPerClusterLimitExceeded": newErrorEndpointAuthorizationsPerClusterLimitExceededFault,
+	"EndpointNotFound":                              newErrorEndpointNotFoundFault,
+	"EndpointsPerAuthorizationLimitExceeded":        newErrorEndpointsPerAuthorizationLimitExceededFault,
+	"EndpointsPerClusterLimitExceeded":              newErrorEndpointsPerClusterLimitExceededFault,
+	"EventSubscriptionQuotaExceeded":                newErrorEventSubscriptionQuotaExceededFault,
+	"HsmClientCertificateAlreadyExistsFault":        newErrorHsmClientCertificateAlreadyExistsFault,
+	"HsmClientCertificateNotFoundFault":             newErrorHsmClientCertificateNotFoundFault,
+	"HsmClientCertificateQuotaExceededFault":        newErrorHsmClientCertificateQuotaExceededFault,
+	"HsmConfigurationAlreadyExistsFault":            newErrorHsmConfigurationAlreadyExistsFault,
+	"HsmConfigurationNotFoundFault":                 newErrorHsmConfigurationNotFoundFault,
+	"HsmConfigurationQuotaExceededFault":            newErrorHsmConfigurationQuotaExceededFault,
+	"InProgressTableRestoreQuotaExceededFault":      newErrorInProgressTableRestoreQuotaExceededFault,
+	"IncompatibleOrderableOptions":                  newErrorIncompatibleOrderableOptions,
+	"InsufficientClusterCapacity":                   newErrorInsufficientClusterCapacityFault,
+	"InsufficientS3BucketPolicyFault":               newErrorInsufficientS3BucketPolicyFault,
+	"IntegrationNotFoundFault":                      newErrorIntegrationNotFoundFault,
+	"InvalidAuthenticationProfileRequestFault":      newErrorInvalidAuthenticationProfileRequestFault,
+	"InvalidAuthorizationState":                     newErrorInvalidAuthorizationStateFault,
+	"InvalidClusterParameterGroupState":             newErrorInvalidClusterParameterGroupStateFault,
+	"InvalidClusterSecurityGroupState":              newErrorInvalidClusterSecurityGroupStateFault,
+	"InvalidClusterSnapshotScheduleState":           newErrorInvalidClusterSnapshotScheduleStateFault,
+	"InvalidClusterSnapshotState":                   newErrorInvalidClusterSnapshotStateFault,
+	"InvalidClusterState":                           newErrorInvalidClusterStateFault,
+	"InvalidClusterSubnetGroupStateFault":           newErrorInvalidClusterSubnetGroupStateFault,
+	"InvalidClusterSubnetStateFault":                newErrorInvalidClusterSubnetStateFault,
+	"InvalidClusterTrack":                           newErrorInvalidClusterTrackFault,
+	"InvalidDataShareFault":                         newErrorInvalidDataShareFault,
+	"InvalidElasticIpFault":                         newErrorInvalidElasticIpFault,
+	"InvalidEndpointState":                          newErrorInvalidEndpointStateFault,
+	"InvalidHsmClientCertificateStateFault":         newErrorInvalidHsmClientCertificateStateFault,
+	"InvalidHsmConfigurationStateFault":             newErrorInvalidHsmConfigurationStateFault,
+	"InvalidNamespaceFault":                         newErrorInvalidNamespaceFault,
+	"InvalidPolicyFault":                            newErrorInvalidPolicyFault,
+	"InvalidReservedNodeState":                      newErrorInvalidReservedNodeStateFault,
+	"InvalidRestore":                                newErrorInvalidRestoreFault,
+	"InvalidRetentionPeriodFault":                   newErrorInvalidRetentionPeriodFault,
+	"InvalidS3BucketNameFault":                      newErrorInvalidS3BucketNameFault,
+	"InvalidS3KeyPrefixFault":                       newErrorInvalidS3KeyPrefixFault,
+	"InvalidSchedule":                               newErrorInvalidScheduleFault,
+	"InvalidScheduledAction":                        newErrorInvalidScheduledActionFault,
+	"InvalidSnapshotCopyGrantStateFault":            newErrorInvalidSnapshotCopyGrantStateFault,
+	"InvalidSubnet":                                 newErrorInvalidSubnet,
+	"InvalidSubscriptionStateFault":                 newErrorInvalidSubscriptionStateFault,
+	"InvalidTableRestoreArgument":                   newErrorInvalidTableRestoreArgumentFault,
+	"InvalidTagFault":                               newErrorInvalidTagFault,
+	"InvalidUsageLimit":                             newErrorInvalidUsageLimitFault,
+	"InvalidVPCNetworkStateFault":                   newErrorInvalidVPCNetworkStateFault,
+	"Ipv6CidrBlockNotFoundFault":                    newErrorIpv6CidrBlockNotFoundFault,
+	"LimitExceededFault":                            newErrorLimitExceededFault,
+	"NumberOfNodesPerClusterLimitExceeded":          newErrorNumberOfNodesPerClusterLimitExceededFault,
+	"NumberOfNodesQuotaExceeded":                    newErrorNumberOfNodesQuotaExceededFault,
+	"PartnerNotFound":                               newErrorPartnerNotFoundFault,
+	"RedshiftIdcApplicationAlreadyExists":           newErrorRedshiftIdcApplicationAlreadyExistsFault,
+	"RedshiftIdcApplicationNotExists":               newErrorRedshiftIdcApplicationNotExistsFault,
+	"RedshiftIdcApplicationQuotaExceeded":           newErrorRedshiftIdcApplicationQuotaExceededFault,
+	"ReservedNodeAlreadyExists":                     newErrorReservedNodeAlreadyExistsFault,
+	"ReservedNodeAlreadyMigrated":                   newErrorReservedNodeAlreadyMigratedFault,
+	"ReservedNodeExchangeNotFond":                   newErrorReservedNodeExchangeNotFoundFault,
+	"ReservedNodeNotFound":                          newErrorReservedNodeNotFoundFault,
+	"ReservedNodeOfferingNotFound":                  newErrorReservedNodeOfferingNotFoundFault,
+	"ReservedNodeQuotaExceeded":                     newErrorReservedNodeQuotaExceededFault,
+	"ResizeNotFound":                                newErrorResizeNotFoundFault,
+	"ResourceNotFoundFault":                         newErrorResourceNotFoundFault,
+	"SNSInvalidTopic":                               newErrorSNSInvalidTopicFault,
+	"SNSNoAuthorization":                            newErrorSNSNoAuthorizationFault,
+	"SNSTopicArnNotFound":                           newErrorSNSTopicArnNotFoundFault,
+	"ScheduleDefinitionTypeUnsupported":             newErrorScheduleDefinitionTypeUnsupportedFault,
+	"ScheduledActionAlreadyExists":                  newErrorScheduledActionAlreadyExistsFault,
+	"ScheduledActionNotFound":                       newErrorScheduledActionNotFoundFault,
+	"ScheduledActionQuotaExceeded":                  newErrorScheduledActionQuotaExceededFault,
+	"ScheduledActionTypeUnsupported":                newErrorScheduledActionTypeUnsupportedFault,
+	"SnapshotCopyAlreadyDisabledFault":              newErrorSnapshotCopyAlreadyDisabledFault,
+	"SnapshotCopyAlreadyEnabledFault":               newErrorSnapshotCopyAlreadyEnabledFault,
+	"SnapshotCopyDisabledFault":                     newErrorSnapshotCopyDisabledFault,
+	"SnapshotCopyGrantAlreadyExistsFault":           newErrorSnapshotCopyGrantAlreadyExistsFault,
+	"SnapshotCopyGrantNotFoundFault":                newErrorSnapshotCopyGrantNotFoundFault,
+	"SnapshotCopyGrantQuotaExceededFault":           newErrorSnapshotCopyGrantQuotaExceededFault,
+	"SnapshotScheduleAlreadyExists":                 newErrorSnapshotScheduleAlreadyExistsFault,
+	"SnapshotScheduleNotFound":                      newErrorSnapshotScheduleNotFoundFault,
+	"SnapshotScheduleQuotaExceeded":                 newErrorSnapshotScheduleQuotaExceededFault,
+	"SnapshotScheduleUpdateInProgress":              newErrorSnapshotScheduleUpdateInProgressFault,
+	"SourceNotFound":                                newErrorSourceNotFoundFault,
+	"SubnetAlreadyInUse":                            newErrorSubnetAlreadyInUse,
+	"SubscriptionAlreadyExist":                      newErrorSubscriptionAlreadyExistFault,
+	"SubscriptionCategoryNotFound":                  newErrorSubscriptionCategoryNotFoundFault,
+	"SubscriptionEventIdNotFound":                   newErrorSubscriptionEventIdNotFoundFault,
+	"SubscriptionNotFound":                          newErrorSubscriptionNotFoundFault,
+	"SubscriptionSeverityNotFound":                  newErrorSubscriptionSeverityNotFoundFault,
+	"TableLimitExceeded":                            newErrorTableLimitExceededFault,
+	"TableRestoreNotFoundFault":                     newErrorTableRestoreNotFoundFault,
+	"TagLimitExceededFault":                         newErrorTagLimitExceededFault,
+	"UnauthorizedOperation":                         newErrorUnauthorizedOperation,
+	"UnauthorizedPartnerIntegration":                newErrorUnauthorizedPartnerIntegrationFault,
+	"UnknownSnapshotCopyRegionFault":                newErrorUnknownSnapshotCopyRegionFault,
+	"UnsupportedOperation":                          newErrorUnsupportedOperationFault,
+	"UnsupportedOptionFault":                        newErrorUnsupportedOptionFault,
+	"UsageLimitAlreadyExists":                       newErrorUsageLimitAlreadyExistsFault,
+	"UsageLimitNotFound":                            newErrorUsageLimitNotFoundFault,
+}
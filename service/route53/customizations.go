@@ -19,7 +19,7 @@ func init() {
 		switch r.Operation.Name {
 		case opChangeResourceRecordSets:
 			r.Handlers.UnmarshalError.Remove(restxml.UnmarshalErrorHandler)
-			r.Handlers.UnmarshalError.PushBack(unmarshalChangeResourceRecordSetsError)
+			r.Handlers.UnmarshalError.PushFront(unmarshalChangeResourceRecordSetsError)
 		}
 	}
 }
@@ -2,6 +2,10 @@
 
 package route53
 
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
 const (
 
 	// ErrCodeCidrBlockInUseException for service response error code
@@ -559,3 +563,1869 @@ const (
 	// The specified VPC and hosted zone are not currently associated.
 	ErrCodeVPCAssociationNotFound = "VPCAssociationNotFound"
 )
+
+// CidrBlockInUseException is the modeled exception returned for the
+// "CidrBlockInUseException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CidrBlockInUseException struct {
+	awserr.RequestFailure
+}
+
+func newErrorCidrBlockInUseException(err awserr.RequestFailure) error {
+	return &CidrBlockInUseException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CidrBlockInUseException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CidrBlockInUseException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CidrCollectionAlreadyExistsException is the modeled exception returned for the
+// "CidrCollectionAlreadyExistsException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CidrCollectionAlreadyExistsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorCidrCollectionAlreadyExistsException(err awserr.RequestFailure) error {
+	return &CidrCollectionAlreadyExistsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CidrCollectionAlreadyExistsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CidrCollectionAlreadyExistsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CidrCollectionInUseException is the modeled exception returned for the
+// "CidrCollectionInUseException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CidrCollectionInUseException struct {
+	awserr.RequestFailure
+}
+
+func newErrorCidrCollectionInUseException(err awserr.RequestFailure) error {
+	return &CidrCollectionInUseException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CidrCollectionInUseException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CidrCollectionInUseException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CidrCollectionVersionMismatchException is the modeled exception returned for the
+// "CidrCollectionVersionMismatchException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CidrCollectionVersionMismatchException struct {
+	awserr.RequestFailure
+}
+
+func newErrorCidrCollectionVersionMismatchException(err awserr.RequestFailure) error {
+	return &CidrCollectionVersionMismatchException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CidrCollectionVersionMismatchException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CidrCollectionVersionMismatchException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ConcurrentModification is the modeled exception returned for the
+// "ConcurrentModification" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ConcurrentModification struct {
+	awserr.RequestFailure
+}
+
+func newErrorConcurrentModification(err awserr.RequestFailure) error {
+	return &ConcurrentModification{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ConcurrentModification) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ConcurrentModification) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ConflictingDomainExists is the modeled exception returned for the
+// "ConflictingDomainExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ConflictingDomainExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorConflictingDomainExists(err awserr.RequestFailure) error {
+	return &ConflictingDomainExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ConflictingDomainExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ConflictingDomainExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ConflictingTypes is the modeled exception returned for the
+// "ConflictingTypes" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ConflictingTypes struct {
+	awserr.RequestFailure
+}
+
+func newErrorConflictingTypes(err awserr.RequestFailure) error {
+	return &ConflictingTypes{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ConflictingTypes) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ConflictingTypes) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DNSSECNotFound is the modeled exception returned for the
+// "DNSSECNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DNSSECNotFound struct {
+	awserr.RequestFailure
+}
+
+func newErrorDNSSECNotFound(err awserr.RequestFailure) error {
+	return &DNSSECNotFound{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DNSSECNotFound) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DNSSECNotFound) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DelegationSetAlreadyCreated is the modeled exception returned for the
+// "DelegationSetAlreadyCreated" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DelegationSetAlreadyCreated struct {
+	awserr.RequestFailure
+}
+
+func newErrorDelegationSetAlreadyCreated(err awserr.RequestFailure) error {
+	return &DelegationSetAlreadyCreated{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DelegationSetAlreadyCreated) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DelegationSetAlreadyCreated) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DelegationSetAlreadyReusable is the modeled exception returned for the
+// "DelegationSetAlreadyReusable" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DelegationSetAlreadyReusable struct {
+	awserr.RequestFailure
+}
+
+func newErrorDelegationSetAlreadyReusable(err awserr.RequestFailure) error {
+	return &DelegationSetAlreadyReusable{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DelegationSetAlreadyReusable) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DelegationSetAlreadyReusable) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DelegationSetInUse is the modeled exception returned for the
+// "DelegationSetInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DelegationSetInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorDelegationSetInUse(err awserr.RequestFailure) error {
+	return &DelegationSetInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DelegationSetInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DelegationSetInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DelegationSetNotAvailable is the modeled exception returned for the
+// "DelegationSetNotAvailable" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DelegationSetNotAvailable struct {
+	awserr.RequestFailure
+}
+
+func newErrorDelegationSetNotAvailable(err awserr.RequestFailure) error {
+	return &DelegationSetNotAvailable{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DelegationSetNotAvailable) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DelegationSetNotAvailable) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DelegationSetNotReusable is the modeled exception returned for the
+// "DelegationSetNotReusable" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DelegationSetNotReusable struct {
+	awserr.RequestFailure
+}
+
+func newErrorDelegationSetNotReusable(err awserr.RequestFailure) error {
+	return &DelegationSetNotReusable{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DelegationSetNotReusable) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DelegationSetNotReusable) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// HealthCheckAlreadyExists is the modeled exception returned for the
+// "HealthCheckAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type HealthCheckAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorHealthCheckAlreadyExists(err awserr.RequestFailure) error {
+	return &HealthCheckAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *HealthCheckAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *HealthCheckAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// HealthCheckInUse is the modeled exception returned for the
+// "HealthCheckInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type HealthCheckInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorHealthCheckInUse(err awserr.RequestFailure) error {
+	return &HealthCheckInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *HealthCheckInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *HealthCheckInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// HealthCheckVersionMismatch is the modeled exception returned for the
+// "HealthCheckVersionMismatch" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type HealthCheckVersionMismatch struct {
+	awserr.RequestFailure
+}
+
+func newErrorHealthCheckVersionMismatch(err awserr.RequestFailure) error {
+	return &HealthCheckVersionMismatch{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *HealthCheckVersionMismatch) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *HealthCheckVersionMismatch) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// HostedZoneAlreadyExists is the modeled exception returned for the
+// "HostedZoneAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type HostedZoneAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorHostedZoneAlreadyExists(err awserr.RequestFailure) error {
+	return &HostedZoneAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *HostedZoneAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *HostedZoneAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// HostedZoneNotEmpty is the modeled exception returned for the
+// "HostedZoneNotEmpty" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type HostedZoneNotEmpty struct {
+	awserr.RequestFailure
+}
+
+func newErrorHostedZoneNotEmpty(err awserr.RequestFailure) error {
+	return &HostedZoneNotEmpty{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *HostedZoneNotEmpty) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *HostedZoneNotEmpty) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// HostedZoneNotFound is the modeled exception returned for the
+// "HostedZoneNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type HostedZoneNotFound struct {
+	awserr.RequestFailure
+}
+
+func newErrorHostedZoneNotFound(err awserr.RequestFailure) error {
+	return &HostedZoneNotFound{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *HostedZoneNotFound) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *HostedZoneNotFound) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// HostedZoneNotPrivate is the modeled exception returned for the
+// "HostedZoneNotPrivate" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type HostedZoneNotPrivate struct {
+	awserr.RequestFailure
+}
+
+func newErrorHostedZoneNotPrivate(err awserr.RequestFailure) error {
+	return &HostedZoneNotPrivate{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *HostedZoneNotPrivate) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *HostedZoneNotPrivate) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// HostedZonePartiallyDelegated is the modeled exception returned for the
+// "HostedZonePartiallyDelegated" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type HostedZonePartiallyDelegated struct {
+	awserr.RequestFailure
+}
+
+func newErrorHostedZonePartiallyDelegated(err awserr.RequestFailure) error {
+	return &HostedZonePartiallyDelegated{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *HostedZonePartiallyDelegated) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *HostedZonePartiallyDelegated) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// IncompatibleVersion is the modeled exception returned for the
+// "IncompatibleVersion" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type IncompatibleVersion struct {
+	awserr.RequestFailure
+}
+
+func newErrorIncompatibleVersion(err awserr.RequestFailure) error {
+	return &IncompatibleVersion{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *IncompatibleVersion) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *IncompatibleVersion) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InsufficientCloudWatchLogsResourcePolicy is the modeled exception returned for the
+// "InsufficientCloudWatchLogsResourcePolicy" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InsufficientCloudWatchLogsResourcePolicy struct {
+	awserr.RequestFailure
+}
+
+func newErrorInsufficientCloudWatchLogsResourcePolicy(err awserr.RequestFailure) error {
+	return &InsufficientCloudWatchLogsResourcePolicy{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InsufficientCloudWatchLogsResourcePolicy) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InsufficientCloudWatchLogsResourcePolicy) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidArgument is the modeled exception returned for the
+// "InvalidArgument" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidArgument struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidArgument(err awserr.RequestFailure) error {
+	return &InvalidArgument{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidArgument) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidArgument) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidChangeBatch is the modeled exception returned for the
+// "InvalidChangeBatch" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidChangeBatch struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidChangeBatch(err awserr.RequestFailure) error {
+	return &InvalidChangeBatch{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidChangeBatch) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidChangeBatch) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDomainName is the modeled exception returned for the
+// "InvalidDomainName" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDomainName struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDomainName(err awserr.RequestFailure) error {
+	return &InvalidDomainName{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDomainName) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDomainName) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidInput is the modeled exception returned for the
+// "InvalidInput" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidInput struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidInput(err awserr.RequestFailure) error {
+	return &InvalidInput{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidInput) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidInput) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidKMSArn is the modeled exception returned for the
+// "InvalidKMSArn" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidKMSArn struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidKMSArn(err awserr.RequestFailure) error {
+	return &InvalidKMSArn{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidKMSArn) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidKMSArn) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidKeySigningKeyName is the modeled exception returned for the
+// "InvalidKeySigningKeyName" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidKeySigningKeyName struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidKeySigningKeyName(err awserr.RequestFailure) error {
+	return &InvalidKeySigningKeyName{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidKeySigningKeyName) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidKeySigningKeyName) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidKeySigningKeyStatus is the modeled exception returned for the
+// "InvalidKeySigningKeyStatus" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidKeySigningKeyStatus struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidKeySigningKeyStatus(err awserr.RequestFailure) error {
+	return &InvalidKeySigningKeyStatus{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidKeySigningKeyStatus) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidKeySigningKeyStatus) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidPaginationToken is the modeled exception returned for the
+// "InvalidPaginationToken" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidPaginationToken struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidPaginationToken(err awserr.RequestFailure) error {
+	return &InvalidPaginationToken{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidPaginationToken) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidPaginationToken) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidSigningStatus is the modeled exception returned for the
+// "InvalidSigningStatus" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidSigningStatus struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidSigningStatus(err awserr.RequestFailure) error {
+	return &InvalidSigningStatus{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidSigningStatus) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidSigningStatus) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidTrafficPolicyDocument is the modeled exception returned for the
+// "InvalidTrafficPolicyDocument" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidTrafficPolicyDocument struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidTrafficPolicyDocument(err awserr.RequestFailure) error {
+	return &InvalidTrafficPolicyDocument{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidTrafficPolicyDocument) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidTrafficPolicyDocument) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidVPCId is the modeled exception returned for the
+// "InvalidVPCId" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidVPCId struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidVPCId(err awserr.RequestFailure) error {
+	return &InvalidVPCId{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidVPCId) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidVPCId) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// KeySigningKeyAlreadyExists is the modeled exception returned for the
+// "KeySigningKeyAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type KeySigningKeyAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorKeySigningKeyAlreadyExists(err awserr.RequestFailure) error {
+	return &KeySigningKeyAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *KeySigningKeyAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *KeySigningKeyAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// KeySigningKeyInParentDSRecord is the modeled exception returned for the
+// "KeySigningKeyInParentDSRecord" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type KeySigningKeyInParentDSRecord struct {
+	awserr.RequestFailure
+}
+
+func newErrorKeySigningKeyInParentDSRecord(err awserr.RequestFailure) error {
+	return &KeySigningKeyInParentDSRecord{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *KeySigningKeyInParentDSRecord) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *KeySigningKeyInParentDSRecord) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// KeySigningKeyInUse is the modeled exception returned for the
+// "KeySigningKeyInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type KeySigningKeyInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorKeySigningKeyInUse(err awserr.RequestFailure) error {
+	return &KeySigningKeyInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *KeySigningKeyInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *KeySigningKeyInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// KeySigningKeyWithActiveStatusNotFound is the modeled exception returned for the
+// "KeySigningKeyWithActiveStatusNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type KeySigningKeyWithActiveStatusNotFound struct {
+	awserr.RequestFailure
+}
+
+func newErrorKeySigningKeyWithActiveStatusNotFound(err awserr.RequestFailure) error {
+	return &KeySigningKeyWithActiveStatusNotFound{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *KeySigningKeyWithActiveStatusNotFound) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *KeySigningKeyWithActiveStatusNotFound) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// LastVPCAssociation is the modeled exception returned for the
+// "LastVPCAssociation" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type LastVPCAssociation struct {
+	awserr.RequestFailure
+}
+
+func newErrorLastVPCAssociation(err awserr.RequestFailure) error {
+	return &LastVPCAssociation{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *LastVPCAssociation) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *LastVPCAssociation) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// LimitsExceeded is the modeled exception returned for the
+// "LimitsExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type LimitsExceeded struct {
+	awserr.RequestFailure
+}
+
+func newErrorLimitsExceeded(err awserr.RequestFailure) error {
+	return &LimitsExceeded{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *LimitsExceeded) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *LimitsExceeded) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchChange is the modeled exception returned for the
+// "NoSuchChange" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchChange struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchChange(err awserr.RequestFailure) error {
+	return &NoSuchChange{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchChange) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchChange) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchCidrCollectionException is the modeled exception returned for the
+// "NoSuchCidrCollectionException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchCidrCollectionException struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchCidrCollectionException(err awserr.RequestFailure) error {
+	return &NoSuchCidrCollectionException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchCidrCollectionException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchCidrCollectionException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchCidrLocationException is the modeled exception returned for the
+// "NoSuchCidrLocationException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchCidrLocationException struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchCidrLocationException(err awserr.RequestFailure) error {
+	return &NoSuchCidrLocationException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchCidrLocationException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchCidrLocationException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchCloudWatchLogsLogGroup is the modeled exception returned for the
+// "NoSuchCloudWatchLogsLogGroup" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchCloudWatchLogsLogGroup struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchCloudWatchLogsLogGroup(err awserr.RequestFailure) error {
+	return &NoSuchCloudWatchLogsLogGroup{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchCloudWatchLogsLogGroup) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchCloudWatchLogsLogGroup) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchDelegationSet is the modeled exception returned for the
+// "NoSuchDelegationSet" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchDelegationSet struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchDelegationSet(err awserr.RequestFailure) error {
+	return &NoSuchDelegationSet{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchDelegationSet) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchDelegationSet) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchGeoLocation is the modeled exception returned for the
+// "NoSuchGeoLocation" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchGeoLocation struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchGeoLocation(err awserr.RequestFailure) error {
+	return &NoSuchGeoLocation{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchGeoLocation) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchGeoLocation) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchHealthCheck is the modeled exception returned for the
+// "NoSuchHealthCheck" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchHealthCheck struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchHealthCheck(err awserr.RequestFailure) error {
+	return &NoSuchHealthCheck{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchHealthCheck) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchHealthCheck) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchHostedZone is the modeled exception returned for the
+// "NoSuchHostedZone" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchHostedZone struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchHostedZone(err awserr.RequestFailure) error {
+	return &NoSuchHostedZone{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchHostedZone) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchHostedZone) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchKeySigningKey is the modeled exception returned for the
+// "NoSuchKeySigningKey" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchKeySigningKey struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchKeySigningKey(err awserr.RequestFailure) error {
+	return &NoSuchKeySigningKey{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchKeySigningKey) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchKeySigningKey) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchQueryLoggingConfig is the modeled exception returned for the
+// "NoSuchQueryLoggingConfig" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchQueryLoggingConfig struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchQueryLoggingConfig(err awserr.RequestFailure) error {
+	return &NoSuchQueryLoggingConfig{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchQueryLoggingConfig) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchQueryLoggingConfig) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchTrafficPolicy is the modeled exception returned for the
+// "NoSuchTrafficPolicy" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchTrafficPolicy struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchTrafficPolicy(err awserr.RequestFailure) error {
+	return &NoSuchTrafficPolicy{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchTrafficPolicy) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchTrafficPolicy) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchTrafficPolicyInstance is the modeled exception returned for the
+// "NoSuchTrafficPolicyInstance" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchTrafficPolicyInstance struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchTrafficPolicyInstance(err awserr.RequestFailure) error {
+	return &NoSuchTrafficPolicyInstance{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchTrafficPolicyInstance) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchTrafficPolicyInstance) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NotAuthorizedException is the modeled exception returned for the
+// "NotAuthorizedException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NotAuthorizedException struct {
+	awserr.RequestFailure
+}
+
+func newErrorNotAuthorizedException(err awserr.RequestFailure) error {
+	return &NotAuthorizedException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NotAuthorizedException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NotAuthorizedException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// PriorRequestNotComplete is the modeled exception returned for the
+// "PriorRequestNotComplete" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type PriorRequestNotComplete struct {
+	awserr.RequestFailure
+}
+
+func newErrorPriorRequestNotComplete(err awserr.RequestFailure) error {
+	return &PriorRequestNotComplete{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *PriorRequestNotComplete) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *PriorRequestNotComplete) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// PublicZoneVPCAssociation is the modeled exception returned for the
+// "PublicZoneVPCAssociation" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type PublicZoneVPCAssociation struct {
+	awserr.RequestFailure
+}
+
+func newErrorPublicZoneVPCAssociation(err awserr.RequestFailure) error {
+	return &PublicZoneVPCAssociation{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *PublicZoneVPCAssociation) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *PublicZoneVPCAssociation) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// QueryLoggingConfigAlreadyExists is the modeled exception returned for the
+// "QueryLoggingConfigAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type QueryLoggingConfigAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorQueryLoggingConfigAlreadyExists(err awserr.RequestFailure) error {
+	return &QueryLoggingConfigAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *QueryLoggingConfigAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *QueryLoggingConfigAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ThrottlingException is the modeled exception returned for the
+// "ThrottlingException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ThrottlingException struct {
+	awserr.RequestFailure
+}
+
+func newErrorThrottlingException(err awserr.RequestFailure) error {
+	return &ThrottlingException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ThrottlingException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ThrottlingException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyHealthChecks is the modeled exception returned for the
+// "TooManyHealthChecks" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyHealthChecks struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyHealthChecks(err awserr.RequestFailure) error {
+	return &TooManyHealthChecks{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyHealthChecks) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyHealthChecks) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyHostedZones is the modeled exception returned for the
+// "TooManyHostedZones" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyHostedZones struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyHostedZones(err awserr.RequestFailure) error {
+	return &TooManyHostedZones{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyHostedZones) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyHostedZones) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyKeySigningKeys is the modeled exception returned for the
+// "TooManyKeySigningKeys" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyKeySigningKeys struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyKeySigningKeys(err awserr.RequestFailure) error {
+	return &TooManyKeySigningKeys{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyKeySigningKeys) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyKeySigningKeys) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyTrafficPolicies is the modeled exception returned for the
+// "TooManyTrafficPolicies" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyTrafficPolicies struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyTrafficPolicies(err awserr.RequestFailure) error {
+	return &TooManyTrafficPolicies{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyTrafficPolicies) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyTrafficPolicies) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyTrafficPolicyInstances is the modeled exception returned for the
+// "TooManyTrafficPolicyInstances" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyTrafficPolicyInstances struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyTrafficPolicyInstances(err awserr.RequestFailure) error {
+	return &TooManyTrafficPolicyInstances{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyTrafficPolicyInstances) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyTrafficPolicyInstances) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyTrafficPolicyVersionsForCurrentPolicy is the modeled exception returned for the
+// "TooManyTrafficPolicyVersionsForCurrentPolicy" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyTrafficPolicyVersionsForCurrentPolicy struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyTrafficPolicyVersionsForCurrentPolicy(err awserr.RequestFailure) error {
+	return &TooManyTrafficPolicyVersionsForCurrentPolicy{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyTrafficPolicyVersionsForCurrentPolicy) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyTrafficPolicyVersionsForCurrentPolicy) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyVPCAssociationAuthorizations is the modeled exception returned for the
+// "TooManyVPCAssociationAuthorizations" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyVPCAssociationAuthorizations struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyVPCAssociationAuthorizations(err awserr.RequestFailure) error {
+	return &TooManyVPCAssociationAuthorizations{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyVPCAssociationAuthorizations) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyVPCAssociationAuthorizations) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TrafficPolicyAlreadyExists is the modeled exception returned for the
+// "TrafficPolicyAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TrafficPolicyAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorTrafficPolicyAlreadyExists(err awserr.RequestFailure) error {
+	return &TrafficPolicyAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TrafficPolicyAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TrafficPolicyAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TrafficPolicyInUse is the modeled exception returned for the
+// "TrafficPolicyInUse" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TrafficPolicyInUse struct {
+	awserr.RequestFailure
+}
+
+func newErrorTrafficPolicyInUse(err awserr.RequestFailure) error {
+	return &TrafficPolicyInUse{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TrafficPolicyInUse) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TrafficPolicyInUse) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TrafficPolicyInstanceAlreadyExists is the modeled exception returned for the
+// "TrafficPolicyInstanceAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TrafficPolicyInstanceAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorTrafficPolicyInstanceAlreadyExists(err awserr.RequestFailure) error {
+	return &TrafficPolicyInstanceAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TrafficPolicyInstanceAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TrafficPolicyInstanceAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// VPCAssociationAuthorizationNotFound is the modeled exception returned for the
+// "VPCAssociationAuthorizationNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type VPCAssociationAuthorizationNotFound struct {
+	awserr.RequestFailure
+}
+
+func newErrorVPCAssociationAuthorizationNotFound(err awserr.RequestFailure) error {
+	return &VPCAssociationAuthorizationNotFound{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *VPCAssociationAuthorizationNotFound) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *VPCAssociationAuthorizationNotFound) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// VPCAssociationNotFound is the modeled exception returned for the
+// "VPCAssociationNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type VPCAssociationNotFound struct {
+	awserr.RequestFailure
+}
+
+func newErrorVPCAssociationNotFound(err awserr.RequestFailure) error {
+	return &VPCAssociationNotFound{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *VPCAssociationNotFound) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *VPCAssociationNotFound) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+var exceptionFromCode = map[string]func(awserr.RequestFailure) error{
+	"CidrBlockInUseException":                      newErrorCidrBlockInUseException,
+	"CidrCollectionAlreadyExistsException":         newErrorCidrCollectionAlreadyExistsException,
+	"CidrCollectionInUseException":                 newErrorCidrCollectionInUseException,
+	"CidrCollectionVersionMismatchException":       newErrorCidrCollectionVersionMismatchException,
+	"ConcurrentModification":                       newErrorConcurrentModification,
+	"ConflictingDomainExists":                      newErrorConflictingDomainExists,
+	"ConflictingTypes":                             newErrorConflictingTypes,
+	"DNSSECNotFound":                               newErrorDNSSECNotFound,
+	"DelegationSetAlreadyCreated":                  newErrorDelegationSetAlreadyCreated,
+	"DelegationSetAlreadyReusable":                 newErrorDelegationSetAlreadyReusable,
+	"DelegationSetInUse":                           newErrorDelegationSetInUse,
+	"DelegationSetNotAvailable":                    newErrorDelegationSetNotAvailable,
+	"DelegationSetNotReusable":                     newErrorDelegationSetNotReusable,
+	"HealthCheckAlreadyExists":                     newErrorHealthCheckAlreadyExists,
+	"HealthCheckInUse":                             newErrorHealthCheckInUse,
+	"HealthCheckVersionMismatch":                   newErrorHealthCheckVersionMismatch,
+	"HostedZoneAlreadyExists":                      newErrorHostedZoneAlreadyExists,
+	"HostedZoneNotEmpty":                           newErrorHostedZoneNotEmpty,
+	"HostedZoneNotFound":                           newErrorHostedZoneNotFound,
+	"HostedZoneNotPrivate":                         newErrorHostedZoneNotPrivate,
+	"HostedZonePartiallyDelegated":                 newErrorHostedZonePartiallyDelegated,
+	"IncompatibleVersion":                          newErrorIncompatibleVersion,
+	"InsufficientCloudWatchLogsResourcePolicy":     newErrorInsufficientCloudWatchLogsResourcePolicy,
+	"InvalidArgument":                              newErrorInvalidArgument,
+	"InvalidChangeBatch":                           newErrorInvalidChangeBatch,
+	"InvalidDomainName":                            newErrorInvalidDomainName,
+	"InvalidInput":                                 newErrorInvalidInput,
+	"InvalidKMSArn":                                newErrorInvalidKMSArn,
+	"InvalidKeySigningKeyName":                     newErrorInvalidKeySigningKeyName,
+	"InvalidKeySigningKeyStatus":                   newErrorInvalidKeySigningKeyStatus,
+	"InvalidPaginationToken":                       newErrorInvalidPaginationToken,
+	"InvalidSigningStatus":                         newErrorInvalidSigningStatus,
+	"InvalidTrafficPolicyDocument":                 newErrorInvalidTrafficPolicyDocument,
+	"InvalidVPCId":                                 newErrorInvalidVPCId,
+	"KeySigningKeyAlreadyExists":                   newErrorKeySigningKeyAlreadyExists,
+	"KeySigningKeyInParentDSRecord":                newErrorKeySigningKeyInParentDSRecord,
+	"KeySigningKeyInUse":                           newErrorKeySigningKeyInUse,
+	"KeySigningKeyWithActiveStatusNotFound":        newErrorKeySigningKeyWithActiveStatusNotFound,
+	"LastVPCAssociation":                           newErrorLastVPCAssociation,
+	"LimitsExceeded":                               newErrorLimitsExceeded,
+	"NoSuchChange":                                 newErrorNoSuchChange,
+	"NoSuchCidrCollectionException":                newErrorNoSuchCidrCollectionException,
+	"NoSuchCidrLocationException":                  newErrorNoSuchCidrLocationException,
+	"NoSuchCloudWatchLogsLogGroup":                 newErrorNoSuchCloudWatchLogsLogGroup,
+	"NoSuchDelegationSet":                          newErrorNoSuchDelegationSet,
+	"NoSuchGeoLocation":                            newErrorNoSuchGeoLocation,
+	"NoSuchHealthCheck":                            newErrorNoSuchHealthCheck,
+	"NoSuchHostedZone":                             newErrorNoSuchHostedZone,
+	"NoSuchKeySigningKey":                          newErrorNoSuchKeySigningKey,
+	"NoSuchQueryLoggingConfig":                     newErrorNoSuchQueryLoggingConfig,
+	"NoSuchTrafficPolicy":                          newErrorNoSuchTrafficPolicy,
+	"NoSuchTrafficPolicyInstance":                  newErrorNoSuchTrafficPolicyInstance,
+	"NotAuthorizedException":                       newErrorNotAuthorizedException,
+	"PriorRequestNotComplete":                      newErrorPriorRequestNotComplete,
+	"PublicZoneVPCAssociation":                     newErrorPublicZoneVPCAssociation,
+	"QueryLoggingConfigAlreadyExists":              newErrorQueryLoggingConfigAlreadyExists,
+	"ThrottlingException":                          newErrorThrottlingException,
+	"TooManyHealthChecks":                          newErrorTooManyHealthChecks,
+	"TooManyHostedZones":                           newErrorTooManyHostedZones,
+	"TooManyKeySigningKeys":                        newErrorTooManyKeySigningKeys,
+	"TooManyTrafficPolicies":                       newErrorTooManyTrafficPolicies,
+	"TooManyTrafficPolicyInstances":                newErrorTooManyTrafficPolicyInstances,
+	"TooManyTrafficPolicyVersionsForCurrentPolicy": newErrorTooManyTrafficPolicyVersionsForCurrentPolicy,
+	"TooManyVPCAssociationAuthorizations":          newErrorTooManyVPCAssociationAuthorizations,
+	"TrafficPolicyAlreadyExists":                   newErrorTrafficPolicyAlreadyExists,
+	"TrafficPolicyInUse":                           newErrorTrafficPolicyInUse,
+	"TrafficPolicyInstanceAlreadyExists":           newErrorTrafficPolicyInstanceAlreadyExists,
+	"VPCAssociationAuthorizationNotFound":          newErrorVPCAssociationAuthorizationNotFound,
+	"VPCAssociationNotFound":                       newErrorVPCAssociationNotFound,
+}
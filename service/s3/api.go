@@ -37357,7 +37357,7 @@ type PutObjectInput struct {
 	ACL *string `location:"header" locationName:"x-amz-acl" type:"string" enum:"ObjectCannedACL"`
 
 	// Object data.
-	Body io.ReadSeeker `type:"blob"`
+	Body io.Reader `type:"blob"`
 
 	// The bucket name to which the PUT action was initiated.
 	//
@@ -37745,7 +37745,7 @@ func (s *PutObjectInput) SetACL(v string) *PutObjectInput {
 }
 
 // SetBody sets the Body field's value.
-func (s *PutObjectInput) SetBody(v io.ReadSeeker) *PutObjectInput {
+func (s *PutObjectInput) SetBody(v io.Reader) *PutObjectInput {
 	s.Body = v
 	return s
 }
@@ -43311,7 +43311,7 @@ type UploadPartInput struct {
 	_ struct{} `locationName:"UploadPartRequest" type:"structure" payload:"Body"`
 
 	// Object data.
-	Body io.ReadSeeker `type:"blob"`
+	Body io.Reader `type:"blob"`
 
 	// The name of the bucket to which the multipart upload was initiated.
 	//
@@ -43512,7 +43512,7 @@ func (s *UploadPartInput) Validate() error {
 }
 
 // SetBody sets the Body field's value.
-func (s *UploadPartInput) SetBody(v io.ReadSeeker) *UploadPartInput {
+func (s *UploadPartInput) SetBody(v io.Reader) *UploadPartInput {
 	s.Body = v
 	return s
 }
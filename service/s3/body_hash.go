@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
 )
 
 const (
@@ -89,6 +90,24 @@ func computeBodyHashes(r *request.Request) {
 	}
 }
 
+// useStreamingPayloadSigning will sign the request with the aws-chunked
+// content encoding if the request's body is not seekable. This allows a body
+// of known length to be signed without reading it into memory.
+func useStreamingPayloadSigning(r *request.Request) {
+	if r.Error != nil || r.IsPresigned() {
+		return
+	}
+	if r.Body == nil || aws.IsReaderSeekable(r.Body) {
+		return
+	}
+
+	r.Handlers.Sign.Swap(v4.SignRequestHandler.Name, v4.BuildNamedHandler(
+		v4.SignRequestHandler.Name, func(s *v4.Signer) {
+			s.DisableURIPathEscaping = true
+		}, v4.WithStreamingPayload,
+	))
+}
+
 const (
 	md5Base64EncLen = (md5.Size + 2) / 3 * 4 // base64.StdEncoding.EncodedLen
 	sha256HexEncLen = sha256.Size * 2        // hex.EncodedLen
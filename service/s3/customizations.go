@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/internal/s3shared/arn"
 	"github.com/aws/aws-sdk-go/internal/s3shared/s3err"
+	"github.com/aws/aws-sdk-go/private/protocol"
 )
 
 func init() {
@@ -33,7 +34,8 @@ func defaultInitClientFn(c *client.Client) {
 
 	// S3 uses custom error unmarshaling logic
 	c.Handlers.UnmarshalError.Clear()
-	c.Handlers.UnmarshalError.PushBack(unmarshalError)
+	c.Handlers.UnmarshalError.PushBackNamed(unmarshalErrorHandler)
+	c.Handlers.UnmarshalError.PushBackNamed(protocol.NewTypedRequestFailureHandler(exceptionFromCode))
 	c.Handlers.UnmarshalError.PushBackNamed(s3err.RequestFailureWrapperHandler())
 }
 
@@ -50,10 +52,11 @@ func defaultInitRequestFn(r *request.Request) {
 		// Auto-populate LocationConstraint with current region
 		r.Handlers.Validate.PushFront(populateLocationConstraint)
 	case opCopyObject, opUploadPartCopy, opCompleteMultipartUpload:
-		r.Handlers.Unmarshal.PushFront(copyMultipartStatusOKUnmarshalError)
+		r.Handlers.Unmarshal.PushFrontNamed(copyMultipartStatusOKUnmarshalErrorHandler)
 		r.Handlers.Unmarshal.PushBackNamed(s3err.RequestFailureWrapperHandler())
 	case opPutObject, opUploadPart:
 		r.Handlers.Build.PushBack(computeBodyHashes)
+		r.Handlers.Build.PushBack(useStreamingPayloadSigning)
 		// Disabled until #1837 root issue is resolved.
 		//	case opGetObject:
 		//		r.Handlers.Build.PushBack(askForTxEncodingAppendMD5)
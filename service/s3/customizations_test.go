@@ -1,6 +1,8 @@
 package s3_test
 
 import (
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -72,3 +74,91 @@ func TestGetObjectMetadataWithUnicode(t *testing.T) {
 	}
 
 }
+
+func TestPutObjectUnseekableBody(t *testing.T) {
+	payload := strings.Repeat("a", 1024)
+
+	cases := map[string]struct {
+		Body              io.Reader
+		ContentLength     *int64
+		ChecksumAlgorithm *string
+		ExpectContentHash string
+		ExpectTrailer     string
+		ExpectErr         string
+	}{
+		"seekable": {
+			Body:              strings.NewReader(payload),
+			ExpectContentHash: "2edc986847e209b4016e141a6dc8716d3207350f416969382d431539bf292e4a",
+		},
+		"unseekable": {
+			Body:              ioutil.NopCloser(strings.NewReader(payload)),
+			ContentLength:     aws.Int64(int64(len(payload))),
+			ExpectContentHash: "STREAMING-AWS4-HMAC-SHA256-PAYLOAD",
+		},
+		"unseekable with checksum": {
+			Body:              ioutil.NopCloser(strings.NewReader(payload)),
+			ContentLength:     aws.Int64(int64(len(payload))),
+			ChecksumAlgorithm: aws.String(s3.ChecksumAlgorithmCrc32),
+			ExpectContentHash: "STREAMING-AWS4-HMAC-SHA256-PAYLOAD-TRAILER",
+			ExpectTrailer:     "x-amz-checksum-crc32",
+		},
+		"unseekable unknown length": {
+			Body:      ioutil.NopCloser(strings.NewReader(payload)),
+			ExpectErr: "of unknown length",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if e, a := c.ExpectContentHash, r.Header.Get("X-Amz-Content-Sha256"); e != a {
+					t.Errorf("expect content hash %v, got %v", e, a)
+				}
+				if e, a := c.ExpectTrailer, r.Header.Get("X-Amz-Trailer"); e != a {
+					t.Errorf("expect trailer %v, got %v", e, a)
+				}
+
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Errorf("expect no error, got %v", err)
+				}
+				if e, a := r.ContentLength, int64(len(b)); e != a {
+					t.Errorf("expect content length %v, got %v", e, a)
+				}
+				if !strings.Contains(string(b), payload) {
+					t.Errorf("expect body to contain payload")
+				}
+				if len(c.ExpectTrailer) != 0 && !strings.Contains(string(b), c.ExpectTrailer+":") {
+					t.Errorf("expect body to contain %v trailer", c.ExpectTrailer)
+				}
+			}))
+			defer server.Close()
+
+			svc := s3.New(unit.Session, &aws.Config{
+				Endpoint:         aws.String(server.URL),
+				DisableSSL:       aws.Bool(true),
+				S3ForcePathStyle: aws.Bool(true),
+			})
+
+			_, err := svc.PutObject(&s3.PutObjectInput{
+				Bucket:            aws.String("bucket"),
+				Key:               aws.String("key"),
+				Body:              c.Body,
+				ContentLength:     c.ContentLength,
+				ChecksumAlgorithm: c.ChecksumAlgorithm,
+			})
+			if len(c.ExpectErr) != 0 {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				if e, a := c.ExpectErr, err.Error(); !strings.Contains(a, e) {
+					t.Errorf("expect %v error, got %v", e, a)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+		})
+	}
+}
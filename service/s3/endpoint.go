@@ -32,6 +32,9 @@ func accessPointResourceParser(a awsarn.ARN) (arn.Resource, error) {
 	case "accesspoint":
 		switch a.Service {
 		case s3Namespace:
+			if len(a.Region) == 0 {
+				return arn.ParseMultiRegionAccessPointResource(a, resParts[1:])
+			}
 			return arn.ParseAccessPointResource(a, resParts[1:])
 		case s3ObjectsLambdaNamespace:
 			return parseS3ObjectLambdaAccessPointResource(a, resParts)
@@ -136,7 +139,10 @@ func endpointHandler(req *request.Request) {
 		return
 	}
 
-	if !resReq.AllowCrossRegion() && resReq.IsCrossRegion() {
+	// Multi-Region Access Points are not specific to a region.
+	_, isMultiRegion := resource.(arn.MultiRegionAccessPointARN)
+
+	if !isMultiRegion && !resReq.AllowCrossRegion() && resReq.IsCrossRegion() {
 		req.Error = s3shared.NewClientRegionMismatchError(resource,
 			req.ClientInfo.PartitionID, aws.StringValue(req.Config.Region), nil)
 		return
@@ -148,6 +154,11 @@ func endpointHandler(req *request.Request) {
 		if err != nil {
 			req.Error = err
 		}
+	case arn.MultiRegionAccessPointARN:
+		err = updateRequestMultiRegionAccessPointEndpoint(req, tv)
+		if err != nil {
+			req.Error = err
+		}
 	case arn.S3ObjectLambdaAccessPointARN:
 		err = updateRequestS3ObjectLambdaAccessPointEndpoint(req, tv)
 		if err != nil {
@@ -200,6 +211,37 @@ func updateRequestAccessPointEndpoint(req *request.Request, accessPoint arn.Acce
 	return nil
 }
 
+func updateRequestMultiRegionAccessPointEndpoint(req *request.Request, accessPoint arn.MultiRegionAccessPointARN) error {
+	// FIPS not supported
+	if req.Config.UseFIPSEndpoint == endpoints.FIPSEndpointStateEnabled {
+		return s3shared.NewFIPSConfigurationError(accessPoint,
+			req.ClientInfo.PartitionID, aws.StringValue(req.Config.Region), nil)
+	}
+
+	// DualStack not supported
+	if isUseDualStackEndpoint(req) {
+		return s3shared.NewClientConfiguredForDualStackError(accessPoint,
+			req.ClientInfo.PartitionID, aws.StringValue(req.Config.Region), nil)
+	}
+
+	// Accelerate not supported
+	if aws.BoolValue(req.Config.S3UseAccelerate) {
+		return s3shared.NewClientConfiguredForAccelerateError(accessPoint,
+			req.ClientInfo.PartitionID, aws.StringValue(req.Config.Region), nil)
+	}
+
+	// Ignore the disable host prefix for access points
+	req.Config.DisableEndpointHostPrefix = aws.Bool(false)
+
+	if err := multiRegionAccessPointEndpointBuilder(accessPoint).build(req); err != nil {
+		return err
+	}
+
+	removeBucketFromPath(req.HTTPRequest.URL)
+
+	return nil
+}
+
 func updateRequestS3ObjectLambdaAccessPointEndpoint(req *request.Request, accessPoint arn.S3ObjectLambdaAccessPointARN) error {
 	// DualStack not supported
 	if isUseDualStackEndpoint(req) {
@@ -1,6 +1,7 @@
 package s3
 
 import (
+	"fmt"
 	"net/url"
 	"strings"
 
@@ -8,6 +9,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/aws/signer/v4a"
 	"github.com/aws/aws-sdk-go/internal/s3shared"
 	"github.com/aws/aws-sdk-go/internal/s3shared/arn"
 	"github.com/aws/aws-sdk-go/private/protocol"
@@ -20,6 +23,12 @@ const (
 
 	outpostPrefixLabel               = "outpost"
 	outpostAccessPointPrefixTemplate = accessPointPrefixTemplate + "{" + outpostPrefixLabel + "}."
+
+	multiRegionAccessPointPrefixTemplate = "{" + accessPointPrefixLabel + "}."
+
+	// multiRegionSigningRegion is the SigV4a region set Multi-Region Access
+	// Point requests are signed for.
+	multiRegionSigningRegion = "*"
 )
 
 // hasCustomEndpoint returns true if endpoint is a custom endpoint
@@ -81,6 +90,77 @@ func (a accessPointEndpointBuilder) hostPrefixLabelValues() map[string]string {
 	}
 }
 
+// multiRegionAccessPointEndpointBuilder represents the endpoint builder for
+// Multi-Region Access Point arn
+type multiRegionAccessPointEndpointBuilder arn.MultiRegionAccessPointARN
+
+// build builds the endpoint for corresponding Multi-Region Access Point arn
+//
+// For building an endpoint from Multi-Region Access Point arn, format used is:
+// - Multi-Region Access point endpoint format : {accesspointAlias}.accesspoint.s3-global.{dnsSuffix}
+// - example : mfzwi23gnjvgw.mrap.accesspoint.s3-global.amazonaws.com
+//
+// Multi-Region Access Point Endpoint requests are signed with SigV4a using
+// "s3" as signing name, for all regions.
+func (a multiRegionAccessPointEndpointBuilder) build(req *request.Request) error {
+	accessPoint := arn.MultiRegionAccessPointARN(a)
+
+	if !hasCustomEndpoint(req) {
+		dnsSuffix, ok := partitionDNSSuffix(req, accessPoint.Partition)
+		if !ok {
+			return s3shared.NewFailedToResolveEndpointError(accessPoint,
+				req.ClientInfo.PartitionID, aws.StringValue(req.Config.Region),
+				fmt.Errorf("unknown partition %s", accessPoint.Partition))
+		}
+
+		endpoint := endpoints.AddScheme("accesspoint.s3-global."+dnsSuffix, aws.BoolValue(req.Config.DisableSSL))
+		if err := updateRequestEndpoint(req, endpoint); err != nil {
+			return err
+		}
+	}
+
+	protocol.HostPrefixBuilder{
+		Prefix:   multiRegionAccessPointPrefixTemplate,
+		LabelsFn: a.hostPrefixLabelValues,
+	}.Build(req)
+
+	// signer redirection, Multi-Region Access Points require SigV4a
+	redirectSigner(req, s3Namespace, multiRegionSigningRegion)
+	req.Handlers.Sign.Swap(v4.SignRequestHandler.Name, v4a.BuildNamedHandler(v4a.SignRequestHandler.Name, func(s *v4a.Signer) {
+		s.DisableURIPathEscaping = true
+	}))
+
+	err := protocol.ValidateEndpointHost(req.Operation.Name, req.HTTPRequest.URL.Host)
+	if err != nil {
+		return s3shared.NewInvalidARNError(accessPoint, err)
+	}
+
+	return nil
+}
+
+func (a multiRegionAccessPointEndpointBuilder) hostPrefixLabelValues() map[string]string {
+	return map[string]string{
+		accessPointPrefixLabel: arn.MultiRegionAccessPointARN(a).AccessPointName,
+	}
+}
+
+// partitionDNSSuffix returns the DNS suffix of the partition, if the
+// partition is known by the request's endpoint resolver or the SDK's
+// default partitions.
+func partitionDNSSuffix(req *request.Request, partitionID string) (string, bool) {
+	ps := endpoints.DefaultPartitions()
+	if enum, ok := req.Config.EndpointResolver.(endpoints.EnumPartitions); ok {
+		ps = enum.Partitions()
+	}
+
+	for _, p := range ps {
+		if p.ID() == partitionID {
+			return p.DNSSuffix(), true
+		}
+	}
+	return "", false
+}
+
 // s3ObjectLambdaAccessPointEndpointBuilder represents the endpoint builder for an s3 object lambda access point arn
 type s3ObjectLambdaAccessPointEndpointBuilder arn.S3ObjectLambdaAccessPointARN
 
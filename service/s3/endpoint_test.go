@@ -9,8 +9,10 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -170,6 +172,65 @@ func TestEndpoint(t *testing.T) {
 			expectedSigningName:   "s3",
 			expectedSigningRegion: "us-west-2",
 		},
+		"Multi-Region AccessPoint": {
+			bucket: "arn:aws:s3::123456789012:accesspoint:mfzwi23gnjvgw.mrap",
+			config: &aws.Config{
+				Region: aws.String("us-west-2"),
+			},
+			expectedEndpoint:      "https://mfzwi23gnjvgw.mrap.accesspoint.s3-global.amazonaws.com",
+			expectedSigningName:   "s3",
+			expectedSigningRegion: "*",
+		},
+		"Multi-Region AccessPoint aws-cn partition": {
+			bucket: "arn:aws-cn:s3::123456789012:accesspoint:mfzwi23gnjvgw.mrap",
+			config: &aws.Config{
+				Region: aws.String("cn-north-1"),
+			},
+			expectedEndpoint:      "https://mfzwi23gnjvgw.mrap.accesspoint.s3-global.amazonaws.com.cn",
+			expectedSigningName:   "s3",
+			expectedSigningRegion: "*",
+		},
+		"Multi-Region AccessPoint with custom endpoint url": {
+			bucket: "arn:aws:s3::123456789012:accesspoint:mfzwi23gnjvgw.mrap",
+			config: &aws.Config{
+				Region:   aws.String("us-west-2"),
+				Endpoint: aws.String("beta.example.com"),
+			},
+			expectedEndpoint:      "https://mfzwi23gnjvgw.mrap.beta.example.com",
+			expectedSigningName:   "s3",
+			expectedSigningRegion: "*",
+		},
+		"Multi-Region AccessPoint Cross-Partition error": {
+			bucket: "arn:aws-cn:s3::123456789012:accesspoint:mfzwi23gnjvgw.mrap",
+			config: &aws.Config{
+				Region: aws.String("us-west-2"),
+			},
+			expectedErr: "client partition does not match provided ARN partition",
+		},
+		"Multi-Region AccessPoint FIPS error": {
+			bucket: "arn:aws:s3::123456789012:accesspoint:mfzwi23gnjvgw.mrap",
+			config: &aws.Config{
+				Region:          aws.String("us-west-2"),
+				UseFIPSEndpoint: endpoints.FIPSEndpointStateEnabled,
+			},
+			expectedErr: "use of ARN is not supported when client or request is configured for FIPS",
+		},
+		"Multi-Region AccessPoint DualStack error": {
+			bucket: "arn:aws:s3::123456789012:accesspoint:mfzwi23gnjvgw.mrap",
+			config: &aws.Config{
+				Region:               aws.String("us-west-2"),
+				UseDualStackEndpoint: endpoints.DualStackEndpointStateEnabled,
+			},
+			expectedErr: "client configured for S3 Dual-stack but is not supported with resource ARN",
+		},
+		"Multi-Region AccessPoint Accelerate error": {
+			bucket: "arn:aws:s3::123456789012:accesspoint:mfzwi23gnjvgw.mrap",
+			config: &aws.Config{
+				Region:          aws.String("us-west-2"),
+				S3UseAccelerate: aws.Bool(true),
+			},
+			expectedErr: "client configured for S3 Accelerate but is not supported with resource ARN",
+		},
 		"Outpost AccessPoint with custom endpoint url": {
 			bucket: "arn:aws:s3-outposts:us-west-2:123456789012:outpost:op-01234567890123456:accesspoint:myaccesspoint",
 			config: &aws.Config{
@@ -719,6 +780,61 @@ func TestEndpoint(t *testing.T) {
 	runValidations(t, cases)
 }
 
+func TestMultiRegionAccessPointSigning(t *testing.T) {
+	const bucket = "arn:aws:s3::123456789012:accesspoint:mfzwi23gnjvgw.mrap"
+
+	svc := New(unit.Session, &aws.Config{Region: aws.String("us-west-2")})
+
+	req, _ := svc.GetObjectRequest(&GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String("key"),
+	})
+	if err := req.Sign(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "AWS4-ECDSA-P256-SHA256 ", req.HTTPRequest.Header.Get("Authorization"); !strings.HasPrefix(a, e) {
+		t.Errorf("expect SigV4a authorization, got %v", a)
+	}
+	if e, a := "*", req.HTTPRequest.Header.Get("X-Amz-Region-Set"); e != a {
+		t.Errorf("expect %v region set, got %v", e, a)
+	}
+
+	req, _ = svc.GetObjectRequest(&GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String("key"),
+	})
+	u, err := req.Presign(5 * time.Minute)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	presigned, err := url.Parse(u)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	q := presigned.Query()
+	if e, a := "AWS4-ECDSA-P256-SHA256", q.Get("X-Amz-Algorithm"); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "*", q.Get("X-Amz-Region-Set"); e != a {
+		t.Errorf("expect %v region set, got %v", e, a)
+	}
+	if e, a := "mfzwi23gnjvgw.mrap.accesspoint.s3-global.amazonaws.com", presigned.Host; e != a {
+		t.Errorf("expect %v host, got %v", e, a)
+	}
+
+	// Requests for other resources are signed with SigV4
+	req, _ = svc.GetObjectRequest(&GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+	if err := req.Sign(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "AWS4-HMAC-SHA256 ", req.HTTPRequest.Header.Get("Authorization"); !strings.HasPrefix(a, e) {
+		t.Errorf("expect SigV4 authorization, got %v", a)
+	}
+}
+
 func runValidations(t *testing.T, cases map[string]testCase) {
 	for name, c := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -2,6 +2,10 @@
 
 package s3
 
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
 const (
 
 	// ErrCodeBucketAlreadyExists for service response error code
@@ -67,3 +71,222 @@ const (
 	// stored in Amazon S3 Glacier.
 	ErrCodeObjectNotInActiveTierError = "ObjectNotInActiveTierError"
 )
+
+// BucketAlreadyExists is the modeled exception returned for the
+// "BucketAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type BucketAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorBucketAlreadyExists(err awserr.RequestFailure) error {
+	return &BucketAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *BucketAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *BucketAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// BucketAlreadyOwnedByYou is the modeled exception returned for the
+// "BucketAlreadyOwnedByYou" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type BucketAlreadyOwnedByYou struct {
+	awserr.RequestFailure
+}
+
+func newErrorBucketAlreadyOwnedByYou(err awserr.RequestFailure) error {
+	return &BucketAlreadyOwnedByYou{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *BucketAlreadyOwnedByYou) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *BucketAlreadyOwnedByYou) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidObjectState is the modeled exception returned for the
+// "InvalidObjectState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidObjectState struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidObjectState(err awserr.RequestFailure) error {
+	return &InvalidObjectState{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidObjectState) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidObjectState) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchBucket is the modeled exception returned for the
+// "NoSuchBucket" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchBucket struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchBucket(err awserr.RequestFailure) error {
+	return &NoSuchBucket{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchBucket) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchBucket) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchKey is the modeled exception returned for the
+// "NoSuchKey" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchKey struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchKey(err awserr.RequestFailure) error {
+	return &NoSuchKey{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchKey) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchKey) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchUpload is the modeled exception returned for the
+// "NoSuchUpload" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchUpload struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchUpload(err awserr.RequestFailure) error {
+	return &NoSuchUpload{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchUpload) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchUpload) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ObjectAlreadyInActiveTierError is the modeled exception returned for the
+// "ObjectAlreadyInActiveTierError" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ObjectAlreadyInActiveTierError struct {
+	awserr.RequestFailure
+}
+
+func newErrorObjectAlreadyInActiveTierError(err awserr.RequestFailure) error {
+	return &ObjectAlreadyInActiveTierError{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ObjectAlreadyInActiveTierError) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ObjectAlreadyInActiveTierError) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ObjectNotInActiveTierError is the modeled exception returned for the
+// "ObjectNotInActiveTierError" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ObjectNotInActiveTierError struct {
+	awserr.RequestFailure
+}
+
+func newErrorObjectNotInActiveTierError(err awserr.RequestFailure) error {
+	return &ObjectNotInActiveTierError{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ObjectNotInActiveTierError) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ObjectNotInActiveTierError) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+var exceptionFromCode = map[string]func(awserr.RequestFailure) error{
+	"BucketAlreadyExists":            newErrorBucketAlreadyExists,
+	"BucketAlreadyOwnedByYou":        newErrorBucketAlreadyOwnedByYou,
+	"InvalidObjectState":             newErrorInvalidObjectState,
+	"NoSuchBucket":                   newErrorNoSuchBucket,
+	"NoSuchKey":                      newErrorNoSuchKey,
+	"NoSuchUpload":                   newErrorNoSuchUpload,
+	"ObjectAlreadyInActiveTierError": newErrorObjectAlreadyInActiveTierError,
+	"ObjectNotInActiveTierError":     newErrorObjectNotInActiveTierError,
+}
@@ -23,7 +23,7 @@ func putObjectRequest(c EncryptionClientOptions, input *s3.PutObjectInput) (*req
 	req, out := c.S3Client.PutObjectRequest(input)
 
 	// Get Size of file
-	n, err := aws.SeekerLen(input.Body)
+	n, err := aws.SeekerLen(aws.ReadSeekCloser(input.Body))
 	if err != nil {
 		req.Error = err
 		return req, out
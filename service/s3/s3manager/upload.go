@@ -95,6 +95,11 @@ func (m multiUploadError) UploadID() string {
 	return m.uploadID
 }
 
+// Unwrap returns the wrapped Error.
+func (m multiUploadError) Unwrap() error {
+	return m.awsError
+}
+
 // UploadOutput represents a response from the Upload() call.
 type UploadOutput struct {
 	// The URL where the object was uploaded to.
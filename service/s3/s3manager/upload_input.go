@@ -9,8 +9,8 @@ import (
 
 // UploadInput provides the input parameters for uploading a stream or buffer
 // to an object in an Amazon S3 bucket. This type is similar to the s3
-// package's PutObjectInput with the exception that the Body member is an
-// io.Reader instead of an io.ReadSeeker.
+// package's PutObjectInput with the exception that the Body member is not
+// required to be of a known length, and there is no ContentLength member.
 //
 // The ContentMD5 member for pre-computed MD5 checksums will be ignored for
 // multipart uploads. Objects that will be uploaded in a single part, the
@@ -11,6 +11,13 @@ import (
 	"github.com/aws/aws-sdk-go/internal/sdkio"
 )
 
+// copyMultipartStatusOKUnmarshalErrorHandler is the named handler checking
+// successful copy and complete multipart upload responses for errors.
+var copyMultipartStatusOKUnmarshalErrorHandler = request.NamedHandler{
+	Name: "awssdk.s3.CopyMultipartStatusOKUnmarshalError",
+	Fn:   copyMultipartStatusOKUnmarshalError,
+}
+
 func copyMultipartStatusOKUnmarshalError(r *request.Request) {
 	b, err := ioutil.ReadAll(r.HTTPResponse.Body)
 	r.HTTPResponse.Body.Close()
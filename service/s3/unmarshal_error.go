@@ -21,6 +21,10 @@ type xmlErrorResponse struct {
 	Message string   `xml:"Message"`
 }
 
+// unmarshalErrorHandler is the named handler for S3's custom error
+// unmarshaling, replacing the restxml protocol error unmarshaler.
+var unmarshalErrorHandler = request.NamedHandler{Name: "awssdk.s3.UnmarshalError", Fn: unmarshalError}
+
 func unmarshalError(r *request.Request) {
 	defer r.HTTPResponse.Body.Close()
 	defer io.Copy(ioutil.Discard, r.HTTPResponse.Body)
@@ -2,6 +2,7 @@ package s3_test
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -264,3 +265,40 @@ func Test200WithErrorUnmarshalError(t *testing.T) {
 		t.Errorf("HostID: expect %s, got %s", e, a)
 	}
 }
+
+func TestUnmarshalError_ModeledException(t *testing.T) {
+	s := s3.New(unit.Session)
+	s.Handlers.Send.Clear()
+	s.Handlers.Send.PushBack(func(r *request.Request) {
+		r.HTTPResponse = &http.Response{
+			StatusCode: 404,
+			Header: http.Header{
+				"X-Amz-Request-Id": []string{"abc123"},
+				"X-Amz-Id-2":       []string{"321cba"},
+			},
+			Body: ioutil.NopCloser(strings.NewReader(
+				`<Error><Code>NoSuchBucket</Code><Message>The specified bucket does not exist</Message></Error>`)),
+			ContentLength: -1,
+		}
+	})
+	_, err := s.GetBucketAcl(&s3.GetBucketAclInput{
+		Bucket: aws.String("bucket"),
+	})
+	if err == nil {
+		t.Fatalf("expect error, got nil")
+	}
+
+	var nsb *s3.NoSuchBucket
+	if !errors.As(err, &nsb) {
+		t.Fatalf("expect %T error, got %T, %v", nsb, err, err)
+	}
+	if e, a := s3.ErrCodeNoSuchBucket, nsb.Code(); e != a {
+		t.Errorf("expect %v code, got %v", e, a)
+	}
+	if e, a := "abc123", nsb.RequestID(); e != a {
+		t.Errorf("expect %v request id, got %v", e, a)
+	}
+	if e, a := "321cba", err.(s3.RequestFailure).HostID(); e != a {
+		t.Errorf("expect %v host id, got %v", e, a)
+	}
+}
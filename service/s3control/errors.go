@@ -2,6 +2,10 @@
 
 package s3control
 
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
 const (
 
 	// ErrCodeBadRequestException for service response error code
@@ -63,3 +67,330 @@ const (
 	// Amazon S3 throws this exception if you have too many tags in your tag set.
 	ErrCodeTooManyTagsException = "TooManyTagsException"
 )
+
+// BadRequestException is the modeled exception returned for the
+// "BadRequestException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type BadRequestException struct {
+	awserr.RequestFailure
+}
+
+func newErrorBadRequestException(err awserr.RequestFailure) error {
+	return &BadRequestException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *BadRequestException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *BadRequestException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// BucketAlreadyExists is the modeled exception returned for the
+// "BucketAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type BucketAlreadyExists struct {
+	awserr.RequestFailure
+}
+
+func newErrorBucketAlreadyExists(err awserr.RequestFailure) error {
+	return &BucketAlreadyExists{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *BucketAlreadyExists) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *BucketAlreadyExists) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// BucketAlreadyOwnedByYou is the modeled exception returned for the
+// "BucketAlreadyOwnedByYou" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type BucketAlreadyOwnedByYou struct {
+	awserr.RequestFailure
+}
+
+func newErrorBucketAlreadyOwnedByYou(err awserr.RequestFailure) error {
+	return &BucketAlreadyOwnedByYou{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *BucketAlreadyOwnedByYou) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *BucketAlreadyOwnedByYou) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// IdempotencyException is the modeled exception returned for the
+// "IdempotencyException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type IdempotencyException struct {
+	awserr.RequestFailure
+}
+
+func newErrorIdempotencyException(err awserr.RequestFailure) error {
+	return &IdempotencyException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *IdempotencyException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *IdempotencyException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InternalServiceException is the modeled exception returned for the
+// "InternalServiceException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InternalServiceException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInternalServiceException(err awserr.RequestFailure) error {
+	return &InternalServiceException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InternalServiceException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InternalServiceException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidNextTokenException is the modeled exception returned for the
+// "InvalidNextTokenException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidNextTokenException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidNextTokenException(err awserr.RequestFailure) error {
+	return &InvalidNextTokenException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidNextTokenException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidNextTokenException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidRequestException is the modeled exception returned for the
+// "InvalidRequestException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidRequestException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidRequestException(err awserr.RequestFailure) error {
+	return &InvalidRequestException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidRequestException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidRequestException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// JobStatusException is the modeled exception returned for the
+// "JobStatusException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type JobStatusException struct {
+	awserr.RequestFailure
+}
+
+func newErrorJobStatusException(err awserr.RequestFailure) error {
+	return &JobStatusException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *JobStatusException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *JobStatusException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchPublicAccessBlockConfiguration is the modeled exception returned for the
+// "NoSuchPublicAccessBlockConfiguration" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchPublicAccessBlockConfiguration struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchPublicAccessBlockConfiguration(err awserr.RequestFailure) error {
+	return &NoSuchPublicAccessBlockConfiguration{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchPublicAccessBlockConfiguration) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchPublicAccessBlockConfiguration) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NotFoundException is the modeled exception returned for the
+// "NotFoundException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorNotFoundException(err awserr.RequestFailure) error {
+	return &NotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyRequestsException is the modeled exception returned for the
+// "TooManyRequestsException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyRequestsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyRequestsException(err awserr.RequestFailure) error {
+	return &TooManyRequestsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyRequestsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyRequestsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyTagsException is the modeled exception returned for the
+// "TooManyTagsException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyTagsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyTagsException(err awserr.RequestFailure) error {
+	return &TooManyTagsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyTagsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyTagsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+var exceptionFromCode = map[string]func(awserr.RequestFailure) error{
+	"BadRequestException":                  newErrorBadRequestException,
+	"BucketAlreadyExists":                  newErrorBucketAlreadyExists,
+	"BucketAlreadyOwnedByYou":              newErrorBucketAlreadyOwnedByYou,
+	"IdempotencyException":                 newErrorIdempotencyException,
+	"InternalServiceException":             newErrorInternalServiceException,
+	"InvalidNextTokenException":            newErrorInvalidNextTokenException,
+	"InvalidRequestException":              newErrorInvalidRequestException,
+	"JobStatusException":                   newErrorJobStatusException,
+	"NoSuchPublicAccessBlockConfiguration": newErrorNoSuchPublicAccessBlockConfiguration,
+	"NotFoundException":                    newErrorNotFoundException,
+	"TooManyRequestsException":             newErrorTooManyRequestsException,
+	"TooManyTagsException":                 newErrorTooManyTagsException,
+}
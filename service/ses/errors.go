@@ -2,6 +2,10 @@
 
 package ses
 
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
 const (
 
 	// ErrCodeAccountSendingPausedException for service response error code
@@ -248,3 +252,924 @@ const (
 	// Indicates that the TrackingOptions object you specified does not exist.
 	ErrCodeTrackingOptionsDoesNotExistException = "TrackingOptionsDoesNotExistException"
 )
+
+// AccountSendingPausedException is the modeled exception returned for the
+// "AccountSendingPausedException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AccountSendingPausedException struct {
+	awserr.RequestFailure
+}
+
+func newErrorAccountSendingPausedException(err awserr.RequestFailure) error {
+	return &AccountSendingPausedException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AccountSendingPausedException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AccountSendingPausedException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// AlreadyExistsException is the modeled exception returned for the
+// "AlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AlreadyExistsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorAlreadyExistsException(err awserr.RequestFailure) error {
+	return &AlreadyExistsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AlreadyExistsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AlreadyExistsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CannotDeleteException is the modeled exception returned for the
+// "CannotDelete" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CannotDeleteException struct {
+	awserr.RequestFailure
+}
+
+func newErrorCannotDeleteException(err awserr.RequestFailure) error {
+	return &CannotDeleteException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CannotDeleteException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CannotDeleteException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ConfigurationSetAlreadyExistsException is the modeled exception returned for the
+// "ConfigurationSetAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ConfigurationSetAlreadyExistsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorConfigurationSetAlreadyExistsException(err awserr.RequestFailure) error {
+	return &ConfigurationSetAlreadyExistsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ConfigurationSetAlreadyExistsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ConfigurationSetAlreadyExistsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ConfigurationSetDoesNotExistException is the modeled exception returned for the
+// "ConfigurationSetDoesNotExist" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ConfigurationSetDoesNotExistException struct {
+	awserr.RequestFailure
+}
+
+func newErrorConfigurationSetDoesNotExistException(err awserr.RequestFailure) error {
+	return &ConfigurationSetDoesNotExistException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ConfigurationSetDoesNotExistException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ConfigurationSetDoesNotExistException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ConfigurationSetSendingPausedException is the modeled exception returned for the
+// "ConfigurationSetSendingPausedException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ConfigurationSetSendingPausedException struct {
+	awserr.RequestFailure
+}
+
+func newErrorConfigurationSetSendingPausedException(err awserr.RequestFailure) error {
+	return &ConfigurationSetSendingPausedException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ConfigurationSetSendingPausedException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ConfigurationSetSendingPausedException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CustomVerificationEmailInvalidContentException is the modeled exception returned for the
+// "CustomVerificationEmailInvalidContent" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CustomVerificationEmailInvalidContentException struct {
+	awserr.RequestFailure
+}
+
+func newErrorCustomVerificationEmailInvalidContentException(err awserr.RequestFailure) error {
+	return &CustomVerificationEmailInvalidContentException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CustomVerificationEmailInvalidContentException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CustomVerificationEmailInvalidContentException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CustomVerificationEmailTemplateAlreadyExistsException is the modeled exception returned for the
+// "CustomVerificationEmailTemplateAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CustomVerificationEmailTemplateAlreadyExistsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorCustomVerificationEmailTemplateAlreadyExistsException(err awserr.RequestFailure) error {
+	return &CustomVerificationEmailTemplateAlreadyExistsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CustomVerificationEmailTemplateAlreadyExistsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CustomVerificationEmailTemplateAlreadyExistsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// CustomVerificationEmailTemplateDoesNotExistException is the modeled exception returned for the
+// "CustomVerificationEmailTemplateDoesNotExist" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type CustomVerificationEmailTemplateDoesNotExistException struct {
+	awserr.RequestFailure
+}
+
+func newErrorCustomVerificationEmailTemplateDoesNotExistException(err awserr.RequestFailure) error {
+	return &CustomVerificationEmailTemplateDoesNotExistException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *CustomVerificationEmailTemplateDoesNotExistException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *CustomVerificationEmailTemplateDoesNotExistException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// EventDestinationAlreadyExistsException is the modeled exception returned for the
+// "EventDestinationAlreadyExists" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type EventDestinationAlreadyExistsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorEventDestinationAlreadyExistsException(err awserr.RequestFailure) error {
+	return &EventDestinationAlreadyExistsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *EventDestinationAlreadyExistsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *EventDestinationAlreadyExistsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// EventDestinationDoesNotExistException is the modeled exception returned for the
+// "EventDestinationDoesNotExist" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type EventDestinationDoesNotExistException struct {
+	awserr.RequestFailure
+}
+
+func newErrorEventDestinationDoesNotExistException(err awserr.RequestFailure) error {
+	return &EventDestinationDoesNotExistException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *EventDestinationDoesNotExistException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *EventDestinationDoesNotExistException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// FromEmailAddressNotVerifiedException is the modeled exception returned for the
+// "FromEmailAddressNotVerified" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type FromEmailAddressNotVerifiedException struct {
+	awserr.RequestFailure
+}
+
+func newErrorFromEmailAddressNotVerifiedException(err awserr.RequestFailure) error {
+	return &FromEmailAddressNotVerifiedException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *FromEmailAddressNotVerifiedException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *FromEmailAddressNotVerifiedException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidCloudWatchDestinationException is the modeled exception returned for the
+// "InvalidCloudWatchDestination" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidCloudWatchDestinationException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidCloudWatchDestinationException(err awserr.RequestFailure) error {
+	return &InvalidCloudWatchDestinationException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidCloudWatchDestinationException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidCloudWatchDestinationException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidConfigurationSetException is the modeled exception returned for the
+// "InvalidConfigurationSet" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidConfigurationSetException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidConfigurationSetException(err awserr.RequestFailure) error {
+	return &InvalidConfigurationSetException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidConfigurationSetException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidConfigurationSetException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidDeliveryOptionsException is the modeled exception returned for the
+// "InvalidDeliveryOptions" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidDeliveryOptionsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidDeliveryOptionsException(err awserr.RequestFailure) error {
+	return &InvalidDeliveryOptionsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidDeliveryOptionsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidDeliveryOptionsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidFirehoseDestinationException is the modeled exception returned for the
+// "InvalidFirehoseDestination" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidFirehoseDestinationException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidFirehoseDestinationException(err awserr.RequestFailure) error {
+	return &InvalidFirehoseDestinationException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidFirehoseDestinationException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidFirehoseDestinationException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidLambdaFunctionException is the modeled exception returned for the
+// "InvalidLambdaFunction" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidLambdaFunctionException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidLambdaFunctionException(err awserr.RequestFailure) error {
+	return &InvalidLambdaFunctionException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidLambdaFunctionException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidLambdaFunctionException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidPolicyException is the modeled exception returned for the
+// "InvalidPolicy" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidPolicyException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidPolicyException(err awserr.RequestFailure) error {
+	return &InvalidPolicyException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidPolicyException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidPolicyException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidRenderingParameterException is the modeled exception returned for the
+// "InvalidRenderingParameter" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidRenderingParameterException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidRenderingParameterException(err awserr.RequestFailure) error {
+	return &InvalidRenderingParameterException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidRenderingParameterException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidRenderingParameterException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidS3ConfigurationException is the modeled exception returned for the
+// "InvalidS3Configuration" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidS3ConfigurationException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidS3ConfigurationException(err awserr.RequestFailure) error {
+	return &InvalidS3ConfigurationException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidS3ConfigurationException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidS3ConfigurationException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidSNSDestinationException is the modeled exception returned for the
+// "InvalidSNSDestination" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidSNSDestinationException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidSNSDestinationException(err awserr.RequestFailure) error {
+	return &InvalidSNSDestinationException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidSNSDestinationException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidSNSDestinationException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidSnsTopicException is the modeled exception returned for the
+// "InvalidSnsTopic" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidSnsTopicException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidSnsTopicException(err awserr.RequestFailure) error {
+	return &InvalidSnsTopicException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidSnsTopicException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidSnsTopicException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidTemplateException is the modeled exception returned for the
+// "InvalidTemplate" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidTemplateException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidTemplateException(err awserr.RequestFailure) error {
+	return &InvalidTemplateException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidTemplateException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidTemplateException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidTrackingOptionsException is the modeled exception returned for the
+// "InvalidTrackingOptions" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidTrackingOptionsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidTrackingOptionsException(err awserr.RequestFailure) error {
+	return &InvalidTrackingOptionsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidTrackingOptionsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidTrackingOptionsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// LimitExceededException is the modeled exception returned for the
+// "LimitExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type LimitExceededException struct {
+	awserr.RequestFailure
+}
+
+func newErrorLimitExceededException(err awserr.RequestFailure) error {
+	return &LimitExceededException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *LimitExceededException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *LimitExceededException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// MailFromDomainNotVerifiedException is the modeled exception returned for the
+// "MailFromDomainNotVerifiedException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type MailFromDomainNotVerifiedException struct {
+	awserr.RequestFailure
+}
+
+func newErrorMailFromDomainNotVerifiedException(err awserr.RequestFailure) error {
+	return &MailFromDomainNotVerifiedException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *MailFromDomainNotVerifiedException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *MailFromDomainNotVerifiedException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// MessageRejected is the modeled exception returned for the
+// "MessageRejected" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type MessageRejected struct {
+	awserr.RequestFailure
+}
+
+func newErrorMessageRejected(err awserr.RequestFailure) error {
+	return &MessageRejected{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *MessageRejected) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *MessageRejected) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// MissingRenderingAttributeException is the modeled exception returned for the
+// "MissingRenderingAttribute" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type MissingRenderingAttributeException struct {
+	awserr.RequestFailure
+}
+
+func newErrorMissingRenderingAttributeException(err awserr.RequestFailure) error {
+	return &MissingRenderingAttributeException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *MissingRenderingAttributeException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *MissingRenderingAttributeException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ProductionAccessNotGrantedException is the modeled exception returned for the
+// "ProductionAccessNotGranted" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ProductionAccessNotGrantedException struct {
+	awserr.RequestFailure
+}
+
+func newErrorProductionAccessNotGrantedException(err awserr.RequestFailure) error {
+	return &ProductionAccessNotGrantedException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ProductionAccessNotGrantedException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ProductionAccessNotGrantedException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// RuleDoesNotExistException is the modeled exception returned for the
+// "RuleDoesNotExist" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type RuleDoesNotExistException struct {
+	awserr.RequestFailure
+}
+
+func newErrorRuleDoesNotExistException(err awserr.RequestFailure) error {
+	return &RuleDoesNotExistException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *RuleDoesNotExistException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *RuleDoesNotExistException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// RuleSetDoesNotExistException is the modeled exception returned for the
+// "RuleSetDoesNotExist" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type RuleSetDoesNotExistException struct {
+	awserr.RequestFailure
+}
+
+func newErrorRuleSetDoesNotExistException(err awserr.RequestFailure) error {
+	return &RuleSetDoesNotExistException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *RuleSetDoesNotExistException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *RuleSetDoesNotExistException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TemplateDoesNotExistException is the modeled exception returned for the
+// "TemplateDoesNotExist" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TemplateDoesNotExistException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTemplateDoesNotExistException(err awserr.RequestFailure) error {
+	return &TemplateDoesNotExistException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TemplateDoesNotExistException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TemplateDoesNotExistException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TrackingOptionsAlreadyExistsException is the modeled exception returned for the
+// "TrackingOptionsAlreadyExistsException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TrackingOptionsAlreadyExistsException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTrackingOptionsAlreadyExistsException(err awserr.RequestFailure) error {
+	return &TrackingOptionsAlreadyExistsException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TrackingOptionsAlreadyExistsException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TrackingOptionsAlreadyExistsException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TrackingOptionsDoesNotExistException is the modeled exception returned for the
+// "TrackingOptionsDoesNotExistException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TrackingOptionsDoesNotExistException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTrackingOptionsDoesNotExistException(err awserr.RequestFailure) error {
+	return &TrackingOptionsDoesNotExistException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TrackingOptionsDoesNotExistException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TrackingOptionsDoesNotExistException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+var exceptionFromCode = map[string]func(awserr.RequestFailure) error{
+	"AccountSendingPausedException":                newErrorAccountSendingPausedException,
+	"AlreadyExists":                                newErrorAlreadyExistsException,
+	"CannotDelete":                                 newErrorCannotDeleteException,
+	"ConfigurationSetAlreadyExists":                newErrorConfigurationSetAlreadyExistsException,
+	"ConfigurationSetDoesNotExist":                 newErrorConfigurationSetDoesNotExistException,
+	"ConfigurationSetSendingPausedException":       newErrorConfigurationSetSendingPausedException,
+	"CustomVerificationEmailInvalidContent":        newErrorCustomVerificationEmailInvalidContentException,
+	"CustomVerificationEmailTemplateAlreadyExists": newErrorCustomVerificationEmailTemplateAlreadyExistsException,
+	"CustomVerificationEmailTemplateDoesNotExist":  newErrorCustomVerificationEmailTemplateDoesNotExistException,
+	"EventDestinationAlreadyExists":                newErrorEventDestinationAlreadyExistsException,
+	"EventDestinationDoesNotExist":                 newErrorEventDestinationDoesNotExistException,
+	"FromEmailAddressNotVerified":                  newErrorFromEmailAddressNotVerifiedException,
+	"InvalidCloudWatchDestination":                 newErrorInvalidCloudWatchDestinationException,
+	"InvalidConfigurationSet":                      newErrorInvalidConfigurationSetException,
+	"InvalidDeliveryOptions":                       newErrorInvalidDeliveryOptionsException,
+	"InvalidFirehoseDestination":                   newErrorInvalidFirehoseDestinationException,
+	"InvalidLambdaFunction":                        newErrorInvalidLambdaFunctionException,
+	"InvalidPolicy":                                newErrorInvalidPolicyException,
+	"InvalidRenderingParameter":                    newErrorInvalidRenderingParameterException,
+	"InvalidS3Configuration":                       newErrorInvalidS3ConfigurationException,
+	"InvalidSNSDestination":                        newErrorInvalidSNSDestinationException,
+	"InvalidSnsTopic":                              newErrorInvalidSnsTopicException,
+	"InvalidTemplate":                              newErrorInvalidTemplateException,
+	"InvalidTrackingOptions":                       newErrorInvalidTrackingOptionsException,
+	"LimitExceeded":                                newErrorLimitExceededException,
+	"MailFromDomainNotVerifiedException":           newErrorMailFromDomainNotVerifiedException,
+	"MessageRejected":                              newErrorMessageRejected,
+	"MissingRenderingAttribute":                    newErrorMissingRenderingAttributeException,
+	"ProductionAccessNotGranted":                   newErrorProductionAccessNotGrantedException,
+	"RuleDoesNotExist":                             newErrorRuleDoesNotExistException,
+	"RuleSetDoesNotExist":                          newErrorRuleSetDoesNotExistException,
+	"TemplateDoesNotExist":                         newErrorTemplateDoesNotExistException,
+	"TrackingOptionsAlreadyExistsException":        newErrorTrackingOptionsAlreadyExistsException,
+	"TrackingOptionsDoesNotExistException":         newErrorTrackingOptionsDoesNotExistException,
+}
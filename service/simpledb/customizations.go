@@ -1,11 +1,15 @@
 package simpledb
 
-import "github.com/aws/aws-sdk-go/aws/client"
+import (
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
 
 func init() {
 	initClient = func(c *client.Client) {
 		// SimpleDB uses custom error unmarshaling logic
 		c.Handlers.UnmarshalError.Clear()
-		c.Handlers.UnmarshalError.PushBack(unmarshalError)
+		c.Handlers.UnmarshalError.PushBackNamed(unmarshalErrorHandler)
+		c.Handlers.UnmarshalError.PushBackNamed(protocol.NewTypedRequestFailureHandler(exceptionFromCode))
 	}
 }
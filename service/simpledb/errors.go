@@ -2,6 +2,10 @@
 
 package simpledb
 
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
 const (
 
 	// ErrCodeAttributeDoesNotExist for service response error code
@@ -107,3 +111,465 @@ const (
 	// Too many attributes requested.
 	ErrCodeTooManyRequestedAttributes = "TooManyRequestedAttributes"
 )
+
+// AttributeDoesNotExist is the modeled exception returned for the
+// "AttributeDoesNotExist" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AttributeDoesNotExist struct {
+	awserr.RequestFailure
+}
+
+func newErrorAttributeDoesNotExist(err awserr.RequestFailure) error {
+	return &AttributeDoesNotExist{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AttributeDoesNotExist) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AttributeDoesNotExist) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// DuplicateItemName is the modeled exception returned for the
+// "DuplicateItemName" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type DuplicateItemName struct {
+	awserr.RequestFailure
+}
+
+func newErrorDuplicateItemName(err awserr.RequestFailure) error {
+	return &DuplicateItemName{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *DuplicateItemName) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *DuplicateItemName) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidNextToken is the modeled exception returned for the
+// "InvalidNextToken" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidNextToken struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidNextToken(err awserr.RequestFailure) error {
+	return &InvalidNextToken{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidNextToken) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidNextToken) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidNumberPredicates is the modeled exception returned for the
+// "InvalidNumberPredicates" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidNumberPredicates struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidNumberPredicates(err awserr.RequestFailure) error {
+	return &InvalidNumberPredicates{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidNumberPredicates) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidNumberPredicates) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidNumberValueTests is the modeled exception returned for the
+// "InvalidNumberValueTests" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidNumberValueTests struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidNumberValueTests(err awserr.RequestFailure) error {
+	return &InvalidNumberValueTests{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidNumberValueTests) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidNumberValueTests) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidParameterValue is the modeled exception returned for the
+// "InvalidParameterValue" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidParameterValue struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidParameterValue(err awserr.RequestFailure) error {
+	return &InvalidParameterValue{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidParameterValue) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidParameterValue) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidQueryExpression is the modeled exception returned for the
+// "InvalidQueryExpression" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidQueryExpression struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidQueryExpression(err awserr.RequestFailure) error {
+	return &InvalidQueryExpression{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidQueryExpression) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidQueryExpression) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// MissingParameter is the modeled exception returned for the
+// "MissingParameter" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type MissingParameter struct {
+	awserr.RequestFailure
+}
+
+func newErrorMissingParameter(err awserr.RequestFailure) error {
+	return &MissingParameter{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *MissingParameter) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *MissingParameter) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NoSuchDomain is the modeled exception returned for the
+// "NoSuchDomain" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NoSuchDomain struct {
+	awserr.RequestFailure
+}
+
+func newErrorNoSuchDomain(err awserr.RequestFailure) error {
+	return &NoSuchDomain{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NoSuchDomain) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NoSuchDomain) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NumberDomainAttributesExceeded is the modeled exception returned for the
+// "NumberDomainAttributesExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NumberDomainAttributesExceeded struct {
+	awserr.RequestFailure
+}
+
+func newErrorNumberDomainAttributesExceeded(err awserr.RequestFailure) error {
+	return &NumberDomainAttributesExceeded{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NumberDomainAttributesExceeded) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NumberDomainAttributesExceeded) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NumberDomainBytesExceeded is the modeled exception returned for the
+// "NumberDomainBytesExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NumberDomainBytesExceeded struct {
+	awserr.RequestFailure
+}
+
+func newErrorNumberDomainBytesExceeded(err awserr.RequestFailure) error {
+	return &NumberDomainBytesExceeded{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NumberDomainBytesExceeded) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NumberDomainBytesExceeded) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NumberDomainsExceeded is the modeled exception returned for the
+// "NumberDomainsExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NumberDomainsExceeded struct {
+	awserr.RequestFailure
+}
+
+func newErrorNumberDomainsExceeded(err awserr.RequestFailure) error {
+	return &NumberDomainsExceeded{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NumberDomainsExceeded) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NumberDomainsExceeded) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NumberItemAttributesExceeded is the modeled exception returned for the
+// "NumberItemAttributesExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NumberItemAttributesExceeded struct {
+	awserr.RequestFailure
+}
+
+func newErrorNumberItemAttributesExceeded(err awserr.RequestFailure) error {
+	return &NumberItemAttributesExceeded{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NumberItemAttributesExceeded) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NumberItemAttributesExceeded) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NumberSubmittedAttributesExceeded is the modeled exception returned for the
+// "NumberSubmittedAttributesExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NumberSubmittedAttributesExceeded struct {
+	awserr.RequestFailure
+}
+
+func newErrorNumberSubmittedAttributesExceeded(err awserr.RequestFailure) error {
+	return &NumberSubmittedAttributesExceeded{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NumberSubmittedAttributesExceeded) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NumberSubmittedAttributesExceeded) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NumberSubmittedItemsExceeded is the modeled exception returned for the
+// "NumberSubmittedItemsExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NumberSubmittedItemsExceeded struct {
+	awserr.RequestFailure
+}
+
+func newErrorNumberSubmittedItemsExceeded(err awserr.RequestFailure) error {
+	return &NumberSubmittedItemsExceeded{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NumberSubmittedItemsExceeded) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NumberSubmittedItemsExceeded) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// RequestTimeout is the modeled exception returned for the
+// "RequestTimeout" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type RequestTimeout struct {
+	awserr.RequestFailure
+}
+
+func newErrorRequestTimeout(err awserr.RequestFailure) error {
+	return &RequestTimeout{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *RequestTimeout) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *RequestTimeout) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyRequestedAttributes is the modeled exception returned for the
+// "TooManyRequestedAttributes" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyRequestedAttributes struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyRequestedAttributes(err awserr.RequestFailure) error {
+	return &TooManyRequestedAttributes{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyRequestedAttributes) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyRequestedAttributes) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+var exceptionFromCode = map[string]func(awserr.RequestFailure) error{
+	"AttributeDoesNotExist":             newErrorAttributeDoesNotExist,
+	"DuplicateItemName":                 newErrorDuplicateItemName,
+	"InvalidNextToken":                  newErrorInvalidNextToken,
+	"InvalidNumberPredicates":           newErrorInvalidNumberPredicates,
+	"InvalidNumberValueTests":           newErrorInvalidNumberValueTests,
+	"InvalidParameterValue":             newErrorInvalidParameterValue,
+	"InvalidQueryExpression":            newErrorInvalidQueryExpression,
+	"MissingParameter":                  newErrorMissingParameter,
+	"NoSuchDomain":                      newErrorNoSuchDomain,
+	"NumberDomainAttributesExceeded":    newErrorNumberDomainAttributesExceeded,
+	"NumberDomainBytesExceeded":         newErrorNumberDomainBytesExceeded,
+	"NumberDomainsExceeded":             newErrorNumberDomainsExceeded,
+	"NumberItemAttributesExceeded":      newErrorNumberItemAttributesExceeded,
+	"NumberSubmittedAttributesExceeded": newErrorNumberSubmittedAttributesExceeded,
+	"NumberSubmittedItemsExceeded":      newErrorNumberSubmittedItemsExceeded,
+	"RequestTimeout":                    newErrorRequestTimeout,
+	"TooManyRequestedAttributes":        newErrorTooManyRequestedAttributes,
+}
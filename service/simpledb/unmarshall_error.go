@@ -47,6 +47,10 @@ func (r *xmlErrorResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement)
 	return nil
 }
 
+// unmarshalErrorHandler is the named handler for SimpleDB's custom error
+// unmarshaling, replacing the query protocol error unmarshaler.
+var unmarshalErrorHandler = request.NamedHandler{Name: "awssdk.simpledb.UnmarshalError", Fn: unmarshalError}
+
 func unmarshalError(r *request.Request) {
 	defer r.HTTPResponse.Body.Close()
 	defer io.Copy(ioutil.Discard, r.HTTPResponse.Body)
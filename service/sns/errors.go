@@ -2,6 +2,10 @@
 
 package sns
 
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
 const (
 
 	// ErrCodeAuthorizationErrorException for service response error code
@@ -227,3 +231,924 @@ const (
 	// Indicates that the one-time password (OTP) used for verification is invalid.
 	ErrCodeVerificationException = "VerificationException"
 )
+
+// AuthorizationErrorException is the modeled exception returned for the
+// "AuthorizationError" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type AuthorizationErrorException struct {
+	awserr.RequestFailure
+}
+
+func newErrorAuthorizationErrorException(err awserr.RequestFailure) error {
+	return &AuthorizationErrorException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *AuthorizationErrorException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *AuthorizationErrorException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// BatchEntryIdsNotDistinctException is the modeled exception returned for the
+// "BatchEntryIdsNotDistinct" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type BatchEntryIdsNotDistinctException struct {
+	awserr.RequestFailure
+}
+
+func newErrorBatchEntryIdsNotDistinctException(err awserr.RequestFailure) error {
+	return &BatchEntryIdsNotDistinctException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *BatchEntryIdsNotDistinctException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *BatchEntryIdsNotDistinctException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// BatchRequestTooLongException is the modeled exception returned for the
+// "BatchRequestTooLong" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type BatchRequestTooLongException struct {
+	awserr.RequestFailure
+}
+
+func newErrorBatchRequestTooLongException(err awserr.RequestFailure) error {
+	return &BatchRequestTooLongException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *BatchRequestTooLongException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *BatchRequestTooLongException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ConcurrentAccessException is the modeled exception returned for the
+// "ConcurrentAccess" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ConcurrentAccessException struct {
+	awserr.RequestFailure
+}
+
+func newErrorConcurrentAccessException(err awserr.RequestFailure) error {
+	return &ConcurrentAccessException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ConcurrentAccessException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ConcurrentAccessException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// EmptyBatchRequestException is the modeled exception returned for the
+// "EmptyBatchRequest" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type EmptyBatchRequestException struct {
+	awserr.RequestFailure
+}
+
+func newErrorEmptyBatchRequestException(err awserr.RequestFailure) error {
+	return &EmptyBatchRequestException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *EmptyBatchRequestException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *EmptyBatchRequestException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// EndpointDisabledException is the modeled exception returned for the
+// "EndpointDisabled" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type EndpointDisabledException struct {
+	awserr.RequestFailure
+}
+
+func newErrorEndpointDisabledException(err awserr.RequestFailure) error {
+	return &EndpointDisabledException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *EndpointDisabledException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *EndpointDisabledException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// FilterPolicyLimitExceededException is the modeled exception returned for the
+// "FilterPolicyLimitExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type FilterPolicyLimitExceededException struct {
+	awserr.RequestFailure
+}
+
+func newErrorFilterPolicyLimitExceededException(err awserr.RequestFailure) error {
+	return &FilterPolicyLimitExceededException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *FilterPolicyLimitExceededException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *FilterPolicyLimitExceededException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InternalErrorException is the modeled exception returned for the
+// "InternalError" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InternalErrorException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInternalErrorException(err awserr.RequestFailure) error {
+	return &InternalErrorException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InternalErrorException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InternalErrorException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidBatchEntryIdException is the modeled exception returned for the
+// "InvalidBatchEntryId" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidBatchEntryIdException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidBatchEntryIdException(err awserr.RequestFailure) error {
+	return &InvalidBatchEntryIdException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidBatchEntryIdException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidBatchEntryIdException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidParameterException is the modeled exception returned for the
+// "InvalidParameter" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidParameterException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidParameterException(err awserr.RequestFailure) error {
+	return &InvalidParameterException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidParameterException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidParameterException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidParameterValueException is the modeled exception returned for the
+// "ParameterValueInvalid" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidParameterValueException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidParameterValueException(err awserr.RequestFailure) error {
+	return &InvalidParameterValueException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidParameterValueException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidParameterValueException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidSecurityException is the modeled exception returned for the
+// "InvalidSecurity" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidSecurityException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidSecurityException(err awserr.RequestFailure) error {
+	return &InvalidSecurityException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidSecurityException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidSecurityException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidStateException is the modeled exception returned for the
+// "InvalidState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidStateException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidStateException(err awserr.RequestFailure) error {
+	return &InvalidStateException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidStateException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidStateException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// KMSAccessDeniedException is the modeled exception returned for the
+// "KMSAccessDenied" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type KMSAccessDeniedException struct {
+	awserr.RequestFailure
+}
+
+func newErrorKMSAccessDeniedException(err awserr.RequestFailure) error {
+	return &KMSAccessDeniedException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *KMSAccessDeniedException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *KMSAccessDeniedException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// KMSDisabledException is the modeled exception returned for the
+// "KMSDisabled" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type KMSDisabledException struct {
+	awserr.RequestFailure
+}
+
+func newErrorKMSDisabledException(err awserr.RequestFailure) error {
+	return &KMSDisabledException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *KMSDisabledException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *KMSDisabledException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// KMSInvalidStateException is the modeled exception returned for the
+// "KMSInvalidState" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type KMSInvalidStateException struct {
+	awserr.RequestFailure
+}
+
+func newErrorKMSInvalidStateException(err awserr.RequestFailure) error {
+	return &KMSInvalidStateException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *KMSInvalidStateException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *KMSInvalidStateException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// KMSNotFoundException is the modeled exception returned for the
+// "KMSNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type KMSNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorKMSNotFoundException(err awserr.RequestFailure) error {
+	return &KMSNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *KMSNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *KMSNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// KMSOptInRequired is the modeled exception returned for the
+// "KMSOptInRequired" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type KMSOptInRequired struct {
+	awserr.RequestFailure
+}
+
+func newErrorKMSOptInRequired(err awserr.RequestFailure) error {
+	return &KMSOptInRequired{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *KMSOptInRequired) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *KMSOptInRequired) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// KMSThrottlingException is the modeled exception returned for the
+// "KMSThrottling" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type KMSThrottlingException struct {
+	awserr.RequestFailure
+}
+
+func newErrorKMSThrottlingException(err awserr.RequestFailure) error {
+	return &KMSThrottlingException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *KMSThrottlingException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *KMSThrottlingException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// NotFoundException is the modeled exception returned for the
+// "NotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type NotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorNotFoundException(err awserr.RequestFailure) error {
+	return &NotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *NotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *NotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// OptedOutException is the modeled exception returned for the
+// "OptedOut" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type OptedOutException struct {
+	awserr.RequestFailure
+}
+
+func newErrorOptedOutException(err awserr.RequestFailure) error {
+	return &OptedOutException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *OptedOutException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *OptedOutException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// PlatformApplicationDisabledException is the modeled exception returned for the
+// "PlatformApplicationDisabled" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type PlatformApplicationDisabledException struct {
+	awserr.RequestFailure
+}
+
+func newErrorPlatformApplicationDisabledException(err awserr.RequestFailure) error {
+	return &PlatformApplicationDisabledException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *PlatformApplicationDisabledException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *PlatformApplicationDisabledException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ReplayLimitExceededException is the modeled exception returned for the
+// "ReplayLimitExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ReplayLimitExceededException struct {
+	awserr.RequestFailure
+}
+
+func newErrorReplayLimitExceededException(err awserr.RequestFailure) error {
+	return &ReplayLimitExceededException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ReplayLimitExceededException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ReplayLimitExceededException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ResourceNotFoundException is the modeled exception returned for the
+// "ResourceNotFound" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ResourceNotFoundException struct {
+	awserr.RequestFailure
+}
+
+func newErrorResourceNotFoundException(err awserr.RequestFailure) error {
+	return &ResourceNotFoundException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ResourceNotFoundException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ResourceNotFoundException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// StaleTagException is the modeled exception returned for the
+// "StaleTag" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type StaleTagException struct {
+	awserr.RequestFailure
+}
+
+func newErrorStaleTagException(err awserr.RequestFailure) error {
+	return &StaleTagException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *StaleTagException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *StaleTagException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// SubscriptionLimitExceededException is the modeled exception returned for the
+// "SubscriptionLimitExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type SubscriptionLimitExceededException struct {
+	awserr.RequestFailure
+}
+
+func newErrorSubscriptionLimitExceededException(err awserr.RequestFailure) error {
+	return &SubscriptionLimitExceededException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *SubscriptionLimitExceededException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *SubscriptionLimitExceededException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TagLimitExceededException is the modeled exception returned for the
+// "TagLimitExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TagLimitExceededException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTagLimitExceededException(err awserr.RequestFailure) error {
+	return &TagLimitExceededException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TagLimitExceededException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TagLimitExceededException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TagPolicyException is the modeled exception returned for the
+// "TagPolicy" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TagPolicyException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTagPolicyException(err awserr.RequestFailure) error {
+	return &TagPolicyException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TagPolicyException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TagPolicyException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ThrottledException is the modeled exception returned for the
+// "Throttled" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ThrottledException struct {
+	awserr.RequestFailure
+}
+
+func newErrorThrottledException(err awserr.RequestFailure) error {
+	return &ThrottledException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ThrottledException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ThrottledException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TooManyEntriesInBatchRequestException is the modeled exception returned for the
+// "TooManyEntriesInBatchRequest" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TooManyEntriesInBatchRequestException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTooManyEntriesInBatchRequestException(err awserr.RequestFailure) error {
+	return &TooManyEntriesInBatchRequestException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TooManyEntriesInBatchRequestException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TooManyEntriesInBatchRequestException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// TopicLimitExceededException is the modeled exception returned for the
+// "TopicLimitExceeded" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type TopicLimitExceededException struct {
+	awserr.RequestFailure
+}
+
+func newErrorTopicLimitExceededException(err awserr.RequestFailure) error {
+	return &TopicLimitExceededException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *TopicLimitExceededException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *TopicLimitExceededException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// UserErrorException is the modeled exception returned for the
+// "UserError" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type UserErrorException struct {
+	awserr.RequestFailure
+}
+
+func newErrorUserErrorException(err awserr.RequestFailure) error {
+	return &UserErrorException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *UserErrorException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *UserErrorException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// ValidationException is the modeled exception returned for the
+// "ValidationException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ValidationException struct {
+	awserr.RequestFailure
+}
+
+func newErrorValidationException(err awserr.RequestFailure) error {
+	return &ValidationException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ValidationException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ValidationException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// VerificationException is the modeled exception returned for the
+// "VerificationException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type VerificationException struct {
+	awserr.RequestFailure
+}
+
+func newErrorVerificationException(err awserr.RequestFailure) error {
+	return &VerificationException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *VerificationException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *VerificationException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+var exceptionFromCode = map[string]func(awserr.RequestFailure) error{
+	"AuthorizationError":           newErrorAuthorizationErrorException,
+	"BatchEntryIdsNotDistinct":     newErrorBatchEntryIdsNotDistinctException,
+	"BatchRequestTooLong":          newErrorBatchRequestTooLongException,
+	"ConcurrentAccess":             newErrorConcurrentAccessException,
+	"EmptyBatchRequest":            newErrorEmptyBatchRequestException,
+	"EndpointDisabled":             newErrorEndpointDisabledException,
+	"FilterPolicyLimitExceeded":    newErrorFilterPolicyLimitExceededException,
+	"InternalError":                newErrorInternalErrorException,
+	"InvalidBatchEntryId":          newErrorInvalidBatchEntryIdException,
+	"InvalidParameter":             newErrorInvalidParameterException,
+	"ParameterValueInvalid":        newErrorInvalidParameterValueException,
+	"InvalidSecurity":              newErrorInvalidSecurityException,
+	"InvalidState":                 newErrorInvalidStateException,
+	"KMSAccessDenied":              newErrorKMSAccessDeniedException,
+	"KMSDisabled":                  newErrorKMSDisabledException,
+	"KMSInvalidState":              newErrorKMSInvalidStateException,
+	"KMSNotFound":                  newErrorKMSNotFoundException,
+	"KMSOptInRequired":             newErrorKMSOptInRequired,
+	"KMSThrottling":                newErrorKMSThrottlingException,
+	"NotFound":                     newErrorNotFoundException,
+	"OptedOut":                     newErrorOptedOutException,
+	"PlatformApplicationDisabled":  newErrorPlatformApplicationDisabledException,
+	"ReplayLimitExceeded":          newErrorReplayLimitExceededException,
+	"ResourceNotFound":             newErrorResourceNotFoundException,
+	"StaleTag":                     newErrorStaleTagException,
+	"SubscriptionLimitExceeded":    newErrorSubscriptionLimitExceededException,
+	"TagLimitExceeded":             newErrorTagLimitExceededException,
+	"TagPolicy":                    newErrorTagPolicyException,
+	"Throttled":                    newErrorThrottledException,
+	"TooManyEntriesInBatchRequest": newErrorTooManyEntriesInBatchRequestException,
+	"TopicLimitExceeded":           newErrorTopicLimitExceededException,
+	"UserError":                    newErrorUserErrorException,
+	"ValidationException":          newErrorValidationException,
+	"VerificationException":        newErrorVerificationException,
+}
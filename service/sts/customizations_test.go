@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/corehandlers"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/awstesting/unit"
@@ -90,3 +91,36 @@ func TestSTSCustomRetryErrorCodes(t *testing.T) {
 		t.Errorf("expect %v requests, got %v", e, a)
 	}
 }
+
+func TestModeledException_BatchedErrors(t *testing.T) {
+	svc := sts.New(unit.Session, &aws.Config{
+		Region: aws.String("mock-region"),
+	})
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *request.Request) {
+		r.HTTPResponse = &http.Response{
+			StatusCode: 400,
+			Header:     http.Header{},
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(
+				`<ErrorResponse><Error><Code>ExpiredTokenException</Code><Message>token expired</Message></Error><RequestId>abc123</RequestId></ErrorResponse>`))),
+		}
+	})
+
+	_, err := svc.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err == nil {
+		t.Fatalf("expect error, got nil")
+	}
+	expired, ok := err.(*sts.ExpiredTokenException)
+	if !ok {
+		t.Fatalf("expect %T error, got %T, %v", expired, err, err)
+	}
+
+	batched, ok := err.(awserr.BatchedErrors)
+	if !ok {
+		t.Fatalf("expect error to implement awserr.BatchedErrors, got %T", err)
+	}
+	expect := expired.RequestFailure.(awserr.BatchedErrors).OrigErrs()
+	if e, a := len(expect), len(batched.OrigErrs()); e != a {
+		t.Errorf("expect %v original errors, got %v", e, a)
+	}
+}
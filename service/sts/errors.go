@@ -2,6 +2,10 @@
 
 package sts
 
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
 const (
 
 	// ErrCodeExpiredTokenException for service response error code
@@ -82,3 +86,222 @@ const (
 	// in the IAM User Guide.
 	ErrCodeRegionDisabledException = "RegionDisabledException"
 )
+
+// ExpiredTokenException is the modeled exception returned for the
+// "ExpiredTokenException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type ExpiredTokenException struct {
+	awserr.RequestFailure
+}
+
+func newErrorExpiredTokenException(err awserr.RequestFailure) error {
+	return &ExpiredTokenException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *ExpiredTokenException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *ExpiredTokenException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// IDPCommunicationErrorException is the modeled exception returned for the
+// "IDPCommunicationError" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type IDPCommunicationErrorException struct {
+	awserr.RequestFailure
+}
+
+func newErrorIDPCommunicationErrorException(err awserr.RequestFailure) error {
+	return &IDPCommunicationErrorException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *IDPCommunicationErrorException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *IDPCommunicationErrorException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// IDPRejectedClaimException is the modeled exception returned for the
+// "IDPRejectedClaim" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type IDPRejectedClaimException struct {
+	awserr.RequestFailure
+}
+
+func newErrorIDPRejectedClaimException(err awserr.RequestFailure) error {
+	return &IDPRejectedClaimException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *IDPRejectedClaimException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *IDPRejectedClaimException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidAuthorizationMessageException is the modeled exception returned for the
+// "InvalidAuthorizationMessageException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidAuthorizationMessageException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidAuthorizationMessageException(err awserr.RequestFailure) error {
+	return &InvalidAuthorizationMessageException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidAuthorizationMessageException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidAuthorizationMessageException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// InvalidIdentityTokenException is the modeled exception returned for the
+// "InvalidIdentityToken" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type InvalidIdentityTokenException struct {
+	awserr.RequestFailure
+}
+
+func newErrorInvalidIdentityTokenException(err awserr.RequestFailure) error {
+	return &InvalidIdentityTokenException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *InvalidIdentityTokenException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *InvalidIdentityTokenException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// MalformedPolicyDocumentException is the modeled exception returned for the
+// "MalformedPolicyDocument" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type MalformedPolicyDocumentException struct {
+	awserr.RequestFailure
+}
+
+func newErrorMalformedPolicyDocumentException(err awserr.RequestFailure) error {
+	return &MalformedPolicyDocumentException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *MalformedPolicyDocumentException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *MalformedPolicyDocumentException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// PackedPolicyTooLargeException is the modeled exception returned for the
+// "PackedPolicyTooLarge" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type PackedPolicyTooLargeException struct {
+	awserr.RequestFailure
+}
+
+func newErrorPackedPolicyTooLargeException(err awserr.RequestFailure) error {
+	return &PackedPolicyTooLargeException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *PackedPolicyTooLargeException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *PackedPolicyTooLargeException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+// RegionDisabledException is the modeled exception returned for the
+// "RegionDisabledException" error code. Use errors.As to match the error
+// returned by an API operation against this type.
+type RegionDisabledException struct {
+	awserr.RequestFailure
+}
+
+func newErrorRegionDisabledException(err awserr.RequestFailure) error {
+	return &RegionDisabledException{RequestFailure: err}
+}
+
+// Unwrap returns the awserr.RequestFailure the exception wraps.
+func (e *RegionDisabledException) Unwrap() error {
+	return e.RequestFailure
+}
+
+// OrigErrs returns the original errors of the awserr.RequestFailure
+// the exception wraps, if it is an awserr.BatchedErrors. Otherwise the
+// wrapped error's original error is returned.
+func (e *RegionDisabledException) OrigErrs() []error {
+	if b, ok := e.RequestFailure.(awserr.BatchedErrors); ok {
+		return b.OrigErrs()
+	}
+	return []error{e.OrigErr()}
+}
+
+var exceptionFromCode = map[string]func(awserr.RequestFailure) error{
+	"ExpiredTokenException":                newErrorExpiredTokenException,
+	"IDPCommunicationError":                newErrorIDPCommunicationErrorException,
+	"IDPRejectedClaim":                     newErrorIDPRejectedClaimException,
+	"InvalidAuthorizationMessageException": newErrorInvalidAuthorizationMessageException,
+	"InvalidIdentityToken":                 newErrorInvalidIdentityTokenException,
+	"MalformedPolicyDocument":              newErrorMalformedPolicyDocumentException,
+	"PackedPolicyTooLarge":                 newErrorPackedPolicyTooLargeException,
+	"RegionDisabledException":              newErrorRegionDisabledException,
+}